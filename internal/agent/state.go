@@ -4,9 +4,11 @@ package agent
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/gofrs/flock"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -65,12 +67,62 @@ func (m *StateManager[T]) Load() (*T, error) {
 	return &state, nil
 }
 
-// Save persists agent state to disk using atomic write.
+// LoadShared is Load under a shared hold of the lock Save takes, so it
+// waits out a save in progress. Where the lock can't be taken (no state
+// directory yet, or a read-only one) it falls back to a plain Load, which
+// the atomic writes in Save already keep from seeing a partial file.
+func (m *StateManager[T]) LoadShared() (*T, error) {
+	lock := flock.New(m.stateFilePath + ".lock")
+	if err := lock.RLock(); err == nil {
+		defer func() { _ = lock.Unlock() }()
+	}
+	return m.Load()
+}
+
+// Save persists agent state to disk using atomic write, so readers always
+// see a complete file. Writers hold an advisory lock on a sibling .lock
+// file, so concurrent gt invocations saving the same state take turns.
 func (m *StateManager[T]) Save(state *T) error {
-	dir := filepath.Dir(m.stateFilePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
+	unlock, err := m.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return util.AtomicWriteJSON(m.stateFilePath, state)
+}
+
+// Update loads the state, applies fn and saves the result, holding the
+// lock Save takes throughout so no other writer can save in between and
+// have its changes overwritten. If fn returns an error nothing is saved
+// and Update returns it.
+func (m *StateManager[T]) Update(fn func(*T) error) error {
+	unlock, err := m.lock()
+	if err != nil {
 		return err
 	}
+	defer unlock()
 
+	state, err := m.Load()
+	if err != nil {
+		return err
+	}
+	if err := fn(state); err != nil {
+		return err
+	}
 	return util.AtomicWriteJSON(m.stateFilePath, state)
 }
+
+// lock takes the writers' lock, creating the state directory if needed.
+func (m *StateManager[T]) lock() (unlock func(), err error) {
+	dir := filepath.Dir(m.stateFilePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	lock := flock.New(m.stateFilePath + ".lock")
+	if err := lock.Lock(); err != nil {
+		return nil, fmt.Errorf("locking state file: %w", err)
+	}
+	return func() { _ = lock.Unlock() }, nil
+}
@@ -1,9 +1,13 @@
 package agent
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/gofrs/flock"
 )
 
 func TestStateConstants(t *testing.T) {
@@ -182,6 +186,94 @@ func TestStateManager_GenericType(t *testing.T) {
 	}
 }
 
+func TestStateManager_LoadShared(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager := NewStateManager[TestState](tmpDir, "state.json", func() *TestState {
+		return &TestState{Value: "default"}
+	})
+
+	// No state directory yet: falls back to a plain Load
+	state, err := manager.LoadShared()
+	if err != nil || state.Value != "default" {
+		t.Fatalf("LoadShared() with no state dir = %+v, %v", state, err)
+	}
+
+	if err := manager.Save(&TestState{Value: "saved"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// A writer holding the lock keeps the read waiting
+	writer := flock.New(manager.StateFile() + ".lock")
+	if err := writer.Lock(); err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	loaded := make(chan *TestState, 1)
+	go func() {
+		s, _ := manager.LoadShared()
+		loaded <- s
+	}()
+	select {
+	case <-loaded:
+		t.Fatal("LoadShared() returned while a save held the lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+	if err := writer.Unlock(); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+	select {
+	case s := <-loaded:
+		if s == nil || s.Value != "saved" {
+			t.Errorf("LoadShared() = %+v, want value %q", s, "saved")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("LoadShared() still waiting after the lock was released")
+	}
+}
+
+func TestStateManager_Update(t *testing.T) {
+	tmpDir := t.TempDir()
+	manager := NewStateManager[TestState](tmpDir, "state.json", func() *TestState {
+		return &TestState{Value: "default"}
+	})
+
+	// Concurrent updates each see the others' saves
+	const n = 20
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			errs <- manager.Update(func(s *TestState) error {
+				s.Count++
+				return nil
+			})
+		}()
+	}
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	}
+	state, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if state.Count != n || state.Value != "default" {
+		t.Errorf("after %d updates: %+v, want count %d", n, state, n)
+	}
+
+	// A failing update saves nothing
+	failed := errors.New("no")
+	err = manager.Update(func(s *TestState) error {
+		s.Value = "changed"
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Errorf("Update() = %v, want %v", err, failed)
+	}
+	if state, _ := manager.Load(); state.Value != "default" {
+		t.Errorf("failed Update() saved value %q", state.Value)
+	}
+}
+
 // TestState is a simple type for testing
 type TestState struct {
 	Value string `json:"value"`
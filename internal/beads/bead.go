@@ -0,0 +1,80 @@
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Bead is an issue as bd show reports it, with the attachment fields gt
+// keeps in its description parsed out. Description still holds the raw
+// text, for fields that aren't modeled here.
+type Bead struct {
+	Issue
+
+	AttachedMolecule string // Root issue ID of the attached molecule
+	AttachedAt       string // ISO 8601 timestamp when attached
+	AttachedArgs     string // Args passed via gt sling --args
+	DispatchedBy     string // Agent ID that dispatched the work
+}
+
+// Show fetches a bead with bd show from the current directory. Unlike
+// Beads.Show it doesn't pin BEADS_DIR, so bd's prefix-based routing
+// (routes.jsonl) resolves town and rig beads alike.
+func Show(id string) (*Bead, error) {
+	return ShowFrom("", id)
+}
+
+// ShowFrom is Show run from dir, e.g. the town root so bd finds
+// routes.jsonl. Uses --no-daemon with --allow-stale: a database out of
+// sync with its JSONL shouldn't hide a bead. Returns ErrNotFound for a
+// bead bd doesn't know.
+func ShowFrom(dir, id string) (*Bead, error) {
+	cmd, cancel := Command("--no-daemon", "show", id, "--json", "--allow-stale")
+	defer cancel()
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("bd show %s: %w", id, err)
+	}
+	return ParseShow(out)
+}
+
+// ParseShow parses bd show --json output: an array whose first element
+// is the bead. Empty output, which bd --no-daemon prints with exit status
+// 0 for an unknown bead, is ErrNotFound.
+func ParseShow(out []byte) (*Bead, error) {
+	if len(out) == 0 {
+		return nil, ErrNotFound
+	}
+	var issues []Issue
+	if err := json.Unmarshal(out, &issues); err != nil {
+		return nil, fmt.Errorf("parsing bd show output: %w", err)
+	}
+	if len(issues) == 0 {
+		return nil, ErrNotFound
+	}
+	return NewBead(&issues[0]), nil
+}
+
+// NewBead wraps issue, parsing its attachment fields.
+func NewBead(issue *Issue) *Bead {
+	b := &Bead{Issue: *issue}
+	if fields := ParseAttachmentFields(issue); fields != nil {
+		b.AttachedMolecule = fields.AttachedMolecule
+		b.AttachedAt = fields.AttachedAt
+		b.AttachedArgs = fields.AttachedArgs
+		b.DispatchedBy = fields.DispatchedBy
+	}
+	return b
+}
+
+// Attachment returns the bead's attachment fields, for changing them with
+// SetAttachmentFields. Never nil.
+func (b *Bead) Attachment() *AttachmentFields {
+	return &AttachmentFields{
+		AttachedMolecule: b.AttachedMolecule,
+		AttachedAt:       b.AttachedAt,
+		AttachedArgs:     b.AttachedArgs,
+		DispatchedBy:     b.DispatchedBy,
+	}
+}
@@ -0,0 +1,73 @@
+package beads
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseShow(t *testing.T) {
+	out := []byte(`[{"id":"gt-abc","title":"Fix it","status":"pinned","assignee":"gastown/polecats/Toast",` +
+		`"description":"role: polecat\nattached_molecule: gt-mol-1\nattached_at: 2026-01-05T12:00:00Z\ndispatched_by: mayor"},` +
+		`{"id":"gt-dependent"}]`)
+
+	bead, err := ParseShow(out)
+	if err != nil {
+		t.Fatalf("ParseShow: %v", err)
+	}
+	if bead.ID != "gt-abc" || bead.Status != "pinned" || bead.Assignee != "gastown/polecats/Toast" {
+		t.Errorf("bead = %+v", bead.Issue)
+	}
+	if bead.AttachedMolecule != "gt-mol-1" || bead.AttachedAt != "2026-01-05T12:00:00Z" || bead.DispatchedBy != "mayor" {
+		t.Errorf("attachment = %q %q %q", bead.AttachedMolecule, bead.AttachedAt, bead.DispatchedBy)
+	}
+	if bead.Description == "" {
+		t.Error("raw description should be kept")
+	}
+
+	fields := bead.Attachment()
+	fields.AttachedArgs = "be careful"
+	desc := SetAttachmentFields(&bead.Issue, fields)
+	if got := NewBead(&Issue{Description: desc}); got.AttachedArgs != "be careful" || got.AttachedMolecule != "gt-mol-1" {
+		t.Errorf("round trip = %+v", got)
+	}
+}
+
+func TestParseShow_NotFound(t *testing.T) {
+	for _, out := range []string{"", "[]"} {
+		if _, err := ParseShow([]byte(out)); !errors.Is(err, ErrNotFound) {
+			t.Errorf("ParseShow(%q) = %v, want ErrNotFound", out, err)
+		}
+	}
+	if _, err := ParseShow([]byte("not json")); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("ParseShow(garbage) = %v, want a parse error", err)
+	}
+}
+
+func TestShowFrom(t *testing.T) {
+	binDir := t.TempDir()
+	script := `#!/bin/sh
+[ "$1" = "--no-daemon" ] || exit 1
+case "$3" in
+  gt-abc) echo '[{"id":"gt-abc","description":"attached_molecule: gt-mol-1"}]' ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	bead, err := ShowFrom(t.TempDir(), "gt-abc")
+	if err != nil {
+		t.Fatalf("ShowFrom: %v", err)
+	}
+	if bead.AttachedMolecule != "gt-mol-1" {
+		t.Errorf("AttachedMolecule = %q, want gt-mol-1", bead.AttachedMolecule)
+	}
+	// bd --no-daemon exits 0 with no output for an unknown bead
+	if _, err := ShowFrom("", "gt-missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ShowFrom(missing) = %v, want ErrNotFound", err)
+	}
+}
@@ -3,6 +3,7 @@ package beads
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/runtime"
 )
@@ -113,6 +116,10 @@ type SyncStatus struct {
 type Beads struct {
 	workDir  string
 	beadsDir string // Optional BEADS_DIR override for cross-database access
+	daemon   bool   // Go through the bd daemon; see WithDaemon
+
+	mu       sync.Mutex
+	warnings []string // Stale-read warnings from the last command
 }
 
 // New creates a new Beads wrapper for the given directory.
@@ -126,14 +133,104 @@ func NewWithBeadsDir(workDir, beadsDir string) *Beads {
 	return &Beads{workDir: workDir, beadsDir: beadsDir}
 }
 
+// WithDaemon returns a copy of b whose bd commands go through the bd
+// daemon, which coalesces writes, instead of opening the database
+// directly. Reads through it see the daemon's view, so nothing is stale.
+func (b *Beads) WithDaemon() *Beads {
+	return &Beads{workDir: b.workDir, beadsDir: b.beadsDir, daemon: true}
+}
+
+// callTimeout bounds each bd invocation; see SetCallTimeout.
+var (
+	callTimeoutMu sync.RWMutex
+	callTimeout   time.Duration
+)
+
+// SetCallTimeout bounds every bd invocation: one still running after d is
+// killed and returns a timed-out error (gt's --call-timeout). Zero, the
+// default, sets no bound. A retry is a new invocation with a new bound.
+func SetCallTimeout(d time.Duration) {
+	callTimeoutMu.Lock()
+	callTimeout = d
+	callTimeoutMu.Unlock()
+}
+
+// callContext returns the context a single bd invocation runs under,
+// bounded by the call timeout if one is set. The caller must call cancel
+// once the invocation is done.
+func callContext() (context.Context, context.CancelFunc) {
+	callTimeoutMu.RLock()
+	timeout := callTimeout
+	callTimeoutMu.RUnlock()
+	if timeout <= 0 {
+		return context.Background(), func() {}
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// Command returns an *exec.Cmd that runs bd with args within the call
+// timeout Beads commands run under (see SetCallTimeout), and the cancel
+// func that releases the timeout; defer it the way runOnce does. Use it
+// for bd invocations that don't fit a Beads, such as ones routed from
+// another directory or talking to the daemon.
+func Command(args ...string) (*exec.Cmd, context.CancelFunc) {
+	ctx, cancel := callContext()
+	return exec.CommandContext(ctx, "bd", args...), cancel //nolint:gosec // G204: bd is a trusted internal tool
+}
+
 // run executes a bd command and returns stdout.
+// Transient failures (lock contention, busy daemon) of read-only commands
+// are retried with exponential backoff; see BDRetriesEnv. A command cut off
+// by the call timeout is not retried.
 func (b *Beads) run(args ...string) ([]byte, error) {
+	retries := bdRetries()
+	for attempt := 0; ; attempt++ {
+		out, stderr, err := b.runOnce(args)
+		if err == nil {
+			b.setWarnings(staleReadWarnings(stderr))
+			return out, nil
+		}
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, fmt.Errorf("bd %s: timed out: %w", args[0], err)
+		}
+		if attempt >= retries || !isReadOnlyBDCommand(args) || !isRetryableBDError(stderr) {
+			b.setWarnings(staleReadWarnings(stderr))
+			return nil, b.wrapError(err, stderr, args)
+		}
+		time.Sleep(bdRetryDelay(attempt))
+	}
+}
+
+// Warnings returns the stale-read warnings bd wrote to stderr during the
+// most recent command run through b, or nil if there were none. The data
+// such a command returned is still valid, but may lag behind the JSONL.
+func (b *Beads) Warnings() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.warnings...)
+}
+
+func (b *Beads) setWarnings(warnings []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.warnings = warnings
+}
+
+// runOnce executes a single bd invocation, returning stdout and stderr.
+// Only stdout is data; stderr is diagnostics, returned on success too so
+// that run can pick out stale-read warnings.
+func (b *Beads) runOnce(args []string) ([]byte, string, error) {
 	// Use --no-daemon for faster read operations (avoids daemon IPC overhead)
 	// The daemon is primarily useful for write coalescing, not reads.
 	// Use --allow-stale to prevent failures when db is out of sync with JSONL
 	// (e.g., after daemon is killed during shutdown before syncing).
 	fullArgs := append([]string{"--no-daemon", "--allow-stale"}, args...)
-	cmd := exec.Command("bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
+	if b.daemon {
+		fullArgs = args
+	}
+	ctx, cancel := callContext()
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = b.workDir
 
 	// Always explicitly set BEADS_DIR to prevent inherited env vars from
@@ -149,19 +246,23 @@ func (b *Beads) run(args ...string) ([]byte, error) {
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
-	err := cmd.Run()
-	if err != nil {
-		return nil, b.wrapError(err, stderr.String(), args)
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, stderr.String(), ctxErr
+		}
+		return nil, stderr.String(), err
 	}
 
 	// Handle bd --no-daemon exit code 0 bug: when issue not found,
 	// --no-daemon exits 0 but writes error to stderr with empty stdout.
 	// Detect this case and treat as error to avoid JSON parse failures.
-	if stdout.Len() == 0 && stderr.Len() > 0 {
-		return nil, b.wrapError(fmt.Errorf("command produced no output"), stderr.String(), args)
+	// Stale-read warnings alone don't count: commands that print nothing
+	// still get them.
+	if stdout.Len() == 0 && hasNonWarningOutput(stderr.String()) {
+		return nil, stderr.String(), fmt.Errorf("command produced no output")
 	}
 
-	return stdout.Bytes(), nil
+	return stdout.Bytes(), stderr.String(), nil
 }
 
 // Run executes a bd command and returns stdout.
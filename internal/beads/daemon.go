@@ -38,7 +38,8 @@ type BdDaemonHealth struct {
 // CheckBdDaemonHealth checks the health of all bd daemons.
 // Returns nil if no daemons are running (which is fine, bd will use direct mode).
 func CheckBdDaemonHealth() (*BdDaemonHealth, error) {
-	cmd := exec.Command("bd", "daemon", "health", "--json")
+	cmd, cancel := Command("daemon", "health", "--json")
+	defer cancel()
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -124,7 +125,8 @@ func restartBdDaemons() error { //nolint:unparam // error return kept for future
 // StartBdDaemonIfNeeded starts the bd daemon for a specific workspace if not running.
 // This is a best-effort operation - failures are logged but don't block execution.
 func StartBdDaemonIfNeeded(workDir string) error {
-	cmd := exec.Command("bd", "daemon", "--start")
+	cmd, cancel := Command("daemon", "--start")
+	defer cancel()
 	cmd.Dir = workDir
 	return cmd.Run()
 }
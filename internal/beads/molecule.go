@@ -4,10 +4,15 @@ package beads
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
 
+// PolecatWorkMolecule is the formula gt sling attaches to a polecat's
+// agent bead along with its work.
+const PolecatWorkMolecule = "mol-polecat-work"
+
 // MoleculeStep represents a parsed step from a molecule definition.
 type MoleculeStep struct {
 	Ref          string         // Step reference (from "## Step: <ref>")
@@ -219,6 +224,41 @@ func parseBackoffConfig(configStr string) *BackoffConfig {
 	return cfg
 }
 
+// Cook runs bd cook on a formula so its proto molecule exists, passing
+// vars as --var key=value template variables. Cooking is idempotent, so
+// callers cook before every attach or pour rather than checking first.
+func (b *Beads) Cook(molecule string, vars map[string]string) error {
+	args, err := cookArgs(molecule, vars)
+	if err != nil {
+		return err
+	}
+	if _, err := b.run(args...); err != nil {
+		return fmt.Errorf("cooking %s: %w", molecule, err)
+	}
+	return nil
+}
+
+// cookArgs builds the bd cook arguments, without the global flags run
+// adds. Variables are sorted by name so the invocation is stable.
+func cookArgs(molecule string, vars map[string]string) ([]string, error) {
+	if molecule == "" || strings.HasPrefix(molecule, "-") {
+		return nil, fmt.Errorf("invalid molecule name %q", molecule)
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		if name == "" || strings.ContainsAny(name, "= ") {
+			return nil, fmt.Errorf("invalid template variable name %q for %s", name, molecule)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	args := []string{"cook", molecule}
+	for _, name := range names {
+		args = append(args, "--var", name+"="+vars[name])
+	}
+	return args, nil
+}
+
 // ExpandTemplateVars replaces {{variable}} placeholders in text using the provided context map.
 // Unknown variables are left as-is.
 func ExpandTemplateVars(text string, ctx map[string]string) string {
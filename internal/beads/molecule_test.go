@@ -1,6 +1,8 @@
 package beads
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -787,3 +789,46 @@ Needs: step1`
 		t.Errorf("step[1].Type = %q, want task", steps[1].Type)
 	}
 }
+
+func TestCook(t *testing.T) {
+	binDir := t.TempDir()
+	argsFile := filepath.Join(binDir, "args")
+	script := `#!/bin/sh
+echo "$@" >> "` + argsFile + `"
+echo "Cooked"
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	b := New(t.TempDir())
+	if err := b.Cook(PolecatWorkMolecule, nil); err != nil {
+		t.Fatalf("Cook: %v", err)
+	}
+	if err := b.Cook("mol-review", map[string]string{"issue": "gt-1", "feature": "Add x"}); err != nil {
+		t.Fatalf("Cook with vars: %v", err)
+	}
+	data, err := os.ReadFile(argsFile)
+	if err != nil {
+		t.Fatalf("reading bd args: %v", err)
+	}
+	want := "--no-daemon --allow-stale cook mol-polecat-work\n" +
+		"--no-daemon --allow-stale cook mol-review --var feature=Add x --var issue=gt-1\n"
+	if string(data) != want {
+		t.Errorf("bd invocations =\n%s\nwant\n%s", data, want)
+	}
+
+	for _, tc := range []struct {
+		molecule string
+		vars     map[string]string
+	}{
+		{"", nil},
+		{"--help", nil},
+		{"mol-x", map[string]string{"a=b": "c"}},
+	} {
+		if err := b.Cook(tc.molecule, tc.vars); err == nil {
+			t.Errorf("Cook(%q, %v) = nil, want error", tc.molecule, tc.vars)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package beads
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BDRetriesEnv overrides how many times a failed read-only bd command is
+// retried when the failure looks transient. Set it to 0 to disable retries.
+// Commands that write are never retried: a write that timed out or hit a
+// busy daemon may already have landed, and running it again could create
+// a duplicate issue or repeat a status change.
+const BDRetriesEnv = "GT_BD_RETRIES"
+
+// DefaultBDRetries is the retry count used when BDRetriesEnv is unset.
+const DefaultBDRetries = 3
+
+// bdRetryBaseDelay is the wait before the first retry; each further retry
+// doubles it. Tests shrink it to keep runs fast.
+var bdRetryBaseDelay = 200 * time.Millisecond
+
+// retryableBDErrors are stderr fragments bd emits for transient conditions.
+// ZFC: like ErrNotFound, this is a narrow exception to not parsing stderr -
+// it only decides whether to try the same command again, never what to do.
+var retryableBDErrors = []string{
+	"database is locked",
+	"database table is locked",
+	"sqlite_busy",
+	"lock contention",
+	"resource temporarily unavailable",
+	"daemon busy",
+	"daemon is busy",
+}
+
+// readOnlyBDCommands are the bd subcommands safe to run again after a
+// transient failure. Entries with a space name a subcommand of a group
+// (bd slot get), which is only read-only when its second word is.
+var readOnlyBDCommands = map[string]bool{
+	"show":             true,
+	"list":             true,
+	"ready":            true,
+	"blocked":          true,
+	"stats":            true,
+	"search":           true,
+	"count":            true,
+	"slot get":         true,
+	"merge-slot check": true,
+}
+
+// isReadOnlyBDCommand reports whether args run a bd command that only
+// reads, and so may be retried.
+func isReadOnlyBDCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	if readOnlyBDCommands[args[0]] {
+		return true
+	}
+	return len(args) > 1 && readOnlyBDCommands[args[0]+" "+args[1]]
+}
+
+// bdRetries returns the configured retry count for bd commands.
+func bdRetries() int {
+	if v := os.Getenv(BDRetriesEnv); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return DefaultBDRetries
+}
+
+// bdRetryDelay returns the backoff before retry number attempt (0-based).
+func bdRetryDelay(attempt int) time.Duration {
+	return bdRetryBaseDelay << attempt
+}
+
+// isRetryableBDError reports whether bd's stderr describes a transient
+// failure worth retrying. Logical failures such as a missing issue are not.
+func isRetryableBDError(stderr string) bool {
+	lower := strings.ToLower(stderr)
+	if strings.Contains(lower, "not found") {
+		return false
+	}
+	for _, frag := range retryableBDErrors {
+		if strings.Contains(lower, frag) {
+			return true
+		}
+	}
+	return false
+}
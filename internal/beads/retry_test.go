@@ -0,0 +1,180 @@
+package beads
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// installFlakyBD puts a bd stub on PATH that fails with stderr failMsg for
+// the first failures calls and then prints a single issue. It returns a
+// function reporting how many times bd was invoked.
+func installFlakyBD(t *testing.T, failures int, failMsg string) func() int {
+	t.Helper()
+	dir := t.TempDir()
+	countFile := filepath.Join(dir, "count")
+	script := `#!/bin/sh
+n=$(cat "` + countFile + `" 2>/dev/null || echo 0)
+n=$((n + 1))
+echo "$n" > "` + countFile + `"
+if [ "$n" -le ` + strconv.Itoa(failures) + ` ]; then
+  echo "` + failMsg + `" >&2
+  exit 1
+fi
+echo '[{"id":"gt-abc","title":"ok","status":"open"}]'
+`
+	if err := os.WriteFile(filepath.Join(dir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	old := bdRetryBaseDelay
+	bdRetryBaseDelay = time.Millisecond
+	t.Cleanup(func() { bdRetryBaseDelay = old })
+
+	return func() int {
+		data, _ := os.ReadFile(countFile)
+		n, _ := strconv.Atoi(strings.TrimSpace(string(data)))
+		return n
+	}
+}
+
+func TestRun_RetriesTransientErrors(t *testing.T) {
+	calls := installFlakyBD(t, 2, "Error: database is locked")
+
+	issue, err := New(t.TempDir()).Show("gt-abc")
+	if err != nil {
+		t.Fatalf("Show after transient failures: %v", err)
+	}
+	if issue.ID != "gt-abc" {
+		t.Errorf("issue ID = %q, want gt-abc", issue.ID)
+	}
+	if got := calls(); got != 3 {
+		t.Errorf("bd calls = %d, want 3", got)
+	}
+}
+
+func TestRun_GivesUpAfterRetries(t *testing.T) {
+	t.Setenv(BDRetriesEnv, "1")
+	calls := installFlakyBD(t, 5, "Error: daemon busy")
+
+	if _, err := New(t.TempDir()).Show("gt-abc"); err == nil {
+		t.Fatal("expected error once retries are exhausted")
+	}
+	if got := calls(); got != 2 {
+		t.Errorf("bd calls = %d, want 2 (1 attempt + 1 retry)", got)
+	}
+}
+
+func TestRun_DoesNotRetryLogicalErrors(t *testing.T) {
+	calls := installFlakyBD(t, 5, "Error: issue not found: gt-abc")
+
+	_, err := New(t.TempDir()).Show("gt-abc")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("err = %v, want ErrNotFound", err)
+	}
+	if got := calls(); got != 1 {
+		t.Errorf("bd calls = %d, want 1", got)
+	}
+}
+
+func TestRun_DoesNotRetryWrites(t *testing.T) {
+	calls := installFlakyBD(t, 5, "Error: database is locked")
+
+	if err := New(t.TempDir()).Close("gt-abc"); err == nil {
+		t.Fatal("expected Close to fail")
+	}
+	if got := calls(); got != 1 {
+		t.Errorf("bd calls = %d, want 1 (writes are not retried)", got)
+	}
+}
+
+func TestIsReadOnlyBDCommand(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"show", "gt-abc", "--json"}, true},
+		{[]string{"list", "--json"}, true},
+		{[]string{"slot", "get", "gt-abc", "hook"}, true},
+		{[]string{"merge-slot", "check", "--json"}, true},
+		{[]string{"create", "--json"}, false},
+		{[]string{"update", "gt-abc"}, false},
+		{[]string{"close", "gt-abc"}, false},
+		{[]string{"slot", "set", "gt-abc", "hook", "gt-def"}, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := isReadOnlyBDCommand(tt.args); got != tt.want {
+			t.Errorf("isReadOnlyBDCommand(%q) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestBDRetries(t *testing.T) {
+	tests := []struct {
+		env  string
+		want int
+	}{
+		{"", DefaultBDRetries},
+		{"0", 0},
+		{"5", 5},
+		{"-1", DefaultBDRetries},
+		{"lots", DefaultBDRetries},
+	}
+	for _, tt := range tests {
+		t.Setenv(BDRetriesEnv, tt.env)
+		if got := bdRetries(); got != tt.want {
+			t.Errorf("bdRetries() with %s=%q = %d, want %d", BDRetriesEnv, tt.env, got, tt.want)
+		}
+	}
+}
+
+func TestBDRetryDelay(t *testing.T) {
+	base := bdRetryBaseDelay
+	for attempt, want := range []time.Duration{base, 2 * base, 4 * base} {
+		if got := bdRetryDelay(attempt); got != want {
+			t.Errorf("bdRetryDelay(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
+
+func TestCallTimeout_KillsEachCall(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "bd"), []byte("#!/bin/sh\nexec sleep 10\n"), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	SetCallTimeout(100 * time.Millisecond)
+	t.Cleanup(func() { SetCallTimeout(0) })
+
+	// Each call gets the full bound, however long the process has run
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		_, err := New(t.TempDir()).Show("gt-abc")
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Show %d past the call timeout = %v, want context.DeadlineExceeded", i, err)
+		}
+		if !strings.Contains(err.Error(), "timed out") {
+			t.Errorf("error %q should say it timed out", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Show %d took %v; bd should have been killed at the call timeout", i, elapsed)
+		}
+	}
+
+	cmd, cancel := Command("show", "gt-abc")
+	defer cancel()
+	start := time.Now()
+	if err := cmd.Run(); err == nil {
+		t.Error("Command past the call timeout succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Command took %v; bd should have been killed at the call timeout", elapsed)
+	}
+}
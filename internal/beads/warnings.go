@@ -0,0 +1,39 @@
+package beads
+
+import "strings"
+
+// staleReadWarningPrefix starts the line bd writes to stderr when
+// --allow-stale lets it read from a database older than the JSONL.
+// Only this exact prefix counts: a real error that merely mentions a stale
+// lock or an out-of-sync database must still surface as an error.
+// ZFC: like retryableBDErrors, this is a narrow exception to not parsing
+// stderr - it only sorts diagnostics from failures, never decides anything.
+const staleReadWarningPrefix = "Warning: database is stale"
+
+// isStaleReadWarning reports whether a line of bd's stderr is a stale-read
+// warning.
+func isStaleReadWarning(line string) bool {
+	return strings.HasPrefix(line, staleReadWarningPrefix)
+}
+
+// staleReadWarnings returns the stale-read warning lines of bd's stderr.
+func staleReadWarnings(stderr string) []string {
+	var warnings []string
+	for _, line := range strings.Split(stderr, "\n") {
+		if line = strings.TrimSpace(line); line != "" && isStaleReadWarning(line) {
+			warnings = append(warnings, line)
+		}
+	}
+	return warnings
+}
+
+// hasNonWarningOutput reports whether bd's stderr holds anything besides
+// stale-read warnings.
+func hasNonWarningOutput(stderr string) bool {
+	for _, line := range strings.Split(stderr, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !isStaleReadWarning(line) {
+			return true
+		}
+	}
+	return false
+}
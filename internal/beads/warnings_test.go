@@ -0,0 +1,67 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const staleWarning = "Warning: database is stale (JSONL is newer), reading anyway"
+
+// installWarningBD puts a bd stub on PATH that writes a stale-read warning
+// to stderr and stdout to stdout.
+func installWarningBD(t *testing.T, stdout string) {
+	t.Helper()
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho '" + staleWarning + "' >&2\n"
+	if stdout != "" {
+		script += "echo '" + stdout + "'\n"
+	}
+	if err := os.WriteFile(filepath.Join(dir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestRun_StaleWarningDoesNotBreakJSON(t *testing.T) {
+	installWarningBD(t, `[{"id":"gt-abc","title":"ok","status":"open"}]`)
+
+	b := New(t.TempDir())
+	issue, err := b.Show("gt-abc")
+	if err != nil {
+		t.Fatalf("Show with stale warning on stderr: %v", err)
+	}
+	if issue.ID != "gt-abc" || issue.Title != "ok" {
+		t.Errorf("issue = %+v, want gt-abc/ok", issue)
+	}
+	if got, want := b.Warnings(), []string{staleWarning}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Warnings() = %q, want %q", got, want)
+	}
+}
+
+func TestRun_StaleWarningWithoutOutput(t *testing.T) {
+	installWarningBD(t, "")
+
+	b := New(t.TempDir())
+	if _, err := b.Run("sync"); err != nil {
+		t.Fatalf("Run with only a stale warning: %v", err)
+	}
+	if len(b.Warnings()) != 1 {
+		t.Errorf("Warnings() = %q, want the stale warning", b.Warnings())
+	}
+}
+
+func TestStaleReadWarnings(t *testing.T) {
+	stderr := "  " + staleWarning + "  \nError: stale lock held by pid 42\nDB out of sync with JSONL\n"
+	want := []string{staleWarning}
+	if got := staleReadWarnings(stderr); !reflect.DeepEqual(got, want) {
+		t.Errorf("staleReadWarnings = %q, want %q", got, want)
+	}
+	if !hasNonWarningOutput(stderr) {
+		t.Error("hasNonWarningOutput = false, want true")
+	}
+	if hasNonWarningOutput(staleWarning + "\n") {
+		t.Error("hasNonWarningOutput(warning only) = true, want false")
+	}
+}
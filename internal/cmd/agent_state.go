@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 
@@ -217,7 +216,8 @@ func modifyAgentState(agentBead, beadsDir string, hasIncr bool) error {
 	}
 
 	// Execute bd update
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stderr bytes.Buffer
@@ -261,7 +261,8 @@ func getAgentLabels(agentBead, beadsDir string) (map[string]string, error) {
 func getAllAgentLabels(agentBead, beadsDir string) ([]string, error) {
 	args := []string{"show", agentBead, "--json"}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stdout, stderr bytes.Buffer
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -12,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -144,11 +144,11 @@ func categorizeSession(name string) *AgentSession {
 	}
 
 	// Rig-level agents use gt- prefix
-	if !strings.HasPrefix(name, "gt-") {
+	if !strings.HasPrefix(name, sessionPrefix()) {
 		return nil
 	}
 
-	suffix := strings.TrimPrefix(name, "gt-")
+	suffix := strings.TrimPrefix(name, sessionPrefix())
 
 	// Witness sessions: legacy format gt-witness-<rig> (fallback)
 	if strings.HasPrefix(suffix, "witness-") {
@@ -330,12 +330,11 @@ func runAgents(cmd *cobra.Command, args []string) error {
 	}
 
 	// Execute tmux display-menu
-	tmuxPath, err := exec.LookPath("tmux")
-	if err != nil {
-		return fmt.Errorf("tmux not found: %w", err)
+	if err := requireTmux(); err != nil {
+		return err
 	}
 
-	execCmd := exec.Command(tmuxPath, menuArgs...)
+	execCmd := tmux.Command(menuArgs...)
 	execCmd.Stdin = os.Stdin
 	execCmd.Stdout = os.Stdout
 	execCmd.Stderr = os.Stderr
@@ -502,7 +501,7 @@ func buildCollisionReport(townRoot string) (*CollisionReport, error) {
 	// Filter to gt- sessions
 	var gtSessions []string
 	for _, s := range sessions {
-		if strings.HasPrefix(s, "gt-") {
+		if strings.HasPrefix(s, sessionPrefix()) {
 			gtSessions = append(gtSessions, s)
 		}
 	}
@@ -574,9 +573,9 @@ func guessSessionFromWorkerDir(workerDir, townRoot string) string {
 
 	switch workerType {
 	case "crew":
-		return fmt.Sprintf("gt-%s-crew-%s", rig, workerName)
+		return session.CrewSessionName(rig, workerName)
 	case "polecats":
-		return fmt.Sprintf("gt-%s-%s", rig, workerName)
+		return session.PolecatSessionName(rig, workerName)
 	}
 
 	return ""
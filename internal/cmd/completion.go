@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Shell completion for rig names and rig/role targets. Completion runs on
+// every <TAB>, so these only read rigs.json and never report errors: outside
+// a workspace, or with a missing or broken rigs.json, they offer nothing.
+// Flags such as --all complete through cobra without any help from here.
+
+var completionCmd = &cobra.Command{
+	Use:     "completion",
+	GroupID: GroupConfig,
+	Short:   "Generate shell completion scripts",
+	Long: `Generate a completion script for bash, zsh, fish or PowerShell.
+
+The script completes commands and flags, and asks gt for rig names (from
+mayor/rigs.json) and rig/role targets as you type, so it never needs
+regenerating when rigs are added.
+
+Install it once per shell:
+
+  bash:        gt completion bash > ~/.local/share/bash-completion/completions/gt
+               (needs the bash-completion package; or source it from ~/.bashrc)
+  zsh:         gt completion zsh > "${fpath[1]}/_gt"
+               (needs "autoload -U compinit; compinit" in ~/.zshrc)
+  fish:        gt completion fish > ~/.config/fish/completions/gt.fish
+  PowerShell:  gt completion powershell | Out-String | Invoke-Expression
+               (add it to your $PROFILE to keep it)
+
+Start a new shell afterwards. --no-descriptions leaves out the one-line
+descriptions shown next to each candidate.`,
+	RunE: requireSubcommand,
+
+	// Generating a script needs no workspace, tmux or bd: skip the root
+	// command's checks, which would otherwise run for gt completion bash.
+	PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+}
+
+// completionNoDesc holds the --no-descriptions flag value.
+var completionNoDesc bool
+
+// completionShells generates the completion script for each shell.
+var completionShells = []struct {
+	name string
+	gen  func(root *cobra.Command, w io.Writer, desc bool) error
+}{
+	{"bash", func(root *cobra.Command, w io.Writer, desc bool) error {
+		return root.GenBashCompletionV2(w, desc)
+	}},
+	{"zsh", func(root *cobra.Command, w io.Writer, desc bool) error {
+		if desc {
+			return root.GenZshCompletion(w)
+		}
+		return root.GenZshCompletionNoDesc(w)
+	}},
+	{"fish", func(root *cobra.Command, w io.Writer, desc bool) error {
+		return root.GenFishCompletion(w, desc)
+	}},
+	{"powershell", func(root *cobra.Command, w io.Writer, desc bool) error {
+		if desc {
+			return root.GenPowerShellCompletionWithDesc(w)
+		}
+		return root.GenPowerShellCompletion(w)
+	}},
+}
+
+// writeCompletionScript writes the completion script for shell to w.
+// The rig completions are registered first, as Execute does, so the
+// script's completion requests find them whichever way it is generated.
+func writeCompletionScript(root *cobra.Command, shell string, w io.Writer, desc bool) error {
+	registerRigFlagCompletions(root)
+	for _, s := range completionShells {
+		if s.name == shell {
+			return s.gen(root, w, desc)
+		}
+	}
+	return fmt.Errorf("unsupported shell %q", shell)
+}
+
+func init() {
+	for _, s := range completionShells {
+		shell := s.name
+		sub := &cobra.Command{
+			Use:   shell,
+			Short: "Generate the completion script for " + shell,
+			Long: fmt.Sprintf(`Write the %s completion script to stdout.
+
+See gt completion --help for how to install it.`, shell),
+			Args:              cobra.NoArgs,
+			ValidArgsFunction: cobra.NoFileCompletions,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return writeCompletionScript(cmd.Root(), shell, cmd.OutOrStdout(), !completionNoDesc)
+			},
+		}
+		sub.Flags().BoolVar(&completionNoDesc, "no-descriptions", false, "Leave out completion descriptions")
+		completionCmd.AddCommand(sub)
+	}
+	rootCmd.AddCommand(completionCmd)
+
+	// Commands taking one <rig> first argument.
+	for _, c := range []*cobra.Command{
+		witnessStopCmd, witnessWatchCmd, witnessLogsCmd, witnessPauseCmd,
+		witnessResumeCmd, witnessAttachCmd, witnessRestartCmd, witnessExplainCmd,
+		witnessWatchAddCmd, witnessWatchRemoveCmd, witnessTailBeadsCmd, witnessCheckCmd,
+		witnessSinceCmd, witnessConfigListCmd, witnessConfigGetCmd, witnessConfigSetCmd,
+		rigRemoveCmd, rigBootCmd, rigRebootCmd, rigShutdownCmd, rigStatusCmd,
+		rigConfigShowCmd, rigConfigSetCmd, rigConfigUnsetCmd,
+		rigDockCmd, rigUndockCmd, themeSetCmd,
+		refineryStartCmd, refineryStopCmd, refineryStatusCmd, refineryQueueCmd,
+		refineryAttachCmd, refineryRestartCmd, refineryUnclaimedCmd,
+		refineryReadyCmd, refineryBlockedCmd, polecatAttachCmd,
+	} {
+		c.ValidArgsFunction = completeRigName
+	}
+
+	// Commands taking several rigs.
+	for _, c := range []*cobra.Command{
+		witnessStartCmd, witnessStatusCmd, witnessExportCmd, witnessDoctorCmd,
+		witnessResetStatsCmd,
+		rigStartCmd, rigStopCmd, rigRestartCmd, rigDoctorCmd,
+		rigParkCmd, rigUnparkCmd,
+	} {
+		c.ValidArgsFunction = completeRigNames
+	}
+}
+
+// completeRigName completes a single leading <rig> argument.
+func completeRigName(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return matchPrefix(completionRigNames(), toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRigNames completes any number of <rig> arguments, skipping rigs
+// already on the command line.
+func completeRigNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	given := make(map[string]bool, len(args))
+	for _, a := range args {
+		given[a] = true
+	}
+	var names []string
+	for _, name := range completionRigNames() {
+		if !given[name] {
+			names = append(names, name)
+		}
+	}
+	return matchPrefix(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRigTarget completes rig/role targets one segment at a time:
+// "rig/", then "rig/witness", "rig/refinery", "rig/polecats/", "rig/crew/",
+// then the polecat or crew names found on disk.
+func completeRigTarget(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	parts := strings.Split(toComplete, "/")
+	switch len(parts) {
+	case 1:
+		var rigs []string
+		for _, name := range completionRigNames() {
+			rigs = append(rigs, name+"/")
+		}
+		return matchPrefix(rigs, toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	case 2:
+		rig := parts[0]
+		candidates := []string{rig + "/witness", rig + "/refinery", rig + "/polecats/", rig + "/crew/"}
+		matches := matchPrefix(candidates, toComplete)
+		directive := cobra.ShellCompDirectiveNoFileComp
+		for _, m := range matches {
+			if strings.HasSuffix(m, "/") {
+				directive |= cobra.ShellCompDirectiveNoSpace
+			}
+		}
+		return matches, directive
+	case 3:
+		if parts[1] != "polecats" && parts[1] != "crew" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var workers []string
+		for _, name := range completionWorkerNames(parts[0], parts[1]) {
+			workers = append(workers, parts[0]+"/"+parts[1]+"/"+name)
+		}
+		return matchPrefix(workers, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// registerRigFlagCompletions adds rig name completion to every --rig flag
+// in the command tree.
+func registerRigFlagCompletions(root *cobra.Command) {
+	if root.Flags().Lookup("rig") != nil {
+		_ = root.RegisterFlagCompletionFunc("rig", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return matchPrefix(completionRigNames(), toComplete), cobra.ShellCompDirectiveNoFileComp
+		})
+	}
+	for _, sub := range root.Commands() {
+		registerRigFlagCompletions(sub)
+	}
+}
+
+// completionRigNames returns the sorted registered rig names, or nil.
+func completionRigNames() []string {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// completionWorkerNames lists the polecat or crew directories of a rig.
+func completionWorkerNames(rig, kind string) []string {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+	entries, err := os.ReadDir(filepath.Join(townRoot, rig, kind))
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() && !strings.HasPrefix(e.Name(), ".") {
+			names = append(names, e.Name())
+		}
+	}
+	return names
+}
+
+func matchPrefix(candidates []string, prefix string) []string {
+	var out []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func setupCompletionTown(t *testing.T, rigsJSON string) string {
+	t.Helper()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "town.json"), []byte(`{"type":"town","name":"test"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if rigsJSON != "" {
+		if err := os.WriteFile(filepath.Join(townRoot, "mayor", "rigs.json"), []byte(rigsJSON), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, dir := range []string{"gastown/polecats/Toast", "gastown/polecats/Nux", "gastown/crew/max"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	originalWd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(originalWd) })
+	return townRoot
+}
+
+func TestCompleteRigNames(t *testing.T) {
+	setupCompletionTown(t, `{"version":1,"rigs":{"gastown":{},"beads":{},"greenplace":{}}}`)
+
+	got, _ := completeRigName(nil, nil, "")
+	if want := []string{"beads", "gastown", "greenplace"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("completeRigName(\"\") = %v, want %v", got, want)
+	}
+	got, _ = completeRigName(nil, nil, "g")
+	if want := []string{"gastown", "greenplace"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("completeRigName(\"g\") = %v, want %v", got, want)
+	}
+	if got, _ := completeRigName(nil, []string{"gastown"}, ""); got != nil {
+		t.Errorf("completeRigName after a rig = %v, want nil", got)
+	}
+
+	got, _ = completeRigNames(nil, []string{"gastown"}, "")
+	if want := []string{"beads", "greenplace"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("completeRigNames skipping gastown = %v, want %v", got, want)
+	}
+}
+
+func TestCompleteRigTarget(t *testing.T) {
+	setupCompletionTown(t, `{"version":1,"rigs":{"gastown":{}}}`)
+
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"ga", []string{"gastown/"}},
+		{"gastown/", []string{"gastown/witness", "gastown/refinery", "gastown/polecats/", "gastown/crew/"}},
+		{"gastown/p", []string{"gastown/polecats/"}},
+		{"gastown/polecats/", []string{"gastown/polecats/Nux", "gastown/polecats/Toast"}},
+		{"gastown/crew/m", []string{"gastown/crew/max"}},
+		{"gastown/witness/x", nil},
+	}
+	for _, tt := range tests {
+		got, _ := completeRigTarget(nil, nil, tt.in)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("completeRigTarget(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCompleteRigNames_NoRigsConfig(t *testing.T) {
+	setupCompletionTown(t, "")
+
+	if got, _ := completeRigName(nil, nil, ""); got != nil {
+		t.Errorf("completeRigName without rigs.json = %v, want nil", got)
+	}
+	if got, _ := completeRigTarget(nil, nil, ""); got != nil {
+		t.Errorf("completeRigTarget without rigs.json = %v, want nil", got)
+	}
+}
+
+func TestWriteCompletionScript(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		for _, desc := range []bool{true, false} {
+			var buf bytes.Buffer
+			if err := writeCompletionScript(rootCmd, shell, &buf, desc); err != nil {
+				t.Errorf("%s (descriptions %v): %v", shell, desc, err)
+				continue
+			}
+			// Rig names come from gt itself at <TAB> time
+			if !strings.Contains(buf.String(), "__complete") {
+				t.Errorf("%s script doesn't ask gt for completions", shell)
+			}
+		}
+	}
+	if err := writeCompletionScript(rootCmd, "tcsh", &bytes.Buffer{}, true); err == nil {
+		t.Error("expected an unsupported shell to fail")
+	}
+	if got := len(completionCmd.Commands()); got != len(completionShells) {
+		t.Errorf("gt completion has %d subcommands, want one per shell (%d)", got, len(completionShells))
+	}
+}
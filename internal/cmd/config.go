@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -29,7 +30,8 @@ Commands:
   gt config agent get <name>         Show agent configuration
   gt config agent set <name> <cmd>   Set custom agent command
   gt config agent remove <name>      Remove custom agent
-  gt config default-agent [name]     Get or set default agent`,
+  gt config default-agent [name]     Get or set default agent
+  gt config session-prefix [prefix]  Get or set the session name prefix`,
 }
 
 // Agent subcommands
@@ -140,9 +142,39 @@ Examples:
 	RunE: runConfigAgentEmailDomain,
 }
 
+var configSessionPrefixCmd = &cobra.Command{
+	Use:   "session-prefix [prefix]",
+	Short: "Get or set the tmux session name prefix",
+	Long: `Get or set the prefix of rig-level tmux session names, such as
+gt-gastown-witness.
+
+"{user}" in the prefix is replaced by the username. Setting gt-{user}-
+gives each user on a shared host their own sessions, so users don't
+clobber each other's agents: alice's witness becomes
+gt-alice-gastown-witness. A trailing "-" is added if missing.
+GT_SESSION_PREFIX overrides the setting.
+
+Change the prefix while the town is down: sessions started under the old
+prefix aren't recognised under the new one. Town-level sessions (hq-mayor,
+hq-deacon) are one per machine and keep their names.
+
+With no arguments, shows the current prefix. With --reset, goes back to
+the default.
+
+Default: gt-
+
+Examples:
+  gt config session-prefix              # Show current prefix
+  gt config session-prefix gt-{user}-   # Sessions of your own on a shared host
+  gt config session-prefix --reset      # Back to gt-`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runConfigSessionPrefix,
+}
+
 // Flags
 var (
-	configAgentListJSON bool
+	configAgentListJSON      bool
+	configSessionPrefixReset bool
 )
 
 // AgentListItem represents an agent in list output.
@@ -513,9 +545,59 @@ func runConfigAgentEmailDomain(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runConfigSessionPrefix(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+
+	settingsPath := config.TownSettingsPath(townRoot)
+	townSettings, err := config.LoadOrCreateTownSettings(settingsPath)
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+
+	if len(args) == 0 && !configSessionPrefixReset {
+		configured := townSettings.SessionPrefix
+		if configured == "" {
+			configured = session.DefaultPrefix + " (default)"
+		}
+		fmt.Printf("Session prefix: %s\n", style.Bold.Render(configured))
+		if env := os.Getenv(session.PrefixEnv); env != "" {
+			fmt.Printf("Overridden by %s=%s\n", session.PrefixEnv, env)
+		}
+		fmt.Printf("\nExample: %s\n", session.WitnessSessionName("gastown"))
+		return nil
+	}
+	if len(args) > 0 && configSessionPrefixReset {
+		return fmt.Errorf("give a prefix or --reset, not both")
+	}
+
+	prefix := ""
+	if len(args) > 0 {
+		prefix = args[0]
+		if err := session.ValidatePrefix(prefix); err != nil {
+			return err
+		}
+	}
+	townSettings.SessionPrefix = prefix
+	if err := config.SaveTownSettings(settingsPath, townSettings); err != nil {
+		return fmt.Errorf("saving town settings: %w", err)
+	}
+	if err := session.SetPrefix(prefix); err != nil {
+		return err
+	}
+
+	fmt.Printf("Session prefix set to '%s'\n", style.Bold.Render(orDefault(prefix, session.DefaultPrefix)))
+	fmt.Printf("\nExample: %s\n", session.WitnessSessionName("gastown"))
+	fmt.Printf("%s\n", style.Dim.Render("Restart the town ('gt down' then 'gt up') so running sessions pick up the new names"))
+	return nil
+}
+
 func init() {
 	// Add flags
 	configAgentListCmd.Flags().BoolVar(&configAgentListJSON, "json", false, "Output as JSON")
+	configSessionPrefixCmd.Flags().BoolVar(&configSessionPrefixReset, "reset", false, "Go back to the default prefix ("+session.DefaultPrefix+")")
 
 	// Add agent subcommands
 	configAgentCmd := &cobra.Command{
@@ -532,6 +614,7 @@ func init() {
 	configCmd.AddCommand(configAgentCmd)
 	configCmd.AddCommand(configDefaultAgentCmd)
 	configCmd.AddCommand(configAgentEmailDomainCmd)
+	configCmd.AddCommand(configSessionPrefixCmd)
 
 	// Register with root
 	rootCmd.AddCommand(configCmd)
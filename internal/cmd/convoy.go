@@ -16,7 +16,9 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/tui/convoy"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -320,7 +322,8 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 		"--json",
 	}
 
-	createCmd := exec.Command("bd", createArgs...)
+	createCmd, cancel := beads.Command(createArgs...)
+	defer cancel()
 	createCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -338,7 +341,8 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 	for _, issueID := range trackedIssues {
 		// Use --type=tracks for non-blocking tracking relation
 		depArgs := []string{"dep", "add", convoyID, issueID, "--type=tracks"}
-		depCmd := exec.Command("bd", depArgs...)
+		depCmd, cancel := beads.Command(depArgs...)
+		defer cancel()
 		depCmd.Dir = townBeads
 
 		if err := depCmd.Run(); err != nil {
@@ -381,7 +385,8 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 
 	// Validate convoy exists and get its status
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
@@ -415,7 +420,8 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	reopened := false
 	if convoy.Status == "closed" {
 		reopenArgs := []string{"update", convoyID, "--status=open"}
-		reopenCmd := exec.Command("bd", reopenArgs...)
+		reopenCmd, cancel := beads.Command(reopenArgs...)
+		defer cancel()
 		reopenCmd.Dir = townBeads
 		if err := reopenCmd.Run(); err != nil {
 			return fmt.Errorf("couldn't reopen convoy: %w", err)
@@ -428,7 +434,8 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	addedCount := 0
 	for _, issueID := range issuesToAdd {
 		depArgs := []string{"dep", "add", convoyID, issueID, "--type=tracks"}
-		depCmd := exec.Command("bd", depArgs...)
+		depCmd, cancel := beads.Command(depArgs...)
+		defer cancel()
 		depCmd.Dir = townBeads
 
 		if err := depCmd.Run(); err != nil {
@@ -483,7 +490,8 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 
 	// Get convoy details
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
@@ -528,7 +536,8 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 
 	// Close the convoy
 	closeArgs := []string{"close", convoyID, "-r", reason}
-	closeCmd := exec.Command("bd", closeArgs...)
+	closeCmd, cancel := beads.Command(closeArgs...)
+	defer cancel()
 	closeCmd.Dir = townBeads
 
 	if err := closeCmd.Run(); err != nil {
@@ -629,7 +638,8 @@ func findStrandedConvoys(townBeads string) ([]strandedConvoyInfo, error) {
 
 	// List all open convoys
 	listArgs := []string{"list", "--type=convoy", "--status=open", "--json"}
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	listCmd.Stdout = &stdout
@@ -679,7 +689,8 @@ func getBlockedIssueIDs() map[string]bool {
 	blocked := make(map[string]bool)
 
 	// Run bd blocked --json
-	blockedCmd := exec.Command("bd", "blocked", "--json")
+	blockedCmd, cancel := beads.Command("blocked", "--json")
+	defer cancel()
 	var stdout bytes.Buffer
 	blockedCmd.Stdout = &stdout
 
@@ -730,8 +741,7 @@ func isReadyIssue(t trackedIssueInfo, blockedIssues map[string]bool) bool {
 	}
 
 	// Check if tmux session exists
-	checkCmd := exec.Command("tmux", "has-session", "-t", sessionName)
-	if err := checkCmd.Run(); err != nil {
+	if has, _ := tmux.NewTmux().HasSession(sessionName); !has {
 		return true // Session doesn't exist = ready
 	}
 
@@ -745,7 +755,8 @@ func checkAndCloseCompletedConvoys(townBeads string) ([]struct{ ID, Title string
 
 	// List all open convoys
 	listArgs := []string{"list", "--type=convoy", "--status=open", "--json"}
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	listCmd.Stdout = &stdout
@@ -781,7 +792,8 @@ func checkAndCloseCompletedConvoys(townBeads string) ([]struct{ ID, Title string
 		if allClosed {
 			// Close the convoy
 			closeArgs := []string{"close", convoy.ID, "-r", "All tracked issues completed"}
-			closeCmd := exec.Command("bd", closeArgs...)
+			closeCmd, cancel := beads.Command(closeArgs...)
+			defer cancel()
 			closeCmd.Dir = townBeads
 
 			if err := closeCmd.Run(); err != nil {
@@ -803,7 +815,8 @@ func checkAndCloseCompletedConvoys(townBeads string) ([]struct{ ID, Title string
 func notifyConvoyCompletion(townBeads, convoyID, title string) {
 	// Get convoy description to find owner and notify addresses
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
@@ -867,7 +880,8 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 
 	// Get convoy details
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
@@ -986,7 +1000,8 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 func showAllConvoyStatus(townBeads string) error {
 	// List all convoy-type issues
 	listArgs := []string{"list", "--type=convoy", "--status=open", "--json"}
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	listCmd.Stdout = &stdout
@@ -1040,7 +1055,8 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 	}
 	// Default (no flags) = open only (bd's default behavior)
 
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	listCmd.Stdout = &stdout
@@ -1274,7 +1290,8 @@ func getIssueDetailsBatch(issueIDs []string) map[string]*issueDetails {
 	args := append([]string{"--no-daemon", "show"}, issueIDs...)
 	args = append(args, "--json")
 
-	showCmd := exec.Command("bd", args...)
+	showCmd, cancel := beads.Command(args...)
+	defer cancel()
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
 
@@ -1318,7 +1335,8 @@ func getIssueDetailsBatch(issueIDs []string) map[string]*issueDetails {
 func getIssueDetails(issueID string) *issueDetails {
 	// Use bd show with routing - it should find the issue in the right rig
 	// Use --no-daemon to ensure fresh data (avoid stale cache)
-	showCmd := exec.Command("bd", "--no-daemon", "show", issueID, "--json")
+	showCmd, cancel := beads.Command("--no-daemon", "show", issueID, "--json")
+	defer cancel()
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
 
@@ -1522,7 +1540,8 @@ func runConvoyTUI() error {
 func resolveConvoyNumber(townBeads string, n int) (string, error) {
 	// Get convoy list (same query as runConvoyList)
 	listArgs := []string{"list", "--type=convoy", "--json"}
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	listCmd.Stdout = &stdout
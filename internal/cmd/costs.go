@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -13,8 +12,10 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -207,7 +208,7 @@ func runLiveCosts() error {
 
 	for _, session := range sessions {
 		// Only process Gas Town sessions (start with "gt-")
-		if !strings.HasPrefix(session, constants.SessionPrefix) {
+		if !strings.HasPrefix(session, sessionPrefix()) {
 			continue
 		}
 
@@ -418,7 +419,8 @@ func querySessionEventsFromLocation(location string) ([]CostEntry, error) {
 		"--json",
 	}
 
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listCmd.Dir = location
 	listOutput, err := listCmd.Output()
 	if err != nil {
@@ -442,7 +444,8 @@ func querySessionEventsFromLocation(location string) ([]CostEntry, error) {
 		showArgs = append(showArgs, item.ID)
 	}
 
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showCmd.Dir = location
 	showOutput, err := showCmd.Output()
 	if err != nil {
@@ -502,7 +505,8 @@ func queryDigestBeads(days int) ([]CostEntry, error) {
 		"--json",
 	}
 
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listOutput, err := listCmd.Output()
 	if err != nil {
 		return nil, nil
@@ -523,7 +527,8 @@ func queryDigestBeads(days int) ([]CostEntry, error) {
 		showArgs = append(showArgs, item.ID)
 	}
 
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showOutput, err := showCmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("showing events: %w", err)
@@ -580,7 +585,7 @@ func queryDigestBeads(days int) ([]CostEntry, error) {
 //   - gt-gastown-crew-joe -> role=crew, rig=gastown, worker=joe
 func parseSessionName(session string) (role, rig, worker string) {
 	// Remove gt- prefix
-	name := strings.TrimPrefix(session, constants.SessionPrefix)
+	name := strings.TrimPrefix(session, sessionPrefix())
 
 	// Check for global agents
 	switch name {
@@ -807,7 +812,8 @@ func runCostsRecord(cmd *cobra.Command, args []string) error {
 	// The bd command will auto-detect the correct rig from cwd.
 
 	// Execute bd create
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd, cancel := beads.Command(bdArgs...)
+	defer cancel()
 	output, err := bdCmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("creating session cost wisp: %w\nOutput: %s", err, string(output))
@@ -818,7 +824,8 @@ func runCostsRecord(cmd *cobra.Command, args []string) error {
 	// Auto-close session cost wisps immediately after creation.
 	// These are informational records that don't need to stay open.
 	// The wisp data is preserved and queryable until digested.
-	closeCmd := exec.Command("bd", "close", wispID, "--reason=auto-closed session cost wisp")
+	closeCmd, cancel := beads.Command("close", wispID, "--reason=auto-closed session cost wisp")
+	defer cancel()
 	if closeErr := closeCmd.Run(); closeErr != nil {
 		// Non-fatal: wisp was created, just couldn't auto-close
 		fmt.Fprintf(os.Stderr, "warning: could not auto-close session cost wisp %s: %v\n", wispID, closeErr)
@@ -851,26 +858,26 @@ func deriveSessionName() string {
 
 	// Polecat: gt-{rig}-{polecat}
 	if polecat != "" && rig != "" {
-		return fmt.Sprintf("gt-%s-%s", rig, polecat)
+		return session.PolecatSessionName(rig, polecat)
 	}
 
 	// Crew: gt-{rig}-crew-{crew}
 	if crew != "" && rig != "" {
-		return fmt.Sprintf("gt-%s-crew-%s", rig, crew)
+		return session.CrewSessionName(rig, crew)
 	}
 
 	// Town-level roles (mayor, deacon): gt-{town}-{role} or gt-{role}
 	if role == "mayor" || role == "deacon" {
 		if town != "" {
-			return fmt.Sprintf("gt-%s-%s", town, role)
+			return fmt.Sprintf("%s%s-%s", sessionPrefix(), town, role)
 		}
 		// No town set - use simple gt-{role} pattern
-		return fmt.Sprintf("gt-%s", role)
+		return fmt.Sprintf("%s%s", sessionPrefix(), role)
 	}
 
 	// Rig-based roles (witness, refinery): gt-{rig}-{role}
 	if role != "" && rig != "" {
-		return fmt.Sprintf("gt-%s-%s", rig, role)
+		return fmt.Sprintf("%s%s-%s", sessionPrefix(), rig, role)
 	}
 
 	return ""
@@ -881,16 +888,14 @@ func deriveSessionName() string {
 // Note: We don't check TMUX env var because it may not be inherited when Claude Code
 // runs bash commands, even though we are inside a tmux session.
 func detectCurrentTmuxSession() string {
-	cmd := exec.Command("tmux", "display-message", "-p", "#S")
-	output, err := cmd.Output()
+	session, err := tmux.NewTmux().Run("display-message", "-p", "#S")
 	if err != nil {
 		return ""
 	}
 
-	session := strings.TrimSpace(string(output))
 	// Only return if it looks like a Gas Town session
 	// Accept both gt- (rig sessions) and hq- (town-level sessions like hq-mayor)
-	if strings.HasPrefix(session, constants.SessionPrefix) || strings.HasPrefix(session, constants.HQSessionPrefix) {
+	if strings.HasPrefix(session, sessionPrefix()) || strings.HasPrefix(session, constants.HQSessionPrefix) {
 		return session
 	}
 	return ""
@@ -1046,7 +1051,8 @@ func runCostsDigest(cmd *cobra.Command, args []string) error {
 // querySessionCostWisps queries ephemeral session.ended events for a target date.
 func querySessionCostWisps(targetDate time.Time) ([]CostEntry, error) {
 	// List all wisps including closed ones
-	listCmd := exec.Command("bd", "mol", "wisp", "list", "--all", "--json")
+	listCmd, cancel := beads.Command("mol", "wisp", "list", "--all", "--json")
+	defer cancel()
 	listOutput, err := listCmd.Output()
 	if err != nil {
 		// No wisps database or command failed
@@ -1071,7 +1077,8 @@ func querySessionCostWisps(targetDate time.Time) ([]CostEntry, error) {
 		showArgs = append(showArgs, wisp.ID)
 	}
 
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showOutput, err := showCmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("showing wisps: %w", err)
@@ -1181,7 +1188,8 @@ func createCostDigestBead(digest CostDigest) (string, error) {
 		"--silent",
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd, cancel := beads.Command(bdArgs...)
+	defer cancel()
 	output, err := bdCmd.CombinedOutput()
 	if err != nil {
 		return "", fmt.Errorf("creating digest bead: %w\nOutput: %s", err, string(output))
@@ -1190,7 +1198,8 @@ func createCostDigestBead(digest CostDigest) (string, error) {
 	digestID := strings.TrimSpace(string(output))
 
 	// Auto-close the digest (it's an audit record, not work)
-	closeCmd := exec.Command("bd", "close", digestID, "--reason=daily cost digest")
+	closeCmd, cancel := beads.Command("close", digestID, "--reason=daily cost digest")
+	defer cancel()
 	_ = closeCmd.Run() // Best effort
 
 	return digestID, nil
@@ -1199,7 +1208,8 @@ func createCostDigestBead(digest CostDigest) (string, error) {
 // deleteSessionCostWisps deletes ephemeral session.ended wisps for a target date.
 func deleteSessionCostWisps(targetDate time.Time) (int, error) {
 	// List all wisps
-	listCmd := exec.Command("bd", "mol", "wisp", "list", "--all", "--json")
+	listCmd, cancel := beads.Command("mol", "wisp", "list", "--all", "--json")
+	defer cancel()
 	listOutput, err := listCmd.Output()
 	if err != nil {
 		if costsVerbose {
@@ -1220,7 +1230,8 @@ func deleteSessionCostWisps(targetDate time.Time) (int, error) {
 
 	for _, wisp := range wispList.Wisps {
 		// Get full wisp details to check if it's a session.ended event
-		showCmd := exec.Command("bd", "show", wisp.ID, "--json")
+		showCmd, cancel := beads.Command("show", wisp.ID, "--json")
+		defer cancel()
 		showOutput, err := showCmd.Output()
 		if err != nil {
 			if costsVerbose {
@@ -1280,7 +1291,8 @@ func deleteSessionCostWisps(targetDate time.Time) (int, error) {
 
 	// Batch delete all wisps in a single subprocess call
 	burnArgs := append([]string{"mol", "burn", "--force"}, wispIDsToDelete...)
-	burnCmd := exec.Command("bd", burnArgs...)
+	burnCmd, cancel := beads.Command(burnArgs...)
+	defer cancel()
 	if burnErr := burnCmd.Run(); burnErr != nil {
 		return 0, fmt.Errorf("batch burn failed: %w", burnErr)
 	}
@@ -1299,7 +1311,8 @@ func runCostsMigrate(cmd *cobra.Command, args []string) error {
 		"--json",
 	}
 
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listOutput, err := listCmd.Output()
 	if err != nil {
 		fmt.Println(style.Dim.Render("No events found or bd command failed"))
@@ -1322,7 +1335,8 @@ func runCostsMigrate(cmd *cobra.Command, args []string) error {
 		showArgs = append(showArgs, item.ID)
 	}
 
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd, cancel := beads.Command(showArgs...)
+	defer cancel()
 	showOutput, err := showCmd.Output()
 	if err != nil {
 		return fmt.Errorf("showing events: %w", err)
@@ -1367,7 +1381,8 @@ func runCostsMigrate(cmd *cobra.Command, args []string) error {
 	// Close all open session.ended events
 	closedMigrated := 0
 	for _, event := range openEvents {
-		closeCmd := exec.Command("bd", "close", event.ID, "--reason=migrated to wisp architecture")
+		closeCmd, cancel := beads.Command("close", event.ID, "--reason=migrated to wisp architecture")
+		defer cancel()
 		if err := closeCmd.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "warning: could not close %s: %v\n", event.ID, err)
 			continue
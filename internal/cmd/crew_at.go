@@ -16,6 +16,9 @@ import (
 )
 
 func runCrewAt(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	var name string
 
 	// Debug mode: --debug flag or GT_DEBUG env var
@@ -13,7 +13,9 @@ import (
 	"github.com/steveyegge/gastown/internal/crew"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -68,7 +70,7 @@ func getCrewManager(rigName string) (*crew.Manager, *rig.Rig, error) {
 
 // crewSessionName generates the tmux session name for a crew worker.
 func crewSessionName(rigName, crewName string) string {
-	return fmt.Sprintf("gt-%s-crew-%s", rigName, crewName)
+	return session.CrewSessionName(rigName, crewName)
 }
 
 // parseRigSlashName parses "rig/name" format into separate rig and name parts.
@@ -203,29 +205,25 @@ func isInTmuxSession(targetSession string) bool {
 	}
 
 	// Get current session name
-	cmd := exec.Command("tmux", "display-message", "-p", "#{session_name}")
-	out, err := cmd.Output()
+	currentSession, err := tmux.NewTmux().Run("display-message", "-p", "#{session_name}")
 	if err != nil {
 		return false
 	}
-
-	currentSession := strings.TrimSpace(string(out))
 	return currentSession == targetSession
 }
 
 // attachToTmuxSession attaches to a tmux session.
 // Should only be called from outside tmux.
 func attachToTmuxSession(sessionID string) error {
-	tmuxPath, err := exec.LookPath("tmux")
-	if err != nil {
-		return fmt.Errorf("tmux not found: %w", err)
+	if err := requireTmux(); err != nil {
+		return err
 	}
 
-	cmd := exec.Command(tmuxPath, "attach-session", "-t", sessionID)
+	cmd := tmux.Command("attach-session", "-t", sessionID)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	return withTmuxVersion(cmd.Run())
 }
 
 // ensureDefaultBranch checks if a git directory is on the default branch.
@@ -283,12 +281,12 @@ func ensureDefaultBranch(dir, roleName, rigPath string) bool { //nolint:unparam
 // Returns empty strings and false if the format doesn't match.
 func parseCrewSessionName(sessionName string) (rigName, crewName string, ok bool) {
 	// Must start with "gt-" and contain "-crew-"
-	if !strings.HasPrefix(sessionName, "gt-") {
+	if !strings.HasPrefix(sessionName, sessionPrefix()) {
 		return "", "", false
 	}
 
 	// Remove "gt-" prefix
-	rest := sessionName[3:]
+	rest := strings.TrimPrefix(sessionName, sessionPrefix())
 
 	// Find "-crew-" separator
 	idx := strings.Index(rest, "-crew-")
@@ -309,17 +307,16 @@ func parseCrewSessionName(sessionName string) (rigName, crewName string, ok bool
 // findRigCrewSessions returns all crew sessions for a given rig, sorted alphabetically.
 // Uses tmux list-sessions to find sessions matching gt-<rig>-crew-* pattern.
 func findRigCrewSessions(rigName string) ([]string, error) { //nolint:unparam // error return kept for future use
-	cmd := exec.Command("tmux", "list-sessions", "-F", "#{session_name}")
-	out, err := cmd.Output()
+	names, err := tmux.NewTmux().ListSessions()
 	if err != nil {
 		// No tmux server or no sessions
 		return nil, nil
 	}
 
-	prefix := fmt.Sprintf("gt-%s-crew-", rigName)
+	prefix := fmt.Sprintf("%s%s-crew-", sessionPrefix(), rigName)
 	var sessions []string
 
-	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+	for _, line := range names {
 		if line == "" {
 			continue
 		}
@@ -140,9 +140,9 @@ func runCrewStatus(cmd *cobra.Command, args []string) error {
 			fmt.Println()
 		}
 
-		sessionStatus := style.Dim.Render("○ stopped")
+		sessionStatus := style.AgentState("stopped")
 		if item.HasSession {
-			sessionStatus = style.Bold.Render("● running")
+			sessionStatus = style.AgentState("running")
 		}
 
 		fmt.Printf("%s %s/%s\n", sessionStatus, item.Rig, item.Name)
@@ -1,12 +1,12 @@
 package cmd
 
 import (
-	"fmt"
-	"os/exec"
 	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 // cycleSession is the --session flag for cycle next/prev commands.
@@ -87,7 +87,7 @@ func cycleToSession(direction int, sessionOverride string) error {
 	}
 
 	// Check if it's a crew session (format: gt-<rig>-crew-<name>)
-	if strings.HasPrefix(session, "gt-") && strings.Contains(session, "-crew-") {
+	if strings.HasPrefix(session, sessionPrefix()) && strings.Contains(session, "-crew-") {
 		return cycleCrewSession(direction, session)
 	}
 
@@ -109,10 +109,10 @@ func cycleToSession(direction int, sessionOverride string) error {
 // Returns empty string if not a rig infra session.
 // Format: gt-<rig>-witness or gt-<rig>-refinery
 func parseRigInfraSession(session string) string {
-	if !strings.HasPrefix(session, "gt-") {
+	if !strings.HasPrefix(session, sessionPrefix()) {
 		return ""
 	}
-	rest := session[3:] // Remove "gt-" prefix
+	rest := strings.TrimPrefix(session, sessionPrefix()) // Remove "gt-" prefix
 
 	// Check for -witness or -refinery suffix
 	if strings.HasSuffix(rest, "-witness") {
@@ -127,8 +127,8 @@ func parseRigInfraSession(session string) string {
 // cycleRigInfraSession cycles between witness and refinery sessions for a rig.
 func cycleRigInfraSession(direction int, currentSession, rig string) error {
 	// Find running infra sessions for this rig
-	witnessSession := fmt.Sprintf("gt-%s-witness", rig)
-	refinerySession := fmt.Sprintf("gt-%s-refinery", rig)
+	witnessSession := session.WitnessSessionName(rig)
+	refinerySession := session.RefinerySessionName(rig)
 
 	var sessions []string
 	allSessions, err := listTmuxSessions()
@@ -170,15 +170,10 @@ func cycleRigInfraSession(direction int, currentSession, rig string) error {
 	}
 
 	// Switch to target session
-	cmd := exec.Command("tmux", "switch-client", "-t", sessions[targetIdx])
-	return cmd.Run()
+	return tmux.NewTmux().SwitchClient(sessions[targetIdx])
 }
 
 // listTmuxSessions returns all tmux session names.
 func listTmuxSessions() ([]string, error) {
-	out, err := exec.Command("tmux", "list-sessions", "-F", "#{session_name}").Output()
-	if err != nil {
-		return nil, err
-	}
-	return splitLines(string(out)), nil
+	return tmux.NewTmux().ListSessions()
 }
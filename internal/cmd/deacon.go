@@ -307,6 +307,9 @@ func init() {
 }
 
 func runDeaconStart(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	t := tmux.NewTmux()
 
 	sessionName := getDeaconSessionName()
@@ -440,6 +443,9 @@ func runDeaconStop(cmd *cobra.Command, args []string) error {
 }
 
 func runDeaconAttach(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	t := tmux.NewTmux()
 
 	sessionName := getDeaconSessionName()
@@ -910,9 +916,9 @@ func agentAddressToIDs(address string) (beadID, sessionName string, err error) {
 		rig, role := parts[0], parts[1]
 		switch role {
 		case "witness":
-			return fmt.Sprintf("gt-%s-witness", rig), fmt.Sprintf("gt-%s-witness", rig), nil
+			return session.WitnessSessionName(rig), session.WitnessSessionName(rig), nil
 		case "refinery":
-			return fmt.Sprintf("gt-%s-refinery", rig), fmt.Sprintf("gt-%s-refinery", rig), nil
+			return session.RefinerySessionName(rig), session.RefinerySessionName(rig), nil
 		default:
 			return "", "", fmt.Errorf("unknown role: %s", role)
 		}
@@ -921,9 +927,9 @@ func agentAddressToIDs(address string) (beadID, sessionName string, err error) {
 		rig, agentType, name := parts[0], parts[1], parts[2]
 		switch agentType {
 		case "polecats":
-			return fmt.Sprintf("gt-%s-polecat-%s", rig, name), fmt.Sprintf("gt-%s-%s", rig, name), nil
+			return fmt.Sprintf("%s%s-polecat-%s", sessionPrefix(), rig, name), session.PolecatSessionName(rig, name), nil
 		case "crew":
-			return fmt.Sprintf("gt-%s-crew-%s", rig, name), fmt.Sprintf("gt-%s-crew-%s", rig, name), nil
+			return session.CrewSessionName(rig, name), session.CrewSessionName(rig, name), nil
 		default:
 			return "", "", fmt.Errorf("unknown agent type: %s", agentType)
 		}
@@ -934,7 +940,8 @@ func agentAddressToIDs(address string) (beadID, sessionName string, err error) {
 
 // getAgentBeadUpdateTime gets the update time from an agent bead.
 func getAgentBeadUpdateTime(townRoot, beadID string) (time.Time, error) {
-	cmd := exec.Command("bd", "show", beadID, "--json")
+	cmd, cancel := beads.Command("show", beadID, "--json")
+	defer cancel()
 	cmd.Dir = townRoot
 
 	output, err := cmd.Output()
@@ -971,7 +978,8 @@ func updateAgentBeadState(townRoot, agent, state, _ string) { // reason unused b
 	}
 
 	// Use bd agent state command
-	cmd := exec.Command("bd", "agent", "state", beadID, state)
+	cmd, cancel := beads.Command("agent", "state", beadID, state)
+	defer cancel()
 	cmd.Dir = townRoot
 	_ = cmd.Run() // Best effort
 }
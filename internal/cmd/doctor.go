@@ -3,8 +3,11 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/doctor"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -14,6 +17,7 @@ var (
 	doctorVerbose         bool
 	doctorRig             string
 	doctorRestartSessions bool
+	doctorJSON            bool
 )
 
 var doctorCmd = &cobra.Command{
@@ -38,6 +42,8 @@ Town root protection:
   - pre-checkout-hook        Verify pre-checkout hook prevents branch switches (fixable)
 
 Infrastructure checks:
+  - tmux-available           Check tmux is installed and recent enough
+  - bd-available             Check the bd (beads) CLI is on PATH and runs
   - stale-binary             Check if gt binary is up to date with repo
   - daemon                   Check if daemon is running (fixable)
   - repo-fingerprint         Check database has valid repo fingerprint (fixable)
@@ -56,7 +62,8 @@ Crew workspace checks:
   - crew-state               Validate crew worker state.json files (fixable)
   - crew-worktrees           Detect stale cross-rig worktrees (fixable)
 
-Rig checks (with --rig flag):
+Rig checks (for every registered rig, shown as <rig>/<check>; --rig
+limits them to one):
   - rig-is-git-repo          Verify rig is a valid git repository
   - git-exclude-configured   Check .git/info/exclude has Gas Town dirs (fixable)
   - witness-exists           Verify witness/ structure exists (fixable)
@@ -80,8 +87,13 @@ Patrol checks:
   - patrol-plugins-accessible Verify plugin directories
   - patrol-roles-have-prompts Verify role prompts exist
 
+A check that fails, or even crashes, doesn't stop the others: the report
+always covers everything, ending with an overall pass or fail.
+
 Use --fix to attempt automatic fixes for issues that support it.
-Use --rig to check a specific rig instead of the entire workspace.`,
+Use --rig to check a specific rig instead of the entire workspace.
+Use --json for a machine-readable report, e.g. in CI; the exit code is 1
+when any check fails either way.`,
 	RunE: runDoctor,
 }
 
@@ -89,6 +101,7 @@ func init() {
 	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "Attempt to automatically fix issues")
 	doctorCmd.Flags().BoolVarP(&doctorVerbose, "verbose", "v", false, "Show detailed output")
 	doctorCmd.Flags().StringVar(&doctorRig, "rig", "", "Check specific rig only")
+	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "Output the report as JSON")
 	doctorCmd.Flags().BoolVar(&doctorRestartSessions, "restart-sessions", false, "Restart patrol sessions when fixing stale settings (use with --fix)")
 	rootCmd.AddCommand(doctorCmd)
 }
@@ -116,6 +129,10 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	d.Register(doctor.NewGlobalStateCheck())
 
+	// Tools everything else depends on
+	d.Register(doctor.NewTmuxAvailableCheck())
+	d.Register(doctor.NewBdAvailableCheck())
+
 	// Register built-in checks
 	d.Register(doctor.NewStaleBinaryCheck())
 	d.Register(doctor.NewTownGitCheck())
@@ -180,17 +197,38 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewHookSingletonCheck())
 	d.Register(doctor.NewOrphanedAttachmentsCheck())
 
-	// Rig-specific checks (only when --rig is specified)
+	// Rig-specific checks: for --rig alongside the rest, otherwise for
+	// every registered rig below
 	if doctorRig != "" {
 		d.RegisterAll(doctor.RigChecks()...)
 	}
 
 	// Run checks
-	var report *doctor.Report
-	if doctorFix {
-		report = d.Fix(ctx)
-	} else {
-		report = d.Run(ctx)
+	report := runDoctorChecks(d, ctx)
+	if doctorRig == "" {
+		for _, rigName := range doctorRigNames(townRoot) {
+			rigCtx := *ctx
+			rigCtx.RigName = rigName
+			rd := doctor.NewDoctor()
+			rd.RegisterAll(doctor.RigChecks()...)
+			report.AddAll(rigName, runDoctorChecks(rd, &rigCtx))
+		}
+	}
+
+	if doctorJSON {
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		out := struct {
+			OK bool `json:"ok"`
+			*doctor.Report
+		}{!report.HasErrors(), report}
+		if err := outputJSON(out); err != nil {
+			return err
+		}
+		if report.HasErrors() {
+			return NewSilentExit(1)
+		}
+		return nil
 	}
 
 	// Print report
@@ -203,3 +241,26 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// runDoctorChecks runs the doctor's checks, fixing what it can with --fix.
+func runDoctorChecks(d *doctor.Doctor, ctx *doctor.CheckContext) *doctor.Report {
+	if doctorFix {
+		return d.Fix(ctx)
+	}
+	return d.Run(ctx)
+}
+
+// doctorRigNames returns the registered rigs, sorted. A registry that
+// can't be read yields none: the rigs-registry checks report that.
+func doctorRigNames(townRoot string) []string {
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -532,7 +532,7 @@ func showDogStatus(mgr *dog.Manager, name string) error {
 	if townRoot != "" {
 		townName, err := workspace.GetTownName(townRoot)
 		if err == nil {
-			sessionName := fmt.Sprintf("gt-%s-deacon-%s", townName, name)
+			sessionName := fmt.Sprintf("%s%s-deacon-%s", sessionPrefix(), townName, name)
 			tm := tmux.NewTmux()
 			if has, _ := tm.HasSession(sessionName); has {
 				fmt.Printf("\nSession: %s (running)\n", sessionName)
@@ -84,8 +84,8 @@ func runDown(cmd *cobra.Command, args []string) error {
 	}
 
 	t := tmux.NewTmux()
-	if !t.IsAvailable() {
-		return fmt.Errorf("tmux not available (is tmux installed and on PATH?)")
+	if err := requireTmux(); err != nil {
+		return err
 	}
 
 	// Phase 0: Acquire shutdown lock (skip for dry-run)
@@ -162,7 +162,7 @@ func runDown(cmd *cobra.Command, args []string) error {
 
 	// Phase 2a: Stop refineries
 	for _, rigName := range rigs {
-		sessionName := fmt.Sprintf("gt-%s-refinery", rigName)
+		sessionName := session.RefinerySessionName(rigName)
 		if downDryRun {
 			if sessionSet.Has(sessionName) {
 				printDownStatus(fmt.Sprintf("Refinery (%s)", rigName), true, "would stop")
@@ -182,7 +182,7 @@ func runDown(cmd *cobra.Command, args []string) error {
 
 	// Phase 2b: Stop witnesses
 	for _, rigName := range rigs {
-		sessionName := fmt.Sprintf("gt-%s-witness", rigName)
+		sessionName := session.WitnessSessionName(rigName)
 		if downDryRun {
 			if sessionSet.Has(sessionName) {
 				printDownStatus(fmt.Sprintf("Witness (%s)", rigName), true, "would stop")
@@ -452,7 +452,7 @@ func verifyShutdown(t *tmux.Tmux, townRoot string) []string {
 	sessions, err := t.ListSessions()
 	if err == nil {
 		for _, sess := range sessions {
-			if strings.HasPrefix(sess, "gt-") || strings.HasPrefix(sess, "hq-") {
+			if strings.HasPrefix(sess, sessionPrefix()) || strings.HasPrefix(sess, session.HQPrefix) {
 				respawned = append(respawned, fmt.Sprintf("tmux session %s", sess))
 			}
 		}
@@ -48,8 +48,19 @@ func runEscalate(cmd *cobra.Command, args []string) error {
 		agentID = "unknown"
 	}
 
+	// A witness in dry-run mode logs its escalations instead of creating them
+	dryRun := escalateDryRun
+	if roleInfo, err := GetRole(); err == nil {
+		if mgr := witnessDryRunFor(roleInfo); mgr != nil {
+			dryRun = true
+			if err := mgr.RecordWouldEscalate(); err != nil {
+				style.PrintWarning("recording would-escalation: %v", err)
+			}
+		}
+	}
+
 	// Dry run mode
-	if escalateDryRun {
+	if dryRun {
 		actions := escalationConfig.GetRouteForSeverity(severity)
 		targets := extractMailTargetsFromActions(actions)
 		fmt.Printf("Would create escalation:\n")
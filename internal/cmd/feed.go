@@ -305,14 +305,13 @@ func runFeedInWindow(workDir string, bdArgs []string) error {
 
 // windowExists checks if a window with the given name exists in the session.
 // Note: getCurrentTmuxSession is defined in handoff.go
-func windowExists(_ *tmux.Tmux, session, windowName string) (bool, error) { // t unused: direct exec for simplicity
-	cmd := exec.Command("tmux", "list-windows", "-t", session, "-F", "#{window_name}")
-	out, err := cmd.Output()
+func windowExists(t *tmux.Tmux, session, windowName string) (bool, error) {
+	out, err := t.Run("list-windows", "-t", session, "-F", "#{window_name}")
 	if err != nil {
 		return false, err
 	}
 
-	for _, line := range strings.Split(string(out), "\n") {
+	for _, line := range strings.Split(out, "\n") {
 		if strings.TrimSpace(line) == windowName {
 			return true, nil
 		}
@@ -321,14 +320,13 @@ func windowExists(_ *tmux.Tmux, session, windowName string) (bool, error) { // t
 }
 
 // createWindow creates a new tmux window with the given name and command.
-func createWindow(_ *tmux.Tmux, session, windowName, workDir, command string) error { // t unused: direct exec for simplicity
-	args := []string{"new-window", "-t", session, "-n", windowName, "-c", workDir, command}
-	cmd := exec.Command("tmux", args...)
-	return cmd.Run()
+func createWindow(t *tmux.Tmux, session, windowName, workDir, command string) error {
+	_, err := t.Run("new-window", "-t", session, "-n", windowName, "-c", workDir, command)
+	return err
 }
 
 // selectWindow switches to the specified window.
-func selectWindow(_ *tmux.Tmux, target string) error { // t unused: direct exec for simplicity
-	cmd := exec.Command("tmux", "select-window", "-t", target)
-	return cmd.Run()
+func selectWindow(t *tmux.Tmux, target string) error {
+	_, err := t.Run("select-window", "-t", target)
+	return err
 }
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -174,7 +175,8 @@ func runFormulaList(cmd *cobra.Command, args []string) error {
 		bdArgs = append(bdArgs, "--json")
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd, cancel := beads.Command(bdArgs...)
+	defer cancel()
 	bdCmd.Stdout = os.Stdout
 	bdCmd.Stderr = os.Stderr
 	return bdCmd.Run()
@@ -188,7 +190,8 @@ func runFormulaShow(cmd *cobra.Command, args []string) error {
 		bdArgs = append(bdArgs, "--json")
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd, cancel := beads.Command(bdArgs...)
+	defer cancel()
 	bdCmd.Stdout = os.Stdout
 	bdCmd.Stderr = os.Stderr
 	return bdCmd.Run()
@@ -336,7 +339,8 @@ func executeConvoyFormula(f *formulaData, formulaName, targetRig string) error {
 		"--description=" + description,
 	}
 
-	createCmd := exec.Command("bd", createArgs...)
+	createCmd, cancel := beads.Command(createArgs...)
+	defer cancel()
 	createCmd.Dir = townBeads
 	createCmd.Stderr = os.Stderr
 	if err := createCmd.Run(); err != nil {
@@ -366,7 +370,8 @@ func executeConvoyFormula(f *formulaData, formulaName, targetRig string) error {
 			"--description=" + legDesc,
 		}
 
-		legCmd := exec.Command("bd", legArgs...)
+		legCmd, cancel := beads.Command(legArgs...)
+		defer cancel()
 		legCmd.Dir = townBeads
 		legCmd.Stderr = os.Stderr
 		if err := legCmd.Run(); err != nil {
@@ -377,7 +382,8 @@ func executeConvoyFormula(f *formulaData, formulaName, targetRig string) error {
 
 		// Track the leg with the convoy
 		trackArgs := []string{"dep", "add", convoyID, legBeadID, "--type=tracks"}
-		trackCmd := exec.Command("bd", trackArgs...)
+		trackCmd, cancel := beads.Command(trackArgs...)
+		defer cancel()
 		trackCmd.Dir = townBeads
 		if err := trackCmd.Run(); err != nil {
 			fmt.Printf("%s Failed to track leg %s: %v\n",
@@ -406,7 +412,8 @@ func executeConvoyFormula(f *formulaData, formulaName, targetRig string) error {
 			"--description=" + synDesc,
 		}
 
-		synCmd := exec.Command("bd", synArgs...)
+		synCmd, cancel := beads.Command(synArgs...)
+		defer cancel()
 		synCmd.Dir = townBeads
 		synCmd.Stderr = os.Stderr
 		if err := synCmd.Run(); err != nil {
@@ -415,14 +422,16 @@ func executeConvoyFormula(f *formulaData, formulaName, targetRig string) error {
 		} else {
 			// Track synthesis with convoy
 			trackArgs := []string{"dep", "add", convoyID, synthesisBeadID, "--type=tracks"}
-			trackCmd := exec.Command("bd", trackArgs...)
+			trackCmd, cancel := beads.Command(trackArgs...)
+			defer cancel()
 			trackCmd.Dir = townBeads
 			_ = trackCmd.Run()
 
 			// Add dependencies: synthesis depends on all legs
 			for _, legBeadID := range legBeads {
 				depArgs := []string{"dep", "add", synthesisBeadID, legBeadID}
-				depCmd := exec.Command("bd", depArgs...)
+				depCmd, cancel := beads.Command(depArgs...)
+				defer cancel()
 				depCmd.Dir = townBeads
 				_ = depCmd.Run()
 			}
@@ -460,7 +469,8 @@ func executeConvoyFormula(f *formulaData, formulaName, targetRig string) error {
 				style.Dim.Render("Warning:"), leg.ID, err)
 			// Add comment to bead about failure
 			commentArgs := []string{"comment", legBeadID, fmt.Sprintf("Failed to sling: %v", err)}
-			commentCmd := exec.Command("bd", commentArgs...)
+			commentCmd, cancel := beads.Command(commentArgs...)
+			defer cancel()
 			commentCmd.Dir = townBeads
 			_ = commentCmd.Run()
 			continue
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
@@ -209,11 +210,7 @@ func runHandoff(cmd *cobra.Command, args []string) error {
 
 // getCurrentTmuxSession returns the current tmux session name.
 func getCurrentTmuxSession() (string, error) {
-	out, err := exec.Command("tmux", "display-message", "-p", "#{session_name}").Output()
-	if err != nil {
-		return "", err
-	}
-	return strings.TrimSpace(string(out)), nil
+	return tmux.NewTmux().Run("display-message", "-p", "#{session_name}")
 }
 
 // resolveRoleToSession converts a role name or path to a tmux session name.
@@ -251,21 +248,21 @@ func resolveRoleToSession(role string) (string, error) {
 		if rig == "" || crewName == "" {
 			return "", fmt.Errorf("cannot determine crew identity - run from crew directory or specify GT_RIG/GT_CREW")
 		}
-		return fmt.Sprintf("gt-%s-crew-%s", rig, crewName), nil
+		return session.CrewSessionName(rig, crewName), nil
 
 	case "witness", "wit":
 		rig := os.Getenv("GT_RIG")
 		if rig == "" {
 			return "", fmt.Errorf("cannot determine rig - set GT_RIG or run from rig context")
 		}
-		return fmt.Sprintf("gt-%s-witness", rig), nil
+		return session.WitnessSessionName(rig), nil
 
 	case "refinery", "ref":
 		rig := os.Getenv("GT_RIG")
 		if rig == "" {
 			return "", fmt.Errorf("cannot determine rig - set GT_RIG or run from rig context")
 		}
-		return fmt.Sprintf("gt-%s-refinery", rig), nil
+		return session.RefinerySessionName(rig), nil
 
 	default:
 		// Assume it's a direct session name (e.g., gt-gastown-crew-max)
@@ -287,14 +284,14 @@ func resolvePathToSession(path string) (string, error) {
 	if len(parts) == 3 && parts[1] == "crew" {
 		rig := parts[0]
 		name := parts[2]
-		return fmt.Sprintf("gt-%s-crew-%s", rig, name), nil
+		return session.CrewSessionName(rig, name), nil
 	}
 
 	// Handle <rig>/polecats/<name> format (explicit polecat path)
 	if len(parts) == 3 && parts[1] == "polecats" {
 		rig := parts[0]
 		name := strings.ToLower(parts[2]) // normalize polecat name
-		return fmt.Sprintf("gt-%s-%s", rig, name), nil
+		return session.PolecatSessionName(rig, name), nil
 	}
 
 	// Handle <rig>/<role-or-polecat> format
@@ -306,9 +303,9 @@ func resolvePathToSession(path string) (string, error) {
 		// Check for known roles first
 		switch secondLower {
 		case "witness":
-			return fmt.Sprintf("gt-%s-witness", rig), nil
+			return session.WitnessSessionName(rig), nil
 		case "refinery":
-			return fmt.Sprintf("gt-%s-refinery", rig), nil
+			return session.RefinerySessionName(rig), nil
 		case "crew":
 			// Just "<rig>/crew" without a name - need more info
 			return "", fmt.Errorf("crew path requires name: %s/crew/<name>", rig)
@@ -323,11 +320,11 @@ func resolvePathToSession(path string) (string, error) {
 			if townRoot != "" {
 				crewPath := filepath.Join(townRoot, rig, "crew", second)
 				if info, err := os.Stat(crewPath); err == nil && info.IsDir() {
-					return fmt.Sprintf("gt-%s-crew-%s", rig, second), nil
+					return session.CrewSessionName(rig, second), nil
 				}
 			}
 			// Not a crew member - treat as polecat name (e.g., gastown/nux)
-			return fmt.Sprintf("gt-%s-%s", rig, secondLower), nil
+			return session.PolecatSessionName(rig, secondLower), nil
 		}
 	}
 
@@ -427,30 +424,22 @@ func sessionWorkDir(sessionName, townRoot string) (string, error) {
 
 	case strings.Contains(sessionName, "-crew-"):
 		// gt-<rig>-crew-<name> -> <townRoot>/<rig>/crew/<name>
-		parts := strings.Split(sessionName, "-")
-		if len(parts) < 4 {
-			return "", fmt.Errorf("invalid crew session name: %s", sessionName)
-		}
-		// Find the index of "crew" to split rig name (may contain dashes)
-		for i, p := range parts {
-			if p == "crew" && i > 1 && i < len(parts)-1 {
-				rig := strings.Join(parts[1:i], "-")
-				name := strings.Join(parts[i+1:], "-")
-				return fmt.Sprintf("%s/%s/crew/%s", townRoot, rig, name), nil
-			}
+		rig, name, ok := parseCrewSessionName(sessionName)
+		if !ok {
+			return "", fmt.Errorf("cannot parse crew session name: %s", sessionName)
 		}
-		return "", fmt.Errorf("cannot parse crew session name: %s", sessionName)
+		return fmt.Sprintf("%s/%s/crew/%s", townRoot, rig, name), nil
 
 	case strings.HasSuffix(sessionName, "-witness"):
 		// gt-<rig>-witness -> <townRoot>/<rig>/witness
 		// Note: witness doesn't have a /rig worktree like refinery does
-		rig := strings.TrimPrefix(sessionName, "gt-")
+		rig := strings.TrimPrefix(sessionName, sessionPrefix())
 		rig = strings.TrimSuffix(rig, "-witness")
 		return fmt.Sprintf("%s/%s/witness", townRoot, rig), nil
 
 	case strings.HasSuffix(sessionName, "-refinery"):
 		// gt-<rig>-refinery -> <townRoot>/<rig>/refinery/rig
-		rig := strings.TrimPrefix(sessionName, "gt-")
+		rig := strings.TrimPrefix(sessionName, sessionPrefix())
 		rig = strings.TrimSuffix(rig, "-refinery")
 		return fmt.Sprintf("%s/%s/refinery/rig", townRoot, rig), nil
 
@@ -533,7 +522,7 @@ func handoffRemoteSession(t *tmux.Tmux, targetSession, restartCmd string) error
 	if handoffWatch {
 		fmt.Printf("Switching to %s...\n", targetSession)
 		// Use tmux switch-client to move our view to the target session
-		if err := exec.Command("tmux", "switch-client", "-t", targetSession).Run(); err != nil {
+		if err := t.SwitchClient(targetSession); err != nil {
 			// Non-fatal - they can manually switch
 			fmt.Printf("Note: Could not auto-switch (use: tmux switch-client -t %s)\n", targetSession)
 		}
@@ -545,11 +534,11 @@ func handoffRemoteSession(t *tmux.Tmux, targetSession, restartCmd string) error
 // getSessionPane returns the pane identifier for a session's main pane.
 func getSessionPane(sessionName string) (string, error) {
 	// Get the pane ID for the first pane in the session
-	out, err := exec.Command("tmux", "list-panes", "-t", sessionName, "-F", "#{pane_id}").Output()
+	out, err := tmux.NewTmux().Run("list-panes", "-t", sessionName, "-F", "#{pane_id}")
 	if err != nil {
 		return "", err
 	}
-	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	lines := strings.Split(out, "\n")
 	if len(lines) == 0 || lines[0] == "" {
 		return "", fmt.Errorf("no panes found in session")
 	}
@@ -600,7 +589,8 @@ func sendHandoffMail(subject, message string) (string, error) {
 		"--silent",    // Output only the bead ID
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Dir = townRoot // Run from town root for town-level beads
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+filepath.Join(townRoot, ".beads"))
 
@@ -622,7 +612,8 @@ func sendHandoffMail(subject, message string) (string, error) {
 	}
 
 	// Auto-hook the created mail bead
-	hookCmd := exec.Command("bd", "update", beadID, "--status=hooked", "--assignee="+agentID)
+	hookCmd, cancel := beads.Command("update", beadID, "--status=hooked", "--assignee="+agentID)
+	defer cancel()
 	hookCmd.Dir = townRoot
 	hookCmd.Env = append(os.Environ(), "BEADS_DIR="+filepath.Join(townRoot, ".beads"))
 	hookCmd.Stderr = os.Stderr
@@ -673,7 +664,8 @@ func looksLikeBeadID(s string) bool {
 // hookBeadForHandoff attaches a bead to the current agent's hook.
 func hookBeadForHandoff(beadID string) error {
 	// Verify the bead exists first
-	verifyCmd := exec.Command("bd", "show", beadID, "--json")
+	verifyCmd, cancel := beads.Command("show", beadID, "--json")
+	defer cancel()
 	if err := verifyCmd.Run(); err != nil {
 		return fmt.Errorf("bead '%s' not found", beadID)
 	}
@@ -692,7 +684,8 @@ func hookBeadForHandoff(beadID string) error {
 	}
 
 	// Pin the bead using bd update (discovery-based approach)
-	pinCmd := exec.Command("bd", "update", beadID, "--status=pinned", "--assignee="+agentID)
+	pinCmd, cancel := beads.Command("update", beadID, "--status=pinned", "--assignee="+agentID)
+	defer cancel()
 	pinCmd.Stderr = os.Stderr
 	if err := pinCmd.Run(); err != nil {
 		return fmt.Errorf("pinning bead: %w", err)
@@ -731,7 +724,9 @@ func collectHandoffState() string {
 	}
 
 	// Get ready beads
-	readyOutput, err := exec.Command("bd", "ready").Output()
+	readyCmd, cancel := beads.Command("ready")
+	defer cancel()
+	readyOutput, err := readyCmd.Output()
 	if err == nil {
 		readyStr := strings.TrimSpace(string(readyOutput))
 		if readyStr != "" && !strings.Contains(readyStr, "No issues ready") {
@@ -745,7 +740,9 @@ func collectHandoffState() string {
 	}
 
 	// Get in-progress beads
-	inProgressOutput, err := exec.Command("bd", "list", "--status=in_progress").Output()
+	inProgressCmd, cancel := beads.Command("list", "--status=in_progress")
+	defer cancel()
+	inProgressOutput, err := inProgressCmd.Output()
 	if err == nil {
 		ipStr := strings.TrimSpace(string(inProgressOutput))
 		if ipStr != "" && !strings.Contains(ipStr, "No issues") {
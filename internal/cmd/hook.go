@@ -179,7 +179,8 @@ func runHook(_ *cobra.Command, args []string) error {
 					if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
 						closeArgs = append(closeArgs, "--session="+sessionID)
 					}
-					closeCmd := exec.Command("bd", closeArgs...)
+					closeCmd, cancel := beads.Command(closeArgs...)
+					defer cancel()
 					closeCmd.Stderr = os.Stderr
 					if err := closeCmd.Run(); err != nil {
 						return fmt.Errorf("closing completed bead %s: %w", existing.ID, err)
@@ -223,7 +224,8 @@ func runHook(_ *cobra.Command, args []string) error {
 	}
 
 	// Hook the bead using bd update (discovery-based approach)
-	hookCmd := exec.Command("bd", "update", beadID, "--status=hooked", "--assignee="+agentID)
+	hookCmd, cancel := beads.Command("update", beadID, "--status=hooked", "--assignee="+agentID)
+	defer cancel()
 	hookCmd.Stderr = os.Stderr
 	if err := hookCmd.Run(); err != nil {
 		return fmt.Errorf("hooking bead: %w", err)
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -156,7 +157,8 @@ func registerCustomTypes(workDir string) error {
 	}
 
 	// Try to set custom types
-	cmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+	cmd, cancel := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
+	defer cancel()
 	cmd.Dir = workDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
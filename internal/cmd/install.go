@@ -356,7 +356,8 @@ func writeJSON(path string, data interface{}) error {
 // Town beads use the "hq-" prefix for mayor mail and cross-rig coordination.
 func initTownBeads(townPath string) error {
 	// Run: bd init --prefix hq
-	cmd := exec.Command("bd", "init", "--prefix", "hq")
+	cmd, cancel := beads.Command("init", "--prefix", "hq")
+	defer cancel()
 	cmd.Dir = townPath
 
 	output, err := cmd.CombinedOutput()
@@ -371,7 +372,8 @@ func initTownBeads(townPath string) error {
 
 	// Configure custom types for Gas Town (agent, role, rig, convoy, slot).
 	// These were extracted from beads core in v0.46.0 and now require explicit config.
-	configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+	configCmd, cancel := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
+	defer cancel()
 	configCmd.Dir = townPath
 	if configOutput, configErr := configCmd.CombinedOutput(); configErr != nil {
 		// Non-fatal: older beads versions don't need this, newer ones do
@@ -417,7 +419,8 @@ func initTownBeads(townPath string) error {
 // has a repository fingerprint. Legacy databases (pre-0.17.5) lack this, which
 // prevents the daemon from starting properly.
 func ensureRepoFingerprint(beadsPath string) error {
-	cmd := exec.Command("bd", "migrate", "--update-repo-id")
+	cmd, cancel := beads.Command("migrate", "--update-repo-id")
+	defer cancel()
 	cmd.Dir = beadsPath
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -431,7 +434,8 @@ func ensureRepoFingerprint(beadsPath string) error {
 // Gas Town needs custom types: agent, role, rig, convoy, slot.
 // This is idempotent - safe to call multiple times.
 func ensureCustomTypes(beadsPath string) error {
-	cmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+	cmd, cancel := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
+	defer cancel()
 	cmd.Dir = beadsPath
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -550,7 +554,8 @@ func ensureBeadsCustomTypes(workDir string, types []string) error {
 		return nil
 	}
 
-	cmd := exec.Command("bd", "config", "set", "types.custom", strings.Join(types, ","))
+	cmd, cancel := beads.Command("config", "set", "types.custom", strings.Join(types, ","))
+	defer cancel()
 	cmd.Dir = workDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
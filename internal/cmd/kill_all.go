@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/boot"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var (
+	killAllRig string
+	killAllYes bool
+)
+
+var killAllCmd = &cobra.Command{
+	Use:     "kill-all",
+	GroupID: GroupServices,
+	Short:   "Kill every Gas Town tmux session",
+	Long: `Kill every Gas Town tmux session: town agents, witnesses, refineries,
+crew and polecats.
+
+Sessions are listed and confirmed before anything is killed (skip the
+prompt with --yes). Witnesses are stopped through the witness manager so
+their state files record the stop; everything else is killed along with
+its processes. Sessions that don't belong to Gas Town are left alone.
+
+With --rig, only that rig's sessions are killed; town agents (mayor,
+deacon, boot) and multi-rig witness sessions are kept.
+
+The daemon is not stopped and may restart agents on its next heartbeat.
+For a full, reversible shutdown use 'gt down' instead.
+
+Examples:
+  gt kill-all
+  gt kill-all --rig greenplace --yes`,
+	Args: cobra.NoArgs,
+	RunE: runKillAll,
+}
+
+func init() {
+	killAllCmd.Flags().StringVar(&killAllRig, "rig", "", "Only kill this rig's sessions")
+	killAllCmd.Flags().BoolVarP(&killAllYes, "yes", "y", false, "Don't ask for confirmation")
+	rootCmd.AddCommand(killAllCmd)
+}
+
+// killTarget is a Gas Town session gt kill-all will kill.
+type killTarget struct {
+	Session string
+	Kind    string
+	Rig     string
+}
+
+// Session kinds, in the order kill-all takes them down: the agents that
+// restart others go first, so nothing is brought back mid-teardown.
+const (
+	killKindDeacon       = "deacon"
+	killKindBoot         = "boot"
+	killKindWitnessGroup = "witness group"
+	killKindWitness      = "witness"
+	killKindRefinery     = "refinery"
+	killKindPolecat      = "polecat"
+	killKindCrew         = "crew"
+	killKindMayor        = "mayor"
+)
+
+var killKindOrder = map[string]int{
+	killKindDeacon:       0,
+	killKindBoot:         1,
+	killKindWitnessGroup: 2,
+	killKindWitness:      3,
+	killKindRefinery:     4,
+	killKindPolecat:      5,
+	killKindCrew:         6,
+	killKindMayor:        7,
+}
+
+// killTargets picks the Gas Town sessions out of a tmux session list,
+// limited to rigName's sessions if it is set, in teardown order.
+func killTargets(sessions []string, rigName string) []killTarget {
+	var targets []killTarget
+	for _, s := range sessions {
+		var kt killTarget
+		if s == boot.SessionName {
+			kt = killTarget{Session: s, Kind: killKindBoot}
+		} else {
+			agent := categorizeSession(s)
+			if agent == nil {
+				continue
+			}
+			kt = killTarget{Session: s, Kind: agentTypeNames[agent.Type], Rig: agent.Rig}
+			if agent.Type == AgentWitness && s != witnessSessionName(agent.Rig) {
+				// gt-witness-<name>: a multi-rig witness group
+				kt = killTarget{Session: s, Kind: killKindWitnessGroup}
+			}
+		}
+		if rigName != "" && kt.Rig != rigName {
+			continue
+		}
+		targets = append(targets, kt)
+	}
+	sort.SliceStable(targets, func(i, j int) bool {
+		if targets[i].Kind != targets[j].Kind {
+			return killKindOrder[targets[i].Kind] < killKindOrder[targets[j].Kind]
+		}
+		return targets[i].Session < targets[j].Session
+	})
+	return targets
+}
+
+func runKillAll(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
+
+	t := tmux.NewTmux()
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return fmt.Errorf("listing sessions: %w", err)
+	}
+	targets := killTargets(sessions, killAllRig)
+	if len(targets) == 0 {
+		if killAllRig != "" {
+			fmt.Printf("No Gas Town sessions running for %s.\n", killAllRig)
+		} else {
+			fmt.Println("No Gas Town sessions running.")
+		}
+		return nil
+	}
+
+	fmt.Printf("%s Gas Town sessions to kill:\n\n", style.Bold.Render("⚠"))
+	for _, kt := range targets {
+		fmt.Printf("  %-28s %s\n", kt.Session, style.Dim.Render(kt.Kind))
+	}
+	fmt.Println()
+	if !killAllYes && !promptYesNo(fmt.Sprintf("Kill these %d session(s)?", len(targets))) {
+		fmt.Println("Aborted")
+		return nil
+	}
+
+	killed := make(map[string]int)
+	var failed []string
+	for _, kt := range targets {
+		if err := killGastownSession(t, kt); err != nil {
+			fmt.Printf("%s %s: %v\n", style.ErrorPrefix, kt.Session, err)
+			failed = append(failed, kt.Session)
+			continue
+		}
+		killed[kt.Kind]++
+	}
+
+	var parts []string
+	for _, kind := range []string{killKindDeacon, killKindBoot, killKindWitnessGroup, killKindWitness,
+		killKindRefinery, killKindPolecat, killKindCrew, killKindMayor} {
+		if n := killed[kind]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, kind))
+		}
+	}
+	if len(failed) == 0 {
+		fmt.Printf("%s Killed %d session(s): %s\n", style.SuccessPrefix, len(targets), strings.Join(parts, ", "))
+		return nil
+	}
+	fmt.Printf("%s Killed %d of %d session(s); failed: %s\n", style.WarningPrefix,
+		len(targets)-len(failed), len(targets), strings.Join(failed, ", "))
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	return NewSilentExit(1)
+}
+
+// killGastownSession kills one session. Witnesses go through the witness
+// manager so their state files say stopped; a session whose rig can't be
+// resolved is still killed.
+func killGastownSession(t *tmux.Tmux, kt killTarget) error {
+	switch kt.Kind {
+	case killKindWitness:
+		if mgr, err := getWitnessManager(kt.Rig); err == nil {
+			if err := mgr.Stop(); err != nil && !errors.Is(err, witness.ErrNotRunning) {
+				return err
+			}
+			return nil
+		}
+	case killKindWitnessGroup:
+		stopWitnessGroupRigs(strings.TrimPrefix(kt.Session, witness.GroupSessionName("")))
+	}
+	return t.KillSessionWithProcesses(kt.Session)
+}
+
+// stopWitnessGroupRigs marks stopped every rig a witness group monitors, so
+// their state files don't claim a loop that is about to be killed.
+func stopWitnessGroupRigs(group string) {
+	rigs, err := allRigNames()
+	if err != nil {
+		return
+	}
+	for _, rigName := range rigs {
+		mgr, err := getWitnessManager(rigName)
+		if err != nil {
+			continue
+		}
+		if w, err := mgr.Status(); err == nil && w.Group == group {
+			_ = mgr.Stop()
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKillTargets(t *testing.T) {
+	sessions := []string{
+		"scratch",
+		"hq-mayor",
+		"gt-gastown-toast",
+		"gt-gastown-witness",
+		"gt-beads-refinery",
+		"gt-gastown-crew-max",
+		"gt-witness-1a2b3c4d",
+		"gt-boot",
+		"hq-deacon",
+		"hq-unknown",
+	}
+
+	var got []string
+	for _, kt := range killTargets(sessions, "") {
+		got = append(got, kt.Session+":"+kt.Kind)
+	}
+	want := []string{
+		"hq-deacon:deacon",
+		"gt-boot:boot",
+		"gt-witness-1a2b3c4d:witness group",
+		"gt-gastown-witness:witness",
+		"gt-beads-refinery:refinery",
+		"gt-gastown-toast:polecat",
+		"gt-gastown-crew-max:crew",
+		"hq-mayor:mayor",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("killTargets = %v, want %v", got, want)
+	}
+
+	got = nil
+	for _, kt := range killTargets(sessions, "gastown") {
+		got = append(got, kt.Session)
+	}
+	want = []string{"gt-gastown-witness", "gt-gastown-toast", "gt-gastown-crew-max"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("killTargets(--rig gastown) = %v, want %v", got, want)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -328,7 +327,8 @@ func listChannelMessages(townRoot, channelName string) ([]channelMessage, error)
 		"--json",
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stdout, stderr bytes.Buffer
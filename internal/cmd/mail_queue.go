@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"sort"
 	"strings"
 	"time"
@@ -145,7 +144,8 @@ func listUnclaimedQueueMessages(beadsDir, queueName string) ([]queueMessage, err
 		"--json",
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stdout, stderr bytes.Buffer
@@ -226,7 +226,8 @@ func claimQueueMessage(beadsDir, messageID, claimant string) error {
 		"claimed-at:" + now,
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Env = append(os.Environ(),
 		"BEADS_DIR="+beadsDir,
 		"BD_ACTOR="+claimant,
@@ -306,7 +307,8 @@ type queueMessageInfo struct {
 func getQueueMessageInfo(beadsDir, messageID string) (*queueMessageInfo, error) {
 	args := []string{"show", messageID, "--json"}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stdout, stderr bytes.Buffer
@@ -375,7 +377,8 @@ func releaseQueueMessage(beadsDir, messageID, actor string) error {
 	// Remove claimed-by label
 	if info.ClaimedBy != "" {
 		args := []string{"label", "remove", messageID, "claimed-by:" + info.ClaimedBy}
-		cmd := exec.Command("bd", args...)
+		cmd, cancel := beads.Command(args...)
+		defer cancel()
 		cmd.Env = append(os.Environ(),
 			"BEADS_DIR="+beadsDir,
 			"BD_ACTOR="+actor,
@@ -396,7 +399,8 @@ func releaseQueueMessage(beadsDir, messageID, actor string) error {
 	if info.ClaimedAt != nil {
 		claimedAtStr := info.ClaimedAt.Format(time.RFC3339)
 		args := []string{"label", "remove", messageID, "claimed-at:" + claimedAtStr}
-		cmd := exec.Command("bd", args...)
+		cmd, cancel := beads.Command(args...)
+		defer cancel()
 		cmd.Env = append(os.Environ(),
 			"BEADS_DIR="+beadsDir,
 			"BD_ACTOR="+actor,
@@ -101,6 +101,9 @@ func getMayorSessionName() string {
 }
 
 func runMayorStart(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	mgr, err := getMayorManager()
 	if err != nil {
 		return err
@@ -140,6 +143,9 @@ func runMayorStop(cmd *cobra.Command, args []string) error {
 }
 
 func runMayorAttach(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	mgr, err := getMayorManager()
 	if err != nil {
 		return err
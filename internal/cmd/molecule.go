@@ -77,14 +77,19 @@ Examples:
 }
 
 var moleculeDetachCmd = &cobra.Command{
-	Use:   "detach <pinned-bead-id>",
+	Use:   "detach <pinned-bead-id | rig/polecats/name>",
 	Short: "Detach molecule from a pinned bead",
 	Long: `Remove molecule attachment from a pinned/handoff bead.
 
 This clears the attached_molecule and attached_at fields from the bead.
 
-Example:
-  gt molecule detach gt-abc`,
+Given a polecat address instead of a bead ID, the work molecule that
+gt sling attached to the polecat's agent bead is detached, so the polecat
+can be reassigned. Detaching a polecat with nothing attached does nothing.
+
+Examples:
+  gt molecule detach gt-abc
+  gt molecule detach gastown/polecats/Toast`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMoleculeDetach,
 }
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
@@ -98,6 +100,15 @@ func detectAgentBeadID() (string, error) {
 func runMoleculeDetach(cmd *cobra.Command, args []string) error {
 	pinnedBeadID := args[0]
 
+	if isPolecatTarget(pinnedBeadID) {
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		rigPath := filepath.Join(townRoot, strings.SplitN(pinnedBeadID, "/", 2)[0])
+		return detachPolecatWorkMolecule(pinnedBeadID, rigPath, townRoot)
+	}
+
 	workDir, err := findLocalBeadsDir()
 	if err != nil {
 		return fmt.Errorf("not in a beads workspace: %w", err)
@@ -148,7 +159,7 @@ func runMoleculeAttachment(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting issue: %w", err)
 	}
 
-	attachment := beads.ParseAttachmentFields(issue)
+	bead := beads.NewBead(issue)
 
 	if moleculeJSON {
 		type attachmentOutput struct {
@@ -159,13 +170,11 @@ func runMoleculeAttachment(cmd *cobra.Command, args []string) error {
 			AttachedAt       string `json:"attached_at,omitempty"`
 		}
 		out := attachmentOutput{
-			IssueID:    issue.ID,
-			IssueTitle: issue.Title,
-			Status:     issue.Status,
-		}
-		if attachment != nil {
-			out.AttachedMolecule = attachment.AttachedMolecule
-			out.AttachedAt = attachment.AttachedAt
+			IssueID:          bead.ID,
+			IssueTitle:       bead.Title,
+			Status:           bead.Status,
+			AttachedMolecule: bead.AttachedMolecule,
+			AttachedAt:       bead.AttachedAt,
 		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -173,16 +182,16 @@ func runMoleculeAttachment(cmd *cobra.Command, args []string) error {
 	}
 
 	// Human-readable output
-	fmt.Printf("\n%s: %s\n", style.Bold.Render(issue.ID), issue.Title)
-	fmt.Printf("Status: %s\n", issue.Status)
+	fmt.Printf("\n%s: %s\n", style.Bold.Render(bead.ID), bead.Title)
+	fmt.Printf("Status: %s\n", bead.Status)
 
-	if attachment == nil || attachment.AttachedMolecule == "" {
+	if bead.AttachedMolecule == "" {
 		fmt.Printf("\n%s\n", style.Dim.Render("No molecule attached"))
 	} else {
 		fmt.Printf("\n%s\n", style.Bold.Render("Attached Molecule:"))
-		fmt.Printf("  ID: %s\n", attachment.AttachedMolecule)
-		if attachment.AttachedAt != "" {
-			fmt.Printf("  Attached at: %s\n", attachment.AttachedAt)
+		fmt.Printf("  ID: %s\n", bead.AttachedMolecule)
+		if bead.AttachedAt != "" {
+			fmt.Printf("  Attached at: %s\n", bead.AttachedAt)
 		}
 	}
 
@@ -347,7 +347,8 @@ func setAgentIdleCycles(agentBead, beadsDir string, cycles int) error {
 		args = append(args, "--set-labels="+label)
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	if err := cmd.Run(); err != nil {
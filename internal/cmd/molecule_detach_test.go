@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeDetachBDStub installs a bd stub whose show output carries desc and
+// which logs every update call to the returned file.
+func writeDetachBDStub(t *testing.T, townRoot, desc string) string {
+	t.Helper()
+	binDir := filepath.Join(townRoot, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatalf("mkdir binDir: %v", err)
+	}
+	logPath := filepath.Join(townRoot, "bd-updates.log")
+	script := `#!/bin/sh
+for arg in "$@"; do
+  case "$arg" in
+    show) printf '%s\n' '[{"id":"gt-gastown-polecat-Toast","status":"pinned","description":"` + desc + `"}]'; exit 0 ;;
+    update) echo "$@" >> "` + logPath + `"; exit 0 ;;
+  esac
+done
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return logPath
+}
+
+func TestDetachPolecatWorkMolecule(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	if err := os.MkdirAll(filepath.Join(rigPath, ".beads"), 0755); err != nil {
+		t.Fatalf("mkdir rig: %v", err)
+	}
+	logPath := writeDetachBDStub(t, townRoot, `role: polecat\nattached_molecule: mol-polecat-work\nattached_at: 2026-01-01T00:00:00Z`)
+
+	if err := detachPolecatWorkMolecule("gastown/polecats/Toast", rigPath, townRoot); err != nil {
+		t.Fatalf("detachPolecatWorkMolecule: %v", err)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("expected a bd update call: %v", err)
+	}
+	update := string(data)
+	if !strings.Contains(update, "gt-gastown-polecat-Toast") {
+		t.Errorf("update targeted wrong bead: %s", update)
+	}
+	if strings.Contains(update, "attached_molecule") || !strings.Contains(update, "role: polecat") {
+		t.Errorf("update should keep other fields and drop the attachment: %s", update)
+	}
+}
+
+func TestDetachPolecatWorkMolecule_NothingAttached(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	if err := os.MkdirAll(filepath.Join(rigPath, ".beads"), 0755); err != nil {
+		t.Fatalf("mkdir rig: %v", err)
+	}
+	logPath := writeDetachBDStub(t, townRoot, `role: polecat`)
+
+	if err := detachPolecatWorkMolecule("gastown/polecats/Toast", rigPath, townRoot); err != nil {
+		t.Fatalf("detachPolecatWorkMolecule: %v", err)
+	}
+	if _, err := os.Stat(logPath); !os.IsNotExist(err) {
+		t.Errorf("expected no bd update when nothing is attached")
+	}
+}
+
+func TestDetachPolecatWorkMolecule_InvalidTarget(t *testing.T) {
+	if err := detachPolecatWorkMolecule("gastown/crew/max", t.TempDir(), t.TempDir()); err == nil {
+		t.Error("expected error for non-polecat target")
+	}
+}
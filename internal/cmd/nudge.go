@@ -107,6 +107,17 @@ func runNudge(cmd *cobra.Command, args []string) error {
 	// Prefix message with sender
 	message = fmt.Sprintf("[from %s] %s", sender, message)
 
+	// A witness in dry-run mode logs its nudges instead of sending them
+	if roleInfo, err := GetRole(); err == nil {
+		if mgr := witnessDryRunFor(roleInfo); mgr != nil {
+			fmt.Printf("%s [dry-run] would nudge %s: %s\n", style.Dim.Render("○"), target, message)
+			if err := mgr.RecordWouldNudge(dryRunPolecat(roleInfo.Rig, target)); err != nil {
+				style.PrintWarning("recording would-nudge: %v", err)
+			}
+			return nil
+		}
+	}
+
 	// Check DND status for target (unless force flag or channel target)
 	townRoot, _ := workspace.FindFromCwd()
 	if townRoot != "" && !nudgeForceFlag && !strings.HasPrefix(target, "channel:") {
@@ -479,15 +490,15 @@ func addressToAgentBeadID(address string) string {
 
 	switch role {
 	case "witness":
-		return fmt.Sprintf("gt-%s-witness", rig)
+		return session.WitnessSessionName(rig)
 	case "refinery":
-		return fmt.Sprintf("gt-%s-refinery", rig)
+		return session.RefinerySessionName(rig)
 	default:
 		// Assume polecat
 		if strings.HasPrefix(role, "crew/") {
 			crewName := strings.TrimPrefix(role, "crew/")
-			return fmt.Sprintf("gt-%s-crew-%s", rig, crewName)
+			return session.CrewSessionName(rig, crewName)
 		}
-		return fmt.Sprintf("gt-%s-polecat-%s", rig, role)
+		return fmt.Sprintf("%s%s-polecat-%s", sessionPrefix(), rig, role)
 	}
 }
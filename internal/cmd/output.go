@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Status commands share one --output flag instead of growing per-command
+// --json/--yaml flags. To adopt it in a command:
+//
+//  1. Register the flags with addOutputFlags(cmd, &fooOutput, &fooJSON),
+//     keeping the old --json variable as the deprecated alias.
+//  2. Resolve the format at the top of RunE with resolveOutputFormat.
+//  3. For formatTable print with style as before; otherwise hand the same
+//     structs the JSON path used to writeOutput.
+//
+// JSON and YAML are rendered from the same value, so field names and order
+// match between them and never carry ANSI styling.
+
+// outputFormat is a value of the shared --output flag.
+type outputFormat string
+
+const (
+	formatTable outputFormat = "table"
+	formatJSON  outputFormat = "json"
+	formatYAML  outputFormat = "yaml"
+)
+
+// addOutputFlags registers --output/-o on cmd, plus --json as a deprecated
+// alias for --output=json.
+func addOutputFlags(cmd *cobra.Command, format *string, jsonAlias *bool) {
+	cmd.Flags().StringVarP(format, "output", "o", string(formatTable), "Output format: table, json, yaml")
+	cmd.Flags().BoolVar(jsonAlias, "json", false, "Output as JSON")
+	_ = cmd.Flags().MarkDeprecated("json", "use --output=json")
+}
+
+// resolveOutputFormat validates --output and folds in the --json alias.
+func resolveOutputFormat(format string, jsonAlias bool) (outputFormat, error) {
+	f := outputFormat(strings.ToLower(format))
+	switch f {
+	case formatTable, formatJSON, formatYAML:
+	case "":
+		f = formatTable
+	default:
+		return "", fmt.Errorf("invalid --output %q: want table, json or yaml", format)
+	}
+	if jsonAlias {
+		if f != formatTable && f != formatJSON {
+			return "", fmt.Errorf("--json conflicts with --output=%s", f)
+		}
+		f = formatJSON
+	}
+	return f, nil
+}
+
+// writeOutput renders v as JSON or YAML. Table output is command-specific
+// and stays with the caller.
+func writeOutput(w io.Writer, format outputFormat, v interface{}) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatYAML:
+		data, err := marshalYAML(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	return fmt.Errorf("writeOutput: unsupported format %q", format)
+}
+
+// marshalYAML renders v as block-style YAML. It goes through encoding/json
+// so json struct tags, omitempty and custom marshalers apply exactly as they
+// do for --output=json, and field order is preserved.
+func marshalYAML(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	root, err := decodeYAMLNode(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	if root.isBlock() {
+		root.writeBlock(&b, 0)
+	} else {
+		b.WriteString(root.inline() + "\n")
+	}
+	return []byte(b.String()), nil
+}
+
+// yamlNode is a decoded JSON value that remembers object key order.
+type yamlNode struct {
+	object bool
+	array  bool
+	keys   []string
+	items  []*yamlNode
+	scalar string
+}
+
+func decodeYAMLNode(dec *json.Decoder) (*yamlNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		n := &yamlNode{object: t == '{', array: t == '['}
+		for dec.More() {
+			if n.object {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				n.keys = append(n.keys, yamlString(keyTok.(string)))
+			}
+			item, err := decodeYAMLNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			n.items = append(n.items, item)
+		}
+		// Consume the closing delimiter.
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case string:
+		return &yamlNode{scalar: yamlString(t)}, nil
+	case json.Number:
+		return &yamlNode{scalar: t.String()}, nil
+	case bool:
+		return &yamlNode{scalar: fmt.Sprintf("%t", t)}, nil
+	case nil:
+		return &yamlNode{scalar: "null"}, nil
+	}
+	return nil, fmt.Errorf("unexpected JSON token %v", tok)
+}
+
+// isBlock reports whether the node renders across lines.
+func (n *yamlNode) isBlock() bool {
+	return (n.object || n.array) && len(n.items) > 0
+}
+
+// inline renders a scalar or an empty collection.
+func (n *yamlNode) inline() string {
+	switch {
+	case n.object:
+		return "{}"
+	case n.array:
+		return "[]"
+	}
+	return n.scalar
+}
+
+func (n *yamlNode) writeBlock(b *strings.Builder, indent int) {
+	pad := strings.Repeat(" ", indent)
+	for i, item := range n.items {
+		lead := pad + "- "
+		if n.object {
+			lead = pad + n.keys[i] + ":"
+		}
+
+		if !item.isBlock() {
+			if n.object {
+				lead += " "
+			}
+			b.WriteString(lead + item.inline() + "\n")
+			continue
+		}
+
+		if n.object {
+			b.WriteString(lead + "\n")
+			item.writeBlock(b, indent+2)
+			continue
+		}
+
+		// A collection inside a list starts on the dash line:
+		// render it one level deeper, then swap its first indent for "- ".
+		var nested strings.Builder
+		item.writeBlock(&nested, indent+2)
+		b.WriteString(lead + strings.TrimPrefix(nested.String(), pad+"  "))
+	}
+}
+
+// yamlPlain matches strings that YAML reads back unchanged without quotes.
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_./@+-]*$`)
+
+// yamlReserved are plain words YAML 1.1 parsers turn into bools or null.
+var yamlReserved = map[string]bool{
+	"true": true, "false": true, "yes": true, "no": true, "on": true,
+	"off": true, "y": true, "n": true, "null": true,
+}
+
+// yamlString quotes s unless it is safe as a plain scalar. JSON string
+// escapes are valid in YAML double-quoted scalars.
+func yamlString(s string) string {
+	if yamlPlain.MatchString(s) && !yamlReserved[strings.ToLower(s)] {
+		return s
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
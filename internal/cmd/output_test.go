@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestResolveOutputFormat(t *testing.T) {
+	tests := []struct {
+		format    string
+		jsonAlias bool
+		want      outputFormat
+		wantErr   bool
+	}{
+		{format: "table", want: formatTable},
+		{format: "", want: formatTable},
+		{format: "JSON", want: formatJSON},
+		{format: "yaml", want: formatYAML},
+		{format: "table", jsonAlias: true, want: formatJSON},
+		{format: "json", jsonAlias: true, want: formatJSON},
+		{format: "yaml", jsonAlias: true, wantErr: true},
+		{format: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := resolveOutputFormat(tt.format, tt.jsonAlias)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("resolveOutputFormat(%q, %v) = %q, want error", tt.format, tt.jsonAlias, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("resolveOutputFormat(%q, %v) = %q, %v; want %q", tt.format, tt.jsonAlias, got, err, tt.want)
+		}
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	type polecat struct {
+		Name  string   `json:"name"`
+		Tags  []string `json:"tags"`
+		Empty []string `json:"empty"`
+	}
+	v := struct {
+		Rig      string            `json:"rig"`
+		Running  bool              `json:"running"`
+		Count    int               `json:"count"`
+		Since    string            `json:"since"`
+		Note     string            `json:"note,omitempty"`
+		Word     string            `json:"word"`
+		Polecats []polecat         `json:"polecats"`
+		Matrix   [][]int           `json:"matrix"`
+		Config   map[string]string `json:"config"`
+		Missing  *polecat          `json:"missing"`
+	}{
+		Rig:      "gastown",
+		Running:  true,
+		Count:    2,
+		Since:    "2026-01-02T03:04:05Z",
+		Word:     "yes",
+		Polecats: []polecat{{Name: "Toast", Tags: []string{"a", "b c"}}},
+		Matrix:   [][]int{{1, 2}},
+		Config:   map[string]string{},
+	}
+
+	got, err := marshalYAML(v)
+	if err != nil {
+		t.Fatalf("marshalYAML: %v", err)
+	}
+	want := strings.Join([]string{
+		`rig: gastown`,
+		`running: true`,
+		`count: 2`,
+		`since: "2026-01-02T03:04:05Z"`,
+		`word: "yes"`,
+		`polecats:`,
+		`  - name: Toast`,
+		`    tags:`,
+		`      - a`,
+		`      - "b c"`,
+		`    empty: null`,
+		`matrix:`,
+		`  - - 1`,
+		`    - 2`,
+		`config: {}`,
+		`missing: null`,
+		``,
+	}, "\n")
+	if string(got) != want {
+		t.Errorf("marshalYAML mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteOutput_JSONHasNoANSI(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeOutput(&buf, formatJSON, map[string]string{"state": "running"}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Errorf("json output contains ANSI escapes: %q", buf.String())
+	}
+}
@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -29,7 +29,8 @@ type PatrolConfig struct {
 func findActivePatrol(cfg PatrolConfig) (patrolID, patrolLine string, found bool) {
 	// Check for in-progress patrol first (if configured)
 	if cfg.CheckInProgress {
-		cmdList := exec.Command("bd", "--no-daemon", "list", "--status=in_progress", "--type=epic")
+		cmdList, cancel := beads.Command("--no-daemon", "list", "--status=in_progress", "--type=epic")
+		defer cancel()
 		cmdList.Dir = cfg.BeadsDir
 		var stdoutList, stderrList bytes.Buffer
 		cmdList.Stdout = &stdoutList
@@ -53,7 +54,8 @@ func findActivePatrol(cfg PatrolConfig) (patrolID, patrolLine string, found bool
 	}
 
 	// Check for open patrols with open children (active wisp)
-	cmdOpen := exec.Command("bd", "--no-daemon", "list", "--status=open", "--type=epic")
+	cmdOpen, cancel := beads.Command("--no-daemon", "list", "--status=open", "--type=epic")
+	defer cancel()
 	cmdOpen.Dir = cfg.BeadsDir
 	var stdoutOpen, stderrOpen bytes.Buffer
 	cmdOpen.Stdout = &stdoutOpen
@@ -71,7 +73,8 @@ func findActivePatrol(cfg PatrolConfig) (patrolID, patrolLine string, found bool
 				if len(parts) > 0 {
 					molID := parts[0]
 					// Check if this molecule has open children
-					cmdShow := exec.Command("bd", "--no-daemon", "show", molID)
+					cmdShow, cancel := beads.Command("--no-daemon", "show", molID)
+					defer cancel()
 					cmdShow.Dir = cfg.BeadsDir
 					var stdoutShow, stderrShow bytes.Buffer
 					cmdShow.Stdout = &stdoutShow
@@ -103,7 +106,8 @@ func findActivePatrol(cfg PatrolConfig) (patrolID, patrolLine string, found bool
 // Returns the patrol ID or an error.
 func autoSpawnPatrol(cfg PatrolConfig) (string, error) {
 	// Find the proto ID for the patrol molecule
-	cmdCatalog := exec.Command("bd", "--no-daemon", "mol", "catalog")
+	cmdCatalog, cancel := beads.Command("--no-daemon", "mol", "catalog")
+	defer cancel()
 	cmdCatalog.Dir = cfg.BeadsDir
 	var stdoutCatalog, stderrCatalog bytes.Buffer
 	cmdCatalog.Stdout = &stdoutCatalog
@@ -136,7 +140,8 @@ func autoSpawnPatrol(cfg PatrolConfig) (string, error) {
 	}
 
 	// Create the patrol wisp
-	cmdSpawn := exec.Command("bd", "--no-daemon", "mol", "wisp", "create", protoID, "--actor", cfg.RoleName)
+	cmdSpawn, cancel := beads.Command("--no-daemon", "mol", "wisp", "create", protoID, "--actor", cfg.RoleName)
+	defer cancel()
 	cmdSpawn.Dir = cfg.BeadsDir
 	var stdoutSpawn, stderrSpawn bytes.Buffer
 	cmdSpawn.Stdout = &stdoutSpawn
@@ -166,7 +171,8 @@ func autoSpawnPatrol(cfg PatrolConfig) (string, error) {
 	}
 
 	// Hook the wisp to the agent so gt mol status sees it
-	cmdPin := exec.Command("bd", "--no-daemon", "update", patrolID, "--status=hooked", "--assignee="+cfg.Assignee)
+	cmdPin, cancel := beads.Command("--no-daemon", "update", patrolID, "--status=hooked", "--assignee="+cfg.Assignee)
+	defer cancel()
 	cmdPin.Dir = cfg.BeadsDir
 	if err := cmdPin.Run(); err != nil {
 		return patrolID, fmt.Errorf("created wisp %s but failed to hook", patrolID)
@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/spf13/cobra"
 )
 
@@ -18,9 +21,9 @@ func init() {
 }
 
 var peekCmd = &cobra.Command{
-	Use:     "peek <rig/polecat> [count]",
+	Use:     "peek <rig/polecat|rig/role|session> [count]",
 	GroupID: GroupComm,
-	Short:   "View recent output from a polecat or crew session",
+	Short:   "View recent output from an agent session",
 	Long: `Capture and display recent terminal output from an agent session.
 
 This is the ergonomic alias for 'gt session capture'. Use it to check
@@ -30,15 +33,20 @@ The nudge/peek pair provides the canonical interface for agent sessions:
   gt nudge - send messages TO a session (reliable delivery)
   gt peek  - read output FROM a session (capture-pane wrapper)
 
-Supports both polecats and crew workers:
+Supports every agent session, without attaching to it:
   - Polecats: rig/name format (e.g., greenplace/furiosa)
   - Crew: rig/crew/name format (e.g., beads/crew/dave)
+  - Rig agents: rig/witness, rig/refinery
+  - Town agents: mayor, deacon
+  - Any tmux session by name (e.g., gt-greenplace-witness, see 'gt sessions')
 
 Examples:
   gt peek greenplace/furiosa         # Polecat: last 100 lines (default)
   gt peek greenplace/furiosa 50      # Polecat: last 50 lines
   gt peek beads/crew/dave            # Crew: last 100 lines
-  gt peek beads/crew/dave -n 200     # Crew: last 200 lines`,
+  gt peek beads/crew/dave -n 200     # Crew: last 200 lines
+  gt peek greenplace/witness         # Witness session
+  gt peek hq-mayor --lines 20        # Session by name`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: runPeek,
 }
@@ -56,6 +64,16 @@ func runPeek(cmd *cobra.Command, args []string) error {
 		lines = n
 	}
 
+	// Rig and town agents and raw session names are captured directly
+	if sessionID, ok := peekSessionName(address); ok {
+		output, err := capturePeekSession(sessionID, lines)
+		if err != nil {
+			return err
+		}
+		fmt.Print(output)
+		return nil
+	}
+
 	rigName, polecatName, err := parseAddress(address)
 	if err != nil {
 		return err
@@ -78,6 +96,9 @@ func runPeek(cmd *cobra.Command, args []string) error {
 		output, err = mgr.Capture(polecatName, lines)
 	}
 
+	if errors.Is(err, polecat.ErrSessionNotFound) {
+		return fmt.Errorf("%s has no running session (see 'gt sessions')", address)
+	}
 	if err != nil {
 		return fmt.Errorf("capturing output: %w", err)
 	}
@@ -85,3 +106,46 @@ func runPeek(cmd *cobra.Command, args []string) error {
 	fmt.Print(output)
 	return nil
 }
+
+// peekSessionName resolves peek targets that aren't polecats or crew:
+// mayor, deacon, rig/witness, rig/refinery and raw hq-/gt- session names.
+func peekSessionName(address string) (string, bool) {
+	switch address {
+	case "mayor":
+		return session.MayorSessionName(), true
+	case "deacon":
+		return session.DeaconSessionName(), true
+	}
+	if strings.HasPrefix(address, session.HQPrefix) || strings.HasPrefix(address, sessionPrefix()) {
+		return address, true
+	}
+	rigName, role, ok := strings.Cut(address, "/")
+	if !ok || rigName == "" {
+		return "", false
+	}
+	switch role {
+	case "witness":
+		return session.WitnessSessionName(rigName), true
+	case "refinery":
+		return session.RefinerySessionName(rigName), true
+	}
+	return "", false
+}
+
+// capturePeekSession captures the last lines of a session by name,
+// reporting a missing session plainly rather than as a tmux error.
+func capturePeekSession(sessionID string, lines int) (string, error) {
+	t := tmux.NewTmux()
+	running, err := t.HasSession(sessionID)
+	if err != nil {
+		return "", fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return "", fmt.Errorf("session %s is not running (see 'gt sessions')", sessionID)
+	}
+	output, err := t.CapturePane(sessionID, lines)
+	if err != nil {
+		return "", fmt.Errorf("capturing output: %w", err)
+	}
+	return output, nil
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import "testing"
+
+func TestPeekSessionName(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+		ok      bool
+	}{
+		{"mayor", "hq-mayor", true},
+		{"deacon", "hq-deacon", true},
+		{"greenplace/witness", "gt-greenplace-witness", true},
+		{"greenplace/refinery", "gt-greenplace-refinery", true},
+		{"gt-greenplace-witness", "gt-greenplace-witness", true},
+		{"hq-mayor", "hq-mayor", true},
+		{"greenplace/furiosa", "", false},
+		{"beads/crew/dave", "", false},
+		{"furiosa", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := peekSessionName(tt.address)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("peekSessionName(%q) = %q, %v; want %q, %v", tt.address, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,8 +17,10 @@ import (
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/witness"
 )
 
 // Polecat command flags
@@ -51,6 +54,11 @@ all currently active polecats with their states:
   - done: Completed work, waiting for cleanup
   - stuck: Needs assistance
 
+Besides polecat worktrees, the list includes polecats that only have a
+tmux session or an open agent bead (gt-<rig>-polecat-<name>), and shows
+each polecat's attached work molecule and whether the rig's witness is
+monitoring it.
+
 Examples:
   gt polecat list greenplace
   gt polecat list --all
@@ -320,6 +328,74 @@ type PolecatListItem struct {
 	State          polecat.State `json:"state"`
 	Issue          string        `json:"issue,omitempty"`
 	SessionRunning bool          `json:"session_running"`
+	Worktree       bool          `json:"worktree"`           // has a polecat worktree
+	Molecule       string        `json:"molecule,omitempty"` // attached work molecule, from the agent bead
+	Monitored      bool          `json:"monitored"`          // in the running witness's monitored set
+}
+
+// mergePolecatSources adds to the worktree polecats of rigName the ones
+// only known from a tmux session or an open agent bead
+// (<prefix>-<rig>-polecat-<name>), and fills in each polecat's attached
+// molecule and whether the witness monitors it. Items are sorted by name.
+func mergePolecatSources(rigName string, items []PolecatListItem, sessions []string, agents []*beads.Issue, monitored []string) []PolecatListItem {
+	index := make(map[string]int)
+	for i, item := range items {
+		index[item.Name] = i
+	}
+	// get returns the named polecat's item, adding one if needed. The
+	// pointer is only good until the next call.
+	get := func(name string) *PolecatListItem {
+		i, ok := index[name]
+		if !ok {
+			i = len(items)
+			index[name] = i
+			items = append(items, PolecatListItem{Rig: rigName, Name: name})
+		}
+		return &items[i]
+	}
+
+	for _, s := range sessions {
+		id, err := session.ParseSessionName(s)
+		if err != nil || id.Role != session.RolePolecat || id.Rig != rigName {
+			continue
+		}
+		get(id.Name).SessionRunning = true
+	}
+	for _, issue := range agents {
+		rig, role, name, ok := beads.ParseAgentBeadID(issue.ID)
+		if !ok || role != "polecat" || rig != rigName || issue.Status == "closed" {
+			continue
+		}
+		item := get(name)
+		if item.State == "" {
+			item.State = polecat.State(beads.ParseAgentFields(issue.Description).AgentState)
+		}
+		if attachment := beads.ParseAttachmentFields(issue); attachment != nil {
+			item.Molecule = attachment.AttachedMolecule
+		}
+	}
+	for _, name := range monitored {
+		if i, ok := index[name]; ok {
+			items[i].Monitored = true
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	return items
+}
+
+// witnessMonitoredPolecats returns the polecats rigName's witness is
+// monitoring, or nil if it isn't running.
+func witnessMonitoredPolecats(rigName string) []string {
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return nil
+	}
+	w, err := mgr.Status()
+	if err != nil || w.State == witness.StateStopped {
+		return nil
+	}
+	return w.MonitoredPolecats
 }
 
 // getPolecatManager creates a polecat manager for the given rig.
@@ -373,16 +449,25 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
+		var items []PolecatListItem
 		for _, p := range polecats {
 			running, _ := polecatMgr.IsRunning(p.Name)
-			allPolecats = append(allPolecats, PolecatListItem{
+			items = append(items, PolecatListItem{
 				Rig:            r.Name,
 				Name:           p.Name,
 				State:          p.State,
 				Issue:          p.Issue,
 				SessionRunning: running,
+				Worktree:       true,
 			})
 		}
+
+		// Sessions and agent beads are best effort: without tmux or bd the
+		// worktrees are still listed.
+		sessions, _ := t.ListSessions()
+		agents, _ := beads.New(r.Path).List(beads.ListOptions{Label: "gt:agent", Priority: -1})
+		allPolecats = append(allPolecats,
+			mergePolecatSources(r.Name, items, sessions, agents, witnessMonitoredPolecats(r.Name))...)
 	}
 
 	// Output
@@ -421,10 +506,27 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 			stateStr = style.Dim.Render(stateStr)
 		}
 
-		fmt.Printf("  %s %s/%s  %s\n", sessionStatus, p.Rig, p.Name, stateStr)
+		if displayState == "" {
+			stateStr = style.Dim.Render("-")
+		}
+		var notes []string
+		if !p.Worktree {
+			notes = append(notes, "no worktree")
+		}
+		if p.Monitored {
+			notes = append(notes, "watched")
+		}
+		line := fmt.Sprintf("  %s %s/%s  %s", sessionStatus, p.Rig, p.Name, stateStr)
+		if len(notes) > 0 {
+			line += "  " + style.Dim.Render("("+strings.Join(notes, ", ")+")")
+		}
+		fmt.Println(line)
 		if p.Issue != "" {
 			fmt.Printf("    %s\n", style.Dim.Render(p.Issue))
 		}
+		if p.Molecule != "" {
+			fmt.Printf("    %s\n", style.Dim.Render("molecule: "+p.Molecule))
+		}
 	}
 
 	return nil
@@ -580,7 +682,8 @@ func runPolecatSync(cmd *cobra.Command, args []string) error {
 
 		fmt.Printf("Syncing %s/%s...\n", rigName, name)
 
-		syncCmd := exec.Command("bd", syncArgs...)
+		syncCmd, cancel := beads.Command(syncArgs...)
+		defer cancel()
 		syncCmd.Dir = p.ClonePath
 		output, err := syncCmd.CombinedOutput()
 		if err != nil {
@@ -1155,7 +1258,7 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 	for _, p := range targets {
 		if polecatNukeDryRun {
 			fmt.Printf("Would nuke %s/%s:\n", p.rigName, p.polecatName)
-			fmt.Printf("  - Kill session: gt-%s-%s\n", p.rigName, p.polecatName)
+			fmt.Printf("  - Kill session: %s\n", session.PolecatSessionName(p.rigName, p.polecatName))
 			fmt.Printf("  - Delete worktree: %s/polecats/%s\n", p.r.Path, p.polecatName)
 			fmt.Printf("  - Delete branch (if exists)\n")
 			fmt.Printf("  - Close agent bead: %s\n", beads.PolecatBeadID(p.rigName, p.polecatName))
@@ -1219,7 +1322,8 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 		if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
 			closeArgs = append(closeArgs, "--session="+sessionID)
 		}
-		closeCmd := exec.Command("bd", closeArgs...)
+		closeCmd, cancel := beads.Command(closeArgs...)
+		defer cancel()
 		closeCmd.Dir = filepath.Join(p.r.Path, "mayor", "rig")
 		if err := closeCmd.Run(); err != nil {
 			// Non-fatal - agent bead might not exist
@@ -0,0 +1,67 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt polecat attach, which attaches to a polecat's
+// tmux session by rig and polecat name.
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var polecatAttachReadOnly bool
+
+var polecatAttachCmd = &cobra.Command{
+	Use:     "attach <rig> <name>",
+	Aliases: []string{"at"},
+	Short:   "Attach to a polecat's session",
+	Long: `Attach the current terminal to a polecat's tmux session, found from the
+rig and polecat name. Detach with Ctrl-B D.
+
+Polecat sessions are never started by attaching: if the polecat has no
+running session this fails; start one with 'gt session start <rig>/<name>'.
+
+With --read-only, keystrokes are not sent to the polecat, so a stray key
+can't derail it. If the installed tmux can't attach read-only, the pane is
+streamed to the terminal instead; stop it with Ctrl-C.
+
+Examples:
+  gt polecat attach greenplace Toast
+  gt polecat attach greenplace Toast --read-only`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatAttach,
+}
+
+func init() {
+	polecatAttachCmd.Flags().BoolVar(&polecatAttachReadOnly, "read-only", false, "Watch without sending keystrokes to the polecat")
+	polecatCmd.AddCommand(polecatAttachCmd)
+}
+
+func runPolecatAttach(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
+	rigName, polecatName := args[0], args[1]
+
+	sessMgr, _, err := getSessionManager(rigName)
+	if err != nil {
+		return err
+	}
+	running, err := sessMgr.IsRunning(polecatName)
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		mgr, _, err := getPolecatManager(rigName)
+		if err != nil {
+			return err
+		}
+		if _, err := mgr.Get(polecatName); err != nil {
+			return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+		}
+		return fmt.Errorf("polecat %s/%s has no running session\nStart it with: gt session start %s/%s",
+			rigName, polecatName, rigName, polecatName)
+	}
+
+	return attachSession(sessMgr.SessionName(polecatName), polecatAttachReadOnly)
+}
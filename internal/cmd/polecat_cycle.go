@@ -2,9 +2,10 @@ package cmd
 
 import (
 	"fmt"
-	"os/exec"
 	"sort"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 // cyclePolecatSession switches to the next or previous polecat session in the same rig.
@@ -71,8 +72,7 @@ func cyclePolecatSession(direction int, sessionOverride string) error {
 	targetSession := sessions[targetIdx]
 
 	// Switch to target session
-	cmd := exec.Command("tmux", "switch-client", "-t", targetSession)
-	if err := cmd.Run(); err != nil {
+	if err := tmux.NewTmux().SwitchClient(targetSession); err != nil {
 		return fmt.Errorf("switching to %s: %w", targetSession, err)
 	}
 
@@ -84,7 +84,7 @@ func cyclePolecatSession(direction int, sessionOverride string) error {
 // Returns empty strings and false if the format doesn't match.
 func parsePolecatSessionName(sessionName string) (rigName, polecatName string, ok bool) { //nolint:unparam // polecatName kept for API consistency
 	// Must start with "gt-"
-	if !strings.HasPrefix(sessionName, "gt-") {
+	if !strings.HasPrefix(sessionName, sessionPrefix()) {
 		return "", "", false
 	}
 
@@ -102,7 +102,7 @@ func parsePolecatSessionName(sessionName string) (rigName, polecatName string, o
 	}
 
 	// Remove "gt-" prefix
-	rest := sessionName[3:]
+	rest := strings.TrimPrefix(sessionName, sessionPrefix())
 
 	// Must have at least one hyphen (rig-name)
 	idx := strings.Index(rest, "-")
@@ -134,17 +134,16 @@ func parsePolecatSessionName(sessionName string) (rigName, polecatName string, o
 // Uses tmux list-sessions to find sessions matching gt-<rig>-<name> pattern,
 // excluding crew, witness, and refinery sessions.
 func findRigPolecatSessions(rigName string) ([]string, error) { //nolint:unparam // error return kept for future use
-	cmd := exec.Command("tmux", "list-sessions", "-F", "#{session_name}")
-	out, err := cmd.Output()
+	names, err := tmux.NewTmux().ListSessions()
 	if err != nil {
 		// No tmux server or no sessions
 		return nil, nil
 	}
 
-	prefix := fmt.Sprintf("gt-%s-", rigName)
+	prefix := fmt.Sprintf("%s%s-", sessionPrefix(), rigName)
 	var sessions []string
 
-	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+	for _, line := range names {
 		if line == "" {
 			continue
 		}
@@ -805,7 +805,8 @@ func queryAssignedIssues(rigPath, assignee, status string) ([]IssueInfo, error)
 		args = append(args, "--status="+status)
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Dir = rigPath
 	out, err := cmd.Output()
 	if err != nil {
@@ -0,0 +1,128 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt polecat prime, which re-primes a running
+// polecat with its rig's current context.
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var polecatPrimeYes bool
+
+var polecatPrimeCmd = &cobra.Command{
+	Use:   "prime <rig> <name>",
+	Short: "Re-prime a polecat with the latest rig context",
+	Long: `Send a running polecat the same priming context gt prime --target
+assembles: the rig's README, its open beads, and the polecat's attached
+molecule. Use it when a polecat has been idle and lost its thread.
+
+The context is sent to the polecat's tmux session the way gt prime
+--target sends it. A session whose pane is dead (its process exited but
+tmux kept the window) is refused. A polecat that looks busy, with pane
+activity more recent than the witness's idle threshold, or whose activity
+can't be read, is only primed after confirmation, so it isn't interrupted
+by accident.
+
+Examples:
+  gt polecat prime greenplace Toast
+  gt polecat prime greenplace Toast --yes`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatPrime,
+}
+
+func init() {
+	polecatPrimeCmd.Flags().BoolVarP(&polecatPrimeYes, "yes", "y", false, "Don't ask before priming a polecat that looks busy")
+	polecatCmd.AddCommand(polecatPrimeCmd)
+}
+
+func runPolecatPrime(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
+	rigName, polecatName := args[0], args[1]
+
+	townRoot, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	if _, err := mgr.Get(polecatName); err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+
+	target := primeTargetSpec{Rig: rigName, Role: RolePolecat, Name: polecatName}
+	t := newTmuxClient()
+	if err := checkPolecatPrimeable(t, target); err != nil {
+		return err
+	}
+
+	sessMgr, _, err := getSessionManager(rigName)
+	if err != nil {
+		return err
+	}
+	var lastActivity time.Time
+	if info, err := sessMgr.Status(polecatName); err == nil {
+		lastActivity = info.LastActivity
+	}
+	idle := witness.DefaultIdleThreshold
+	if w, err := witness.NewManager(r).Snapshot(); err == nil {
+		idle, _ = w.Config.Thresholds()
+	}
+	if q := polecatPrimeQuestion(target, lastActivity, time.Now(), idle); q != "" && !polecatPrimeYes && !promptYesNo(q) {
+		fmt.Println("Not primed.")
+		return nil
+	}
+
+	if err := tmux.NewTmux().NudgeSession(target.sessionName(), assemblePrimeContext(townRoot, target)); err != nil {
+		return fmt.Errorf("sending priming context to %s: %w", target.sessionName(), err)
+	}
+	fmt.Printf("%s Primed %s\n", style.SuccessPrefix, target)
+	return nil
+}
+
+// checkPolecatPrimeable makes sure the polecat's session is there to type
+// into: running, and not a dead pane left behind by remain-on-exit.
+func checkPolecatPrimeable(t tmux.Client, target primeTargetSpec) error {
+	sessionName := target.sessionName()
+	running, err := t.HasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking session %s: %w", sessionName, err)
+	}
+	if !running {
+		return fmt.Errorf("polecat %s/%s has no running session\nStart it with: gt session start %s/%s",
+			target.Rig, target.Name, target.Rig, target.Name)
+	}
+	dead, err := t.PaneIsDead(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking session %s: %w", sessionName, err)
+	}
+	if dead {
+		return fmt.Errorf("polecat %s/%s has exited; its session %s only holds a dead pane\nRestart it with: gt session restart %s/%s",
+			target.Rig, target.Name, sessionName, target.Rig, target.Name)
+	}
+	return nil
+}
+
+// polecatPrimeQuestion returns what to ask before priming a polecat whose
+// pane last changed at lastActivity (zero: unknown), or "" when it has
+// been quiet past the idle threshold, i.e. the witness would see it as
+// idle. A polecat whose activity is unknown may be busy, so it is asked
+// about too.
+func polecatPrimeQuestion(target primeTargetSpec, lastActivity, now time.Time, idle time.Duration) string {
+	switch {
+	case lastActivity.IsZero():
+		return fmt.Sprintf("Can't tell whether %s is busy; re-prime it anyway?", target)
+	case now.Sub(lastActivity) < idle:
+		return fmt.Sprintf("%s was active %s; re-prime it anyway?", target, formatActivityTime(lastActivity))
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/tmux/tmuxtest"
+)
+
+func TestMergePolecatSources(t *testing.T) {
+	items := []PolecatListItem{
+		{Rig: "greenplace", Name: "toast", State: polecat.StateWorking, Worktree: true},
+	}
+	sessions := []string{"gt-greenplace-toast", "gt-greenplace-nux", "gt-greenplace-witness", "gt-otherrig-ace"}
+	agents := []*beads.Issue{
+		{ID: "gt-greenplace-polecat-toast", Status: "open", Description: "role_type: polecat\nattached_molecule: gt-mol-1"},
+		{ID: "gt-greenplace-polecat-slit", Status: "open", Description: "role_type: polecat\nagent_state: idle"},
+		{ID: "gt-greenplace-polecat-gone", Status: "closed"},
+		{ID: "gt-otherrig-polecat-ace", Status: "open"},
+		{ID: "gt-greenplace-witness", Status: "open"},
+	}
+
+	got := mergePolecatSources("greenplace", items, sessions, agents, []string{"toast", "nux"})
+
+	want := []PolecatListItem{
+		{Rig: "greenplace", Name: "nux", SessionRunning: true, Monitored: true},
+		{Rig: "greenplace", Name: "slit", State: "idle"},
+		{Rig: "greenplace", Name: "toast", State: polecat.StateWorking, SessionRunning: true,
+			Worktree: true, Molecule: "gt-mol-1", Monitored: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d polecats %+v, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("polecat %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCheckPolecatPrimeable(t *testing.T) {
+	target := primeTargetSpec{Rig: "greenplace", Role: RolePolecat, Name: "Toast"}
+	session := target.sessionName()
+
+	if err := checkPolecatPrimeable(tmuxtest.NewFakeTmux(), target); err == nil ||
+		!strings.Contains(err.Error(), "no running session") {
+		t.Errorf("missing session: err = %v, want no running session", err)
+	}
+
+	f := tmuxtest.NewFakeTmux(session)
+	f.Session(session).Dead = true
+	if err := checkPolecatPrimeable(f, target); err == nil || !strings.Contains(err.Error(), "dead pane") {
+		t.Errorf("dead pane: err = %v, want it refused", err)
+	}
+
+	if err := checkPolecatPrimeable(tmuxtest.NewFakeTmux(session), target); err != nil {
+		t.Errorf("live session: %v", err)
+	}
+}
+
+func TestPolecatPrimeQuestion(t *testing.T) {
+	now := time.Now()
+	target := primeTargetSpec{Rig: "greenplace", Role: RolePolecat, Name: "Toast"}
+	tests := []struct {
+		name         string
+		lastActivity time.Time
+		wantAsk      bool
+	}{
+		{"recent activity", now.Add(-30 * time.Second), true},
+		{"quiet past the threshold", now.Add(-10 * time.Minute), false},
+		{"no activity known", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		if got := polecatPrimeQuestion(target, tt.lastActivity, now, 5*time.Minute); (got != "") != tt.wantAsk {
+			t.Errorf("%s: polecatPrimeQuestion = %q, want asked %v", tt.name, got, tt.wantAsk)
+		}
+	}
+}
@@ -62,7 +62,16 @@ HOOK MODE (--hook):
   Claude Code sends JSON on stdin:
     {"session_id": "uuid", "transcript_path": "/path", "source": "startup|resume"}
 
-  Other agents can set GT_SESSION_ID environment variable instead.`,
+  Other agents can set GT_SESSION_ID environment variable instead.
+
+CONTEXT SOURCES (--target, --print):
+  Assembles priming context for an agent from the rig's README, the rig's
+  open beads, and the agent's attached molecule. --target sends it into the
+  agent's tmux session; --print writes it to stdout instead. Without
+  --target, --print uses the role detected from the current directory.
+
+    gt prime --target gastown/witness
+    gt prime --target gastown/polecats/Toast --print`,
 	RunE: runPrime,
 }
 
@@ -77,6 +86,11 @@ func init() {
 		"Output state as JSON (requires --state)")
 	primeCmd.Flags().BoolVar(&primeExplain, "explain", false,
 		"Show why each section was included")
+	primeCmd.Flags().StringVar(&primeTarget, "target", "",
+		"Send context-source priming to an agent (rig/witness, rig/refinery, rig/polecats/<name>, rig/crew/<name>)")
+	primeCmd.Flags().BoolVar(&primePrint, "print", false,
+		"Print context-source priming to stdout instead of sending it")
+	_ = primeCmd.RegisterFlagCompletionFunc("target", completeRigTarget)
 	rootCmd.AddCommand(primeCmd)
 }
 
@@ -117,6 +131,20 @@ func runPrime(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace")
 	}
 
+	// Context-source mode: build priming text for a target agent
+	if primeTarget != "" || primePrint {
+		if primeHookMode || primeState || primeDryRun || primeExplain {
+			return fmt.Errorf("--target and --print cannot be combined with --hook, --state, --dry-run or --explain")
+		}
+		var roleInfo RoleInfo
+		if primeTarget == "" {
+			if roleInfo, err = GetRoleWithContext(cwd, townRoot); err != nil {
+				return fmt.Errorf("detecting role: %w", err)
+			}
+		}
+		return runPrimeTarget(townRoot, roleInfo)
+	}
+
 	// Handle hook mode: read session ID from stdin and persist it
 	if primeHookMode {
 		sessionID, source := readHookSessionID()
@@ -343,7 +371,8 @@ func detectRole(cwd, townRoot string) RoleInfo {
 // runBdPrime runs `bd prime` and outputs the result.
 // This provides beads workflow context to the agent.
 func runBdPrime(workDir string) {
-	cmd := exec.Command("bd", "prime")
+	cmd, cancel := beads.Command("prime")
+	defer cancel()
 	cmd.Dir = workDir
 
 	var stdout, stderr bytes.Buffer
@@ -478,7 +507,8 @@ func checkSlungWork(ctx RoleContext) bool {
 
 	// Show bead preview using bd show
 	fmt.Println("**Bead details:**")
-	cmd := exec.Command("bd", "show", hookedBead.ID)
+	cmd, cancel := beads.Command("show", hookedBead.ID)
+	defer cancel()
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -674,7 +704,8 @@ func ensureBeadsRedirect(ctx RoleContext) {
 // This is called on Mayor startup to surface issues needing human attention.
 func checkPendingEscalations(ctx RoleContext) {
 	// Query for open escalations using bd list with tag filter
-	cmd := exec.Command("bd", "list", "--status=open", "--tag=escalation", "--json")
+	cmd, cancel := beads.Command("list", "--status=open", "--tag=escalation", "--json")
+	defer cancel()
 	cmd.Dir = ctx.WorkDir
 
 	var stdout, stderr bytes.Buffer
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var primeTarget string
+var primePrint bool
+
+// Limits that keep assembled priming context small enough to paste.
+const (
+	primeReadmeMaxLines = 200
+	primeMaxOpenBeads   = 20
+)
+
+// primeTargetSpec identifies the agent a priming context is built for.
+type primeTargetSpec struct {
+	Rig  string
+	Role Role
+	Name string // polecat or crew name; empty for witness and refinery
+}
+
+func (t primeTargetSpec) String() string {
+	switch t.Role {
+	case RolePolecat:
+		return t.Rig + "/polecats/" + t.Name
+	case RoleCrew:
+		return t.Rig + "/crew/" + t.Name
+	}
+	return t.Rig + "/" + string(t.Role)
+}
+
+// sessionName returns the tmux session the agent runs in.
+func (t primeTargetSpec) sessionName() string {
+	switch t.Role {
+	case RoleWitness:
+		return session.WitnessSessionName(t.Rig)
+	case RoleRefinery:
+		return session.RefinerySessionName(t.Rig)
+	case RoleCrew:
+		return session.CrewSessionName(t.Rig, t.Name)
+	}
+	return session.PolecatSessionName(t.Rig, t.Name)
+}
+
+// parsePrimeTarget parses rig/witness, rig/refinery, rig/polecats/<name>
+// and rig/crew/<name>.
+func parsePrimeTarget(target string) (primeTargetSpec, error) {
+	parts := strings.Split(target, "/")
+	if len(parts) == 2 && parts[0] != "" {
+		switch Role(parts[1]) {
+		case RoleWitness, RoleRefinery:
+			return primeTargetSpec{Rig: parts[0], Role: Role(parts[1])}, nil
+		}
+	}
+	if len(parts) == 3 && parts[0] != "" && parts[2] != "" {
+		switch parts[1] {
+		case "polecats":
+			return primeTargetSpec{Rig: parts[0], Role: RolePolecat, Name: parts[2]}, nil
+		case "crew":
+			return primeTargetSpec{Rig: parts[0], Role: RoleCrew, Name: parts[2]}, nil
+		}
+	}
+	return primeTargetSpec{}, fmt.Errorf("invalid prime target %q: want rig/witness, rig/refinery, rig/polecats/<name> or rig/crew/<name>", target)
+}
+
+// runPrimeTarget builds the context-source priming text for --target/--print
+// and either prints it or sends it into the target's tmux session.
+func runPrimeTarget(townRoot string, roleInfo RoleInfo) error {
+	var target primeTargetSpec
+	if primeTarget != "" {
+		var err error
+		if target, err = parsePrimeTarget(primeTarget); err != nil {
+			return err
+		}
+	} else {
+		target = primeTargetSpec{Rig: roleInfo.Rig, Role: roleInfo.Role, Name: roleInfo.Polecat}
+		if target.Rig == "" {
+			return fmt.Errorf("--print needs a rig-level agent; run it from a rig or pass --target")
+		}
+	}
+
+	text := assemblePrimeContext(townRoot, target)
+	if primePrint {
+		fmt.Print(text)
+		return nil
+	}
+
+	if err := requireTmux(); err != nil {
+		return err
+	}
+	t := tmux.NewTmux()
+	sessionName := target.sessionName()
+	running, err := t.HasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking session %s: %w", sessionName, err)
+	}
+	if !running {
+		return fmt.Errorf("%s is not running (no session %s)", target, sessionName)
+	}
+	if err := t.NudgeSession(sessionName, text); err != nil {
+		return fmt.Errorf("sending priming context to %s: %w", sessionName, err)
+	}
+	fmt.Printf("%s Primed %s\n", style.SuccessPrefix, target)
+	return nil
+}
+
+// assemblePrimeContext gathers priming context for target from its sources:
+// the rig README, the rig's open beads, and the agent's attached molecule.
+// Sources that are unavailable are noted rather than failing the whole prime.
+func assemblePrimeContext(townRoot string, target primeTargetSpec) string {
+	rigPath := filepath.Join(townRoot, target.Rig)
+	prefix := config.GetRigPrefix(townRoot, target.Rig)
+	b := beads.New(beads.ResolveHookDir(townRoot, prefix+"-"+target.Rig, rigPath))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Priming context for %s\n", target)
+
+	sb.WriteString("\n## README\n\n")
+	sb.WriteString(primeReadme(rigPath))
+
+	sb.WriteString("\n## Open beads\n\n")
+	sb.WriteString(primeOpenBeads(b))
+
+	sb.WriteString("\n## Attached molecule\n\n")
+	agentBeadID := beads.AgentBeadIDWithPrefix(prefix, target.Rig, string(target.Role), target.Name)
+	sb.WriteString(primeAttachedMolecule(b, agentBeadID))
+
+	return sb.String()
+}
+
+// primeReadme returns the head of the rig's README from its canonical clone.
+func primeReadme(rigPath string) string {
+	for _, clone := range []string{"mayor/rig", "refinery/rig"} {
+		data, err := os.ReadFile(filepath.Join(rigPath, clone, "README.md"))
+		if err != nil {
+			continue
+		}
+		lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+		if len(lines) > primeReadmeMaxLines {
+			lines = append(lines[:primeReadmeMaxLines], fmt.Sprintf("... (%d more lines)", len(lines)-primeReadmeMaxLines))
+		}
+		return strings.Join(lines, "\n") + "\n"
+	}
+	return "(no README found)\n"
+}
+
+// primeOpenBeads lists the rig's open beads, most relevant first as bd orders them.
+func primeOpenBeads(b *beads.Beads) string {
+	issues, err := b.List(beads.ListOptions{Status: "open", Priority: -1})
+	if err != nil {
+		return fmt.Sprintf("(unavailable: %v)\n", err)
+	}
+	if len(issues) == 0 {
+		return "(none)\n"
+	}
+
+	var sb strings.Builder
+	for i, issue := range issues {
+		if i == primeMaxOpenBeads {
+			fmt.Fprintf(&sb, "- ... and %d more\n", len(issues)-primeMaxOpenBeads)
+			break
+		}
+		fmt.Fprintf(&sb, "- %s: %s\n", issue.ID, issue.Title)
+	}
+	return sb.String()
+}
+
+// primeAttachedMolecule describes the molecule attached to the agent bead.
+func primeAttachedMolecule(b *beads.Beads, agentBeadID string) string {
+	attachment, err := b.GetAttachment(agentBeadID)
+	if err != nil {
+		return fmt.Sprintf("(unavailable: %v)\n", err)
+	}
+	if attachment == nil || attachment.AttachedMolecule == "" {
+		return "(none)\n"
+	}
+	if attachment.AttachedAt != "" {
+		return fmt.Sprintf("%s (attached %s)\n", attachment.AttachedMolecule, attachment.AttachedAt)
+	}
+	return attachment.AttachedMolecule + "\n"
+}
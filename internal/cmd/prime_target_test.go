@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParsePrimeTarget(t *testing.T) {
+	tests := []struct {
+		target      string
+		want        primeTargetSpec
+		wantSession string
+		wantErr     bool
+	}{
+		{target: "gastown/witness", want: primeTargetSpec{Rig: "gastown", Role: RoleWitness}, wantSession: "gt-gastown-witness"},
+		{target: "gastown/refinery", want: primeTargetSpec{Rig: "gastown", Role: RoleRefinery}, wantSession: "gt-gastown-refinery"},
+		{target: "gastown/polecats/Toast", want: primeTargetSpec{Rig: "gastown", Role: RolePolecat, Name: "Toast"}, wantSession: "gt-gastown-Toast"},
+		{target: "gastown/crew/max", want: primeTargetSpec{Rig: "gastown", Role: RoleCrew, Name: "max"}, wantSession: "gt-gastown-crew-max"},
+		{target: "gastown", wantErr: true},
+		{target: "gastown/mayor", wantErr: true},
+		{target: "/witness", wantErr: true},
+		{target: "gastown/polecats/", wantErr: true},
+		{target: "gastown/dogs/rex", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			got, err := parsePrimeTarget(tt.target)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parsePrimeTarget(%q) = %+v, want error", tt.target, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePrimeTarget(%q): %v", tt.target, err)
+			}
+			if got != tt.want {
+				t.Errorf("parsePrimeTarget(%q) = %+v, want %+v", tt.target, got, tt.want)
+			}
+			if got.String() != tt.target {
+				t.Errorf("String() = %q, want %q", got.String(), tt.target)
+			}
+			if s := got.sessionName(); s != tt.wantSession {
+				t.Errorf("sessionName() = %q, want %q", s, tt.wantSession)
+			}
+		})
+	}
+}
+
+func TestAssemblePrimeContext(t *testing.T) {
+	townRoot := t.TempDir()
+	clone := filepath.Join(townRoot, "gastown", "mayor", "rig")
+	if err := os.MkdirAll(clone, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(clone, "README.md"), []byte("# Gastown\nMulti-agent workspace.\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	binDir := filepath.Join(townRoot, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	script := `#!/bin/sh
+for arg in "$@"; do
+  case "$arg" in
+    list) echo '[{"id":"gt-1","title":"Fix the flux capacitor","status":"open"}]'; exit 0 ;;
+    show) echo '[{"id":"gt-gastown-polecat-Toast","status":"pinned","description":"attached_molecule: mol-polecat-work"}]'; exit 0 ;;
+  esac
+done
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	text := assemblePrimeContext(townRoot, primeTargetSpec{Rig: "gastown", Role: RolePolecat, Name: "Toast"})
+
+	for _, want := range []string{
+		"# Priming context for gastown/polecats/Toast",
+		"Multi-agent workspace.",
+		"- gt-1: Fix the flux capacitor",
+		"mol-polecat-work",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("priming context missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestPrimeReadme_Missing(t *testing.T) {
+	if got := primeReadme(t.TempDir()); !strings.Contains(got, "no README") {
+		t.Errorf("primeReadme with no clone = %q", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -273,6 +274,9 @@ func getRefineryManager(rigName string) (*refinery.Manager, *rig.Rig, string, er
 }
 
 func runRefineryStart(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	rigName := ""
 	if len(args) > 0 {
 		rigName = args[0]
@@ -352,16 +356,7 @@ func runRefineryStatus(cmd *cobra.Command, args []string) error {
 	// Human-readable output
 	fmt.Printf("%s Refinery: %s\n\n", style.Bold.Render("⚙"), rigName)
 
-	stateStr := string(ref.State)
-	switch ref.State {
-	case refinery.StateRunning:
-		stateStr = style.Bold.Render("● running")
-	case refinery.StateStopped:
-		stateStr = style.Dim.Render("○ stopped")
-	case refinery.StatePaused:
-		stateStr = style.Dim.Render("⏸ paused")
-	}
-	fmt.Printf("  State: %s\n", stateStr)
+	fmt.Printf("  State: %s\n", style.AgentState(string(ref.State)))
 
 	if ref.StartedAt != nil {
 		fmt.Printf("  Started: %s\n", ref.StartedAt.Format("2006-01-02 15:04:05"))
@@ -475,6 +470,9 @@ func runRefineryQueue(cmd *cobra.Command, args []string) error {
 }
 
 func runRefineryAttach(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	rigName := ""
 	if len(args) > 0 {
 		rigName = args[0]
@@ -487,7 +485,7 @@ func runRefineryAttach(cmd *cobra.Command, args []string) error {
 	}
 
 	// Session name follows the same pattern as refinery manager
-	sessionID := fmt.Sprintf("gt-%s-refinery", rigName)
+	sessionID := session.RefinerySessionName(rigName)
 
 	// Check if session exists
 	t := tmux.NewTmux()
@@ -509,6 +507,9 @@ func runRefineryAttach(cmd *cobra.Command, args []string) error {
 }
 
 func runRefineryRestart(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	rigName := ""
 	if len(args) > 0 {
 		rigName = args[0]
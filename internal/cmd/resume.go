@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -93,7 +94,8 @@ func runResume(cmd *cobra.Command, args []string) error {
 	}
 
 	// Check gate status
-	gateCheck := exec.Command("bd", "gate", "show", parked.GateID, "--json")
+	gateCheck, cancel := beads.Command("gate", "show", parked.GateID, "--json")
+	defer cancel()
 	gateOutput, err := gateCheck.Output()
 	gateNotFound := false
 	if err != nil {
@@ -155,7 +157,8 @@ func runResume(cmd *cobra.Command, args []string) error {
 
 	// Pin the bead to restore work
 	if parked.BeadID != "" {
-		pinCmd := exec.Command("bd", "update", parked.BeadID, "--status=pinned", "--assignee="+agentID)
+		pinCmd, cancel := beads.Command("update", parked.BeadID, "--status=pinned", "--assignee="+agentID)
+		defer cancel()
 		pinCmd.Dir = cloneRoot
 		pinCmd.Stderr = os.Stderr
 		if err := pinCmd.Run(); err != nil {
@@ -2,6 +2,8 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -17,6 +19,7 @@ import (
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/wisp"
@@ -70,7 +73,15 @@ Example:
 var rigListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all rigs in the workspace",
-	RunE:  runRigList,
+	Long: `List every rig registered in mayor/rigs.json with its live status.
+
+Each rig shows its beads prefix, polecat count, and whether its witness
+session is running. Rigs whose directory no longer exists are flagged.
+
+Examples:
+  gt rig list
+  gt rig list --json`,
+	RunE: runRigList,
 }
 
 var rigRemoveCmd = &cobra.Command{
@@ -260,6 +271,7 @@ var (
 	rigResetMail       bool
 	rigResetStale      bool
 	rigResetDryRun     bool
+	rigListJSON        bool
 	rigResetRole       string
 	rigShutdownForce   bool
 	rigShutdownNuclear bool
@@ -287,6 +299,8 @@ func init() {
 	rigAddCmd.Flags().StringVar(&rigAddLocalRepo, "local-repo", "", "Local repo path to share git objects (optional)")
 	rigAddCmd.Flags().StringVar(&rigAddBranch, "branch", "", "Default branch name (default: auto-detected from remote)")
 
+	rigListCmd.Flags().BoolVar(&rigListJSON, "json", false, "Output as JSON")
+
 	rigResetCmd.Flags().BoolVar(&rigResetHandoff, "handoff", false, "Clear handoff content")
 	rigResetCmd.Flags().BoolVar(&rigResetMail, "mail", false, "Clear stale mail messages")
 	rigResetCmd.Flags().BoolVar(&rigResetStale, "stale", false, "Reset orphaned in_progress issues (no active session)")
@@ -432,6 +446,13 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// rigListEntry is one row of gt rig list: the registry listing plus
+// whether the rig's witness session is running.
+type rigListEntry struct {
+	rig.RigListing
+	WitnessRunning bool `json:"witness_running"`
+}
+
 func runRigList(cmd *cobra.Command, args []string) error {
 	// Find workspace
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -443,47 +464,52 @@ func runRigList(cmd *cobra.Command, args []string) error {
 	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsPath)
 	if err != nil {
-		fmt.Println("No rigs configured.")
-		return nil
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+
+	g := git.NewGit(townRoot)
+	mgr := rig.NewManager(townRoot, rigsConfig, g)
+	t := tmux.NewTmux()
+
+	entries := make([]rigListEntry, 0, len(rigsConfig.Rigs))
+	for _, l := range mgr.ListRigs() {
+		running, _ := t.HasSession(session.WitnessSessionName(l.Name))
+		entries = append(entries, rigListEntry{RigListing: l, WitnessRunning: running})
+	}
+
+	if rigListJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
 	}
 
-	if len(rigsConfig.Rigs) == 0 {
+	if len(entries) == 0 {
 		fmt.Println("No rigs configured.")
 		fmt.Printf("\nAdd one with: %s\n", style.Dim.Render("gt rig add <name> <git-url>"))
 		return nil
 	}
 
-	// Create rig manager to get details
-	g := git.NewGit(townRoot)
-	mgr := rig.NewManager(townRoot, rigsConfig, g)
-
 	fmt.Printf("Rigs in %s:\n\n", townRoot)
 
-	for name := range rigsConfig.Rigs {
-		r, err := mgr.GetRig(name)
-		if err != nil {
-			fmt.Printf("  %s %s\n", style.Warning.Render("!"), name)
+	for _, e := range entries {
+		if !e.PathExists {
+			fmt.Printf("  %s %s\n", style.Warning.Render("!"), style.Bold.Render(e.Name))
+			fmt.Printf("    Path missing: %s\n", e.Path)
+			fmt.Printf("    %s\n\n", style.Dim.Render("Restore the directory or run: gt rig remove "+e.Name))
 			continue
 		}
 
-		summary := r.Summary()
-		fmt.Printf("  %s\n", style.Bold.Render(name))
-		fmt.Printf("    Polecats: %d  Crew: %d\n", summary.PolecatCount, summary.CrewCount)
-
-		agents := []string{}
-		if summary.HasRefinery {
-			agents = append(agents, "refinery")
-		}
-		if summary.HasWitness {
-			agents = append(agents, "witness")
+		prefix := e.Prefix
+		if prefix == "" {
+			prefix = "-"
 		}
-		if r.HasMayor {
-			agents = append(agents, "mayor")
+		witnessState := style.Dim.Render("stopped")
+		if e.WitnessRunning {
+			witnessState = style.Success.Render("running")
 		}
-		if len(agents) > 0 {
-			fmt.Printf("    Agents: %v\n", agents)
-		}
-		fmt.Println()
+
+		fmt.Printf("  %s\n", style.Bold.Render(e.Name))
+		fmt.Printf("    Prefix: %s  Polecats: %d  Witness: %s\n\n", prefix, e.PolecatCount, witnessState)
 	}
 
 	return nil
@@ -701,11 +727,11 @@ func assigneeToSessionName(assignee string) (sessionName string, isPersistent bo
 	switch len(parts) {
 	case 2:
 		// rig/polecatName -> gt-rig-polecatName
-		return fmt.Sprintf("gt-%s-%s", parts[0], parts[1]), false
+		return fmt.Sprintf("%s%s-%s", sessionPrefix(), parts[0], parts[1]), false
 	case 3:
 		// rig/crew/name -> gt-rig-crew-name
 		if parts[1] == "crew" {
-			return fmt.Sprintf("gt-%s-crew-%s", parts[0], parts[2]), true
+			return fmt.Sprintf("%s%s-crew-%s", sessionPrefix(), parts[0], parts[2]), true
 		}
 		// Other 3-part formats not recognized
 		return "", false
@@ -752,7 +778,7 @@ func runRigBoot(cmd *cobra.Command, args []string) error {
 
 	// 1. Start the witness
 	// Check actual tmux session, not state file (may be stale)
-	witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
+	witnessSession := session.WitnessSessionName(rigName)
 	witnessRunning, _ := t.HasSession(witnessSession)
 	if witnessRunning {
 		skipped = append(skipped, "witness (already running)")
@@ -760,7 +786,7 @@ func runRigBoot(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Starting witness...\n")
 		witMgr := witness.NewManager(r)
 		if err := witMgr.Start(false, "", nil); err != nil {
-			if err == witness.ErrAlreadyRunning {
+			if errors.Is(err, witness.ErrAlreadyRunning) {
 				skipped = append(skipped, "witness (already running)")
 			} else {
 				return fmt.Errorf("starting witness: %w", err)
@@ -772,7 +798,7 @@ func runRigBoot(cmd *cobra.Command, args []string) error {
 
 	// 2. Start the refinery
 	// Check actual tmux session, not state file (may be stale)
-	refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+	refinerySession := session.RefinerySessionName(rigName)
 	refineryRunning, _ := t.HasSession(refinerySession)
 	if refineryRunning {
 		skipped = append(skipped, "refinery (already running)")
@@ -832,7 +858,7 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 		hasError := false
 
 		// 1. Start the witness
-		witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
+		witnessSession := session.WitnessSessionName(rigName)
 		witnessRunning, _ := t.HasSession(witnessSession)
 		if witnessRunning {
 			skipped = append(skipped, "witness")
@@ -840,7 +866,7 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  Starting witness...\n")
 			witMgr := witness.NewManager(r)
 			if err := witMgr.Start(false, "", nil); err != nil {
-				if err == witness.ErrAlreadyRunning {
+				if errors.Is(err, witness.ErrAlreadyRunning) {
 					skipped = append(skipped, "witness")
 				} else {
 					fmt.Printf("  %s Failed to start witness: %v\n", style.Warning.Render("⚠"), err)
@@ -852,7 +878,7 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 		}
 
 		// 2. Start the refinery
-		refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+		refinerySession := session.RefinerySessionName(rigName)
 		refineryRunning, _ := t.HasSession(refinerySession)
 		if refineryRunning {
 			skipped = append(skipped, "refinery")
@@ -1066,7 +1092,7 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 
 	// Witness status
 	fmt.Printf("%s\n", style.Bold.Render("Witness"))
-	witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
+	witnessSession := session.WitnessSessionName(rigName)
 	witnessRunning, _ := t.HasSession(witnessSession)
 	witMgr := witness.NewManager(r)
 	witStatus, _ := witMgr.Status()
@@ -1083,7 +1109,7 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 
 	// Refinery status
 	fmt.Printf("%s\n", style.Bold.Render("Refinery"))
-	refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+	refinerySession := session.RefinerySessionName(rigName)
 	refineryRunning, _ := t.HasSession(refinerySession)
 	refMgr := refinery.NewManager(r)
 	refStatus, _ := refMgr.Status()
@@ -1113,7 +1139,7 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 	} else {
 		fmt.Printf(" (%d)\n", len(polecats))
 		for _, p := range polecats {
-			sessionName := fmt.Sprintf("gt-%s-%s", rigName, p.Name)
+			sessionName := session.PolecatSessionName(rigName, p.Name)
 			hasSession, _ := t.HasSession(sessionName)
 
 			sessionIcon := style.Dim.Render("○")
@@ -1412,14 +1438,14 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 		var skipped []string
 
 		// 1. Start the witness
-		witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
+		witnessSession := session.WitnessSessionName(rigName)
 		witnessRunning, _ := t.HasSession(witnessSession)
 		if witnessRunning {
 			skipped = append(skipped, "witness")
 		} else {
 			fmt.Printf("    Starting witness...\n")
 			if err := witMgr.Start(false, "", nil); err != nil {
-				if err == witness.ErrAlreadyRunning {
+				if errors.Is(err, witness.ErrAlreadyRunning) {
 					skipped = append(skipped, "witness")
 				} else {
 					fmt.Printf("    %s Failed to start witness: %v\n", style.Warning.Render("⚠"), err)
@@ -1431,7 +1457,7 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 		}
 
 		// 2. Start the refinery
-		refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+		refinerySession := session.RefinerySessionName(rigName)
 		refineryRunning, _ := t.HasSession(refinerySession)
 		if refineryRunning {
 			skipped = append(skipped, "refinery")
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/witness"
@@ -112,7 +113,7 @@ func runRigDock(cmd *cobra.Command, args []string) error {
 	t := tmux.NewTmux()
 
 	// Stop witness if running
-	witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
+	witnessSession := session.WitnessSessionName(rigName)
 	witnessRunning, _ := t.HasSession(witnessSession)
 	if witnessRunning {
 		fmt.Printf("  Stopping witness...\n")
@@ -125,7 +126,7 @@ func runRigDock(cmd *cobra.Command, args []string) error {
 	}
 
 	// Stop refinery if running
-	refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+	refinerySession := session.RefinerySessionName(rigName)
 	refineryRunning, _ := t.HasSession(refinerySession)
 	if refineryRunning {
 		fmt.Printf("  Stopping refinery...\n")
@@ -146,7 +147,8 @@ func runRigDock(cmd *cobra.Command, args []string) error {
 
 	// Sync beads to propagate to other clones
 	fmt.Printf("  Syncing beads...\n")
-	syncCmd := exec.Command("bd", "sync")
+	syncCmd, cancel := beads.Command("sync")
+	defer cancel()
 	syncCmd.Dir = r.BeadsPath()
 	if output, err := syncCmd.CombinedOutput(); err != nil {
 		fmt.Printf("  %s bd sync warning: %v\n%s", style.Warning.Render("!"), err, string(output))
@@ -212,7 +214,8 @@ func runRigUndock(cmd *cobra.Command, args []string) error {
 
 	// Sync beads to propagate to other clones
 	fmt.Printf("  Syncing beads...\n")
-	syncCmd := exec.Command("bd", "sync")
+	syncCmd, cancel := beads.Command("sync")
+	defer cancel()
 	syncCmd.Dir = r.BeadsPath()
 	if output, err := syncCmd.CombinedOutput(); err != nil {
 		fmt.Printf("  %s bd sync warning: %v\n%s", style.Warning.Render("!"), err, string(output))
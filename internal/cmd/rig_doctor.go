@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var rigDoctorCmd = &cobra.Command{
+	Use:   "doctor [rig...]",
+	Short: "Validate rig configuration",
+	Long: `Check that each rig's configuration is usable before work is slung to it.
+
+For every rig (or only the named rigs) this checks that:
+  - the rig directory exists
+  - the rig has a .beads directory
+  - the prefix in mayor/rigs.json matches the one in .beads/routes.jsonl
+  - bd is reachable on PATH
+
+Each check prints a pass, warn, or fail line with a hint on how to fix it.
+Exits 1 if any check fails, so it can gate setup scripts.
+
+Examples:
+  gt rig doctor
+  gt rig doctor gastown`,
+	RunE: runRigDoctor,
+}
+
+// rigCheckStatus is the outcome of a single rig doctor check.
+type rigCheckStatus int
+
+const (
+	rigCheckPass rigCheckStatus = iota
+	rigCheckWarn
+	rigCheckFail
+)
+
+// rigCheck is one line of gt rig doctor output.
+type rigCheck struct {
+	Name    string
+	Status  rigCheckStatus
+	Message string
+	Hint    string
+}
+
+func init() {
+	rigCmd.AddCommand(rigDoctorCmd)
+}
+
+func runRigDoctor(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		if errors.Is(err, config.ErrNotFound) {
+			return fmt.Errorf("no rig registry at %s; add a rig with 'gt rig add <name> <git-url>'", rigsPath)
+		}
+		return fmt.Errorf("rig registry %s is malformed: %w", rigsPath, err)
+	}
+
+	names := args
+	if len(names) == 0 {
+		for name := range rigsConfig.Rigs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		if _, ok := rigsConfig.Rigs[name]; !ok {
+			return fmt.Errorf("rig %q is not registered in %s", name, rigsPath)
+		}
+	}
+	if len(names) == 0 {
+		fmt.Println("No rigs configured.")
+		return nil
+	}
+
+	routes, routesErr := beads.LoadRoutes(filepath.Join(townRoot, ".beads"))
+	_, bdErr := exec.LookPath("bd")
+
+	failed := 0
+	for i, name := range names {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s\n", style.Bold.Render(name))
+		for _, c := range checkRig(townRoot, name, rigsConfig.Rigs[name], routes, routesErr, bdErr) {
+			printRigCheck(c)
+			if c.Status == rigCheckFail {
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("\n%s %d check(s) failed\n", style.ErrorPrefix, failed)
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		return NewSilentExit(1)
+	}
+	fmt.Printf("\n%s All rigs look healthy\n", style.SuccessPrefix)
+	return nil
+}
+
+// checkRig runs every doctor check for one rig. routesErr and bdErr are
+// computed once per run since routes.jsonl and bd are shared by the town.
+func checkRig(townRoot, name string, entry config.RigEntry, routes []beads.Route, routesErr, bdErr error) []rigCheck {
+	rigPath := filepath.Join(townRoot, name)
+	var checks []rigCheck
+
+	if info, err := os.Stat(rigPath); err != nil || !info.IsDir() {
+		checks = append(checks, rigCheck{
+			Name:    "path",
+			Status:  rigCheckFail,
+			Message: fmt.Sprintf("%s does not exist", rigPath),
+			Hint:    fmt.Sprintf("Restore the rig directory or unregister it with 'gt rig remove %s'", name),
+		})
+	} else {
+		checks = append(checks, rigCheck{Name: "path", Status: rigCheckPass, Message: rigPath})
+
+		if _, err := os.Stat(filepath.Join(rigPath, ".beads")); err != nil {
+			checks = append(checks, rigCheck{
+				Name:    "beads",
+				Status:  rigCheckFail,
+				Message: ".beads directory is missing",
+				Hint:    fmt.Sprintf("Run 'bd init' in %s or 'gt doctor --fix'", rigPath),
+			})
+		} else {
+			checks = append(checks, rigCheck{Name: "beads", Status: rigCheckPass, Message: ".beads present"})
+		}
+	}
+
+	checks = append(checks, checkRigPrefix(name, entry, routes, routesErr))
+
+	if bdErr != nil {
+		checks = append(checks, rigCheck{
+			Name:    "bd",
+			Status:  rigCheckFail,
+			Message: "bd not found on PATH",
+			Hint:    "Install beads (https://github.com/steveyegge/beads) and make sure bd is on PATH",
+		})
+	} else {
+		checks = append(checks, rigCheck{Name: "bd", Status: rigCheckPass, Message: "bd reachable"})
+	}
+
+	return checks
+}
+
+// checkRigPrefix compares the rig's prefix in rigs.json with its route.
+func checkRigPrefix(name string, entry config.RigEntry, routes []beads.Route, routesErr error) rigCheck {
+	c := rigCheck{Name: "prefix"}
+	if routesErr != nil {
+		c.Status = rigCheckFail
+		c.Message = fmt.Sprintf("cannot read routes.jsonl: %v", routesErr)
+		c.Hint = "Fix or remove .beads/routes.jsonl, then run 'gt doctor --fix'"
+		return c
+	}
+
+	want := ""
+	if entry.BeadsConfig != nil {
+		want = entry.BeadsConfig.Prefix
+	}
+
+	route, found := "", false
+	for _, r := range routes {
+		if strings.SplitN(r.Path, "/", 2)[0] == name {
+			route, found = strings.TrimSuffix(r.Prefix, "-"), true
+			break
+		}
+	}
+
+	switch {
+	case want == "" && !found:
+		c.Status = rigCheckWarn
+		c.Message = "no prefix in rigs.json and no route in routes.jsonl"
+		c.Hint = "Run 'gt doctor --fix' to add the missing route"
+	case !found:
+		c.Status = rigCheckWarn
+		c.Message = fmt.Sprintf("prefix %q has no route in routes.jsonl", want)
+		c.Hint = "Run 'gt doctor --fix' to add the missing route"
+	case want == "":
+		c.Status = rigCheckWarn
+		c.Message = fmt.Sprintf("routes.jsonl uses %q but rigs.json has no prefix", route)
+		c.Hint = "Run 'gt doctor --fix' to record the prefix in rigs.json"
+	case want != route:
+		c.Status = rigCheckFail
+		c.Message = fmt.Sprintf("rigs.json says %q, routes.jsonl uses %q", want, route)
+		c.Hint = "Run 'gt doctor --fix' to update rigs.json to match routes.jsonl"
+	default:
+		c.Status = rigCheckPass
+		c.Message = fmt.Sprintf("%q matches routes.jsonl", want)
+	}
+	return c
+}
+
+func printRigCheck(c rigCheck) {
+	glyph := style.SuccessPrefix
+	switch c.Status {
+	case rigCheckWarn:
+		glyph = style.WarningPrefix
+	case rigCheckFail:
+		glyph = style.ErrorPrefix
+	}
+	fmt.Printf("  %s %-7s %s\n", glyph, c.Name, c.Message)
+	if c.Hint != "" && c.Status != rigCheckPass {
+		fmt.Printf("            %s\n", style.Dim.Render(c.Hint))
+	}
+}
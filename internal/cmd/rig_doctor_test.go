@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func rigCheckByName(t *testing.T, checks []rigCheck, name string) rigCheck {
+	t.Helper()
+	for _, c := range checks {
+		if c.Name == name {
+			return c
+		}
+	}
+	t.Fatalf("no %q check in %+v", name, checks)
+	return rigCheck{}
+}
+
+func TestCheckRig(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "good", ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, "nobeads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	routes := []beads.Route{
+		{Prefix: "gd-", Path: "good/mayor/rig"},
+		{Prefix: "xx-", Path: "nobeads/mayor/rig"},
+	}
+	entry := func(prefix string) config.RigEntry {
+		return config.RigEntry{BeadsConfig: &config.BeadsConfig{Prefix: prefix}}
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		for _, c := range checkRig(townRoot, "good", entry("gd"), routes, nil, nil) {
+			if c.Status != rigCheckPass {
+				t.Errorf("%s = %d (%s), want pass", c.Name, c.Status, c.Message)
+			}
+		}
+	})
+
+	t.Run("missing beads and prefix mismatch", func(t *testing.T) {
+		checks := checkRig(townRoot, "nobeads", entry("nb"), routes, nil, nil)
+		if c := rigCheckByName(t, checks, "beads"); c.Status != rigCheckFail {
+			t.Errorf("beads = %d, want fail", c.Status)
+		}
+		if c := rigCheckByName(t, checks, "prefix"); c.Status != rigCheckFail || c.Hint == "" {
+			t.Errorf("prefix = %+v, want fail with hint", c)
+		}
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		checks := checkRig(townRoot, "gone", entry("gn"), routes, nil, nil)
+		if c := rigCheckByName(t, checks, "path"); c.Status != rigCheckFail {
+			t.Errorf("path = %d, want fail", c.Status)
+		}
+		if c := rigCheckByName(t, checks, "prefix"); c.Status != rigCheckWarn {
+			t.Errorf("prefix without route = %d, want warn", c.Status)
+		}
+	})
+
+	t.Run("bd and routes unreadable", func(t *testing.T) {
+		checks := checkRig(townRoot, "good", entry("gd"), nil, errors.New("bad json"), errors.New("not found"))
+		if c := rigCheckByName(t, checks, "bd"); c.Status != rigCheckFail {
+			t.Errorf("bd = %d, want fail", c.Status)
+		}
+		if c := rigCheckByName(t, checks, "prefix"); c.Status != rigCheckFail {
+			t.Errorf("prefix = %d, want fail", c.Status)
+		}
+	})
+}
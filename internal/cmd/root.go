@@ -6,9 +6,14 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/version"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -30,6 +35,11 @@ var beadsExemptCommands = map[string]bool{
 	"version":    true,
 	"help":       true,
 	"completion": true,
+	"doctor":     true, // Reports a missing or broken bd itself
+
+	// Hidden commands cobra runs for dynamic shell completion on every <TAB>.
+	cobra.ShellCompRequestCmd:       true,
+	cobra.ShellCompNoDescRequestCmd: true,
 }
 
 // Commands exempt from the town root branch warning.
@@ -41,13 +51,56 @@ var branchCheckExemptCommands = map[string]bool{
 	"doctor":     true, // Used to fix the problem
 	"install":    true, // Initial setup
 	"git-init":   true, // Git setup
+
+	cobra.ShellCompRequestCmd:       true,
+	cobra.ShellCompNoDescRequestCmd: true,
 }
 
+// tmuxCmdOverride holds the --tmux-cmd global flag value.
+var tmuxCmdOverride string
+
+// colorMode, noColor and themeName hold the --color, --no-color and
+// --theme global flag values.
+var (
+	colorMode string
+	noColor   bool
+	themeName string
+)
+
+// callTimeout holds the --call-timeout global flag value.
+var callTimeout time.Duration
+
 // persistentPreRun runs before every command.
 func persistentPreRun(cmd *cobra.Command, args []string) error {
 	// Get the root command name being run
 	cmdName := cmd.Name()
 
+	// Apply the color mode before anything is rendered
+	mode := style.ColorMode(colorMode)
+	if noColor {
+		mode = style.ColorNever
+	}
+	if err := style.SetColorMode(mode); err != nil {
+		return err
+	}
+	if err := style.SetTheme(style.Theme(themeName)); err != nil {
+		return err
+	}
+
+	// Route tmux invocations through the override (e.g. "ssh host tmux")
+	if tmuxCmdOverride != "" {
+		if err := tmux.SetCommand(tmuxCmdOverride); err != nil {
+			return err
+		}
+	}
+
+	if err := applySessionPrefix(); err != nil {
+		return err
+	}
+	tmux.SetRigSessionPrefix(session.RigPrefix())
+
+	applyCallTimeout()
+
 	// Check town root branch (warning only, non-blocking)
 	if !branchCheckExemptCommands[cmdName] {
 		warnIfTownRootOffMain()
@@ -62,6 +115,45 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 	return CheckBeadsVersion()
 }
 
+// applyCallTimeout installs the --call-timeout bound on every bd and tmux
+// invocation: one still running after that long is killed and fails with
+// a timed-out error. The bound is per call, not a deadline for the whole
+// command, so long-running commands (a foreground witness loop, --watch
+// views) keep working, each call still bounded. It is named apart from
+// the --timeout flags some commands have for their own waits.
+func applyCallTimeout() {
+	if callTimeout <= 0 {
+		return
+	}
+	tmux.SetCallTimeout(callTimeout)
+	beads.SetCallTimeout(callTimeout)
+}
+
+// applySessionPrefix checks GT_SESSION_PREFIX, or failing that loads the
+// town's session_prefix, so every session name this process builds matches
+// the ones other gt processes in the town use. A bad town setting only
+// warns, leaving 'gt config session-prefix' usable to fix it.
+func applySessionPrefix() error {
+	if env := os.Getenv(session.PrefixEnv); env != "" {
+		if err := session.ValidatePrefix(env); err != nil {
+			return fmt.Errorf("%s: %w", session.PrefixEnv, err)
+		}
+		return nil
+	}
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil // Commands that need the settings report this themselves
+	}
+	if err := session.SetPrefix(settings.SessionPrefix); err != nil {
+		style.PrintWarning("ignoring town session_prefix: %v", err)
+	}
+	return nil
+}
+
 // warnIfTownRootOffMain prints a warning if the town root is not on main branch.
 // This is a non-blocking warning to help catch accidental branch switches.
 func warnIfTownRootOffMain() {
@@ -158,6 +250,7 @@ func checkStaleBinaryWarning() {
 // Execute runs the root command and returns an exit code.
 // The caller (main) should call os.Exit with this code.
 func Execute() int {
+	registerRigFlagCompletions(rootCmd)
 	if err := rootCmd.Execute(); err != nil {
 		// Check for silent exit (scripting commands that signal status via exit code)
 		if code, ok := IsSilentExit(err); ok {
@@ -195,12 +288,20 @@ func init() {
 		&cobra.Group{ID: GroupDiag, Title: "Diagnostics:"},
 	)
 
-	// Put help and completion in a sensible group
+	// Put help in a sensible group (completionCmd sets its own)
 	rootCmd.SetHelpCommandGroupID(GroupDiag)
-	rootCmd.SetCompletionCommandGroupID(GroupConfig)
 
-	// Global flags can be added here
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file")
+	// Global flags
+	rootCmd.PersistentFlags().StringVar(&tmuxCmdOverride, "tmux-cmd", "",
+		"Command used to invoke tmux, e.g. \"ssh host tmux\" for a remote server (env: "+tmux.EnvTmuxCmd+")")
+	rootCmd.PersistentFlags().StringVar(&colorMode, "color", string(style.ColorAuto),
+		"When to color output: auto (TTY and no NO_COLOR), always, never")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false,
+		"Disable colored output (same as --color=never)")
+	rootCmd.PersistentFlags().StringVar(&themeName, "theme", "",
+		"Color palette: default, or accessible for a colorblind-safe one (env: "+style.EnvAccessible+"=1)")
+	rootCmd.PersistentFlags().DurationVar(&callTimeout, "call-timeout", 0,
+		"Kill any single bd or tmux call still running after this long, e.g. 30s; each call gets the full bound, it is not a deadline for the whole command (default: no bound)")
 }
 
 // buildCommandPath walks the command hierarchy to build the full command path.
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/suggest"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -24,6 +25,7 @@ import (
 // Session command flags
 var (
 	sessionIssue     string
+	sessionEnv       []string
 	sessionForce     bool
 	sessionLines     int
 	sessionMessage   string
@@ -55,9 +57,14 @@ var sessionStartCmd = &cobra.Command{
 Creates a tmux session, navigates to the polecat's working directory,
 and launches claude. Optionally inject an initial issue to work on.
 
+--env KEY=VALUE (repeatable) passes extra variables, such as API keys or
+a model override, to the agent on top of GT_ROLE, GT_RIG and the rest of
+its environment. A pair without "=" or with an invalid name is rejected.
+
 Examples:
   gt session start wyvern/Toast
-  gt session start wyvern/Toast --issue gt-123`,
+  gt session start wyvern/Toast --issue gt-123
+  gt session start wyvern/Toast --env ANTHROPIC_MODEL=claude-sonnet-4-5`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSessionStart,
 }
@@ -133,7 +140,7 @@ var sessionRestartCmd = &cobra.Command{
 	Long: `Restart a polecat session (stop + start).
 
 Gracefully stops the current session and starts a fresh one.
-Use --force to skip graceful shutdown.`,
+Use --force to skip graceful shutdown. --env works as for start.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSessionRestart,
 }
@@ -169,6 +176,7 @@ Examples:
 func init() {
 	// Start flags
 	sessionStartCmd.Flags().StringVar(&sessionIssue, "issue", "", "Issue ID to work on")
+	sessionStartCmd.Flags().StringArrayVar(&sessionEnv, "env", nil, "Extra environment variable for the agent (KEY=VALUE, can be repeated)")
 
 	// Stop flags
 	sessionStopCmd.Flags().BoolVarP(&sessionForce, "force", "f", false, "Force immediate shutdown")
@@ -186,6 +194,7 @@ func init() {
 
 	// Restart flags
 	sessionRestartCmd.Flags().BoolVarP(&sessionForce, "force", "f", false, "Force immediate shutdown")
+	sessionRestartCmd.Flags().StringArrayVar(&sessionEnv, "env", nil, "Extra environment variable for the agent (KEY=VALUE, can be repeated)")
 
 	// Add subcommands
 	sessionCmd.AddCommand(sessionStartCmd)
@@ -237,6 +246,9 @@ func getSessionManager(rigName string) (*polecat.SessionManager, *rig.Rig, error
 }
 
 func runSessionStart(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	rigName, polecatName, err := parseAddress(args[0])
 	if err != nil {
 		return err
@@ -263,6 +275,7 @@ func runSessionStart(cmd *cobra.Command, args []string) error {
 
 	opts := polecat.SessionStartOptions{
 		Issue: sessionIssue,
+		Env:   sessionEnv,
 	}
 
 	fmt.Printf("Starting session for %s/%s...\n", rigName, polecatName)
@@ -321,6 +334,9 @@ func runSessionStop(cmd *cobra.Command, args []string) error {
 }
 
 func runSessionAttach(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
 	rigName, polecatName, err := parseAddress(args[0])
 	if err != nil {
 		return err
@@ -494,6 +510,10 @@ func runSessionRestart(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	// Reject bad --env before stopping the running session
+	if _, err := config.ParseEnvAssignments(sessionEnv); err != nil {
+		return err
+	}
 
 	polecatMgr, _, err := getSessionManager(rigName)
 	if err != nil {
@@ -520,7 +540,7 @@ func runSessionRestart(cmd *cobra.Command, args []string) error {
 
 	// Start fresh session
 	fmt.Printf("Starting session for %s/%s...\n", rigName, polecatName)
-	opts := polecat.SessionStartOptions{}
+	opts := polecat.SessionStartOptions{Env: sessionEnv}
 	if err := polecatMgr.Start(polecatName, opts); err != nil {
 		return fmt.Errorf("starting session: %w", err)
 	}
@@ -552,9 +572,9 @@ func runSessionStatus(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Session: %s/%s\n\n", style.Bold.Render("📺"), rigName, polecatName)
 
 	if info.Running {
-		fmt.Printf("  State: %s\n", style.Bold.Render("● running"))
+		fmt.Printf("  State: %s\n", style.AgentState("running"))
 	} else {
-		fmt.Printf("  State: %s\n", style.Dim.Render("○ stopped"))
+		fmt.Printf("  State: %s\n", style.AgentState("stopped"))
 		return nil
 	}
 
@@ -653,7 +673,7 @@ func runSessionCheck(cmd *cobra.Command, args []string) error {
 				continue
 			}
 			polecatName := entry.Name()
-			sessionName := fmt.Sprintf("gt-%s-%s", r.Name, polecatName)
+			sessionName := session.PolecatSessionName(r.Name, polecatName)
 			totalChecked++
 
 			// Check if session exists
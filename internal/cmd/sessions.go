@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var sessionsJSON bool
+
+var sessionsCmd = &cobra.Command{
+	Use:     "sessions",
+	GroupID: GroupAgents,
+	Short:   "List all Gas Town tmux sessions",
+	Long: `List every tmux session managed by Gas Town.
+
+Shows town-level sessions (hq-mayor, hq-deacon) and rig sessions
+(gt-<rig>-witness, gt-<rig>-refinery, crew and polecats) with their rig,
+role and uptime. Other tmux sessions are ignored.
+
+Examples:
+  gt sessions
+  gt sessions --json`,
+	Args: cobra.NoArgs,
+	RunE: runSessions,
+}
+
+// agentTypeNames maps agent types to role names for display.
+var agentTypeNames = map[AgentType]string{
+	AgentMayor:    "mayor",
+	AgentDeacon:   "deacon",
+	AgentWitness:  "witness",
+	AgentRefinery: "refinery",
+	AgentCrew:     "crew",
+	AgentPolecat:  "polecat",
+}
+
+// sessionRow is one Gas Town session as listed by gt sessions.
+type sessionRow struct {
+	Session string        `json:"session"`
+	Rig     string        `json:"rig,omitempty"`
+	Role    string        `json:"role"`
+	Name    string        `json:"name,omitempty"`
+	Created *time.Time    `json:"created,omitempty"`
+	Uptime  time.Duration `json:"uptime_ns,omitempty"`
+
+	agentType AgentType
+}
+
+func init() {
+	sessionsCmd.Flags().BoolVar(&sessionsJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(sessionsCmd)
+}
+
+func runSessions(cmd *cobra.Command, args []string) error {
+	entries, err := tmux.NewTmux().ListSessionsCreated()
+	if err != nil {
+		return fmt.Errorf("listing sessions: %w", err)
+	}
+	rows := gastownSessions(entries, time.Now())
+
+	if sessionsJSON {
+		if rows == nil {
+			rows = []sessionRow{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("No Gas Town sessions running.")
+		fmt.Printf("  %s\n", style.Dim.Render("Start one with 'gt up' or 'gt witness start <rig>'"))
+		return nil
+	}
+
+	cells := [][4]string{{"RIG", "ROLE", "SESSION", "UPTIME"}}
+	for _, r := range rows {
+		rigName := r.Rig
+		if rigName == "" {
+			rigName = "(town)"
+		}
+		role := r.Role
+		if r.Name != "" {
+			role += "/" + r.Name
+		}
+		uptime := "-"
+		if r.Created != nil {
+			uptime = formatDuration(r.Uptime)
+		}
+		cells = append(cells, [4]string{rigName, role, r.Session, uptime})
+	}
+
+	var widths [3]int
+	for _, c := range cells {
+		for i := range widths {
+			widths[i] = max(widths[i], len(c[i]))
+		}
+	}
+	for i, c := range cells {
+		line := fmt.Sprintf("%-*s  %-*s  %-*s  %s", widths[0], c[0], widths[1], c[1], widths[2], c[2], c[3])
+		if i == 0 {
+			line = style.Bold.Render(line)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// gastownSessions picks the Gas Town sessions out of entries, with role and
+// rig parsed from the session name, ordered town-level first, then by rig
+// and role.
+func gastownSessions(entries []tmux.SessionEntry, now time.Time) []sessionRow {
+	var rows []sessionRow
+	for _, e := range entries {
+		agent := categorizeSession(e.Name)
+		if agent == nil {
+			continue
+		}
+		row := sessionRow{
+			Session:   e.Name,
+			Rig:       agent.Rig,
+			Role:      agentTypeNames[agent.Type],
+			Name:      agent.AgentName,
+			agentType: agent.Type,
+		}
+		if !e.Created.IsZero() {
+			created := e.Created
+			row.Created = &created
+			row.Uptime = now.Sub(created)
+		}
+		rows = append(rows, row)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if (a.Rig == "") != (b.Rig == "") {
+			return a.Rig == ""
+		}
+		if a.Rig != b.Rig {
+			return a.Rig < b.Rig
+		}
+		if a.agentType != b.agentType {
+			return a.agentType < b.agentType
+		}
+		return a.Name < b.Name
+	})
+	return rows
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestGastownSessions(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	entries := []tmux.SessionEntry{
+		{Name: "gt-zebra-Toast", Created: now.Add(-time.Hour)},
+		{Name: "my-notes"},
+		{Name: "gt-alpha-witness", Created: now.Add(-2 * time.Hour)},
+		{Name: "hq-mayor", Created: now.Add(-3 * time.Hour)},
+		{Name: "gt-alpha-crew-max"},
+	}
+
+	rows := gastownSessions(entries, now)
+	var got []string
+	for _, r := range rows {
+		got = append(got, r.Session)
+	}
+	want := []string{"hq-mayor", "gt-alpha-witness", "gt-alpha-crew-max", "gt-zebra-Toast"}
+	if len(got) != len(want) {
+		t.Fatalf("sessions = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sessions = %v, want %v", got, want)
+		}
+	}
+
+	if r := rows[3]; r.Rig != "zebra" || r.Role != "polecat" || r.Name != "Toast" || r.Uptime != time.Hour {
+		t.Errorf("polecat row = %+v", r)
+	}
+	if r := rows[2]; r.Created != nil || r.Uptime != 0 {
+		t.Errorf("row without creation time = %+v", r)
+	}
+	if gastownSessions(nil, now) != nil {
+		t.Error("no sessions should yield no rows")
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -381,11 +380,8 @@ func runSling(cmd *cobra.Command, args []string) error {
 
 		// Step 1: Cook the formula (ensures proto exists)
 		// Cook runs from rig directory to access the correct formula database
-		cookCmd := exec.Command("bd", "--no-daemon", "cook", formulaName)
-		cookCmd.Dir = formulaWorkDir
-		cookCmd.Stderr = os.Stderr
-		if err := cookCmd.Run(); err != nil {
-			return fmt.Errorf("cooking formula %s: %w", formulaName, err)
+		if err := beads.New(formulaWorkDir).Cook(formulaName, nil); err != nil {
+			return err
 		}
 
 		// Step 2: Create wisp with feature and issue variables from bead
@@ -393,7 +389,8 @@ func runSling(cmd *cobra.Command, args []string) error {
 		featureVar := fmt.Sprintf("feature=%s", info.Title)
 		issueVar := fmt.Sprintf("issue=%s", beadID)
 		wispArgs := []string{"--no-daemon", "mol", "wisp", formulaName, "--var", featureVar, "--var", issueVar, "--json"}
-		wispCmd := exec.Command("bd", wispArgs...)
+		wispCmd, cancel := beads.Command(wispArgs...)
+		defer cancel()
 		wispCmd.Dir = formulaWorkDir
 		wispCmd.Env = append(os.Environ(), "GT_ROOT="+townRoot)
 		wispCmd.Stderr = os.Stderr
@@ -412,7 +409,8 @@ func runSling(cmd *cobra.Command, args []string) error {
 		// Step 3: Bond wisp to original bead (creates compound)
 		// Use --no-daemon for mol bond (requires direct database access)
 		bondArgs := []string{"--no-daemon", "mol", "bond", wispRootID, beadID, "--json"}
-		bondCmd := exec.Command("bd", bondArgs...)
+		bondCmd, cancel := beads.Command(bondArgs...)
+		defer cancel()
 		bondCmd.Dir = formulaWorkDir
 		bondCmd.Stderr = os.Stderr
 		bondOut, err := bondCmd.Output()
@@ -440,7 +438,8 @@ func runSling(cmd *cobra.Command, args []string) error {
 
 	// Hook the bead using bd update.
 	// See: https://github.com/steveyegge/gastown/issues/148
-	hookCmd := exec.Command("bd", "--no-daemon", "update", beadID, "--status=hooked", "--assignee="+targetAgent)
+	hookCmd, cancel := beads.Command("--no-daemon", "update", beadID, "--status=hooked", "--assignee="+targetAgent)
+	defer cancel()
 	hookCmd.Dir = beads.ResolveHookDir(townRoot, beadID, hookWorkDir)
 	hookCmd.Stderr = os.Stderr
 	if err := hookCmd.Run(); err != nil {
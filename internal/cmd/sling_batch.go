@@ -3,7 +3,6 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 
 	"github.com/steveyegge/gastown/internal/beads"
@@ -93,7 +92,8 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 
 		// Hook the bead. See: https://github.com/steveyegge/gastown/issues/148
 		townRoot := filepath.Dir(townBeadsDir)
-		hookCmd := exec.Command("bd", "--no-daemon", "update", beadID, "--status=hooked", "--assignee="+targetAgent)
+		hookCmd, cancel := beads.Command("--no-daemon", "update", beadID, "--status=hooked", "--assignee="+targetAgent)
+		defer cancel()
 		hookCmd.Dir = beads.ResolveHookDir(townRoot, beadID, hookWorkDir)
 		hookCmd.Stderr = os.Stderr
 		if err := hookCmd.Run(); err != nil {
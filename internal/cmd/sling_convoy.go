@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -81,7 +82,8 @@ func createAutoConvoy(beadID, beadTitle string) (string, error) {
 		"--description=" + description,
 	}
 
-	createCmd := exec.Command("bd", append([]string{"--no-daemon"}, createArgs...)...)
+	createCmd, cancel := beads.Command(append([]string{"--no-daemon"}, createArgs...)...)
+	defer cancel()
 	createCmd.Dir = townBeads
 	createCmd.Stderr = os.Stderr
 
@@ -92,7 +94,8 @@ func createAutoConvoy(beadID, beadTitle string) (string, error) {
 	// Add tracking relation: convoy tracks the issue
 	trackBeadID := formatTrackBeadID(beadID)
 	depArgs := []string{"--no-daemon", "dep", "add", convoyID, trackBeadID, "--type=tracks"}
-	depCmd := exec.Command("bd", depArgs...)
+	depCmd, cancel := beads.Command(depArgs...)
+	defer cancel()
 	depCmd.Dir = townBeads
 	depCmd.Stderr = os.Stderr
 
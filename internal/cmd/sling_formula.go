@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -55,13 +54,15 @@ func verifyFormulaExists(formulaName string) error {
 	// Try bd formula show (handles all formula file formats)
 	// Use Output() instead of Run() to detect bd --no-daemon exit 0 bug:
 	// when formula not found, --no-daemon may exit 0 but produce empty stdout.
-	cmd := exec.Command("bd", "--no-daemon", "formula", "show", formulaName, "--allow-stale")
+	cmd, cancel := beads.Command("--no-daemon", "formula", "show", formulaName, "--allow-stale")
+	defer cancel()
 	if out, err := cmd.Output(); err == nil && len(out) > 0 {
 		return nil
 	}
 
 	// Try with mol- prefix
-	cmd = exec.Command("bd", "--no-daemon", "formula", "show", "mol-"+formulaName, "--allow-stale")
+	cmd, cancel = beads.Command("--no-daemon", "formula", "show", "mol-"+formulaName, "--allow-stale")
+	defer cancel()
 	if out, err := cmd.Output(); err == nil && len(out) > 0 {
 		return nil
 	}
@@ -181,7 +182,8 @@ func runSlingFormula(args []string) error {
 	// Step 1: Cook the formula (ensures proto exists)
 	fmt.Printf("  Cooking formula...\n")
 	cookArgs := []string{"--no-daemon", "cook", formulaName}
-	cookCmd := exec.Command("bd", cookArgs...)
+	cookCmd, cancel := beads.Command(cookArgs...)
+	defer cancel()
 	cookCmd.Stderr = os.Stderr
 	if err := cookCmd.Run(); err != nil {
 		return fmt.Errorf("cooking formula: %w", err)
@@ -195,7 +197,8 @@ func runSlingFormula(args []string) error {
 	}
 	wispArgs = append(wispArgs, "--json")
 
-	wispCmd := exec.Command("bd", wispArgs...)
+	wispCmd, cancel := beads.Command(wispArgs...)
+	defer cancel()
 	wispCmd.Stderr = os.Stderr // Show wisp errors to user
 	wispOut, err := wispCmd.Output()
 	if err != nil {
@@ -212,7 +215,8 @@ func runSlingFormula(args []string) error {
 
 	// Step 3: Hook the wisp bead using bd update.
 	// See: https://github.com/steveyegge/gastown/issues/148
-	hookCmd := exec.Command("bd", "--no-daemon", "update", wispRootID, "--status=hooked", "--assignee="+targetAgent)
+	hookCmd, cancel := beads.Command("--no-daemon", "update", wispRootID, "--status=hooked", "--assignee="+targetAgent)
+	defer cancel()
 	hookCmd.Dir = beads.ResolveHookDir(townRoot, wispRootID, "")
 	hookCmd.Stderr = os.Stderr
 	if err := hookCmd.Run(); err != nil {
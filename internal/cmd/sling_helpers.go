@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -11,162 +11,95 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
-// beadInfo holds status and assignee for a bead.
-type beadInfo struct {
-	Title    string `json:"title"`
-	Status   string `json:"status"`
-	Assignee string `json:"assignee"`
-}
-
-// verifyBeadExists checks that the bead exists using bd show.
-// Uses bd's native prefix-based routing via routes.jsonl - do NOT set BEADS_DIR
-// as that overrides routing and breaks resolution of rig-level beads.
+// showBead fetches a bead with bd show, run from the town root so bd can
+// find routes.jsonl for prefix-based routing. Do NOT set BEADS_DIR - that
+// overrides routing and breaks resolution of rig-level beads.
 //
 // Uses --no-daemon with --allow-stale to avoid daemon socket timing issues
 // while still finding beads when database is out of sync with JSONL.
-// For existence checks, stale data is acceptable - we just need to know it exists.
-func verifyBeadExists(beadID string) error {
-	cmd := exec.Command("bd", "--no-daemon", "show", beadID, "--json", "--allow-stale")
-	// Run from town root so bd can find routes.jsonl for prefix-based routing.
-	// Do NOT set BEADS_DIR - that overrides routing and breaks rig bead resolution.
+func showBead(beadID string) (*beads.Bead, error) {
+	dir := ""
 	if townRoot, err := workspace.FindFromCwd(); err == nil {
-		cmd.Dir = townRoot
+		dir = townRoot
 	}
-	// Use Output() instead of Run() to detect bd --no-daemon exit 0 bug:
-	// when issue not found, --no-daemon exits 0 but produces empty stdout.
-	out, err := cmd.Output()
-	if err != nil {
-		return fmt.Errorf("bead '%s' not found (bd show failed)", beadID)
-	}
-	if len(out) == 0 {
-		return fmt.Errorf("bead '%s' not found", beadID)
-	}
-	return nil
+	return beads.ShowFrom(dir, beadID)
 }
 
-// getBeadInfo returns status and assignee for a bead.
-// Uses bd's native prefix-based routing via routes.jsonl.
-// Uses --no-daemon with --allow-stale for consistency with verifyBeadExists.
-func getBeadInfo(beadID string) (*beadInfo, error) {
-	cmd := exec.Command("bd", "--no-daemon", "show", beadID, "--json", "--allow-stale")
-	// Run from town root so bd can find routes.jsonl for prefix-based routing.
-	if townRoot, err := workspace.FindFromCwd(); err == nil {
-		cmd.Dir = townRoot
-	}
-	out, err := cmd.Output()
-	if err != nil {
-		return nil, fmt.Errorf("bead '%s' not found", beadID)
-	}
-	// Handle bd --no-daemon exit 0 bug: when issue not found,
-	// --no-daemon exits 0 but produces empty stdout (error goes to stderr).
-	if len(out) == 0 {
+// verifyBeadExists checks that the bead exists using bd show.
+// For existence checks, stale data is acceptable - we just need to know it exists.
+func verifyBeadExists(beadID string) error {
+	_, err := getBeadInfo(beadID)
+	return err
+}
+
+// getBeadInfo returns a bead's title, status and assignee (and the rest
+// of bd show's output).
+func getBeadInfo(beadID string) (*beads.Bead, error) {
+	bead, err := showBead(beadID)
+	if errors.Is(err, beads.ErrNotFound) {
 		return nil, fmt.Errorf("bead '%s' not found", beadID)
 	}
-	// bd show --json returns an array (issue + dependents), take first element
-	var infos []beadInfo
-	if err := json.Unmarshal(out, &infos); err != nil {
-		return nil, fmt.Errorf("parsing bead info: %w", err)
-	}
-	if len(infos) == 0 {
-		return nil, fmt.Errorf("bead '%s' not found", beadID)
+	if err != nil {
+		return nil, fmt.Errorf("bead '%s' not found (%v)", beadID, err)
 	}
-	return &infos[0], nil
+	return bead, nil
 }
 
 // storeArgsInBead stores args in the bead's description using attached_args field.
 // This enables no-tmux mode where agents discover args via gt prime / bd show.
 func storeArgsInBead(beadID, args string) error {
-	// Get the bead to preserve existing description content
-	showCmd := exec.Command("bd", "--no-daemon", "show", beadID, "--json", "--allow-stale")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-	// Handle bd --no-daemon exit 0 bug: empty stdout means not found
-	if len(out) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the args
-	fields.AttachedArgs = args
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := exec.Command("bd", "--no-daemon", "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
+	return updateBeadAttachment(attachmentBeads(beadID), beadID, func(fields *beads.AttachmentFields) {
+		fields.AttachedArgs = args
+	})
 }
 
 // storeDispatcherInBead stores the dispatcher agent ID in the bead's description.
 // This enables polecats to notify the dispatcher when work is complete.
+// It goes through the bd daemon, like the other writes sling makes while
+// the polecat starts up.
 func storeDispatcherInBead(beadID, dispatcher string) error {
 	if dispatcher == "" {
 		return nil
 	}
+	return updateBeadAttachment(attachmentBeads(beadID).WithDaemon(), beadID, func(fields *beads.AttachmentFields) {
+		fields.DispatchedBy = dispatcher
+	})
+}
+
+// attachmentBeads returns the beads database that holds beadID: bd update
+// doesn't route by prefix, so the bead is read and written in its rig's
+// directory (see beads.ResolveHookDir).
+func attachmentBeads(beadID string) *beads.Beads {
+	townRoot, _ := workspace.FindFromCwd()
+	return beads.New(beads.ResolveHookDir(townRoot, beadID, ""))
+}
 
+// updateBeadAttachment changes a bead's attachment fields with fn, keeping
+// the rest of its description. The bead is read and written through b, so
+// the update starts from the description b's database holds.
+func updateBeadAttachment(b *beads.Beads, beadID string, fn func(*beads.AttachmentFields)) error {
 	// Get the bead to preserve existing description content
-	showCmd := exec.Command("bd", "show", beadID, "--json")
-	out, err := showCmd.Output()
+	issue, err := b.Show(beadID)
 	if err != nil {
+		if errors.Is(err, beads.ErrNotFound) {
+			return fmt.Errorf("bead not found")
+		}
 		return fmt.Errorf("fetching bead: %w", err)
 	}
 
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the dispatcher
-	fields.DispatchedBy = dispatcher
-
-	// Update the description
+	fields := beads.NewBead(issue).Attachment()
+	fn(fields)
 	newDesc := beads.SetAttachmentFields(issue, fields)
 
-	// Update the bead
-	updateCmd := exec.Command("bd", "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
+	if err := b.Update(beadID, beads.UpdateOptions{Description: &newDesc}); err != nil {
 		return fmt.Errorf("updating bead description: %w", err)
 	}
-
 	return nil
 }
 
@@ -209,12 +142,11 @@ func injectStartPrompt(pane, beadID, subject, args string) error {
 func getSessionFromPane(pane string) string {
 	if strings.HasPrefix(pane, "%") {
 		// Pane ID format - query tmux for the session
-		cmd := exec.Command("tmux", "display-message", "-t", pane, "-p", "#{session_name}")
-		out, err := cmd.Output()
+		session, err := tmux.NewTmux().Run("display-message", "-t", pane, "-p", "#{session_name}")
 		if err != nil {
 			return ""
 		}
-		return strings.TrimSpace(string(out))
+		return session
 	}
 	// Session:window.pane format - extract session name
 	if idx := strings.Index(pane, ":"); idx > 0 {
@@ -368,8 +300,8 @@ func wakeRigAgents(rigName string) {
 
 	// Nudge witness and refinery to clear any backoff
 	t := tmux.NewTmux()
-	witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
-	refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+	witnessSession := session.WitnessSessionName(rigName)
+	refinerySession := session.RefinerySessionName(rigName)
 
 	// Silent nudges - sessions might not exist yet
 	_ = t.NudgeSession(witnessSession, "Polecat dispatched - check for work")
@@ -391,24 +323,10 @@ func isPolecatTarget(target string) bool {
 //
 // Per issue #288: gt sling should auto-attach mol-polecat-work when slinging to polecats.
 func attachPolecatWorkMolecule(targetAgent, hookWorkDir, townRoot string) error {
-	// Parse the polecat name from targetAgent (format: "rig/polecats/name")
-	parts := strings.Split(targetAgent, "/")
-	if len(parts) != 3 || parts[1] != "polecats" {
-		return fmt.Errorf("invalid polecat agent format: %s", targetAgent)
+	rigDir, agentBeadID, err := polecatAgentBead(targetAgent, hookWorkDir, townRoot)
+	if err != nil {
+		return err
 	}
-	rigName := parts[0]
-	polecatName := parts[2]
-
-	// Get the polecat's agent bead ID
-	// Format: "<prefix>-<rig>-polecat-<name>" (e.g., "gt-gastown-polecat-Toast")
-	prefix := config.GetRigPrefix(townRoot, rigName)
-	agentBeadID := beads.PolecatBeadIDWithPrefix(prefix, rigName, polecatName)
-
-	// Resolve the rig directory for running bd commands.
-	// Use ResolveHookDir to ensure we run bd from the correct rig directory
-	// (not from the polecat's worktree, which doesn't have a .beads directory).
-	// This fixes issue #197: polecat fails to hook when slinging with molecule.
-	rigDir := beads.ResolveHookDir(townRoot, prefix+"-"+polecatName, hookWorkDir)
 
 	b := beads.New(rigDir)
 
@@ -421,16 +339,13 @@ func attachPolecatWorkMolecule(targetAgent, hookWorkDir, townRoot string) error
 
 	// Cook the mol-polecat-work formula to ensure the proto exists
 	// This is safe to run multiple times - cooking is idempotent
-	cookCmd := exec.Command("bd", "--no-daemon", "cook", "mol-polecat-work")
-	cookCmd.Dir = rigDir
-	cookCmd.Stderr = os.Stderr
-	if err := cookCmd.Run(); err != nil {
-		return fmt.Errorf("cooking mol-polecat-work formula: %w", err)
+	moleculeID := beads.PolecatWorkMolecule
+	if err := b.Cook(moleculeID, nil); err != nil {
+		return err
 	}
 
 	// Attach the molecule to the polecat's agent bead
 	// The molecule ID is the formula name "mol-polecat-work"
-	moleculeID := "mol-polecat-work"
 	_, err = b.AttachMolecule(agentBeadID, moleculeID)
 	if err != nil {
 		return fmt.Errorf("attaching molecule %s to %s: %w", moleculeID, agentBeadID, err)
@@ -439,3 +354,70 @@ func attachPolecatWorkMolecule(targetAgent, hookWorkDir, townRoot string) error
 	fmt.Printf("%s Attached %s to %s\n", style.Bold.Render("✓"), moleculeID, agentBeadID)
 	return nil
 }
+
+// detachPolecatWorkMolecule reverses attachPolecatWorkMolecule, clearing the
+// attached_molecule fields from a polecat's agent bead so its work can be
+// reassigned. Detaching when nothing is attached is a no-op.
+func detachPolecatWorkMolecule(targetAgent, rigPath, townRoot string) error {
+	rigDir, agentBeadID, err := polecatAgentBead(targetAgent, rigPath, townRoot)
+	if err != nil {
+		return err
+	}
+	b := beads.New(rigDir)
+
+	attachment, err := b.GetAttachment(agentBeadID)
+	if err != nil {
+		return fmt.Errorf("checking attachment on %s: %w", agentBeadID, err)
+	}
+	if attachment == nil || attachment.AttachedMolecule == "" {
+		// Nothing attached - skip
+		return nil
+	}
+
+	if _, err := b.DetachMoleculeWithAudit(agentBeadID, beads.DetachOptions{
+		Operation: "detach",
+		Agent:     detectCurrentAgent(),
+	}); err != nil {
+		return fmt.Errorf("detaching molecule %s from %s: %w", attachment.AttachedMolecule, agentBeadID, err)
+	}
+
+	fmt.Printf("%s Detached %s from %s\n", style.Bold.Render("✓"), attachment.AttachedMolecule, agentBeadID)
+	return nil
+}
+
+// parsePolecatAgent splits a polecat agent address into rig and polecat name.
+// The rig is the first segment and the polecat is the last; a "polecats"
+// segment must sit between them, so nested groups such as
+// "rig/polecats/team-a/name" are accepted alongside "rig/polecats/name".
+func parsePolecatAgent(targetAgent string) (rigName, polecatName string, err error) {
+	parts := strings.Split(targetAgent, "/")
+	last := len(parts) - 1
+	for i := 1; i < last; i++ {
+		if parts[i] == "polecats" && parts[0] != "" && parts[last] != "" {
+			return parts[0], parts[last], nil
+		}
+	}
+	return "", "", fmt.Errorf("invalid polecat agent format: %s", targetAgent)
+}
+
+// polecatAgentBead resolves a "rig/polecats/name" target to the rig directory
+// bd must run in and the polecat's agent bead ID.
+func polecatAgentBead(targetAgent, hookWorkDir, townRoot string) (string, string, error) {
+	rigName, polecatName, err := parsePolecatAgent(targetAgent)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Get the polecat's agent bead ID
+	// Format: "<prefix>-<rig>-polecat-<name>" (e.g., "gt-gastown-polecat-Toast")
+	prefix := config.GetRigPrefix(townRoot, rigName)
+	agentBeadID := beads.PolecatBeadIDWithPrefix(prefix, rigName, polecatName)
+
+	// Resolve the rig directory for running bd commands.
+	// Use ResolveHookDir to ensure we run bd from the correct rig directory
+	// (not from the polecat's worktree, which doesn't have a .beads directory).
+	// This fixes issue #197: polecat fails to hook when slinging with molecule.
+	rigDir := beads.ResolveHookDir(townRoot, prefix+"-"+polecatName, hookWorkDir)
+
+	return rigDir, agentBeadID, nil
+}
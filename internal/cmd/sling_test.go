@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 func TestParseWispIDFromJSON(t *testing.T) {
@@ -59,6 +61,44 @@ func TestParseWispIDFromJSON(t *testing.T) {
 	}
 }
 
+func TestParsePolecatAgent(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		wantRig     string
+		wantPolecat string
+		wantErr     bool
+	}{
+		{name: "flat", target: "gastown/polecats/Toast", wantRig: "gastown", wantPolecat: "Toast"},
+		{name: "nested group", target: "gastown/polecats/team-a/Toast", wantRig: "gastown", wantPolecat: "Toast"},
+		{name: "deeply nested", target: "gastown/polecats/team-a/night/Toast", wantRig: "gastown", wantPolecat: "Toast"},
+		{name: "single segment", target: "Toast", wantErr: true},
+		{name: "no polecats segment", target: "gastown/crew/max", wantErr: true},
+		{name: "polecats without name", target: "gastown/polecats", wantErr: true},
+		{name: "trailing slash", target: "gastown/polecats/", wantErr: true},
+		{name: "polecats as rig", target: "polecats/Toast", wantErr: true},
+		{name: "empty rig", target: "/polecats/Toast", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rig, polecat, err := parsePolecatAgent(tt.target)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "invalid polecat agent format") {
+					t.Fatalf("parsePolecatAgent(%q) err = %v, want invalid polecat agent format", tt.target, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePolecatAgent(%q): %v", tt.target, err)
+			}
+			if rig != tt.wantRig || polecat != tt.wantPolecat {
+				t.Errorf("parsePolecatAgent(%q) = %q, %q; want %q, %q", tt.target, rig, polecat, tt.wantRig, tt.wantPolecat)
+			}
+		})
+	}
+}
+
 func TestFormatTrackBeadID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -703,3 +743,70 @@ func TestLooksLikeBeadID(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateBeadAttachment_ReadsAndWritesOneDatabase(t *testing.T) {
+	dir := t.TempDir()
+	binDir := filepath.Join(dir, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	logPath := filepath.Join(dir, "bd.log")
+	script := `#!/bin/sh
+printf '%s|%s|%s' "$PWD" "$BEADS_DIR" "$*" | tr '\n' '~' >> "` + logPath + `"
+echo >> "` + logPath + `"
+for arg in "$@"; do
+  case "$arg" in
+    show) printf '%s\n' '[{"id":"gt-abc","status":"hooked","description":"Fix it\n\nattached_args: fast"}]'; exit 0 ;;
+    update) exit 0 ;;
+  esac
+done
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	rigDir := filepath.Join(dir, "gastown")
+	if err := os.MkdirAll(rigDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		b          *beads.Beads
+		wantDaemon bool
+	}{
+		{beads.New(rigDir), false},
+		{beads.New(rigDir).WithDaemon(), true},
+	} {
+		b := tt.b
+		_ = os.Remove(logPath)
+		err := updateBeadAttachment(b, "gt-abc", func(fields *beads.AttachmentFields) {
+			fields.DispatchedBy = "mayor"
+		})
+		if err != nil {
+			t.Fatalf("updateBeadAttachment: %v", err)
+		}
+
+		data, err := os.ReadFile(logPath)
+		if err != nil {
+			t.Fatalf("read bd log: %v", err)
+		}
+		calls := strings.Split(strings.TrimSpace(string(data)), "\n")
+		if len(calls) != 2 {
+			t.Fatalf("bd calls = %q, want a show and an update", calls)
+		}
+		show, update := strings.SplitN(calls[0], "|", 3), strings.SplitN(calls[1], "|", 3)
+		if show[0] != update[0] || show[1] != update[1] {
+			t.Errorf("show ran in %s with BEADS_DIR=%s, update in %s with BEADS_DIR=%s; want the same database",
+				show[0], show[1], update[0], update[1])
+		}
+		for _, call := range []string{show[2], update[2]} {
+			if daemon := !strings.Contains(call, "--no-daemon"); daemon != tt.wantDaemon {
+				t.Errorf("bd %q through the daemon = %v, want %v", call, daemon, tt.wantDaemon)
+			}
+		}
+		if !strings.Contains(update[2], "attached_args: fast") || !strings.Contains(update[2], "dispatched_by: mayor") {
+			t.Errorf("update = %q, want the existing args kept and the dispatcher added", update[2])
+		}
+	}
+}
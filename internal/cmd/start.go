@@ -480,7 +480,7 @@ func runShutdown(cmd *cobra.Command, args []string) error {
 func categorizeSessions(sessions []string, mayorSession, deaconSession string) (toStop, preserved []string) {
 	for _, sess := range sessions {
 		// Gas Town sessions use gt- (rig-level) or hq- (town-level) prefix
-		if !strings.HasPrefix(sess, "gt-") && !strings.HasPrefix(sess, "hq-") {
+		if !strings.HasPrefix(sess, sessionPrefix()) && !strings.HasPrefix(sess, "hq-") {
 			continue // Not a Gas Town session
 		}
 
@@ -490,13 +490,8 @@ func categorizeSessions(sessions []string, mayorSession, deaconSession string) (
 		// Check if it's a polecat session (pattern: gt-<rig>-<name> where name is not crew/witness/refinery)
 		isPolecat := false
 		if !isCrew && sess != mayorSession && sess != deaconSession {
-			parts := strings.Split(sess, "-")
-			if len(parts) >= 3 {
-				role := parts[2]
-				if role != "witness" && role != "refinery" && role != "crew" {
-					isPolecat = true
-				}
-			}
+			id, err := session.ParseSessionName(sess)
+			isPolecat = err == nil && id.Role == session.RolePolecat
 		}
 
 		// Decide based on flags
@@ -19,6 +19,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -1044,7 +1045,7 @@ func discoverRigAgents(allSessions map[string]bool, r *rig.Rig, crews []string,
 		defs = append(defs, agentDef{
 			name:    "refinery",
 			address: r.Name + "/refinery",
-			session: fmt.Sprintf("gt-%s-refinery", r.Name),
+			session: session.RefinerySessionName(r.Name),
 			role:    "refinery",
 			beadID:  beads.RefineryBeadIDWithPrefix(prefix, r.Name),
 		})
@@ -1055,7 +1056,7 @@ func discoverRigAgents(allSessions map[string]bool, r *rig.Rig, crews []string,
 		defs = append(defs, agentDef{
 			name:    name,
 			address: r.Name + "/" + name,
-			session: fmt.Sprintf("gt-%s-%s", r.Name, name),
+			session: session.PolecatSessionName(r.Name, name),
 			role:    "polecat",
 			beadID:  beads.PolecatBeadIDWithPrefix(prefix, r.Name, name),
 		})
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
@@ -235,7 +236,8 @@ func runSwarmCreate(cmd *cobra.Command, args []string) error {
 	// First check if the epic already exists (it may be pre-created)
 	// Use BeadsPath() to ensure we read from git-synced beads location
 	beadsPath := r.BeadsPath()
-	checkCmd := exec.Command("bd", "show", swarmEpic, "--json")
+	checkCmd, cancel := beads.Command("show", swarmEpic, "--json")
+	defer cancel()
 	checkCmd.Dir = beadsPath
 	if err := checkCmd.Run(); err != nil {
 		// Epic doesn't exist, create it as a swarm molecule
@@ -246,7 +248,8 @@ func runSwarmCreate(cmd *cobra.Command, args []string) error {
 			"--title", swarmEpic,
 			"--silent",
 		}
-		createCmd := exec.Command("bd", createArgs...)
+		createCmd, cancel := beads.Command(createArgs...)
+		defer cancel()
 		createCmd.Dir = beadsPath
 		var stdout bytes.Buffer
 		createCmd.Stdout = &stdout
@@ -282,7 +285,8 @@ func runSwarmCreate(cmd *cobra.Command, args []string) error {
 	// Start if requested
 	if swarmStart {
 		// Get swarm status to find ready tasks
-		statusCmd := exec.Command("bd", "swarm", "status", swarmEpic, "--json")
+		statusCmd, cancel := beads.Command("swarm", "status", swarmEpic, "--json")
+		defer cancel()
 		statusCmd.Dir = beadsPath
 		var statusOut bytes.Buffer
 		statusCmd.Stdout = &statusOut
@@ -325,7 +329,8 @@ func runSwarmStart(cmd *cobra.Command, args []string) error {
 	for _, r := range rigs {
 		// Check if swarm exists in this rig by querying beads
 		// Use BeadsPath() to ensure we read from git-synced location
-		checkCmd := exec.Command("bd", "show", swarmID, "--json")
+		checkCmd, cancel := beads.Command("show", swarmID, "--json")
+		defer cancel()
 		checkCmd.Dir = r.BeadsPath()
 		if err := checkCmd.Run(); err == nil {
 			foundRig = r
@@ -338,7 +343,8 @@ func runSwarmStart(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get swarm status from beads
-	statusCmd := exec.Command("bd", "swarm", "status", swarmID, "--json")
+	statusCmd, cancel := beads.Command("swarm", "status", swarmID, "--json")
+	defer cancel()
 	statusCmd.Dir = foundRig.BeadsPath()
 	var stdout bytes.Buffer
 	statusCmd.Stdout = &stdout
@@ -404,7 +410,8 @@ func runSwarmDispatch(cmd *cobra.Command, args []string) error {
 			continue
 		}
 		// Use BeadsPath() to ensure we read from git-synced location
-		checkCmd := exec.Command("bd", "show", epicID, "--json")
+		checkCmd, cancel := beads.Command("show", epicID, "--json")
+		defer cancel()
 		checkCmd.Dir = r.BeadsPath()
 		if err := checkCmd.Run(); err == nil {
 			foundRig = r
@@ -420,7 +427,8 @@ func runSwarmDispatch(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get swarm/epic status to find ready tasks
-	statusCmd := exec.Command("bd", "swarm", "status", epicID, "--json")
+	statusCmd, cancel := beads.Command("swarm", "status", epicID, "--json")
+	defer cancel()
 	statusCmd.Dir = foundRig.BeadsPath()
 	var stdout bytes.Buffer
 	statusCmd.Stdout = &stdout
@@ -562,7 +570,8 @@ func runSwarmStatus(cmd *cobra.Command, args []string) error {
 	var foundRig *rig.Rig
 	for _, r := range rigs {
 		// Use BeadsPath() to ensure we read from git-synced location
-		checkCmd := exec.Command("bd", "show", swarmID, "--json")
+		checkCmd, cancel := beads.Command("show", swarmID, "--json")
+		defer cancel()
 		checkCmd.Dir = r.BeadsPath()
 		if err := checkCmd.Run(); err == nil {
 			foundRig = r
@@ -580,7 +589,8 @@ func runSwarmStatus(cmd *cobra.Command, args []string) error {
 		bdArgs = append(bdArgs, "--json")
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd, cancel := beads.Command(bdArgs...)
+	defer cancel()
 	bdCmd.Dir = foundRig.BeadsPath()
 	bdCmd.Stdout = os.Stdout
 	bdCmd.Stderr = os.Stderr
@@ -630,7 +640,8 @@ func runSwarmList(cmd *cobra.Command, args []string) error {
 	var allSwarms []swarmListEntry
 
 	for _, r := range rigs {
-		bdCmd := exec.Command("bd", bdArgs...)
+		bdCmd, cancel := beads.Command(bdArgs...)
+		defer cancel()
 		bdCmd.Dir = r.BeadsPath() // Use BeadsPath() for git-synced beads
 		var stdout bytes.Buffer
 		bdCmd.Stdout = &stdout
@@ -710,7 +721,8 @@ func runSwarmLand(cmd *cobra.Command, args []string) error {
 	var foundRig *rig.Rig
 	for _, r := range rigs {
 		// Use BeadsPath() for git-synced beads
-		checkCmd := exec.Command("bd", "show", swarmID, "--json")
+		checkCmd, cancel := beads.Command("show", swarmID, "--json")
+		defer cancel()
 		checkCmd.Dir = r.BeadsPath()
 		if err := checkCmd.Run(); err == nil {
 			foundRig = r
@@ -723,7 +735,8 @@ func runSwarmLand(cmd *cobra.Command, args []string) error {
 	}
 
 	// Check swarm status - all children should be closed
-	statusCmd := exec.Command("bd", "swarm", "status", swarmID, "--json")
+	statusCmd, cancel := beads.Command("swarm", "status", swarmID, "--json")
+	defer cancel()
 	statusCmd.Dir = foundRig.BeadsPath()
 	var stdout bytes.Buffer
 	statusCmd.Stdout = &stdout
@@ -776,7 +789,8 @@ func runSwarmLand(cmd *cobra.Command, args []string) error {
 	if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
 		closeArgs = append(closeArgs, "--session="+sessionID)
 	}
-	closeCmd := exec.Command("bd", closeArgs...)
+	closeCmd, cancel := beads.Command(closeArgs...)
+	defer cancel()
 	closeCmd.Dir = foundRig.BeadsPath()
 	if err := closeCmd.Run(); err != nil {
 		style.PrintWarning("couldn't close swarm epic in beads: %v", err)
@@ -800,7 +814,8 @@ func runSwarmCancel(cmd *cobra.Command, args []string) error {
 	var foundRig *rig.Rig
 	for _, r := range rigs {
 		// Use BeadsPath() for git-synced beads
-		checkCmd := exec.Command("bd", "show", swarmID, "--json")
+		checkCmd, cancel := beads.Command("show", swarmID, "--json")
+		defer cancel()
 		checkCmd.Dir = r.BeadsPath()
 		if err := checkCmd.Run(); err == nil {
 			foundRig = r
@@ -813,7 +828,8 @@ func runSwarmCancel(cmd *cobra.Command, args []string) error {
 	}
 
 	// Check if swarm is already closed
-	checkCmd := exec.Command("bd", "show", swarmID, "--json")
+	checkCmd, cancel := beads.Command("show", swarmID, "--json")
+	defer cancel()
 	checkCmd.Dir = foundRig.BeadsPath()
 	var stdout bytes.Buffer
 	checkCmd.Stdout = &stdout
@@ -835,7 +851,8 @@ func runSwarmCancel(cmd *cobra.Command, args []string) error {
 	if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
 		closeArgs = append(closeArgs, "--session="+sessionID)
 	}
-	closeCmd := exec.Command("bd", closeArgs...)
+	closeCmd, cancel := beads.Command(closeArgs...)
+	defer cancel()
 	closeCmd.Dir = foundRig.BeadsPath()
 	if err := closeCmd.Run(); err != nil {
 		return fmt.Errorf("closing swarm: %w", err)
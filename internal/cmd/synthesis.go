@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/style"
@@ -326,7 +327,8 @@ func runSynthesisClose(cmd *cobra.Command, args []string) error {
 	if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
 		closeArgs = append(closeArgs, "--session="+sessionID)
 	}
-	closeCmd := exec.Command("bd", closeArgs...)
+	closeCmd, cancel := beads.Command(closeArgs...)
+	defer cancel()
 	closeCmd.Dir = townBeads
 	closeCmd.Stderr = os.Stderr
 
@@ -349,7 +351,8 @@ func getConvoyMeta(convoyID string) (*ConvoyMeta, error) {
 		return nil, err
 	}
 
-	showCmd := exec.Command("bd", "show", convoyID, "--json")
+	showCmd, cancel := beads.Command("show", convoyID, "--json")
+	defer cancel()
 	showCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
@@ -539,7 +542,8 @@ func createSynthesisBead(convoyID string, meta *ConvoyMeta, f *formula.Formula,
 		return "", err
 	}
 
-	createCmd := exec.Command("bd", createArgs...)
+	createCmd, cancel := beads.Command(createArgs...)
+	defer cancel()
 	createCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	createCmd.Stdout = &stdout
@@ -564,7 +568,8 @@ func createSynthesisBead(convoyID string, meta *ConvoyMeta, f *formula.Formula,
 
 	// Add tracking relation: convoy tracks synthesis
 	depArgs := []string{"dep", "add", convoyID, result.ID, "--type=tracks"}
-	depCmd := exec.Command("bd", depArgs...)
+	depCmd, cancel := beads.Command(depArgs...)
+	defer cancel()
 	depCmd.Dir = townBeads
 	_ = depCmd.Run() // Non-fatal if this fails
 
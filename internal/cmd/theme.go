@@ -1,14 +1,18 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -17,6 +21,7 @@ var (
 	themeListFlag    bool
 	themeApplyFlag   bool
 	themeApplyAllFlag bool
+	themeSetClearFlag bool
 )
 
 var themeCmd = &cobra.Command{
@@ -32,10 +37,35 @@ Examples:
   gt theme              # Show current theme
   gt theme --list       # List available themes
   gt theme forest       # Set theme to 'forest'
-  gt theme apply        # Apply theme to all running sessions in this rig`,
+  gt theme apply        # Apply theme to all running sessions in this rig
+  gt theme set gastown ocean  # Pin a rig to a theme town-wide
+  gt theme list         # Show themes with a color preview`,
 	RunE: runTheme,
 }
 
+var themeSetCmd = &cobra.Command{
+	Use:   "set <rig> <theme>",
+	Short: "Pin a rig to a theme",
+	Long: `Pin a rig to a theme in the town config (mayor/config.json).
+
+New sessions for the rig use the pinned theme instead of the one picked
+from the rig name. A theme set in the rig's own settings (gt theme <name>
+from inside the rig) still takes precedence.
+
+Examples:
+  gt theme set gastown ocean
+  gt theme set gastown --clear`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runThemeSet,
+}
+
+var themeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available themes with a color preview",
+	Args:  cobra.NoArgs,
+	RunE:  runThemeList,
+}
+
 var themeApplyCmd = &cobra.Command{
 	Use:   "apply",
 	Short: "Apply theme to running sessions",
@@ -49,6 +79,9 @@ Use --all to apply to sessions across all rigs.`,
 func init() {
 	rootCmd.AddCommand(themeCmd)
 	themeCmd.AddCommand(themeApplyCmd)
+	themeCmd.AddCommand(themeSetCmd)
+	themeCmd.AddCommand(themeListCmd)
+	themeSetCmd.Flags().BoolVar(&themeSetClearFlag, "clear", false, "Remove the rig's pin and go back to the default theme")
 	themeCmd.Flags().BoolVarP(&themeListFlag, "list", "l", false, "List available themes")
 	themeApplyCmd.Flags().BoolVarP(&themeApplyAllFlag, "all", "a", false, "Apply to all rigs, not just current")
 }
@@ -56,15 +89,7 @@ func init() {
 func runTheme(cmd *cobra.Command, args []string) error {
 	// List mode
 	if themeListFlag {
-		fmt.Println("Available themes:")
-		for _, name := range tmux.ListThemeNames() {
-			theme := tmux.GetThemeByName(name)
-			fmt.Printf("  %-10s  %s\n", name, theme.Style())
-		}
-		// Also show Mayor theme
-		mayor := tmux.MayorTheme()
-		fmt.Printf("  %-10s  %s (Mayor only)\n", mayor.Name, mayor.Style())
-		return nil
+		return runThemeList(cmd, nil)
 	}
 
 	// Determine current rig
@@ -79,8 +104,11 @@ func runTheme(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Rig: %s\n", rigName)
 		fmt.Printf("Theme: %s (%s)\n", theme.Name, theme.Style())
 		// Show if it's configured vs default
+		townRoot, _ := workspace.FindFromCwd()
 		if configured := loadRigTheme(rigName); configured != "" {
 			fmt.Printf("(configured in settings/config.json)\n")
+		} else if townRoot != "" && tmux.TownRigTheme(townRoot, rigName) != "" {
+			fmt.Printf("(pinned in mayor/config.json)\n")
 		} else {
 			fmt.Printf("(default, based on rig name hash)\n")
 		}
@@ -124,7 +152,7 @@ func runThemeApply(cmd *cobra.Command, args []string) error {
 	// Apply to matching sessions
 	applied := 0
 	for _, sess := range sessions {
-		if !strings.HasPrefix(sess, "gt-") {
+		if !strings.HasPrefix(sess, sessionPrefix()) {
 			continue
 		}
 
@@ -140,9 +168,9 @@ func runThemeApply(cmd *cobra.Command, args []string) error {
 			theme = tmux.DeaconTheme()
 			worker = "Deacon"
 			role = "health-check"
-		} else if strings.HasSuffix(sess, "-witness") && strings.HasPrefix(sess, "gt-") {
+		} else if strings.HasSuffix(sess, "-witness") && strings.HasPrefix(sess, sessionPrefix()) {
 			// Witness sessions: gt-<rig>-witness
-			rig = strings.TrimPrefix(strings.TrimSuffix(sess, "-witness"), "gt-")
+			rig = strings.TrimPrefix(strings.TrimSuffix(sess, "-witness"), sessionPrefix())
 			theme = getThemeForRole(rig, "witness")
 			worker = "witness"
 			role = "witness"
@@ -210,11 +238,10 @@ func detectCurrentRig() string {
 	}
 
 	// Try to extract from tmux session name
-	if session := detectCurrentSession(); session != "" {
+	if sess := detectCurrentSession(); sess != "" {
 		// Extract rig from session name: gt-<rig>-...
-		parts := strings.SplitN(session, "-", 3)
-		if len(parts) >= 2 && parts[0] == "gt" && parts[1] != "mayor" && parts[1] != "deacon" {
-			return parts[1]
+		if id, err := session.ParseSessionName(sess); err == nil && id.Rig != "" {
+			return id.Rig
 		}
 	}
 
@@ -248,14 +275,8 @@ func detectCurrentRig() string {
 
 // getThemeForRig returns the theme for a rig, checking config first.
 func getThemeForRig(rigName string) tmux.Theme {
-	// Try to load configured theme
-	if themeName := loadRigTheme(rigName); themeName != "" {
-		if theme := tmux.GetThemeByName(themeName); theme != nil {
-			return *theme
-		}
-	}
-	// Fall back to hash-based assignment
-	return tmux.AssignTheme(rigName)
+	townRoot, _ := workspace.FindFromCwd()
+	return tmux.AssignThemeForTown(townRoot, rigName)
 }
 
 // getThemeForRole returns the theme for a specific role in a rig.
@@ -362,3 +383,101 @@ func saveRigTheme(rigName, themeName string) error {
 
 	return nil
 }
+
+func runThemeList(cmd *cobra.Command, args []string) error {
+	townRoot, _ := workspace.FindFromCwd()
+	pinned := make(map[string][]string)
+	if townRoot != "" {
+		if cfg, err := config.LoadMayorConfig(constants.MayorConfigPath(townRoot)); err == nil && cfg.Theme != nil {
+			for rigName, themeName := range cfg.Theme.RigThemes {
+				pinned[themeName] = append(pinned[themeName], rigName)
+			}
+		}
+	}
+
+	fmt.Println("Available themes:")
+	for _, name := range tmux.ListThemeNames() {
+		theme := tmux.GetThemeByName(name)
+		line := fmt.Sprintf("  %s  %-10s  %s", themePreview(*theme), name, theme.Style())
+		if rigs := pinned[name]; len(rigs) > 0 {
+			line += " " + style.Dim.Render("(pinned: "+strings.Join(rigs, ", ")+")")
+		}
+		fmt.Println(line)
+	}
+	// Also show the town-level themes
+	mayor := tmux.MayorTheme()
+	fmt.Printf("  %s  %-10s  %s %s\n", themePreview(mayor), mayor.Name, mayor.Style(), style.Dim.Render("(Mayor only)"))
+	deacon := tmux.DeaconTheme()
+	fmt.Printf("  %s  %-10s  %s %s\n", themePreview(deacon), deacon.Name, deacon.Style(), style.Dim.Render("(Deacon only)"))
+	return nil
+}
+
+// themePreview renders a swatch of a theme's status bar colors.
+func themePreview(theme tmux.Theme) string {
+	return lipgloss.NewStyle().
+		Background(lipgloss.Color(theme.BG)).
+		Foreground(lipgloss.Color(theme.FG)).
+		Render(" gt ")
+}
+
+func runThemeSet(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	if themeSetClearFlag == (len(args) == 2) {
+		return fmt.Errorf("give either a theme name or --clear")
+	}
+
+	townRoot, _, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	var themeName string
+	if !themeSetClearFlag {
+		themeName = args[1]
+		if tmux.GetThemeByName(themeName) == nil {
+			return fmt.Errorf("unknown theme: %s (use 'gt theme list' to see available themes)", themeName)
+		}
+	}
+
+	if err := saveTownRigTheme(townRoot, rigName, themeName); err != nil {
+		return fmt.Errorf("saving theme config: %w", err)
+	}
+
+	if themeSetClearFlag {
+		fmt.Printf("%s Rig '%s' no longer pinned; using %s\n", style.Success.Render("✓"), rigName, tmux.AssignThemeForTown(townRoot, rigName).Name)
+	} else {
+		fmt.Printf("%s Rig '%s' pinned to theme '%s'\n", style.Success.Render("✓"), rigName, themeName)
+	}
+	if configured := loadRigTheme(rigName); configured != "" {
+		style.PrintWarning("rig settings set theme '%s', which takes precedence", configured)
+	}
+	fmt.Println("Run 'gt theme apply --all' to apply to running sessions")
+	return nil
+}
+
+// saveTownRigTheme pins rigName to themeName in the town's mayor config,
+// or removes the pin when themeName is empty.
+func saveTownRigTheme(townRoot, rigName, themeName string) error {
+	path := constants.MayorConfigPath(townRoot)
+	cfg, err := config.LoadMayorConfig(path)
+	if err != nil {
+		if !errors.Is(err, config.ErrNotFound) {
+			return fmt.Errorf("loading config: %w", err)
+		}
+		cfg = config.NewMayorConfig()
+	}
+
+	if cfg.Theme == nil {
+		cfg.Theme = &config.TownThemeConfig{}
+	}
+	if themeName == "" {
+		delete(cfg.Theme.RigThemes, rigName)
+	} else {
+		if cfg.Theme.RigThemes == nil {
+			cfg.Theme.RigThemes = make(map[string]string)
+		}
+		cfg.Theme.RigThemes[rigName] = themeName
+	}
+
+	return config.SaveMayorConfig(path, cfg)
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// newTmuxClient returns the tmux client commands manage sessions with.
+// Tests swap it for a tmuxtest.FakeTmux to check which tmux calls a
+// command makes without a tmux server.
+var newTmuxClient = func() tmux.Client { return tmux.NewTmux() }
+
+// requireTmux fails fast with an actionable message when tmux can't be run.
+// Call it at the top of commands that start, attach to or drive sessions,
+// before any work that would otherwise fail with a low-level exec error.
+func requireTmux() error {
+	_, err := tmux.NewTmux().Available()
+	return err
+}
+
+// withTmuxVersion annotates a failed tmux operation with the tmux version,
+// which is usually the first thing needed to debug it.
+func withTmuxVersion(err error) error {
+	if err == nil {
+		return nil
+	}
+	if version, verr := tmux.NewTmux().Available(); verr == nil && version != "" {
+		return fmt.Errorf("%w (%s)", err, version)
+	}
+	return err
+}
+
+// sessionPrefix returns the prefix rig-level session names are built from
+// and recognised by: "gt-" unless GT_SESSION_PREFIX or the town's
+// session_prefix setting say otherwise.
+func sessionPrefix() string {
+	return session.RigPrefix()
+}
+
+// migrateLegacySession renames a session still running under its name
+// from before the session prefix was changed (see session.LegacyName) to
+// sessionName, so commands find it rather than starting a duplicate.
+// Nothing is renamed when the prefix is the default, no legacy session is
+// running, or sessionName is already taken. Reports whether it renamed.
+func migrateLegacySession(t tmux.Client, sessionName string) (bool, error) {
+	legacy := session.LegacyName(sessionName)
+	if legacy == "" {
+		return false, nil
+	}
+	if running, err := t.HasSession(legacy); err != nil || !running {
+		return false, err
+	}
+	if taken, err := t.HasSession(sessionName); err != nil || taken {
+		return false, err
+	}
+	if err := t.RenameSession(legacy, sessionName); err != nil {
+		return false, fmt.Errorf("renaming session %s to %s: %w", legacy, sessionName, err)
+	}
+	return true, nil
+}
+
+// migrateLegacySessionNote is migrateLegacySession for commands that go on
+// either way: it reports a rename or a failure on stderr, leaving stdout
+// to the command's own (possibly structured) output.
+func migrateLegacySessionNote(t tmux.Client, sessionName string) {
+	renamed, err := migrateLegacySession(t, sessionName)
+	switch {
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "%s %v\n", style.WarningPrefix, err)
+	case renamed:
+		fmt.Fprintf(os.Stderr, "Renamed session %s to %s (session prefix changed)\n",
+			session.LegacyName(sessionName), sessionName)
+	}
+}
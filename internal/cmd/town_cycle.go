@@ -2,10 +2,11 @@ package cmd
 
 import (
 	"fmt"
-	"os/exec"
 	"sort"
 
 	"github.com/spf13/cobra"
+
+	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 // townCycleSession is the --session flag for town next/prev commands.
@@ -135,8 +136,7 @@ func cycleTownSession(direction int, sessionOverride string) error {
 	targetSession := sessions[targetIdx]
 
 	// Switch to target session
-	cmd := exec.Command("tmux", "switch-client", "-t", targetSession)
-	if err := cmd.Run(); err != nil {
+	if err := tmux.NewTmux().SwitchClient(targetSession); err != nil {
 		return fmt.Errorf("switching to %s: %w", targetSession, err)
 	}
 
@@ -146,7 +146,7 @@ func cycleTownSession(direction int, sessionOverride string) error {
 // findRunningTownSessions returns a list of currently running town-level sessions.
 func findRunningTownSessions() ([]string, error) {
 	// Get all tmux sessions
-	out, err := exec.Command("tmux", "list-sessions", "-F", "#{session_name}").Output()
+	sessions, err := tmux.NewTmux().ListSessions()
 	if err != nil {
 		return nil, fmt.Errorf("listing tmux sessions: %w", err)
 	}
@@ -158,7 +158,7 @@ func findRunningTownSessions() ([]string, error) {
 	}
 
 	var running []string
-	for _, line := range splitLines(string(out)) {
+	for _, line := range sessions {
 		if line == "" {
 			continue
 		}
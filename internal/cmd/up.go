@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -20,6 +21,7 @@ import (
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/witness"
@@ -188,7 +190,7 @@ func runUp(cmd *cobra.Command, args []string) error {
 		for _, rigName := range rigs {
 			crewStarted, crewErrors := startCrewFromSettings(townRoot, rigName)
 			for _, name := range crewStarted {
-				printStatus(fmt.Sprintf("Crew (%s/%s)", rigName, name), true, fmt.Sprintf("gt-%s-crew-%s", rigName, name))
+				printStatus(fmt.Sprintf("Crew (%s/%s)", rigName, name), true, session.CrewSessionName(rigName, name))
 			}
 			for name, err := range crewErrors {
 				printStatus(fmt.Sprintf("Crew (%s/%s)", rigName, name), false, err.Error())
@@ -200,7 +202,7 @@ func runUp(cmd *cobra.Command, args []string) error {
 		for _, rigName := range rigs {
 			polecatsStarted, polecatErrors := startPolecatsWithWork(townRoot, rigName)
 			for _, name := range polecatsStarted {
-				printStatus(fmt.Sprintf("Polecat (%s/%s)", rigName, name), true, fmt.Sprintf("gt-%s-polecat-%s", rigName, name))
+				printStatus(fmt.Sprintf("Polecat (%s/%s)", rigName, name), true, fmt.Sprintf("%s%s-polecat-%s", sessionPrefix(), rigName, name))
 			}
 			for name, err := range polecatErrors {
 				printStatus(fmt.Sprintf("Polecat (%s/%s)", rigName, name), false, err.Error())
@@ -435,7 +437,7 @@ func upStartWitness(rigName string, r *rig.Rig) agentStartResult {
 	name := "Witness (" + rigName + ")"
 	mgr := witness.NewManager(r)
 	if err := mgr.Start(false, "", nil); err != nil {
-		if err == witness.ErrAlreadyRunning {
+		if errors.Is(err, witness.ErrAlreadyRunning) {
 			return agentStartResult{name: name, ok: true, detail: mgr.SessionName()}
 		}
 		return agentStartResult{name: name, ok: false, detail: err.Error()}
@@ -18,35 +18,50 @@ var (
 	// Commit and Branch - the git revision the binary was built from (optional ldflag)
 	Commit = ""
 	Branch = ""
+	// BuildTime - when the binary was built, RFC 3339 (optional ldflag)
+	BuildTime = ""
 )
 
+var versionJSON bool
+
 var versionCmd = &cobra.Command{
 	Use:     "version",
 	GroupID: GroupDiag,
 	Short:   "Print version information",
-	Run: func(cmd *cobra.Command, args []string) {
-		commit := resolveCommitHash()
-		branch := resolveBranch()
-
-		if commit != "" && branch != "" {
-			fmt.Printf("gt version %s (%s: %s@%s)\n", Version, Build, branch, version.ShortCommit(commit))
-		} else if commit != "" {
-			fmt.Printf("gt version %s (%s: %s)\n", Version, Build, version.ShortCommit(commit))
-		} else {
-			fmt.Printf("gt version %s (%s)\n", Version, Build)
+	Long: `Print the gt version with the git commit and branch it was built from,
+the build date and the Go version. Include this when reporting a bug.
+
+gt --version prints the same.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		info := buildInfo()
+		if versionJSON {
+			return outputJSON(info)
 		}
+		fmt.Println(info)
+		return nil
 	},
 }
 
 func init() {
+	versionCmd.Flags().BoolVar(&versionJSON, "json", false, "Output as JSON")
 	rootCmd.AddCommand(versionCmd)
 
+	// gt --version prints what gt version does. Through a template
+	// function, so the branch lookup only runs when it's asked for.
+	cobra.AddTemplateFunc("gtVersion", func() string { return buildInfo().String() })
+	rootCmd.SetVersionTemplate("{{gtVersion}}\n")
+
 	// Pass the build-time commit to the version package for stale binary checks
 	if Commit != "" {
 		version.SetCommit(Commit)
 	}
 }
 
+// buildInfo collects the running binary's build metadata.
+func buildInfo() version.Info {
+	return version.NewInfo(Version, Build, resolveCommitHash(), resolveBranch(), BuildTime)
+}
+
 func resolveCommitHash() string {
 	if Commit != "" {
 		return Commit
@@ -1,24 +1,81 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/terminal"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
 )
 
 // Witness command flags
 var (
-	witnessForeground    bool
-	witnessStatusJSON    bool
-	witnessAgentOverride string
-	witnessEnvOverrides  []string
+	witnessForeground     bool
+	witnessStatusJSON     bool
+	witnessStatusOutput   string
+	witnessStatusHistory  bool
+	witnessStatusPolecat  string
+	witnessStatusForce    bool
+	witnessStatusNDJSON   bool
+	witnessSinceStart     bool
+	witnessAgentOverride  string
+	witnessAgentCommand   string
+	witnessEnvOverrides   []string
+	witnessQuietDates     []string
+	witnessQuietFile      string
+	witnessQuietHours     string
+	witnessIdleThreshold  time.Duration
+	witnessStuckThreshold time.Duration
+	witnessNudgeInterval  time.Duration
+	witnessLogFile        string
+	witnessLogMaxSizeMB   int
+	witnessWatchInterval  int
+	witnessFollowState    bool
+	witnessNudgeTmplFile  string
+	witnessEscalateAfter  int
+	witnessCrashLoopLimit int
+	witnessDryRun         bool
+	witnessDiscover       bool
+	witnessMetricsAddr    string
+	witnessEventsPath     string
+	witnessGroupName      string
+	witnessLogsLines      int
+	witnessLogsFollow     bool
+	witnessLogsInterval   time.Duration
+	witnessStopTimeout    time.Duration
+	witnessStopForce      bool
+	witnessExplainCat     string
+	witnessExplainJSON    bool
+	witnessAttachReadOnly bool
+	witnessAttachSelect   bool
+	witnessPrintSession   bool
+	witnessReconnect      bool
+	witnessIfRunning      bool
+	witnessNewWindow      bool
+	witnessAll            bool
+	witnessLayout         string
+	witnessCheckInterval  time.Duration
+	witnessAdaptive       bool
 )
 
 var witnessCmd = &cobra.Command{
@@ -33,11 +90,14 @@ nudges polecats that seem blocked, and reports status to the mayor.
 
 In the self-cleaning model, polecats nuke themselves after work completion.
 The Witness handles edge cases: crashed sessions, orphaned worktrees, and
-stuck polecats that need intervention.`,
+stuck polecats that need intervention.
+
+A rig can be named by part of its name: "green" finds greenplace, as long
+as no other rig name contains it. An exact name always wins.`,
 }
 
 var witnessStartCmd = &cobra.Command{
-	Use:     "start <rig>",
+	Use:     "start <rig> [rig...]",
 	Aliases: []string{"spawn"},
 	Short:   "Start the witness",
 	Long: `Start the Witness for a rig.
@@ -49,12 +109,125 @@ Self-Cleaning Model: Polecats nuke themselves after work. The Witness handles
 crash recovery (restart with hooked work) and orphan cleanup (nuke abandoned
 sandboxes). There is no "idle" state - polecats either have work or don't exist.
 
+With --foreground, runs the Go monitoring loop in this terminal instead of
+launching an agent session. The loop checks polecat pane activity each
+minute and nudges polecats that appear stuck. Stop it with Ctrl-C.
+
+Each check discovers the rig's polecats from their tmux sessions, so new
+polecats are picked up and vanished ones marked stale without a restart.
+With --discover=false the rig's polecats at start are monitored instead,
+until the witness is restarted.
+
+Quiet dates suppress nudges on planned downtime (holidays, weekends) while
+the loop keeps checking. They persist in the witness state file.
+--quiet-hours does the same for a daily window such as 22:00-07:00, which
+may wrap past midnight; limit it to some days or set its timezone with gt
+witness config set (quiet_hours_days, quiet_hours_timezone).
+
+Given several rigs, one witness loop monitors them all instead of running
+a session per rig. Each rig's stats and state stay in its own state file.
+In the background the loop runs in the tmux session gt-witness-<name>,
+where the name comes from --name or a stable hash of the rig names.
+Stopping a rig (gt witness stop <rig>) drops it from the group.
+
+--idle-threshold and --stuck-threshold set how long a polecat may go without
+pane activity before it counts as idle or stuck (defaults 10m and 30m).
+Raise them for rigs running slow model calls. They also persist in the
+witness state file.
+
+A polecat that has gone quiet is also matched against pane patterns: one
+showing a prompt waiting on a human, a running tool call or an agent error
+is reported as awaiting-input, in-tool or errored rather than stuck, and
+is not nudged. Tune the patterns with gt witness config set
+(awaiting_input_pattern, in_tool_pattern, errored_pattern).
+
+A stuck polecat is nudged at most once per --min-nudge-interval (default
+5m); checks in between count a held nudge instead. A polecat that stays
+stuck through --escalation-threshold nudges (default 3) is escalated to the
+mayor in a town-level escalation bead. Held nudges don't count toward it.
+Further nudges update that bead rather than opening new ones. With
+webhook_url set (gt witness config set), escalations are also POSTed there
+as JSON, e.g. to a Slack incoming webhook; escalation_delivery=webhook
+sends them only there. A failed delivery doesn't stop monitoring and is
+shown in gt witness status.
+
+To keep the witness's hands off some polecats, set nudge_allowlist or
+nudge_denylist (comma-separated globs on polecat names) with gt witness
+config set. Polecats outside the allowlist, or on the denylist, which wins,
+are observe-only: checked and counted but never nudged or escalated.
+
+If the witness agent keeps dying, the daemon restarts it each heartbeat.
+After --crash-loop-threshold crashes (default 3) within 30 minutes the
+witness is held stopped instead, with the last crash reason shown in
+gt witness status. Starting or restarting it by hand clears the hold.
+
+The Go loop (--foreground, or a multi-rig witness) checks every --interval
+(default 1m). With --adaptive the interval is halved after a check that
+finds an active polecat or nudges one, and doubled after one that finds
+nothing going on, staying between min_check_interval (default 15s) and
+max_check_interval (default 10m); set those with gt witness config set.
+gt witness status shows the interval in effect.
+
+--layout=split adds a pane running gt witness watch beside the agent in
+its tmux session; the default, single, runs the agent alone. The layout is
+remembered, so daemon restarts keep it.
+
+--env KEY=VALUE (repeatable) passes extra variables, such as API keys or
+a model override, to the witness agent on top of GT_ROLE and GT_RIG. To
+set them for every start, including the daemon's restarts, use the env
+key of gt witness config set. The agent sees them from its first launch,
+and they stay set for a respawned agent. A pair without "=" or with an
+invalid name is rejected.
+
+--nudge-template-file sets the message sent to stuck polecats. The file is
+a Go text/template with .Polecat, .Rig and .IdleFor; its contents are
+stored in the witness state file. An invalid template is rejected here.
+
+Each monitoring pass appends one JSON line per polecat (state, action,
+reason) to the witness log, rotated when it exceeds --log-max-size.
+In the foreground the entries are also written to stderr.
+
+--dry-run runs the full detection logic but only logs the nudges and
+escalations it would make, counting them as would-nudges in gt witness
+status. In the background, the witness agent's gt nudge and gt escalate
+calls are logged instead of sent.
+
+--metrics-addr serves the loop's stats on /metrics in Prometheus text
+format for as long as it runs. The address must be free; use :0 for a
+random port. It needs the Go loop, so it applies to --foreground and
+multi-rig witnesses.
+
+--events appends what the loop does (check-started, polecat-state-changed,
+nudged, escalated, paused, resumed) as JSON lines to a file or named pipe,
+for dashboards and other tools to tail. Each line carries a format version
+("v"); see witness.Event. A slow or absent reader never holds up the loop:
+events it can't keep up with are dropped and counted in an events-dropped
+line. Like --metrics-addr, it needs the Go loop.
+
+--all starts a witness for every rig in mayor/rigs.json, each in its own
+session, and reports which rigs failed.
+
+--agent-command launches the witness agent with your own shell command
+instead of the rig's runtime, for this start only; set agent_command with
+gt witness config set to make it stick. The rig name is exported as GT_RIG,
+so write "$GT_RIG" rather than the name itself.
+
 Examples:
   gt witness start greenplace
   gt witness start greenplace --agent codex
   gt witness start greenplace --env ANTHROPIC_MODEL=claude-3-haiku
-  gt witness start greenplace --foreground`,
-	Args: cobra.ExactArgs(1),
+  gt witness start greenplace --agent-command 'claude --rig-note "$GT_RIG"'
+  gt witness start greenplace --foreground
+  gt witness start greenplace --foreground --quiet-date weekends --quiet-dates-file ~/holidays.ics
+  gt witness start greenplace --quiet-hours 22:00-07:00
+  gt witness start greenplace --foreground --idle-threshold=5m --stuck-threshold=15m
+  gt witness start greenplace --foreground --interval=30s --adaptive
+  gt witness start greenplace --dry-run
+  gt witness start greenplace --foreground --metrics-addr=:9090
+  gt witness start greenplace --foreground --events /tmp/witness.fifo
+  gt witness start rig1 rig2 rig3 --name small-rigs
+  gt witness start --all`,
+	Args: witnessRigArgs(cobra.MinimumNArgs(1)),
 	RunE: runWitnessStart,
 }
 
@@ -63,21 +236,159 @@ var witnessStopCmd = &cobra.Command{
 	Short: "Stop the witness",
 	Long: `Stop a running Witness.
 
-Gracefully stops the witness monitoring agent.`,
-	Args: cobra.ExactArgs(1),
+Gracefully stops the witness monitoring agent. A foreground monitoring
+loop finishes the check in progress, saves its stats and state, and only
+then exits; if it hasn't within --timeout it is stopped forcefully.
+An agent session is sent Ctrl-C and given --timeout to exit cleanly
+before its session is killed. The tmux session is torn down after the
+state file is written.
+
+Use --force to stop immediately without waiting for the loop or agent.
+Use --all instead of a rig to stop the witness of every rig.`,
+	Args: witnessRigArgs(cobra.ExactArgs(1)),
 	RunE: runWitnessStop,
 }
 
 var witnessStatusCmd = &cobra.Command{
-	Use:   "status <rig>",
+	Use:   "status <rig> [rig...]",
 	Short: "Show witness status",
 	Long: `Show the status of a rig's Witness.
 
-Displays running state, monitored polecats, and statistics.`,
-	Args: cobra.ExactArgs(1),
+Displays running state, monitored polecats, and statistics.
+Each polecat is marked with why it is listed: explicit (one of the rig's
+polecats with --discover=false, or added by watch-add), discovered (found
+by polecat discovery), or stale (no longer monitored, stats kept). -o json
+has the two sets as explicit_polecats and discovered_polecats.
+Given several rigs (e.g. a multi-rig witness group), shows a section per
+rig; -o json or -o yaml then outputs a list.
+
+--output (-o) selects table (default), json or yaml. --json is a
+deprecated alias for --output=json.
+
+The exit code reflects the state, after checking the state file against
+the live tmux session:
+  0  running
+  2  status could not be read
+  3  stopped
+  4  paused
+With several rigs, the first of 2, 3, 4 that applies to any rig wins.
+
+--history adds the last two weeks of daily stats, with a sparkline of
+nudges per day; json/yaml output always includes the full history.
+
+--since-start shows the statistics accumulated since the witness last
+started (or since gt witness reset-stats, if later) next to the all-time
+totals.
+
+--all shows every rig in mayor/rigs.json. A rig whose status can't be read
+is reported (with an "error" field in json/yaml output) without hiding
+the rest. With --ndjson the rigs are read concurrently and each is written
+as one compact JSON line as soon as it is ready, in the order they finish,
+instead of one array at the end.
+
+--polecat <name> narrows a single rig's status to one polecat: its
+detected state, stats, last action and recent nudge times, without the
+rig-wide summary; -o json or -o yaml then outputs just that polecat. A
+polecat the witness doesn't monitor is an error, unless --force is given
+to show whatever stats are left for it.`,
+	Args: witnessRigArgs(cobra.MinimumNArgs(1)),
 	RunE: runWitnessStatus,
 }
 
+var witnessWatchCmd = &cobra.Command{
+	Use:   "watch <rig>",
+	Short: "Watch witness status live",
+	Long: `Continuously redraw the Witness status for a rig.
+
+Refreshes every --interval seconds until Ctrl-C. If the witness stops
+while being watched, prints a final line and exits non-zero.
+
+With --follow-state it also redraws as soon as the witness loop writes its
+state file, and the interval refresh, which still catches a session that
+dies without a final write, defaults to every 30 seconds. Where file change
+notifications aren't available it falls back to plain interval refreshes.
+
+Examples:
+  gt witness watch greenplace
+  gt witness watch greenplace --interval 5
+  gt witness watch greenplace --follow-state`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessWatch,
+}
+
+var witnessLogsCmd = &cobra.Command{
+	Use:   "logs <rig>",
+	Short: "Show recent witness output",
+	Long: `Show recent output from the Witness without attaching to its session.
+
+Captures the last lines of the witness tmux pane. With --follow, keeps
+polling the pane and prints new lines as they appear.
+
+If the witness has no tmux session (e.g. it ran in the foreground), shows
+the witness JSON check log instead.
+
+Examples:
+  gt witness logs greenplace
+  gt witness logs greenplace -n 200
+  gt witness logs greenplace --follow`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessLogs,
+}
+
+var witnessPauseCmd = &cobra.Command{
+	Use:   "pause <rig>",
+	Short: "Pause witness nudges and escalations",
+	Long: `Pause the Witness for a rig.
+
+While paused the Witness keeps running: the tmux session stays alive and
+the monitoring loop keeps checking polecats so statistics stay current,
+but no nudges or escalations are sent. A running agent session is told
+about the pause.
+
+Resume with: gt witness resume <rig>`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessPause,
+}
+
+var witnessWatchAddCmd = &cobra.Command{
+	Use:   "watch-add <rig> <polecat>",
+	Short: "Add a polecat to the witness's monitored set",
+	Long: `Start monitoring a polecat without restarting the Witness.
+
+The polecat must have a tmux session. A running witness picks the change
+up on its next check. The choice is kept across restarts and wins over
+polecat discovery, so the polecat stays monitored even if discovery
+would not find it. gt witness status marks it explicit.
+
+Examples:
+  gt witness watch-add greenplace Toast`,
+	Args: witnessRigArg(cobra.ExactArgs(2)),
+	RunE: runWitnessWatchAdd,
+}
+
+var witnessWatchRemoveCmd = &cobra.Command{
+	Use:   "watch-remove <rig> <polecat>",
+	Short: "Remove a polecat from the witness's monitored set",
+	Long: `Stop monitoring a polecat without restarting the Witness.
+
+A running witness drops the polecat on its next check. Its statistics are
+kept and shown as stale. The choice is kept across restarts and wins over
+polecat discovery; use watch-add to monitor the polecat again.
+
+Examples:
+  gt witness watch-remove greenplace Toast`,
+	Args: witnessRigArg(cobra.ExactArgs(2)),
+	RunE: runWitnessWatchRemove,
+}
+
+var witnessResumeCmd = &cobra.Command{
+	Use:   "resume <rig>",
+	Short: "Resume a paused witness",
+	Long:  `Resume a paused Witness, restoring normal nudges and escalations.`,
+	Args:  witnessRigArg(cobra.ExactArgs(1)),
+	RunE:  runWitnessResume,
+}
+
 var witnessAttachCmd = &cobra.Command{
 	Use:     "attach [rig]",
 	Aliases: []string{"at"},
@@ -87,13 +398,49 @@ var witnessAttachCmd = &cobra.Command{
 Attaches the current terminal to the witness's tmux session.
 Detach with Ctrl-B D.
 
-If the witness is not running, this will start it first.
+If the witness is not running, this will start it first. With
+--if-running it is never started: attach fails with a non-zero exit
+instead, so scripts that only mean to observe can't launch an agent by
+accident. With --all, rigs whose witness is down are skipped, and it
+fails only if none is running.
 If rig is not specified, infers it from the current directory.
 
+With --select, or when the rig can't be inferred, the rigs whose witness is
+running are listed and you pick one by number. This needs a terminal:
+without one, name the rig instead.
+
+With --read-only, keystrokes are not sent to the witness, so a stray key
+can't derail it. If the installed tmux can't attach read-only, the pane is
+streamed to the terminal instead; stop it with Ctrl-C.
+
+With --reconnect, an attach that drops out because the connection or the
+tmux server went away (tmux exits non-zero) is retried after a short
+pause, up to 5 times, as long as the witness session still exists. A
+detach with Ctrl-B D still returns straight away.
+
+With --new-window, the attach opens in a new terminal window and this
+shell is left alone. On macOS that is a Terminal window, or iTerm when run
+from iTerm; elsewhere set GT_TERMINAL to a command that runs a program in
+a new window, such as "gnome-terminal --", "kitty" or "alacritty -e".
+GT_TERMINAL also overrides the macOS default. With --all, a window opens
+for every rig's witness.
+
+With --print-session, nothing is attached: the witness is started as
+usual (or, with --if-running, checked), then its tmux session name is
+printed for use in your own tmux commands. It can't be combined with the
+flags that shape the attach itself.
+
 Examples:
   gt witness attach greenplace
+  gt witness attach greenplace --read-only
+  gt witness attach greenplace --reconnect
+  gt witness attach greenplace --if-running
+  gt witness attach greenplace --new-window
+  gt witness attach --all --new-window
+  gt witness attach --select # pick from the running witnesses
+  tmux send-keys -t "$(gt witness attach greenplace --print-session --if-running)" C-l
   gt witness attach          # infer rig from cwd`,
-	Args: cobra.MaximumNArgs(1),
+	Args: witnessRigArg(cobra.MaximumNArgs(1)),
 	RunE: runWitnessAttach,
 }
 
@@ -102,35 +449,126 @@ var witnessRestartCmd = &cobra.Command{
 	Short: "Restart the witness",
 	Long: `Restart the Witness for a rig.
 
-Stops the current session (if running) and starts a fresh one.
+Stops the witness the same way gt witness stop does, waits for its tmux
+session to disappear, then starts a fresh one. Stats carry over. If the
+witness wasn't running it is simply started. A crash-loop hold is cleared.
+Use --all instead of a rig to restart the witness of every rig.
 
 Examples:
   gt witness restart greenplace
   gt witness restart greenplace --agent codex
   gt witness restart greenplace --env ANTHROPIC_MODEL=claude-3-haiku`,
-	Args: cobra.ExactArgs(1),
+	Args: witnessRigArgs(cobra.ExactArgs(1)),
 	RunE: runWitnessRestart,
 }
 
+var witnessExplainCmd = &cobra.Command{
+	Use:   "explain <rig>",
+	Short: "Explain a polecat's current classification",
+	Long: `Explain why the Witness classifies a polecat the way it does.
+
+Runs the same classification as a monitoring pass, without nudging or
+updating state, and prints each signal that was considered: session
+presence, idle time against the idle and stuck thresholds, pane content
+stability, and whether a quiet period is in effect. Ends with the action
+the next pass would take.
+
+Examples:
+  gt witness explain greenplace --polecat Toast
+  gt witness explain greenplace --polecat Toast --json`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessExplain,
+}
+
 func init() {
 	// Start flags
 	witnessStartCmd.Flags().BoolVar(&witnessForeground, "foreground", false, "Run in foreground (default: background)")
+	witnessStartCmd.Flags().StringVar(&witnessLayout, "layout", "", "Agent session layout: single, or split to add a gt witness watch pane")
 	witnessStartCmd.Flags().StringVar(&witnessAgentOverride, "agent", "", "Agent alias to run the Witness with (overrides town default)")
+	witnessStartCmd.Flags().StringVar(&witnessAgentCommand, "agent-command", "", "Shell command to launch the Witness agent this time (overrides agent_command; use $GT_RIG for the rig)")
 	witnessStartCmd.Flags().StringArrayVar(&witnessEnvOverrides, "env", nil, "Environment variable override (KEY=VALUE, can be repeated)")
+	witnessStartCmd.Flags().StringArrayVar(&witnessQuietDates, "quiet-date", nil, "Date (YYYY-MM-DD) or weekday rule (sat, weekends) on which not to act (can be repeated)")
+	witnessStartCmd.Flags().StringVar(&witnessQuietFile, "quiet-dates-file", "", "ICS calendar or date-list file of quiet dates")
+	witnessStartCmd.Flags().StringVar(&witnessQuietHours, "quiet-hours", "", "Daily HH:MM-HH:MM window in which not to act, e.g. 22:00-07:00")
+	witnessStartCmd.Flags().DurationVar(&witnessIdleThreshold, "idle-threshold", 0, "Inactivity before a polecat counts as idle (default 10m)")
+	witnessStartCmd.Flags().StringVar(&witnessGroupName, "name", "", "Name for a multi-rig witness (default: derived from the rig names)")
+	witnessStartCmd.Flags().DurationVar(&witnessNudgeInterval, "min-nudge-interval", 0, "Least time between two nudges to the same polecat (default 5m)")
+	witnessStartCmd.Flags().IntVar(&witnessEscalateAfter, "escalation-threshold", 0, "Unanswered nudges before escalating a polecat to the mayor (default 3)")
+	witnessStartCmd.Flags().IntVar(&witnessCrashLoopLimit, "crash-loop-threshold", 0, "Agent crashes within 30m before the witness is held stopped (default 3)")
+	witnessStartCmd.Flags().BoolVar(&witnessDryRun, "dry-run", false, "Log the nudges and escalations the witness would make without making them")
+	witnessStartCmd.Flags().BoolVar(&witnessDiscover, "discover", true, "Discover polecats from tmux sessions on each check (false: fix the set at start)")
+	witnessStartCmd.Flags().StringVar(&witnessMetricsAddr, "metrics-addr", "", "Serve Prometheus metrics on this address (e.g. :9090, :0 for a random port)")
+	witnessStartCmd.Flags().StringVar(&witnessEventsPath, "events", "", "Append loop events as JSON lines to this file or FIFO")
+	witnessStartCmd.Flags().StringVar(&witnessNudgeTmplFile, "nudge-template-file", "", "File containing a text/template for nudges to stuck polecats")
+	witnessStartCmd.Flags().StringVar(&witnessLogFile, "log-file", "", "Path of the JSON check log (default: <rig>/.runtime/witness.log)")
+	witnessStartCmd.Flags().IntVar(&witnessLogMaxSizeMB, "log-max-size", 0, "Rotate the check log when it exceeds this many MB (default 10)")
+	witnessStartCmd.Flags().DurationVar(&witnessStuckThreshold, "stuck-threshold", 0, "Inactivity before a polecat counts as stuck and is nudged (default 30m)")
+	witnessStartCmd.Flags().DurationVar(&witnessCheckInterval, "interval", 0, "Time between monitoring passes of the Go loop (default 1m)")
+	witnessStartCmd.Flags().BoolVar(&witnessAdaptive, "adaptive", false, "Back off the check interval while idle and speed it up on activity (between min_check_interval and max_check_interval)")
+
+	// Stop flags
+	witnessStopCmd.Flags().DurationVar(&witnessStopTimeout, "timeout", 30*time.Second, "How long to wait for the loop to finish its current check")
+	witnessStopCmd.Flags().BoolVar(&witnessStopForce, "force", false, "Stop immediately, without waiting for the loop or agent")
 
 	// Status flags
-	witnessStatusCmd.Flags().BoolVar(&witnessStatusJSON, "json", false, "Output as JSON")
+	addOutputFlags(witnessStatusCmd, &witnessStatusOutput, &witnessStatusJSON)
+	witnessStatusCmd.Flags().BoolVar(&witnessStatusHistory, "history", false, "Show the last two weeks of daily stats")
+	witnessStatusCmd.Flags().BoolVar(&witnessSinceStart, "since-start", false, "Show stats since the witness started next to the all-time totals")
+	witnessStatusCmd.Flags().StringVar(&witnessStatusPolecat, "polecat", "", "Show only this polecat's state and stats")
+	witnessStatusCmd.Flags().BoolVar(&witnessStatusForce, "force", false, "With --polecat, show what there is for a polecat that isn't monitored")
+	witnessStatusCmd.Flags().BoolVar(&witnessStatusNDJSON, "ndjson", false, "With --all, stream one compact JSON line per rig as each is read")
+
+	// Watch flags
+	witnessWatchCmd.Flags().IntVarP(&witnessWatchInterval, "interval", "n", 2, "Refresh interval in seconds")
+	witnessWatchCmd.Flags().BoolVar(&witnessFollowState, "follow-state", false, "Redraw when the witness writes its state file (interval default 30s)")
+
+	// Logs flags
+	witnessLogsCmd.Flags().IntVarP(&witnessLogsLines, "lines", "n", 100, "Number of lines to show")
+	witnessLogsCmd.Flags().BoolVarP(&witnessLogsFollow, "follow", "f", false, "Keep printing new output")
+	witnessLogsCmd.Flags().DurationVar(&witnessLogsInterval, "interval", 2*time.Second, "Polling interval for --follow")
+
+	// Explain flags
+	witnessExplainCmd.Flags().StringVar(&witnessExplainCat, "polecat", "", "Polecat to explain (required)")
+	witnessExplainCmd.Flags().BoolVar(&witnessExplainJSON, "json", false, "Output as JSON")
+	_ = witnessExplainCmd.MarkFlagRequired("polecat")
+
+	// Attach flags
+	witnessAttachCmd.Flags().BoolVar(&witnessAttachReadOnly, "read-only", false, "Watch without sending keystrokes to the witness")
+	witnessAttachCmd.Flags().BoolVar(&witnessReconnect, "reconnect", false, "Re-attach when the connection drops, rather than on a clean detach")
+	witnessAttachCmd.Flags().BoolVar(&witnessAttachSelect, "select", false, "Pick the rig from a list of running witnesses")
+	witnessAttachCmd.Flags().BoolVar(&witnessIfRunning, "if-running", false, "Fail instead of starting the witness when it isn't running")
+	witnessAttachCmd.Flags().BoolVar(&witnessNewWindow, "new-window", false, "Attach in a new terminal window (see GT_TERMINAL)")
+	witnessAttachCmd.Flags().BoolVar(&witnessAll, "all", false, "Attach to every rig's witness, each in its own window (needs --new-window)")
+	witnessAttachCmd.Flags().BoolVar(&witnessPrintSession, "print-session", false, "Print the witness's tmux session name instead of attaching")
 
 	// Restart flags
 	witnessRestartCmd.Flags().StringVar(&witnessAgentOverride, "agent", "", "Agent alias to run the Witness with (overrides town default)")
+	witnessRestartCmd.Flags().StringVar(&witnessAgentCommand, "agent-command", "", "Shell command to launch the Witness agent this time (overrides agent_command; use $GT_RIG for the rig)")
 	witnessRestartCmd.Flags().StringArrayVar(&witnessEnvOverrides, "env", nil, "Environment variable override (KEY=VALUE, can be repeated)")
+	witnessRestartCmd.Flags().StringVar(&witnessLayout, "layout", "", "Agent session layout: single, or split to add a gt witness watch pane")
+	witnessRestartCmd.Flags().DurationVar(&witnessStopTimeout, "timeout", 30*time.Second, "How long to wait for the old witness to stop")
+	for _, c := range []*cobra.Command{witnessStartCmd, witnessRestartCmd} {
+		c.MarkFlagsMutuallyExclusive("agent", "agent-command")
+	}
+
+	// --all flags
+	for _, c := range []*cobra.Command{witnessStartCmd, witnessStopCmd, witnessStatusCmd, witnessRestartCmd} {
+		c.Flags().BoolVar(&witnessAll, "all", false, "Apply to every rig in the town")
+	}
 
 	// Add subcommands
 	witnessCmd.AddCommand(witnessStartCmd)
 	witnessCmd.AddCommand(witnessStopCmd)
 	witnessCmd.AddCommand(witnessRestartCmd)
 	witnessCmd.AddCommand(witnessStatusCmd)
+	witnessCmd.AddCommand(witnessWatchCmd)
+	witnessCmd.AddCommand(witnessLogsCmd)
+	witnessCmd.AddCommand(witnessPauseCmd)
+	witnessCmd.AddCommand(witnessResumeCmd)
+	witnessCmd.AddCommand(witnessWatchAddCmd)
+	witnessCmd.AddCommand(witnessWatchRemoveCmd)
 	witnessCmd.AddCommand(witnessAttachCmd)
+	witnessCmd.AddCommand(witnessExplainCmd)
 
 	rootCmd.AddCommand(witnessCmd)
 }
@@ -143,50 +581,464 @@ func getWitnessManager(rigName string) (*witness.Manager, error) {
 	}
 
 	mgr := witness.NewManager(r)
+	mgr.SetTmux(newTmuxClient())
 	return mgr, nil
 }
 
 func runWitnessStart(cmd *cobra.Command, args []string) error {
-	rigName := args[0]
+	if err := requireTmux(); err != nil {
+		return err
+	}
+	if err := witness.ValidateLayout(witnessLayout); err != nil {
+		return err
+	}
+	if witnessForeground && witnessLayout != "" {
+		return fmt.Errorf("--layout applies to the agent session; it can't be combined with --foreground")
+	}
+	if witnessAll {
+		if witnessForeground || witnessGroupName != "" || witnessMetricsAddr != "" || witnessEventsPath != "" {
+			return fmt.Errorf("--all starts each rig's own witness; it can't be combined with --foreground, --name, --metrics-addr or --events")
+		}
+		return runForEachWitnessRig(cmd, "Started", func(rigName string) error {
+			return startWitnessRig(cmd, rigName)
+		})
+	}
+	if len(args) > 1 || witnessGroupName != "" {
+		return runWitnessStartGroup(cmd, args)
+	}
+	return startWitnessRig(cmd, args[0])
+}
 
+// startWitnessRig starts a single rig's witness.
+func startWitnessRig(cmd *cobra.Command, rigName string) error {
 	mgr, err := getWitnessManager(rigName)
 	if err != nil {
 		return err
 	}
 
+	update, err := witnessConfigUpdate(cmd)
+	if err != nil {
+		return err
+	}
+	if update != nil {
+		if err := mgr.UpdateConfig(update); err != nil {
+			return fmt.Errorf("updating witness config: %w", err)
+		}
+	}
+
+	// An explicit start overrides crash-loop detection
+	if err := mgr.ClearCrashLoop(); err != nil {
+		return fmt.Errorf("clearing crash loop: %w", err)
+	}
+
+	var metrics *witness.MetricsServer
+	if witnessMetricsAddr != "" {
+		if !witnessForeground {
+			return fmt.Errorf("--metrics-addr needs the Go monitoring loop; use --foreground")
+		}
+		metrics, err = witness.NewMetricsServer(witnessMetricsAddr, mgr)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = metrics.Close() }()
+	}
+	if witnessEventsPath != "" {
+		if !witnessForeground {
+			return fmt.Errorf("--events needs the Go monitoring loop; use --foreground")
+		}
+		events, err := openWitnessEvents()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = events.Close() }()
+		mgr.SetEventSink(events)
+	}
+
 	fmt.Printf("Starting witness for %s...\n", rigName)
 
+	if !witnessForeground {
+		migrateLegacySessionNote(newTmuxClient(), witnessSessionName(rigName))
+	}
+	mgr.SetDryRun(witnessDryRun)
+	mgr.SetDiscover(witnessDiscover)
+	mgr.SetAgentCommand(witnessAgentCommand)
+	mgr.SetLayout(witnessLayout)
 	if err := mgr.Start(witnessForeground, witnessAgentOverride, witnessEnvOverrides); err != nil {
-		if err == witness.ErrAlreadyRunning {
+		var stateErr *witness.StateError
+		var sessionErr *witness.SessionError
+		switch {
+		case errors.As(err, &stateErr) && stateErr.Current == witness.StatePaused:
+			fmt.Printf("%s Witness is already running, but paused\n", style.Dim.Render("⏸"))
+			fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness resume' to resume"))
+			return nil
+		case errors.Is(err, witness.ErrAlreadyRunning):
 			fmt.Printf("%s Witness is already running\n", style.Dim.Render("⚠"))
 			fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness attach' to connect"))
 			return nil
+		case errors.As(err, &sessionErr):
+			return fmt.Errorf("starting witness: %w (try 'gt witness stop --force %s')", err, rigName)
 		}
 		return fmt.Errorf("starting witness: %w", err)
 	}
 
 	if witnessForeground {
-		fmt.Printf("%s Note: Foreground mode no longer runs patrol loop\n", style.Dim.Render("⚠"))
-		fmt.Printf("  %s\n", style.Dim.Render("Patrol logic is now handled by mol-witness-patrol molecule"))
-		return nil
+		if metrics != nil {
+			fmt.Printf("  %s\n", style.Dim.Render("Metrics at http://"+metrics.Addr()+"/metrics"))
+		}
+		return runWitnessForeground(mgr, rigName)
 	}
 
 	fmt.Printf("%s Witness started for %s\n", style.Bold.Render("✓"), rigName)
+	if witnessDryRun {
+		fmt.Printf("  %s\n", style.Dim.Render("Dry run: nudges and escalations are logged, not sent"))
+	}
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness attach' to connect"))
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness status' to check progress"))
 	return nil
 }
 
+// witnessConfigUpdate builds a config update from the start flags that were
+// set, or returns nil if none were.
+func witnessConfigUpdate(cmd *cobra.Command) (func(*witness.WitnessConfig), error) {
+	flags := cmd.Flags()
+	if !flags.Changed("quiet-date") && !flags.Changed("quiet-dates-file") && !flags.Changed("quiet-hours") &&
+		!flags.Changed("idle-threshold") && !flags.Changed("stuck-threshold") &&
+		!flags.Changed("log-file") && !flags.Changed("log-max-size") &&
+		!flags.Changed("nudge-template-file") && !flags.Changed("escalation-threshold") &&
+		!flags.Changed("crash-loop-threshold") && !flags.Changed("min-nudge-interval") &&
+		!flags.Changed("interval") && !flags.Changed("adaptive") {
+		return nil, nil
+	}
+
+	logFile := witnessLogFile
+	if logFile != "" {
+		// The witness may later run from another directory
+		if abs, err := filepath.Abs(logFile); err == nil {
+			logFile = abs
+		}
+	}
+
+	var quietStart, quietEnd string
+	if witnessQuietHours != "" {
+		var err error
+		if quietStart, quietEnd, err = witness.ParseQuietWindow(witnessQuietHours); err != nil {
+			return nil, err
+		}
+	}
+
+	var nudgeTemplate string
+	if witnessNudgeTmplFile != "" {
+		data, err := os.ReadFile(witnessNudgeTmplFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading nudge template: %w", err)
+		}
+		nudgeTemplate = strings.TrimSpace(string(data))
+	}
+
+	return func(cfg *witness.WitnessConfig) {
+		if flags.Changed("quiet-date") {
+			cfg.QuietDates = witnessQuietDates
+		}
+		if flags.Changed("quiet-dates-file") {
+			cfg.QuietDatesFile = witnessQuietFile
+		}
+		if flags.Changed("quiet-hours") {
+			if quietStart == "" {
+				cfg.QuietHours = nil
+			} else {
+				if cfg.QuietHours == nil {
+					cfg.QuietHours = &witness.QuietHours{}
+				}
+				cfg.QuietHours.Start, cfg.QuietHours.End = quietStart, quietEnd
+			}
+		}
+		if flags.Changed("idle-threshold") {
+			cfg.IdleThreshold = witnessIdleThreshold
+		}
+		if flags.Changed("stuck-threshold") {
+			cfg.StuckThreshold = witnessStuckThreshold
+		}
+		if flags.Changed("log-file") {
+			cfg.LogFile = logFile
+		}
+		if flags.Changed("log-max-size") {
+			cfg.LogMaxSizeMB = witnessLogMaxSizeMB
+		}
+		if flags.Changed("nudge-template-file") {
+			cfg.NudgeTemplate = nudgeTemplate
+		}
+		if flags.Changed("escalation-threshold") {
+			cfg.EscalationThreshold = witnessEscalateAfter
+		}
+		if flags.Changed("crash-loop-threshold") {
+			cfg.CrashLoopThreshold = witnessCrashLoopLimit
+		}
+		if flags.Changed("min-nudge-interval") {
+			cfg.MinNudgeInterval = witnessNudgeInterval
+		}
+		if flags.Changed("interval") {
+			cfg.CheckInterval = witnessCheckInterval
+		}
+		if flags.Changed("adaptive") {
+			cfg.AdaptiveInterval = witnessAdaptive
+		}
+	}, nil
+}
+
+// runWitnessStartGroup starts one witness loop monitoring several rigs.
+// In the background the loop runs in its own tmux session as
+// "gt witness start --foreground --name <group> <rigs...>".
+func runWitnessStartGroup(cmd *cobra.Command, args []string) error {
+	if witnessAgentOverride != "" || witnessAgentCommand != "" || len(witnessEnvOverrides) > 0 || witnessLayout != "" {
+		return fmt.Errorf("--agent, --agent-command, --env and --layout apply to a single rig's agent session, not a multi-rig witness")
+	}
+
+	var townRoot string
+	rigs := make([]*rig.Rig, 0, len(args))
+	for _, rigName := range args {
+		root, r, err := getRig(rigName)
+		if err != nil {
+			return err
+		}
+		townRoot = root
+		rigs = append(rigs, r)
+	}
+
+	update, err := witnessConfigUpdate(cmd)
+	if err != nil {
+		return err
+	}
+	group := witness.NewGroup(witnessGroupName, rigs)
+	group.SetDryRun(witnessDryRun)
+	group.SetDiscover(witnessDiscover)
+	if update != nil {
+		for _, mgr := range group.Managers() {
+			if err := mgr.UpdateConfig(update); err != nil {
+				return fmt.Errorf("updating witness config: %w", err)
+			}
+		}
+	}
+
+	if !witnessForeground {
+		return startWitnessGroupSession(group, townRoot, args)
+	}
+
+	if witnessMetricsAddr != "" {
+		metrics, err := witness.NewMetricsServer(witnessMetricsAddr, group.Managers()...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = metrics.Close() }()
+		fmt.Printf("  %s\n", style.Dim.Render("Metrics at http://"+metrics.Addr()+"/metrics"))
+	}
+
+	fmt.Printf("Starting witness group %s for %s...\n", group.Name(), strings.Join(args, ", "))
+
+	var started []*rig.Rig
+	for i, mgr := range group.Managers() {
+		if err := mgr.Start(true, "", nil); err != nil {
+			if errors.Is(err, witness.ErrAlreadyRunning) {
+				style.PrintWarning("witness for %s is already running, leaving it there", rigs[i].Name)
+				continue
+			}
+			return fmt.Errorf("starting witness for %s: %w", rigs[i].Name, err)
+		}
+		started = append(started, rigs[i])
+	}
+	if len(started) == 0 {
+		return fmt.Errorf("no rigs left to monitor")
+	}
+	group = witness.NewGroup(group.Name(), started)
+	if witnessEventsPath != "" {
+		events, err := openWitnessEvents()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = events.Close() }()
+		for _, mgr := range group.Managers() {
+			mgr.SetEventSink(events)
+		}
+		fmt.Printf("  %s\n", style.Dim.Render("Writing events to "+events.Path()))
+	}
+
+	fmt.Printf("%s Witness group %s monitoring %d rigs in foreground (Ctrl-C to stop)\n",
+		style.Bold.Render("✓"), group.Name(), len(started))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	runErr := group.Run(ctx)
+	for _, mgr := range group.Managers() {
+		if err := mgr.Stop(); err != nil && !errors.Is(err, witness.ErrNotRunning) {
+			style.PrintWarning("failed to update witness state: %v", err)
+		}
+	}
+	if runErr != nil {
+		return fmt.Errorf("monitoring loop: %w", runErr)
+	}
+
+	fmt.Printf("%s Witness group %s stopped\n", style.Bold.Render("✓"), group.Name())
+	return nil
+}
+
+// witnessDryRunFor returns the witness manager for the current agent if it
+// is a witness running in dry-run mode, so its gt nudge and gt escalate
+// calls are logged rather than carried out. Returns nil otherwise.
+func witnessDryRunFor(roleInfo RoleInfo) *witness.Manager {
+	if roleInfo.Role != RoleWitness || roleInfo.Rig == "" {
+		return nil
+	}
+	mgr, err := getWitnessManager(roleInfo.Rig)
+	if err != nil || !mgr.DryRun() {
+		return nil
+	}
+	return mgr
+}
+
+// dryRunPolecat returns the polecat name a nudge target in rigName refers
+// to, or "" if the target isn't one of the rig's polecats.
+func dryRunPolecat(rigName, target string) string {
+	targetRig, name, ok := strings.Cut(target, "/")
+	if !ok || targetRig != rigName || strings.Contains(name, "/") {
+		return ""
+	}
+	if name == "witness" || name == "refinery" {
+		return ""
+	}
+	return name
+}
+
+// startWitnessGroupSession launches a witness group's loop in a tmux session.
+func startWitnessGroupSession(group *witness.Group, townRoot string, rigNames []string) error {
+	sessionName := group.SessionName()
+	gtPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("finding gt executable: %w", err)
+	}
+	command, err := witnessGroupCommand(gtPath, group.Name(), rigNames)
+	if err != nil {
+		return err
+	}
+
+	t := newTmuxClient()
+	migrateLegacySessionNote(t, sessionName)
+	created, err := t.EnsureSessionWithCommand(sessionName, townRoot, command)
+	if err != nil {
+		return fmt.Errorf("creating tmux session: %w", err)
+	}
+	if !created {
+		fmt.Printf("%s Witness group %s is already running\n", style.Dim.Render("⚠"), group.Name())
+		fmt.Printf("  %s\n", style.Dim.Render("Session: "+sessionName))
+		return nil
+	}
+
+	fmt.Printf("%s Witness group %s started for %s\n", style.Bold.Render("✓"), group.Name(), strings.Join(rigNames, ", "))
+	fmt.Printf("  %s\n", style.Dim.Render("Session: "+sessionName))
+	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness status "+strings.Join(rigNames, " ")+"' to check progress"))
+	return nil
+}
+
+// witnessGroupCommand returns the shell command the group's session runs:
+// gt witness start --foreground for rigNames, with the start flags that
+// were given carried over.
+func witnessGroupCommand(gtPath, groupName string, rigNames []string) (string, error) {
+	args := []string{gtPath, "witness", "start", "--foreground"}
+	if witnessDryRun {
+		args = append(args, "--dry-run")
+	}
+	if !witnessDiscover {
+		args = append(args, "--discover=false")
+	}
+	if witnessMetricsAddr != "" {
+		args = append(args, "--metrics-addr="+witnessMetricsAddr)
+	}
+	if witnessEventsPath != "" {
+		events, err := filepath.Abs(witnessEventsPath)
+		if err != nil {
+			return "", fmt.Errorf("resolving --events path: %w", err)
+		}
+		args = append(args, "--events="+events)
+	}
+	args = append(args, "--name", groupName)
+	return util.ShellJoin(append(args, rigNames...)...), nil
+}
+
+// openWitnessEvents opens the --events stream, resolved to an absolute path.
+func openWitnessEvents() (*witness.EventSink, error) {
+	path, err := filepath.Abs(witnessEventsPath)
+	if err != nil {
+		return nil, fmt.Errorf("resolving --events path: %w", err)
+	}
+	return witness.NewEventSink(path)
+}
+
+// runWitnessForeground drives the monitoring loop until interrupted,
+// then marks the witness stopped.
+func runWitnessForeground(mgr *witness.Manager, rigName string) error {
+	fmt.Printf("%s Witness monitoring %s in foreground (Ctrl-C to stop)\n", style.Bold.Render("✓"), rigName)
+	if mgr.DryRun() {
+		fmt.Printf("  %s\n", style.Dim.Render("Dry run: nudges and escalations are logged, not sent"))
+	}
+	fmt.Printf("  %s\n", style.Dim.Render("Logging checks to "+mgr.LogPath()))
+	if witnessEventsPath != "" {
+		fmt.Printf("  %s\n", style.Dim.Render("Writing events to "+witnessEventsPath))
+	}
+	mgr.SetLogTee(os.Stderr)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	runErr := mgr.Run(ctx)
+	if err := mgr.Stop(); err != nil && !errors.Is(err, witness.ErrNotRunning) {
+		style.PrintWarning("failed to update witness state: %v", err)
+	}
+	if runErr != nil {
+		return fmt.Errorf("monitoring loop: %w", runErr)
+	}
+
+	fmt.Printf("%s Witness stopped for %s\n", style.Bold.Render("✓"), rigName)
+	return nil
+}
+
 func runWitnessStop(cmd *cobra.Command, args []string) error {
-	rigName := args[0]
+	if witnessAll {
+		return runForEachWitnessRig(cmd, "Stopped", stopWitnessRig)
+	}
+	return stopWitnessRig(args[0])
+}
 
+// stopWitnessRig stops a single rig's witness.
+func stopWitnessRig(rigName string) error {
 	mgr, err := getWitnessManager(rigName)
 	if err != nil {
 		return err
 	}
 
+	if witnessStopForce {
+		return forceStopWitness(mgr, rigName)
+	}
+
+	if err := mgr.StopGraceful(witnessStopTimeout); err != nil {
+		switch {
+		case errors.Is(err, witness.ErrNotRunning):
+			fmt.Printf("%s Witness is not running\n", style.Dim.Render("⚠"))
+			return nil
+		case errors.Is(err, witness.ErrStopTimeout):
+			style.PrintWarning("%v", err)
+		default:
+			return fmt.Errorf("stopping witness: %w", err)
+		}
+	}
+
+	fmt.Printf("%s Witness stopped for %s\n", style.Bold.Render("✓"), rigName)
+	return nil
+}
+
+// forceStopWitness kills the witness session straight away, without giving
+// a monitoring loop the chance to finish its check.
+func forceStopWitness(mgr *witness.Manager, rigName string) error {
 	// Kill tmux session if it exists
-	t := tmux.NewTmux()
+	t := newTmuxClient()
 	sessionName := witnessSessionName(rigName)
 	running, _ := t.HasSession(sessionName)
 	if running {
@@ -197,7 +1049,7 @@ func runWitnessStop(cmd *cobra.Command, args []string) error {
 
 	// Update state file
 	if err := mgr.Stop(); err != nil {
-		if err == witness.ErrNotRunning && !running {
+		if errors.Is(err, witness.ErrNotRunning) && !running {
 			fmt.Printf("%s Witness is not running\n", style.Dim.Render("⚠"))
 			return nil
 		}
@@ -211,126 +1063,1206 @@ func runWitnessStop(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runWitnessStatus(cmd *cobra.Command, args []string) error {
-	rigName := args[0]
+// Exit codes for gt witness status, so scripts can branch on the state.
+const (
+	witnessExitRunning = 0
+	witnessExitError   = 2
+	witnessExitStopped = 3
+	witnessExitPaused  = 4
+)
 
-	mgr, err := getWitnessManager(rigName)
-	if err != nil {
-		return err
-	}
+func runWitnessStatus(cmd *cobra.Command, args []string) error {
+	// Exit codes carry the result; don't let cobra add usage or "Error: exit 3".
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
 
-	w, err := mgr.Status()
+	format, err := resolveOutputFormat(witnessStatusOutput, witnessStatusJSON)
 	if err != nil {
-		return fmt.Errorf("getting status: %w", err)
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return NewSilentExit(witnessExitError)
 	}
 
-	// Check actual tmux session state (more reliable than state file)
-	t := tmux.NewTmux()
-	sessionName := witnessSessionName(rigName)
-	sessionRunning, _ := t.HasSession(sessionName)
-
-	// Reconcile state: tmux session is the source of truth for background mode
-	if sessionRunning && w.State != witness.StateRunning {
-		w.State = witness.StateRunning
-	} else if !sessionRunning && w.State == witness.StateRunning {
-		w.State = witness.StateStopped
+	if witnessStatusPolecat != "" {
+		return runWitnessPolecatStatus(args, format)
 	}
-
-	// JSON output
-	if witnessStatusJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(w)
+	if witnessStatusForce {
+		fmt.Fprintf(os.Stderr, "Error: --force only applies with --polecat\n")
+		return NewSilentExit(witnessExitError)
 	}
-
-	// Human-readable output
-	fmt.Printf("%s Witness: %s\n\n", style.Bold.Render(AgentTypeIcons[AgentWitness]), rigName)
-
-	stateStr := string(w.State)
-	switch w.State {
-	case witness.StateRunning:
-		stateStr = style.Bold.Render("● running")
-	case witness.StateStopped:
-		stateStr = style.Dim.Render("○ stopped")
-	case witness.StatePaused:
-		stateStr = style.Dim.Render("⏸ paused")
+	if witnessStatusNDJSON {
+		if !witnessAll || format == formatYAML {
+			fmt.Fprintf(os.Stderr, "Error: --ndjson only applies with --all, and not with -o yaml\n")
+			return NewSilentExit(witnessExitError)
+		}
+		return runWitnessStatusNDJSON()
 	}
-	fmt.Printf("  State: %s\n", stateStr)
-	if sessionRunning {
-		fmt.Printf("  Session: %s\n", sessionName)
+	if witnessAll {
+		return runWitnessStatusAll(format)
 	}
 
-	if w.StartedAt != nil {
-		fmt.Printf("  Started: %s\n", w.StartedAt.Format("2006-01-02 15:04:05"))
+	views := make([]*witnessStatusView, 0, len(args))
+	for _, rigName := range args {
+		ws, err := loadRigWitnessStatus(rigName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return NewSilentExit(witnessExitError)
+		}
+		views = append(views, ws)
 	}
 
-	// Show monitored polecats
-	fmt.Printf("\n  %s\n", style.Bold.Render("Monitored Polecats:"))
-	if len(w.MonitoredPolecats) == 0 {
-		fmt.Printf("    %s\n", style.Dim.Render("(none)"))
+	// Structured output
+	if format != formatTable {
+		var v interface{}
+		if len(views) == 1 {
+			v = views[0].Witness
+		} else {
+			all := make([]*witness.Witness, 0, len(views))
+			for _, ws := range views {
+				all = append(all, ws.Witness)
+			}
+			v = all
+		}
+		if err := writeOutput(os.Stdout, format, v); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return NewSilentExit(witnessExitError)
+		}
 	} else {
-		for _, p := range w.MonitoredPolecats {
-			fmt.Printf("    • %s\n", p)
+		for i, ws := range views {
+			if i > 0 {
+				fmt.Println()
+			}
+			printWitnessStatus(args[i], ws)
 		}
 	}
 
+	if code := witnessStatusExitCode(views); code != witnessExitRunning {
+		return NewSilentExit(code)
+	}
 	return nil
 }
 
-// witnessSessionName returns the tmux session name for a rig's witness.
-func witnessSessionName(rigName string) string {
-	return fmt.Sprintf("gt-%s-witness", rigName)
+// witnessStatusExitCode picks the exit code for reconciled witness states:
+// stopped if any rig is stopped, else paused if any is paused, else running.
+func witnessStatusExitCode(views []*witnessStatusView) int {
+	code := witnessExitRunning
+	for _, ws := range views {
+		switch ws.State {
+		case witness.StateStopped:
+			return witnessExitStopped
+		case witness.StatePaused:
+			code = witnessExitPaused
+		}
+	}
+	return code
 }
 
-func runWitnessAttach(cmd *cobra.Command, args []string) error {
-	rigName := ""
-	if len(args) > 0 {
-		rigName = args[0]
-	}
+// witnessFollowStateInterval is the default refresh interval, in seconds,
+// of gt witness watch --follow-state, whose redraws come mostly from state
+// file writes.
+const witnessFollowStateInterval = 30
 
-	// Infer rig from cwd if not provided
-	if rigName == "" {
-		townRoot, err := workspace.FindFromCwdOrError()
-		if err != nil {
-			return fmt.Errorf("not in a Gas Town workspace: %w", err)
-		}
-		rigName, err = inferRigFromCwd(townRoot)
-		if err != nil {
-			return fmt.Errorf("could not determine rig: %w\nUsage: gt witness attach <rig>", err)
-		}
+// witnessStateDebounce coalesces the several state writes of one check
+// into a single redraw.
+const witnessStateDebounce = 200 * time.Millisecond
+
+func runWitnessWatch(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
+	rigName := args[0]
+	if witnessWatchInterval <= 0 {
+		return fmt.Errorf("interval must be positive, got %d", witnessWatchInterval)
 	}
 
-	// Verify rig exists and get manager
 	mgr, err := getWitnessManager(rigName)
 	if err != nil {
 		return err
 	}
 
-	sessionName := witnessSessionName(rigName)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	interval := witnessWatchInterval
+	var stateChanges <-chan struct{}
+	if witnessFollowState {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if stateChanges, err = mgr.NotifyStateChanges(ctx, witnessStateDebounce); err != nil {
+			// Shown in the header, which the redraws keep on screen
+			stateChanges = nil
+		} else if !cmd.Flags().Changed("interval") {
+			interval = witnessFollowStateInterval
+		}
+	}
+	every := fmt.Sprintf("every %ds", interval)
+	switch {
+	case stateChanges != nil:
+		every = fmt.Sprintf("on state change, at least every %ds", interval)
+	case witnessFollowState:
+		every += fmt.Sprintf("; not following state: %v", err)
+	}
+
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+	if isTTY {
+		fmt.Print("\033[?25l")       // ANSI: hide cursor
+		defer fmt.Print("\033[?25h") // ANSI: show cursor
+	}
+
+	wasLive := false
+	for {
+		ws, err := loadWitnessStatus(mgr, rigName)
+
+		if isTTY {
+			fmt.Print("\033[H\033[2J") // ANSI: cursor home + clear screen
+		}
+		header := fmt.Sprintf("[%s] gt witness watch %s (%s, Ctrl+C to stop)",
+			time.Now().Format("15:04:05"), rigName, every)
+		if isTTY {
+			header = style.Dim.Render(header)
+		}
+		fmt.Printf("%s\n\n", header)
+
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+		} else {
+			printWitnessStatus(rigName, ws)
+
+			// Only a transition counts; watching an already-stopped witness is fine
+			live := ws.State != witness.StateStopped
+			if wasLive && !live {
+				fmt.Printf("\n%s Witness for %s stopped\n", style.Dim.Render("○"), rigName)
+				return NewSilentExit(1)
+			}
+			wasLive = live
+		}
 
-	// Ensure session exists (creates if needed)
-	if err := mgr.Start(false, "", nil); err != nil && err != witness.ErrAlreadyRunning {
+		select {
+		case <-sigChan:
+			if isTTY {
+				fmt.Println("\nStopped.")
+			}
+			return nil
+		case <-ticker.C:
+		case <-stateChanges:
+		}
+	}
+}
+
+func runWitnessLogs(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	if witnessLogsLines <= 0 {
+		return fmt.Errorf("lines must be positive, got %d", witnessLogsLines)
+	}
+	if witnessLogsInterval <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", witnessLogsInterval)
+	}
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	ws, err := loadWitnessStatus(mgr, rigName)
+	if err != nil {
 		return err
-	} else if err == nil {
-		fmt.Printf("Started witness session for %s\n", rigName)
+	}
+	if !ws.SessionRunning {
+		logPath := mgr.LogPath()
+		if _, err := os.Stat(logPath); err != nil {
+			return fmt.Errorf("witness session %s is not running and there is no check log at %s", ws.SessionName, logPath)
+		}
+		fmt.Printf("%s\n", style.Dim.Render(fmt.Sprintf("Session %s not running; showing %s", ws.SessionName, logPath)))
+		return tailWitnessLogFile(logPath)
 	}
 
-	// Attach to the session
-	tmuxPath, err := exec.LookPath("tmux")
+	t := newTmuxClient()
+	out, err := t.CapturePane(ws.SessionName, witnessLogsLines)
 	if err != nil {
-		return fmt.Errorf("tmux not found: %w", err)
+		return fmt.Errorf("capturing witness pane: %w", err)
+	}
+	prev := paneLines(out)
+	for _, line := range prev {
+		fmt.Println(line)
+	}
+	if !witnessLogsFollow {
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ticker := time.NewTicker(witnessLogsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		out, err := t.CapturePane(ws.SessionName, witnessLogsLines)
+		if err != nil {
+			fmt.Printf("%s Witness session ended\n", style.Dim.Render("○"))
+			return nil
+		}
+		cur := paneLines(out)
+		for _, line := range newPaneLines(prev, cur) {
+			fmt.Println(line)
+		}
+		prev = cur
+	}
+}
+
+// paneLines splits captured pane output into lines, dropping the blank rows
+// below the cursor that capture-pane pads the visible area with.
+func paneLines(out string) []string {
+	lines := strings.Split(out, "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// newPaneLines returns the lines of cur that weren't in prev. Between two
+// captures the pane scrolls, so the longest tail of prev that reappears at
+// the head of cur is the overlap; anything after it is new. With no overlap
+// (cleared screen, or more output than one capture holds) all of cur is new.
+func newPaneLines(prev, cur []string) []string {
+	maxK := len(prev)
+	if len(cur) < maxK {
+		maxK = len(cur)
+	}
+	for k := maxK; k > 0; k-- {
+		if equalLines(prev[len(prev)-k:], cur[:k]) {
+			return cur[k:]
+		}
 	}
+	return cur
+}
+
+func equalLines(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return len(a) == len(b)
+}
+
+// tailWitnessLogFile prints the last lines of the witness check log and,
+// with --follow, anything appended to it afterwards.
+func tailWitnessLogFile(path string) error {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is from witness config
+	if err != nil {
+		return fmt.Errorf("reading witness log: %w", err)
+	}
+	lines := paneLines(string(data))
+	if len(lines) > witnessLogsLines {
+		lines = lines[len(lines)-witnessLogsLines:]
+	}
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+	if !witnessLogsFollow {
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ticker := time.NewTicker(witnessLogsInterval)
+	defer ticker.Stop()
+
+	offset := int64(len(data))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if info.Size() < offset {
+			offset = 0 // Rotated
+		}
+		if info.Size() == offset {
+			continue
+		}
+		f, err := os.Open(path) //nolint:gosec // G304: path is from witness config
+		if err != nil {
+			continue
+		}
+		buf := make([]byte, info.Size()-offset)
+		n, _ := f.ReadAt(buf, offset)
+		_ = f.Close()
+		fmt.Print(string(buf[:n]))
+		offset += int64(n)
+	}
+}
+
+// witnessStatusView is a witness state reconciled against what is actually running.
+type witnessStatusView struct {
+	*witness.Witness
+	SessionName    string
+	SessionRunning bool
+	Now            time.Time
+
+	// PaneDead is set when the session is still there but its process
+	// exited and tmux kept the pane (remain-on-exit): the session doesn't
+	// count as running.
+	PaneDead bool
+
+	// Uptime is how long the witness has been up: from the tmux session
+	// when one is running, else from the state file's StartedAt.
+	Uptime time.Duration
+}
+
+// loadWitnessStatus loads the witness state and reconciles it with reality:
+// the tmux session is the source of truth for background mode, the loop's
+// check-in heartbeat for foreground mode. A live witness may be running or
+// paused; only the state file knows which.
+func loadWitnessStatus(mgr *witness.Manager, rigName string) (*witnessStatusView, error) {
+	w, err := mgr.Snapshot()
+	if err != nil {
+		return nil, fmt.Errorf("getting status: %w", err)
+	}
+
+	// Check actual tmux session state (more reliable than state file).
+	// A rig in a witness group is monitored from the group's session.
+	t := newTmuxClient()
+	sessionName := witnessSessionName(rigName)
+	if w.Group != "" {
+		sessionName = witness.GroupSessionName(w.Group)
+	}
+	migrateLegacySessionNote(t, sessionName)
+	sessionRunning, _ := t.HasSession(sessionName)
+	paneDead := false
+	if sessionRunning {
+		// e.g. the loop left its respawn wrapper but tmux kept the window
+		if dead, err := t.PaneIsDead(sessionName); err == nil && dead {
+			sessionRunning, paneDead = false, true
+		}
+	}
+
+	now := time.Now()
+	reconcileWitnessState(t, w, sessionRunning, now)
+
+	ws := &witnessStatusView{
+		Witness:        w,
+		SessionName:    sessionName,
+		SessionRunning: sessionRunning,
+		Now:            now,
+		PaneDead:       paneDead,
+	}
+	if sessionRunning {
+		// The session may have been restarted without going through gt
+		if uptime, err := t.SessionUptime(sessionName); err == nil {
+			ws.Uptime = uptime
+		}
+	}
+	if ws.Uptime == 0 && w.StartedAt != nil && w.State != witness.StateStopped {
+		ws.Uptime = now.Sub(*w.StartedAt)
+	}
+	return ws, nil
+}
+
+// loadRigWitnessStatus loads and reconciles the witness status of a rig.
+func loadRigWitnessStatus(rigName string) (*witnessStatusView, error) {
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return nil, err
+	}
+	return loadWitnessStatus(mgr, rigName)
+}
+
+// reconcileWitnessState corrects a state file that disagrees with whether the
+// witness session or foreground loop is actually alive.
+func reconcileWitnessState(t tmux.Client, w *witness.Witness, sessionRunning bool, now time.Time) {
+	if sessionRunning && w.State == witness.StateStopped {
+		w.State = witness.StateRunning
+	} else if !sessionRunning && w.State != witness.StateStopped && !w.LoopAlive(t, now) {
+		w.State = witness.StateStopped
+	}
+}
+
+// formatUptime renders an uptime compactly: 45s, 13m, 2h13m, 3d4h.
+func formatUptime(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+}
+
+// printWitnessStatus renders the human-readable witness status.
+func printWitnessStatus(rigName string, ws *witnessStatusView) {
+	w, now := ws.Witness, ws.Now
+
+	// Human-readable output
+	fmt.Printf("%s Witness: %s\n\n", style.Bold.Render(AgentTypeIcons[AgentWitness]), rigName)
+
+	stateStr := style.AgentState(string(w.State))
+	if w.State == witness.StateStopped && w.CrashLoopAt != nil {
+		stateStr += " " + style.Dim.Render("(crash loop)")
+	}
+	if w.State == witness.StateRunning {
+		if reason := w.Config.QuietReason(now); reason != "" {
+			stateStr += " " + style.Dim.Render(fmt.Sprintf("quiet (%s)", reason))
+		}
+	}
+	fmt.Printf("  State: %s\n", stateStr)
+	if ws.SessionRunning {
+		fmt.Printf("  Session: %s\n", ws.SessionName)
+	} else if ws.PaneDead {
+		fmt.Printf("  Session: %s %s\n", ws.SessionName,
+			style.Warning.Render("(pane dead; the witness process exited)"))
+	} else if w.Foreground && w.State != witness.StateStopped {
+		fmt.Printf("  Mode: foreground monitoring loop\n")
+	}
+	if w.Group != "" && w.State != witness.StateStopped {
+		fmt.Printf("  Group: %s\n", w.Group)
+	}
+	if w.DryRun && w.State != witness.StateStopped {
+		fmt.Printf("  Dry run: %s\n", style.Dim.Render("nudges and escalations are logged, not sent"))
+	}
+	if w.State == witness.StatePaused && w.PausedAt != nil {
+		fmt.Printf("  Paused: %s\n", w.PausedAt.Format("2006-01-02 15:04:05"))
+	}
+
+	if w.StartedAt != nil {
+		fmt.Printf("  Started: %s\n", w.StartedAt.Format("2006-01-02 15:04:05"))
+	}
+	if ws.Uptime > 0 {
+		fmt.Printf("  Uptime: %s\n", formatUptime(ws.Uptime))
+	}
+	if w.LastCheckAt != nil {
+		fmt.Printf("  Last check: %s\n", w.LastCheckAt.Format("2006-01-02 15:04:05"))
+	}
+	interval := w.EffectiveInterval().String()
+	if w.Config.AdaptiveInterval {
+		lo, hi := w.Config.IntervalBounds()
+		interval += style.Dim.Render(fmt.Sprintf(" (adaptive, %s-%s)", lo, hi))
+	}
+	fmt.Printf("  Check interval: %s\n", interval)
+	if w.CrashLoopAt != nil && w.LastError != "" {
+		fmt.Printf("  Error: %s\n", w.LastError)
+		fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness start' to clear it and try again"))
+	}
+	if w.LastCrashAt != nil {
+		crash := w.LastCrashAt.Format("2006-01-02 15:04:05")
+		if w.LastCrashReason != "" {
+			crash += ": " + w.LastCrashReason
+		}
+		fmt.Printf("  Last crash: %s\n", crash)
+	}
+	idle, stuck := w.Config.Thresholds()
+	fmt.Printf("  Thresholds: idle %s, stuck %s, escalate after %d nudges\n",
+		idle, stuck, w.Config.EscalationLimit())
+	if grace := w.Config.StartupGrace; grace > 0 {
+		fmt.Printf("  Startup grace: %s\n", grace)
+	}
+	if d := w.Config.Delivery(); d != witness.DeliveryBeads {
+		fmt.Printf("  Escalations: %s\n", d)
+	}
+	if budget := w.Config.RigNudgeBudget; budget > 0 {
+		used := fmt.Sprintf("%d/%d nudges in the last hour", w.NudgesLastHour(now), budget)
+		if exhausted, wait := w.NudgeBudgetExhausted(now); exhausted {
+			used += " " + style.Warning.Render(fmt.Sprintf("(exhausted, resets in %s)", formatUptime(wait)))
+		}
+		fmt.Printf("  Nudge budget: %s\n", used)
+	}
+	if n := len(w.DeliveryErrors); n > 0 {
+		last := w.DeliveryErrors[n-1]
+		fmt.Printf("  %s Escalation delivery failed for %s at %s: %s\n", style.WarningPrefix,
+			last.Polecat, last.At.Format("2006-01-02 15:04:05"), last.Error)
+	}
+	if len(w.Config.NudgeAllowlist) > 0 {
+		fmt.Printf("  Nudge allowlist: %s\n", strings.Join(w.Config.NudgeAllowlist, ", "))
+	}
+	if len(w.Config.NudgeDenylist) > 0 {
+		fmt.Printf("  Nudge denylist: %s\n", strings.Join(w.Config.NudgeDenylist, ", "))
+	}
+	if q := w.Config.QuietHours; q != nil {
+		quiet := q.String()
+		if q.Contains(now) {
+			quiet += " " + style.Bold.Render("active now")
+		}
+		fmt.Printf("  Quiet hours: %s\n", quiet)
+	}
+
+	// Show monitored polecats
+	heading := "Monitored Polecats:"
+	if w.StaticPolecats {
+		heading = "Monitored Polecats (fixed at start):"
+	}
+	fmt.Printf("\n  %s\n", style.Bold.Render(heading))
+	if len(w.MonitoredPolecats) == 0 {
+		fmt.Printf("    %s\n", style.Dim.Render("(none)"))
+	}
+	for _, sp := range w.PolecatSources() {
+		line := "    • " + sp.Name
+		if sp.Source == witness.SourceStale {
+			// No longer monitored; its last state is out of date
+			fmt.Println(line + " " + style.Dim.Render("(stale)"))
+			continue
+		}
+		ps := w.Stats.PerPolecat[sp.Name]
+		if ps.LastState != "" {
+			line += "  " + witnessPolecatStateLabel(ps.LastState)
+		}
+		if left := ps.WarmupLeft(now, w.Config.StartupGrace); left > 0 {
+			line += " " + style.Dim.Render(witnessWarmingUpLabel(left))
+		}
+		if left := ps.EscalationCooldownLeft(now, w.Config.Cooldown()); left > 0 {
+			line += " " + style.Warning.Render(witnessEscalatedLabel(left))
+		}
+		line += " " + style.Dim.Render("("+string(sp.Source)+")")
+		if w.Config.ObserveOnly(sp.Name) {
+			line += " " + style.Dim.Render("(observe-only)")
+		}
+		fmt.Println(line)
+	}
+	if len(w.Unwatched) > 0 {
+		fmt.Printf("    %s\n", style.Dim.Render("Not watched (watch-remove): "+strings.Join(w.Unwatched, ", ")))
+	}
+
+	// Show monitoring statistics
+	fmt.Printf("\n  %s\n", style.Bold.Render("Statistics:"))
+	if witnessSinceStart {
+		printWitnessSinceStart(w, now)
+	}
+	fmt.Printf("    Checks today:      %d\n", w.Stats.TodayChecks)
+	fmt.Printf("    Nudges today:      %d\n", w.Stats.TodayNudges)
+	fmt.Printf("    Total checks:      %d\n", w.Stats.TotalChecks)
+	fmt.Printf("    Total nudges:      %d\n", w.Stats.TotalNudges)
+	fmt.Printf("    Total escalations: %d\n", w.Stats.TotalEscalations)
+	if w.Stats.TotalHeldNudges > 0 {
+		fmt.Printf("    Held nudges today: %d\n", w.Stats.TodayHeldNudges)
+		fmt.Printf("    Total held nudges: %d\n", w.Stats.TotalHeldNudges)
+	}
+	if w.Stats.TotalBudgetHeldNudges > 0 {
+		fmt.Printf("    Nudges held by budget: %d\n", w.Stats.TotalBudgetHeldNudges)
+	}
+	if w.Stats.TotalWouldNudges > 0 || w.Stats.TotalWouldEscalations > 0 {
+		fmt.Printf("    Would-nudges today:      %d\n", w.Stats.TodayWouldNudges)
+		fmt.Printf("    Total would-nudges:      %d\n", w.Stats.TotalWouldNudges)
+		fmt.Printf("    Total would-escalations: %d\n", w.Stats.TotalWouldEscalations)
+	}
+
+	if len(w.Stats.PerPolecat) > 0 {
+		fmt.Printf("\n  %s\n", style.Bold.Render("Per Polecat:"))
+		printWitnessPolecatStats(w.Stats.PerPolecat, now)
+	}
+
+	if witnessStatusHistory {
+		printWitnessHistory(w.Stats.RecentDays(witnessStatusHistoryDays))
+	}
+}
+
+// printWitnessSinceStart prints the stats since the witness started next
+// to the all-time totals.
+func printWitnessSinceStart(w *witness.Witness, now time.Time) {
+	d := w.SinceStart(now)
+	if d == nil {
+		fmt.Printf("    %s\n", style.Dim.Render("(no stats since start: restart the witness to begin counting)"))
+		return
+	}
+	fmt.Printf("    %-18s %11s %9s\n", "", "SINCE START", "ALL TIME")
+	fmt.Printf("    %-18s %11d %9d\n", "Checks:", d.Checks, w.Stats.TotalChecks)
+	fmt.Printf("    %-18s %11d %9d\n", "Nudges:", d.Nudges, w.Stats.TotalNudges)
+	fmt.Printf("    %-18s %11d %9d\n", "Escalations:", d.Escalations, w.Stats.TotalEscalations)
+	fmt.Printf("    %s\n", style.Dim.Render(fmt.Sprintf("since %s (%s ago)",
+		d.Since.Local().Format("2006-01-02 15:04:05"), formatUptime(now.Sub(d.Since)))))
+}
+
+// witnessStatusHistoryDays is how many days gt witness status --history shows.
+const witnessStatusHistoryDays = 14
+
+// printWitnessHistory renders recent daily stats as a table, with a
+// sparkline of nudges per day underneath.
+func printWitnessHistory(days []witness.DailySnapshot) {
+	fmt.Printf("\n  %s\n", style.Bold.Render("History:"))
+	if len(days) == 0 {
+		fmt.Printf("    %s\n", style.Dim.Render("(no daily stats yet)"))
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "    DATE\tCHECKS\tNUDGES\tESCALATIONS")
+	nudges := make([]int, 0, len(days))
+	for _, d := range days {
+		_, _ = fmt.Fprintf(tw, "    %s\t%d\t%d\t%d\n", d.Date, d.Checks, d.Nudges, d.Escalations)
+		nudges = append(nudges, d.Nudges)
+	}
+	_ = tw.Flush()
+	fmt.Printf("    Nudges: %s\n", style.Dim.Render(sparkline(nudges)))
+}
+
+// sparkline draws values as a row of block characters scaled to the
+// largest value.
+func sparkline(values []int) string {
+	const bars = "▁▂▃▄▅▆▇█"
+	levels := []rune(bars)
+	peak := 0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if peak > 0 {
+			i = v * (len(levels) - 1) / peak
+		}
+		b.WriteRune(levels[i])
+	}
+	return b.String()
+}
+
+// witnessPolecatStateLabel renders a polecat's detected state, drawing
+// attention to the ones that need a human or a nudge.
+func witnessPolecatStateLabel(state witness.PolecatState) string {
+	switch state {
+	case witness.PolecatStuck, witness.PolecatErrored:
+		return style.Warning.Render(string(state))
+	case witness.PolecatAwaitingInput:
+		return style.Bold.Render(string(state))
+	}
+	return style.Dim.Render(string(state))
+}
+
+// printWitnessPolecatStats renders the per-polecat statistics table,
+// with polecats that are no longer monitored marked stale.
+func printWitnessPolecatStats(stats map[string]witness.PolecatStats, now time.Time) {
+	names := make([]string, 0, len(stats))
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dryRun := false
+	for _, ps := range stats {
+		if ps.WouldNudges > 0 {
+			dryRun = true
+		}
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if dryRun {
+		_, _ = fmt.Fprintln(tw, "    POLECAT\tCHECKS\tNUDGES\tESCALATIONS\tWOULD NUDGE\tLAST ACTIVE\tLAST ACTION")
+	} else {
+		_, _ = fmt.Fprintln(tw, "    POLECAT\tCHECKS\tNUDGES\tESCALATIONS\tLAST ACTIVE\tLAST ACTION")
+	}
+	for _, name := range names {
+		ps := stats[name]
+		lastActive := "-"
+		if ps.LastActiveAt != nil {
+			lastActive = ps.LastActiveAt.Format("2006-01-02 15:04:05")
+		}
+		label := name
+		if ps.Stale {
+			label += " (stale)"
+		}
+		lastAction := witnessLastAction(ps.LastAction, now)
+		if dryRun {
+			_, _ = fmt.Fprintf(tw, "    %s\t%d\t%d\t%d\t%d\t%s\t%s\n", label, ps.Checks, ps.Nudges, ps.Escalations, ps.WouldNudges, lastActive, lastAction)
+			continue
+		}
+		_, _ = fmt.Fprintf(tw, "    %s\t%d\t%d\t%d\t%s\t%s\n", label, ps.Checks, ps.Nudges, ps.Escalations, lastActive, lastAction)
+	}
+	_ = tw.Flush()
+}
+
+// witnessWarmingUpLabel describes a polecat with left of its startup grace
+// to go.
+func witnessWarmingUpLabel(left time.Duration) string {
+	return fmt.Sprintf("warming up (%s left)", formatUptime(left))
+}
+
+// witnessEscalatedLabel describes a polecat escalated left before the end
+// of its escalation cooldown.
+func witnessEscalatedLabel(left time.Duration) string {
+	return fmt.Sprintf("escalated (cooldown %s remaining)", formatUptime(left))
+}
+
+// witnessLastAction renders a polecat's last action as, e.g., "nudged 3m
+// ago (no activity for 8m0s)", or "-" if the loop never acted on it.
+func witnessLastAction(a *witness.PolecatAction, now time.Time) string {
+	if a == nil {
+		return "-"
+	}
+	s := fmt.Sprintf("%s %s ago", a.Action, formatUptime(now.Sub(a.At)))
+	if a.Reason != "" {
+		s += " (" + a.Reason + ")"
+	}
+	return s
+}
+
+func runWitnessExplain(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
 
-	attachCmd := exec.Command(tmuxPath, "attach-session", "-t", sessionName)
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	e, err := mgr.Explain(witnessExplainCat)
+	if err != nil {
+		return err
+	}
+
+	if witnessExplainJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(e)
+	}
+
+	fmt.Printf("%s Polecat: %s/%s\n\n", style.Bold.Render(AgentTypeIcons[AgentPolecat]), rigName, e.Polecat)
+	fmt.Printf("  State: %s\n", style.Bold.Render(string(e.State)))
+	fmt.Printf("  Session: %s\n", e.Session)
+	if !e.LastActivity.IsZero() {
+		fmt.Printf("  Last activity: %s (%s ago)\n",
+			e.LastActivity.Format("2006-01-02 15:04:05"), e.IdleFor.Round(time.Second))
+	}
+	if e.PaneHash != "" {
+		fmt.Printf("  Pane hash: %s\n", e.PaneHash)
+	}
+
+	fmt.Printf("\n  %s\n", style.Bold.Render("Signals:"))
+	for _, sig := range e.Signals {
+		mark := style.Dim.Render("○")
+		if sig.Fired {
+			mark = style.Bold.Render("●")
+		}
+		fmt.Printf("    %s %-16s %s\n", mark, sig.Name, style.Dim.Render(sig.Detail))
+	}
+
+	next := "no action"
+	switch e.Action {
+	case witness.ActionNudged:
+		next = "nudge"
+	case witness.ActionSuppressed:
+		next = fmt.Sprintf("nudge suppressed, quiet (%s)", e.Quiet)
+	case witness.ActionHeld:
+		next = fmt.Sprintf("nudge held back, nudged within the last %s", e.NudgeInterval)
+	case witness.ActionWouldNudge:
+		next = "would nudge (dry run)"
+	case witness.ActionOverBudget:
+		next = "nudge held back, rig nudge budget exhausted"
+	case witness.ActionWarmingUp:
+		next = "not nudged, warming up"
+	case witness.ActionObserved:
+		next = "not nudged, observe-only"
+	}
+	if e.Escalates {
+		next += ", then escalate to the mayor"
+	}
+	fmt.Printf("\n  Next pass: %s\n", next)
+	return nil
+}
+
+func runWitnessPause(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.Pause(); err != nil {
+		switch {
+		case errors.Is(err, witness.ErrAlreadyPaused):
+			fmt.Printf("%s Witness is already paused\n", style.Dim.Render("○"))
+			fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness resume' to resume"))
+			return nil
+		case errors.Is(err, witness.ErrNotRunning):
+			fmt.Printf("%s Witness is not running\n", style.Dim.Render("⚠"))
+			return nil
+		}
+		return fmt.Errorf("pausing witness: %w", err)
+	}
+
+	fmt.Printf("%s Witness paused for %s\n", style.Bold.Render("⏸"), rigName)
+	fmt.Printf("  %s\n", style.Dim.Render("Monitoring continues; nudges and escalations are suppressed"))
+	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness resume' to resume"))
+	return nil
+}
+
+func runWitnessResume(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.Resume(); err != nil {
+		var stateErr *witness.StateError
+		if errors.As(err, &stateErr) && errors.Is(err, witness.ErrNotPaused) {
+			fmt.Printf("%s Witness is not paused (%s)\n", style.Dim.Render("○"), stateErr.Current)
+			return nil
+		}
+		return fmt.Errorf("resuming witness: %w", err)
+	}
+
+	fmt.Printf("%s Witness resumed for %s\n", style.Bold.Render("▶"), rigName)
+	return nil
+}
+
+func runWitnessWatchAdd(cmd *cobra.Command, args []string) error {
+	rigName, polecat := args[0], args[1]
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.WatchAdd(polecat); err != nil {
+		if errors.Is(err, witness.ErrAlreadyWatched) {
+			fmt.Printf("%s %s is already watched in %s\n", style.Dim.Render("○"), polecat, rigName)
+			return nil
+		}
+		return fmt.Errorf("adding %s: %w", polecat, err)
+	}
+
+	fmt.Printf("%s Watching %s in %s\n", style.Bold.Render("✓"), polecat, rigName)
+	fmt.Printf("  %s\n", style.Dim.Render("A running witness picks it up on its next check"))
+	return nil
+}
+
+func runWitnessWatchRemove(cmd *cobra.Command, args []string) error {
+	rigName, polecat := args[0], args[1]
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.WatchRemove(polecat); err != nil {
+		if errors.Is(err, witness.ErrNotWatched) {
+			fmt.Printf("%s %s is not watched in %s\n", style.Dim.Render("○"), polecat, rigName)
+			return nil
+		}
+		return fmt.Errorf("removing %s: %w", polecat, err)
+	}
+
+	fmt.Printf("%s Stopped watching %s in %s\n", style.Bold.Render("✓"), polecat, rigName)
+	fmt.Printf("  %s\n", style.Dim.Render("Its stats are kept; use 'gt witness watch-add' to watch it again"))
+	return nil
+}
+
+// witnessSessionName returns the tmux session name for a rig's witness.
+func witnessSessionName(rigName string) string {
+	return session.WitnessSessionName(rigName)
+}
+
+func runWitnessAttach(cmd *cobra.Command, args []string) error {
+	if err := requireTmux(); err != nil {
+		return err
+	}
+	if witnessPrintSession {
+		if err := checkPrintSessionFlags(cmd); err != nil {
+			return err
+		}
+	}
+	if witnessAll {
+		if !witnessNewWindow {
+			return fmt.Errorf("--all needs --new-window: one terminal can only attach to one session")
+		}
+		if len(args) > 0 {
+			return fmt.Errorf("--all attaches to every rig's witness; don't name a rig")
+		}
+		if witnessAttachSelect {
+			return fmt.Errorf("--select picks one rig; it can't be combined with --all")
+		}
+		return runWitnessAttachAll()
+	}
+	rigName := ""
+	if len(args) > 0 {
+		rigName = args[0]
+	}
+	if witnessAttachSelect && rigName != "" {
+		return fmt.Errorf("--select picks the rig; don't name one")
+	}
+
+	if witnessAttachSelect {
+		var err error
+		if rigName, err = selectWitnessRig(); err != nil {
+			return err
+		}
+	} else if rigName == "" {
+		// Infer rig from cwd, falling back to the picker
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		rigName, err = inferRigFromCwd(townRoot)
+		if err != nil {
+			if !canPrompt() {
+				return fmt.Errorf("could not determine rig: %w\nUsage: gt witness attach <rig>", err)
+			}
+			if rigName, err = selectWitnessRig(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Verify rig exists and get manager
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+	if err := ensureWitnessForAttach(mgr, rigName); err != nil {
+		return err
+	}
+
+	sessionName := witnessSessionName(rigName)
+	if witnessPrintSession {
+		fmt.Println(sessionName)
+		return nil
+	}
+	if witnessNewWindow {
+		if witnessReconnect {
+			return fmt.Errorf("--reconnect works in this terminal; it can't be combined with --new-window")
+		}
+		launcher, err := terminal.Default()
+		if err != nil {
+			return err
+		}
+		return launchWitnessAttach(launcher, rigName, sessionName)
+	}
+
+	if witnessReconnect {
+		t := newTmuxClient()
+		return attachReconnecting(
+			func() error { return attachSession(sessionName, witnessAttachReadOnly) },
+			func() bool { ok, err := t.HasSession(sessionName); return err == nil && ok },
+		)
+	}
+	return attachSession(sessionName, witnessAttachReadOnly)
+}
+
+// checkPrintSessionFlags rejects the attach flags --print-session has no
+// use for, since it never attaches.
+func checkPrintSessionFlags(cmd *cobra.Command) error {
+	for _, name := range []string{"read-only", "reconnect", "new-window", "all"} {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("--print-session doesn't attach; it can't be combined with --%s", name)
+		}
+	}
+	return nil
+}
+
+// attachReconnectTries caps how often --reconnect re-attaches in a row.
+const attachReconnectTries = 5
+
+// attachReconnectDelay is the pause before each re-attach; a var so tests
+// needn't wait.
+var attachReconnectDelay = 2 * time.Second
+
+// attachReconnecting runs attach, re-running it when it drops out with a
+// tmux failure while the session is still alive, up to
+// attachReconnectTries times. A clean detach ends it at once.
+func attachReconnecting(attach func() error, sessionAlive func() bool) error {
+	for try := 1; ; try++ {
+		err := attach()
+		if !attachDropped(err) || try > attachReconnectTries || !sessionAlive() {
+			return err
+		}
+		fmt.Printf("%s\n", style.Dim.Render(fmt.Sprintf("Attach dropped (%v); reconnecting in %s (%d/%d)...",
+			err, attachReconnectDelay, try, attachReconnectTries)))
+		time.Sleep(attachReconnectDelay)
+	}
+}
+
+// attachDropped reports whether a tmux attach ended with a failure, such
+// as a lost terminal or a server that went away, rather than a detach:
+// tmux exits 0 on Ctrl-B D and non-zero when the client is cut off.
+func attachDropped(err error) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() > 0
+}
+
+// attachSession attaches the terminal to a tmux session, honoring
+// --tmux-cmd for remote servers. With readOnly, keystrokes aren't sent to
+// the session; a tmux that can't attach read-only gets the pane streamed
+// instead.
+func attachSession(sessionName string, readOnly bool) error {
+	attachArgs := []string{"attach-session", "-t", sessionName}
+	if readOnly {
+		if !newTmuxClient().SupportsReadOnlyAttach() {
+			fmt.Printf("%s\n", style.Dim.Render("This tmux can't attach read-only; streaming the pane instead (Ctrl-C to stop)"))
+			return streamSessionPane(sessionName)
+		}
+		attachArgs = append(attachArgs, "-r")
+	}
+
+	attachCmd := tmux.Command(attachArgs...)
+	if attachCmd.Err != nil {
+		return fmt.Errorf("tmux not found: %w", attachCmd.Err)
+	}
 	attachCmd.Stdin = os.Stdin
 	attachCmd.Stdout = os.Stdout
 	attachCmd.Stderr = os.Stderr
-	return attachCmd.Run()
+	return withTmuxVersion(attachCmd.Run())
+}
+
+// runWitnessAttachAll opens a terminal window attached to every rig's
+// witness, starting the ones that aren't running.
+func runWitnessAttachAll() error {
+	launcher, err := terminal.Default()
+	if err != nil {
+		return err
+	}
+	rigs, err := allRigNames()
+	if err != nil {
+		return err
+	}
+	opened := 0
+	for _, rigName := range rigs {
+		mgr, err := getWitnessManager(rigName)
+		if err != nil {
+			return err
+		}
+		if err := ensureWitnessForAttach(mgr, rigName); err != nil {
+			if witnessIfRunning && errors.Is(err, witness.ErrNotRunning) {
+				fmt.Printf("%s Witness for %s is not running, skipped\n", style.Dim.Render("○"), rigName)
+				continue
+			}
+			return fmt.Errorf("%s: %w", rigName, err)
+		}
+		if err := launchWitnessAttach(launcher, rigName, witnessSessionName(rigName)); err != nil {
+			return err
+		}
+		opened++
+	}
+	if opened == 0 && witnessIfRunning {
+		return fmt.Errorf("%w for any rig (--if-running doesn't start them)", witness.ErrNotRunning)
+	}
+	return nil
+}
+
+// ensureWitnessForAttach starts the rig's witness session if it isn't
+// running, so there is something to attach to. With --if-running it only
+// checks, and fails with witness.ErrNotRunning if the session is down.
+func ensureWitnessForAttach(mgr *witness.Manager, rigName string) error {
+	if witnessIfRunning {
+		sessionName := witnessSessionName(rigName)
+		running, err := newTmuxClient().HasSession(sessionName)
+		if err != nil {
+			return fmt.Errorf("checking session %s: %w", sessionName, err)
+		}
+		if !running {
+			return fmt.Errorf("%w for %s (--if-running doesn't start it; use 'gt witness start %s')",
+				witness.ErrNotRunning, rigName, rigName)
+		}
+		return nil
+	}
+	if err := mgr.Start(false, "", nil); err != nil && !errors.Is(err, witness.ErrAlreadyRunning) {
+		return err
+	} else if err == nil {
+		// Keep stdout to the session name for --print-session
+		out := os.Stdout
+		if witnessPrintSession {
+			out = os.Stderr
+		}
+		fmt.Fprintf(out, "Started witness session for %s\n", rigName)
+	}
+	return nil
+}
+
+// launchWitnessAttach opens a new terminal window attached to the witness
+// session. The window runs tmux directly, with any --tmux-cmd wrapper
+// spelled out, since it doesn't inherit gt's flags.
+func launchWitnessAttach(launcher terminal.Launcher, rigName, sessionName string) error {
+	attachArgs := []string{"attach-session", "-t", sessionName}
+	if witnessAttachReadOnly {
+		if !newTmuxClient().SupportsReadOnlyAttach() {
+			return fmt.Errorf("this tmux can't attach read-only; use --read-only without --new-window to stream the pane instead")
+		}
+		attachArgs = append(attachArgs, "-r")
+	}
+	attachCmd := tmux.Command(attachArgs...)
+	if attachCmd.Err != nil {
+		return fmt.Errorf("tmux not found: %w", attachCmd.Err)
+	}
+	if err := launcher.Launch(attachCmd.Args); err != nil {
+		return err
+	}
+	fmt.Printf("%s Opened %s window attached to the witness for %s\n", style.Bold.Render("✓"), launcher.Name(), rigName)
+	return nil
+}
+
+// streamSessionPane redraws the session's pane every second until Ctrl-C
+// or the session ends: a read-only view for tmux versions without attach -r.
+func streamSessionPane(sessionName string) error {
+	t := newTmuxClient()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+	var prev []string
+	for {
+		rows := 40
+		if isTTY {
+			if _, h, err := term.GetSize(int(os.Stdout.Fd())); err == nil && h > 1 {
+				rows = h - 1
+			}
+		}
+		out, err := t.CapturePane(sessionName, rows)
+		if err != nil {
+			fmt.Printf("%s Session %s ended\n", style.Dim.Render("○"), sessionName)
+			return nil
+		}
+		cur := paneLines(out)
+		lines := cur
+		if isTTY {
+			fmt.Print("\033[H\033[2J") // ANSI: cursor home + clear screen
+		} else {
+			lines = newPaneLines(prev, cur) // Piped: append-only
+		}
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+		prev = cur
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
 }
 
 func runWitnessRestart(cmd *cobra.Command, args []string) error {
-	rigName := args[0]
+	if err := requireTmux(); err != nil {
+		return err
+	}
+	if err := witness.ValidateLayout(witnessLayout); err != nil {
+		return err
+	}
+	// Reject bad --env before stopping the running witness
+	if _, err := config.ParseEnvAssignments(witnessEnvOverrides); err != nil {
+		return err
+	}
+	if witnessAll {
+		return runForEachWitnessRig(cmd, "Restarted", restartWitnessRig)
+	}
+	return restartWitnessRig(args[0])
+}
 
+// restartWitnessRig restarts a single rig's witness.
+func restartWitnessRig(rigName string) error {
 	mgr, err := getWitnessManager(rigName)
 	if err != nil {
 		return err
@@ -338,13 +2270,31 @@ func runWitnessRestart(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Restarting witness for %s...\n", rigName)
 
-	// Stop existing session (non-fatal: may not be running)
-	_ = mgr.Stop()
+	switch err := mgr.StopGraceful(witnessStopTimeout); {
+	case err == nil:
+		fmt.Printf("  %s Stopped\n", style.Success.Render("✓"))
+	case errors.Is(err, witness.ErrNotRunning):
+		fmt.Printf("  %s Was not running\n", style.Dim.Render("○"))
+	case errors.Is(err, witness.ErrStopTimeout):
+		style.PrintWarning("%v", err)
+	default:
+		return fmt.Errorf("stopping witness: %w", err)
+	}
+
+	// Don't start beside a session that is still going away.
+	if err := mgr.WaitSessionGone(witnessStopTimeout); err != nil {
+		return fmt.Errorf("%w; not starting another (try gt witness stop --force %s)", err, rigName)
+	}
+	if err := mgr.ClearCrashLoop(); err != nil {
+		return fmt.Errorf("clearing crash loop: %w", err)
+	}
 
-	// Start fresh
+	mgr.SetAgentCommand(witnessAgentCommand)
+	mgr.SetLayout(witnessLayout)
 	if err := mgr.Start(false, witnessAgentOverride, witnessEnvOverrides); err != nil {
 		return fmt.Errorf("starting witness: %w", err)
 	}
+	fmt.Printf("  %s Started\n", style.Success.Render("✓"))
 
 	fmt.Printf("%s Witness restarted for %s\n", style.Bold.Render("✓"), rigName)
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness attach' to connect"))
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// witnessRigArgs validates the rig arguments of a witness command that
+// takes --all: no rigs with --all, otherwise whatever check applies. Each
+// argument is then resolved in place (see resolveRig), so the command
+// sees the full rig names.
+func witnessRigArgs(check cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if witnessAll {
+			if len(args) > 0 {
+				return fmt.Errorf("--all takes no rig arguments")
+			}
+			return nil
+		}
+		if err := check(cmd, args); err != nil {
+			return err
+		}
+		return resolveRigArgs(args)
+	}
+}
+
+// witnessRigArg is witnessRigArgs for witness commands whose first
+// argument is a rig and the rest something else, such as a polecat.
+func witnessRigArg(check cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := check(cmd, args); err != nil {
+			return err
+		}
+		if len(args) == 0 {
+			return nil
+		}
+		return resolveRigArgs(args[:1])
+	}
+}
+
+// resolveRigArgs replaces each rig argument with the rig it resolves to.
+func resolveRigArgs(args []string) error {
+	for i, arg := range args {
+		name, err := resolveRig(arg)
+		if err != nil {
+			return err
+		}
+		args[i] = name
+	}
+	return nil
+}
+
+// resolveRig turns a rig argument into a registered rig name. An exact
+// match always wins. Otherwise a rig whose name contains arg, ignoring
+// case, is used if it is the only one, with a note on stderr; several
+// are an error listing them. With no match, or outside a town, arg is
+// returned as is for the command to report.
+func resolveRig(arg string) (string, error) {
+	names, err := allRigNames()
+	if err != nil {
+		return arg, nil
+	}
+	name, err := matchRigName(arg, names)
+	if err != nil {
+		return "", err
+	}
+	if name != arg {
+		fmt.Fprintf(os.Stderr, "%s using rig %s for %q\n", style.Dim.Render("note:"), name, arg)
+	}
+	return name, nil
+}
+
+// matchRigName picks the rig arg names out of names; see resolveRig.
+func matchRigName(arg string, names []string) (string, error) {
+	if arg == "" || slices.Contains(names, arg) {
+		return arg, nil
+	}
+	var matches []string
+	for _, name := range names {
+		if strings.Contains(strings.ToLower(name), strings.ToLower(arg)) {
+			matches = append(matches, name)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return arg, nil
+	case 1:
+		return matches[0], nil
+	}
+	return "", fmt.Errorf("rig %q is ambiguous: it matches %s", arg, strings.Join(matches, ", "))
+}
+
+// allRigNames lists the rigs registered in mayor/rigs.json, sorted.
+func allRigNames() ([]string, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return nil, fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading rigs config: %w", err)
+	}
+	names := make([]string, 0, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// runForEachWitnessRig applies fn to every rig, carrying on past failures,
+// then prints which rigs succeeded and failed. It exits 1 if any failed.
+func runForEachWitnessRig(cmd *cobra.Command, done string, fn func(rigName string) error) error {
+	rigs, err := allRigNames()
+	if err != nil {
+		return err
+	}
+	if len(rigs) == 0 {
+		fmt.Println("No rigs registered.")
+		return nil
+	}
+
+	var failed []string
+	for i, rigName := range rigs {
+		if i > 0 {
+			fmt.Println()
+		}
+		if err := fn(rigName); err != nil {
+			fmt.Printf("%s %s: %v\n", style.ErrorPrefix, rigName, err)
+			failed = append(failed, rigName)
+		}
+	}
+
+	fmt.Println()
+	if len(failed) == 0 {
+		fmt.Printf("%s %s %d rig(s)\n", style.SuccessPrefix, done, len(rigs))
+		return nil
+	}
+	fmt.Printf("%s %s %d of %d rig(s); failed: %s\n", style.WarningPrefix, done,
+		len(rigs)-len(failed), len(rigs), strings.Join(failed, ", "))
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	return NewSilentExit(1)
+}
+
+// witnessRigStatus is one rig's entry in gt witness status --all -o json.
+// RigName is set even when the status couldn't be read.
+type witnessRigStatus struct {
+	*witness.Witness
+	RigName string `json:"rig_name"`
+	Error   string `json:"error,omitempty"`
+}
+
+// runWitnessStatusAll shows the witness status of every rig. Rigs whose
+// status can't be read are reported alongside the rest and make it exit 2.
+func runWitnessStatusAll(format outputFormat) error {
+	rigs, err := allRigNames()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return NewSilentExit(witnessExitError)
+	}
+
+	entries := make([]witnessRigStatus, 0, len(rigs))
+	var views []*witnessStatusView
+	var failed []string
+	for i, rigName := range rigs {
+		ws, err := loadRigWitnessStatus(rigName)
+		if err != nil {
+			entries = append(entries, witnessRigStatus{RigName: rigName, Error: err.Error()})
+			failed = append(failed, rigName)
+		} else {
+			entries = append(entries, witnessRigStatus{Witness: ws.Witness, RigName: rigName})
+			views = append(views, ws)
+		}
+		if format != formatTable {
+			continue
+		}
+
+		if i > 0 {
+			fmt.Println()
+		}
+		if err != nil {
+			fmt.Printf("%s %s: %v\n", style.ErrorPrefix, rigName, err)
+		} else {
+			printWitnessStatus(rigName, ws)
+		}
+	}
+
+	if format != formatTable {
+		if err := writeOutput(os.Stdout, format, entries); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return NewSilentExit(witnessExitError)
+		}
+	} else if len(rigs) == 0 {
+		fmt.Println("No rigs registered.")
+	}
+
+	if len(failed) > 0 {
+		if format == formatTable {
+			fmt.Printf("\n%s Status unavailable for: %s\n", style.WarningPrefix, strings.Join(failed, ", "))
+		}
+		return NewSilentExit(witnessExitError)
+	}
+	if code := witnessStatusExitCode(views); code != witnessExitRunning {
+		return NewSilentExit(code)
+	}
+	return nil
+}
+
+// witnessStatusWorkers bounds how many rigs gt witness status --all
+// --ndjson reads at once.
+const witnessStatusWorkers = 8
+
+// runWitnessStatusNDJSON is gt witness status --all --ndjson. Exit codes are
+// those of runWitnessStatusAll.
+func runWitnessStatusNDJSON() error {
+	rigs, err := allRigNames()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return NewSilentExit(witnessExitError)
+	}
+	views, failed, err := streamWitnessStatus(os.Stdout, rigs, loadRigWitnessStatus)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return NewSilentExit(witnessExitError)
+	}
+	if len(failed) > 0 {
+		return NewSilentExit(witnessExitError)
+	}
+	if code := witnessStatusExitCode(views); code != witnessExitRunning {
+		return NewSilentExit(code)
+	}
+	return nil
+}
+
+// streamWitnessStatus reads the status of each rig with load, a few at a
+// time, writing each rig's witnessRigStatus to w as a JSON line as soon as
+// it is read. It returns the statuses read and the rigs that failed.
+func streamWitnessStatus(w io.Writer, rigs []string, load func(rigName string) (*witnessStatusView, error)) ([]*witnessStatusView, []string, error) {
+	type result struct {
+		entry witnessRigStatus
+		view  *witnessStatusView
+	}
+	results := make(chan result, len(rigs))
+	sem := make(chan struct{}, witnessStatusWorkers)
+	for _, rigName := range rigs {
+		go func(rigName string) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			ws, err := load(rigName)
+			if err != nil {
+				results <- result{entry: witnessRigStatus{RigName: rigName, Error: err.Error()}}
+				return
+			}
+			results <- result{entry: witnessRigStatus{Witness: ws.Witness, RigName: rigName}, view: ws}
+		}(rigName)
+	}
+
+	enc := json.NewEncoder(w)
+	var views []*witnessStatusView
+	var failed []string
+	for range rigs {
+		r := <-results
+		if err := enc.Encode(r.entry); err != nil {
+			return nil, nil, err
+		}
+		if r.view == nil {
+			failed = append(failed, r.entry.RigName)
+		} else {
+			views = append(views, r.view)
+		}
+	}
+	sort.Strings(failed)
+	return views, failed, nil
+}
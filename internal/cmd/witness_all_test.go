@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+func TestWitnessRigArgs(t *testing.T) {
+	check := witnessRigArgs(cobra.ExactArgs(1))
+	defer func() { witnessAll = false }()
+
+	witnessAll = false
+	if err := check(witnessStopCmd, nil); err == nil {
+		t.Error("expected a rig to be required without --all")
+	}
+	if err := check(witnessStopCmd, []string{"gastown"}); err != nil {
+		t.Errorf("single rig: %v", err)
+	}
+
+	witnessAll = true
+	if err := check(witnessStopCmd, nil); err != nil {
+		t.Errorf("--all without rigs: %v", err)
+	}
+	if err := check(witnessStopCmd, []string{"gastown"}); err == nil {
+		t.Error("expected --all with a rig argument to be rejected")
+	}
+}
+
+func TestWitnessAllFlag(t *testing.T) {
+	for _, c := range []*cobra.Command{witnessStartCmd, witnessStopCmd, witnessStatusCmd, witnessRestartCmd} {
+		if c.Flags().Lookup("all") == nil {
+			t.Errorf("expected gt witness %s to define --all", c.Name())
+		}
+	}
+}
+
+func TestWitnessRigStatusJSON(t *testing.T) {
+	entries := []witnessRigStatus{
+		{Witness: &witness.Witness{RigName: "alpha", State: witness.StateRunning}, RigName: "alpha"},
+		{RigName: "beta", Error: "rig 'beta' not found"},
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got []map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got[0]["rig_name"] != "alpha" || got[0]["state"] != "running" {
+		t.Errorf("status entry = %v", got[0])
+	}
+	if _, ok := got[0]["error"]; ok {
+		t.Errorf("status entry has an error field: %v", got[0])
+	}
+	if got[1]["rig_name"] != "beta" || !strings.Contains(got[1]["error"].(string), "not found") {
+		t.Errorf("failed entry = %v", got[1])
+	}
+	if _, ok := got[1]["state"]; ok {
+		t.Errorf("failed entry has a state: %v", got[1])
+	}
+}
+
+func TestMatchRigName(t *testing.T) {
+	names := []string{"gastown", "greenplace", "green", "redwood"}
+	tests := []struct {
+		arg     string
+		want    string
+		wantErr bool
+	}{
+		{"greenplace", "greenplace", false},
+		{"green", "green", false}, // exact beats the longer greenplace
+		{"place", "greenplace", false},
+		{"REDW", "redwood", false},
+		{"re", "", true}, // greenplace, green, redwood
+		{"nosuch", "nosuch", false},
+	}
+	for _, tt := range tests {
+		got, err := matchRigName(tt.arg, names)
+		if tt.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+				t.Errorf("matchRigName(%q) = %q, %v; want an ambiguity error", tt.arg, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("matchRigName(%q) = %q, %v; want %q", tt.arg, got, err, tt.want)
+		}
+	}
+}
+
+func TestStreamWitnessStatus(t *testing.T) {
+	// beta is slow, so it is written last whatever order it started in
+	load := func(rigName string) (*witnessStatusView, error) {
+		switch rigName {
+		case "beta":
+			time.Sleep(50 * time.Millisecond)
+			return &witnessStatusView{Witness: &witness.Witness{RigName: "beta", State: witness.StateRunning}}, nil
+		case "broken":
+			return nil, errors.New("rig 'broken' not found")
+		}
+		return &witnessStatusView{Witness: &witness.Witness{RigName: rigName, State: witness.StateStopped}}, nil
+	}
+
+	var buf bytes.Buffer
+	views, failed, err := streamWitnessStatus(&buf, []string{"alpha", "beta", "broken"}, load)
+	if err != nil {
+		t.Fatalf("streamWitnessStatus: %v", err)
+	}
+	if len(views) != 2 || !reflect.DeepEqual(failed, []string{"broken"}) {
+		t.Errorf("views = %d, failed = %v; want 2 and [broken]", len(views), failed)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want one per rig:\n%s", len(lines), buf.String())
+	}
+	var last map[string]any
+	for _, line := range lines {
+		if strings.HasPrefix(line, " ") || !json.Valid([]byte(line)) {
+			t.Errorf("line %q is not compact JSON", line)
+		}
+	}
+	if err := json.Unmarshal([]byte(lines[2]), &last); err != nil {
+		t.Fatal(err)
+	}
+	if last["rig_name"] != "beta" || last["state"] != "running" {
+		t.Errorf("last line = %v, want the slow beta", last)
+	}
+}
@@ -0,0 +1,85 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness tail-beads, the view of a rig's
+// escalation queue.
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var witnessTailBeadsOpenOnly bool
+
+var witnessTailBeadsCmd = &cobra.Command{
+	Use:   "tail-beads <rig>",
+	Short: "Show the escalation beads the witness raised for a rig",
+	Long: `Show the escalation beads a rig's Witness created for stuck polecats,
+newest first, with their id, status, age and title.
+
+Escalations live in the town's beads; the witness records itself as their
+source (witness:<rig>), which is how they are found here. Use --open-only
+to hide the ones the mayor has already closed.
+
+Examples:
+  gt witness tail-beads greenplace
+  gt witness tail-beads greenplace --open-only`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessTailBeads,
+}
+
+func init() {
+	witnessTailBeadsCmd.Flags().BoolVar(&witnessTailBeadsOpenOnly, "open-only", false, "Only show unresolved escalations")
+	witnessCmd.AddCommand(witnessTailBeadsCmd)
+}
+
+func runWitnessTailBeads(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	issues, err := mgr.Escalations(witnessTailBeadsOpenOnly)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		if witnessTailBeadsOpenOnly {
+			fmt.Printf("No open witness escalations for %s\n", rigName)
+		} else {
+			fmt.Printf("No witness escalations for %s\n", rigName)
+		}
+		return nil
+	}
+
+	fmt.Printf("%s Witness escalations for %s (%d):\n\n", style.Bold.Render("📋"), rigName, len(issues))
+	now := time.Now()
+	for _, issue := range issues {
+		fmt.Printf("  %-12s %-8s %6s  %s\n", issue.ID, escalationStatus(issue), escalationAge(issue, now), issue.Title)
+	}
+	return nil
+}
+
+// escalationStatus is the bead status, or "acked" for an open escalation
+// the mayor has acknowledged.
+func escalationStatus(issue *beads.Issue) string {
+	if issue.Status != "closed" && beads.HasLabel(issue, "acked") {
+		return "acked"
+	}
+	return issue.Status
+}
+
+// escalationAge is how long ago the escalation was created, or "?" if its
+// timestamp doesn't parse.
+func escalationAge(issue *beads.Issue, now time.Time) string {
+	created, err := time.Parse(time.RFC3339, issue.CreatedAt)
+	if err != nil {
+		return "?"
+	}
+	return formatUptime(now.Sub(created))
+}
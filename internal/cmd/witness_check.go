@@ -0,0 +1,91 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness check, which makes a rig's witness
+// check its polecats now instead of at the next interval.
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var (
+	witnessCheckTimeout time.Duration
+	witnessCheckJSON    bool
+)
+
+var witnessCheckCmd = &cobra.Command{
+	Use:   "check <rig>",
+	Short: "Make the witness check its polecats now",
+	Long: `Make a rig's Witness check its polecats right away instead of waiting for
+the next interval, e.g. after unsticking a polecat, and print what it found
+and did.
+
+A running monitoring loop (gt witness start --foreground, or a multi-rig
+group) is asked through the witness state file and the command waits for
+it to finish the pass. A witness running as an agent session has no loop
+to ask, and checking beside it could double its nudges, so the command
+fails instead; use gt witness explain to see what it would do. Fails too
+if the witness isn't running.
+
+Examples:
+  gt witness check greenplace
+  gt witness check greenplace --json`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessCheck,
+}
+
+func init() {
+	witnessCheckCmd.Flags().DurationVar(&witnessCheckTimeout, "timeout", 30*time.Second, "How long to wait for the loop to run the check")
+	witnessCheckCmd.Flags().BoolVar(&witnessCheckJSON, "json", false, "Output the check result as JSON")
+	witnessCmd.AddCommand(witnessCheckCmd)
+}
+
+func runWitnessCheck(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	result, err := mgr.CheckNow(witnessCheckTimeout)
+	if err != nil {
+		if errors.Is(err, witness.ErrNotRunning) {
+			return fmt.Errorf("witness for %s is not running; start it with: gt witness start %s", rigName, rigName)
+		}
+		if errors.Is(err, witness.ErrCheckInSession) {
+			return fmt.Errorf("witness for %s runs as an agent session, which does its own checks; see: gt witness explain %s --polecat <name>", rigName, rigName)
+		}
+		return err
+	}
+	if witnessCheckJSON {
+		return outputJSON(result)
+	}
+
+	fmt.Printf("%s Checked %d polecat(s) for %s at %s\n",
+		style.SuccessPrefix, len(result.Polecats), rigName, result.CheckedAt.Format("15:04:05"))
+	if result.Quiet != "" {
+		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("quiet (%s): nudges and escalations suppressed", result.Quiet)))
+	}
+	for _, pc := range result.Polecats {
+		line := fmt.Sprintf("  %-16s %s", pc.Name, witnessPolecatStateLabel(pc.State))
+		if pc.Action != "" && pc.Action != witness.ActionNone {
+			line += "  " + style.Bold.Render(pc.Action)
+		}
+		if pc.Escalated {
+			line += "  " + style.Warning.Render("escalated")
+		}
+		fmt.Println(line)
+		if pc.Reason != "" {
+			fmt.Printf("    %s\n", style.Dim.Render(pc.Reason))
+		}
+		if pc.Error != "" {
+			fmt.Printf("    %s %s\n", style.ErrorPrefix, pc.Error)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements the gt witness config commands for viewing and
+// editing a rig's witness settings.
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var witnessConfigJSON bool
+
+var witnessConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "View and edit witness settings",
+	Long: `View and edit the witness settings for a rig.
+
+Settings come from, in increasing order of precedence:
+  default   the built-in defaults
+  town      the town's settings/witness.toml (or .json)
+  rig       the rig's .gastown/witness.toml (or .json), kept in its repo
+  override  the rig's witness state file (.runtime/witness.json), which
+            gt witness start flags and gt witness config set write
+
+The config files are flat tables of the keys below, e.g.
+  stuck_threshold = "45m"
+  escalation_threshold = 5
+  nudge_denylist = ["scratch-*"]
+
+Keys: ` + strings.Join(witness.ConfigKeys(), ", "),
+	RunE: requireSubcommand,
+}
+
+var witnessConfigListCmd = &cobra.Command{
+	Use:   "list <rig>",
+	Short: "Show all witness settings and where they come from",
+	Long: `Show every witness setting for a rig with its effective value and
+where it comes from: the default, the town or rig config file, or an
+override in the witness state file.
+
+Example:
+  gt witness config list greenplace`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessConfigList,
+}
+
+var witnessConfigGetCmd = &cobra.Command{
+	Use:   "get <rig> <key>",
+	Short: "Show the effective value of a witness setting",
+	Long: `Print the effective value of a witness setting, including defaults.
+
+Example:
+  gt witness config get greenplace stuck_threshold`,
+	Args: witnessRigArg(cobra.ExactArgs(2)),
+	RunE: runWitnessConfigGet,
+}
+
+var witnessConfigSetCmd = &cobra.Command{
+	Use:   "set <rig> <key> <value>",
+	Short: "Change a witness setting",
+	Long: `Change a witness setting. The value is validated before it is saved:
+durations must parse, counts must be positive, nudge templates must
+compile and quiet dates must be valid. The value is saved as an override
+in the witness state file, winning over the config files. An empty value
+removes the override, so the key falls back to the config files or its
+default.
+
+Changes take effect on the next gt witness start.
+
+Examples:
+  gt witness config set greenplace stuck_threshold 45m
+  gt witness config set greenplace quiet_dates weekends,2026-12-25
+  gt witness config set greenplace escalation_threshold ""`,
+	Args: witnessRigArg(cobra.ExactArgs(3)),
+	RunE: runWitnessConfigSet,
+}
+
+func init() {
+	witnessConfigListCmd.Flags().BoolVar(&witnessConfigJSON, "json", false, "Output as JSON")
+
+	witnessConfigCmd.AddCommand(witnessConfigListCmd)
+	witnessConfigCmd.AddCommand(witnessConfigGetCmd)
+	witnessConfigCmd.AddCommand(witnessConfigSetCmd)
+	witnessCmd.AddCommand(witnessConfigCmd)
+}
+
+func runWitnessConfigList(cmd *cobra.Command, args []string) error {
+	mgr, err := getWitnessManager(args[0])
+	if err != nil {
+		return err
+	}
+
+	values, err := mgr.ConfigValues()
+	if err != nil {
+		return err
+	}
+
+	if witnessConfigJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(values)
+	}
+
+	fmt.Printf("%-22s %-30s %s\n", "Key", "Value", "Source")
+	fmt.Printf("%-22s %-30s %s\n", "---", "-----", "------")
+	for _, v := range values {
+		value := v.Value
+		if value == "" {
+			value = "(none)"
+		}
+		if len(value) > 30 {
+			value = value[:27] + "..."
+		}
+		source := string(v.Source)
+		switch v.Source {
+		case witness.SourceDefault:
+			source = style.Dim.Render(source)
+		case witness.SourceTown, witness.SourceRig:
+			source += " " + style.Dim.Render("("+v.File+")")
+		}
+		fmt.Printf("%-22s %-30s %s\n", v.Key, value, source)
+	}
+	return nil
+}
+
+func runWitnessConfigGet(cmd *cobra.Command, args []string) error {
+	mgr, err := getWitnessManager(args[0])
+	if err != nil {
+		return err
+	}
+
+	v, err := mgr.ConfigValue(args[1])
+	if err != nil {
+		return err
+	}
+	fmt.Println(v.Value)
+	return nil
+}
+
+func runWitnessConfigSet(cmd *cobra.Command, args []string) error {
+	rigName, key, value := args[0], args[1], args[2]
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.SetConfigValue(key, value); err != nil {
+		return err
+	}
+
+	if value == "" {
+		fmt.Printf("%s Reset %s to its default for %s\n", style.Success.Render("✓"), key, rigName)
+	} else {
+		fmt.Printf("%s Set %s=%s for %s\n", style.Success.Render("✓"), key, value, rigName)
+	}
+
+	if w, err := mgr.Status(); err == nil && w.State != witness.StateStopped {
+		style.PrintWarning("the witness for %s is running; restart it (gt witness restart %s) to apply the change", rigName, rigName)
+	}
+	return nil
+}
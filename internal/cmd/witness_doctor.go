@@ -0,0 +1,89 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness doctor, which diagnoses a witness that
+// isn't doing its job.
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var witnessDoctorCmd = &cobra.Command{
+	Use:   "doctor <rig>...",
+	Short: "Diagnose a witness that isn't doing anything",
+	Long: `Check what a rig's Witness needs to do its job:
+  - the state file reads, parses and has a schema this gt supports
+  - the witness config is valid
+  - tmux is available
+  - the agent session is alive with its agent running (or, for a
+    foreground or multi-rig witness, the loop is still checking)
+  - the agent isn't crash-looping
+  - bd is reachable, so stuck polecats can be escalated
+
+Each check prints a pass, warn, or fail line with a hint on how to fix it.
+Exits 1 if any check fails.
+
+Examples:
+  gt witness doctor greenplace
+  gt witness doctor --all`,
+	Args: witnessRigArgs(cobra.MinimumNArgs(1)),
+	RunE: runWitnessDoctor,
+}
+
+func init() {
+	witnessDoctorCmd.Flags().BoolVar(&witnessAll, "all", false, "Diagnose every rig's witness")
+	witnessCmd.AddCommand(witnessDoctorCmd)
+}
+
+func runWitnessDoctor(cmd *cobra.Command, args []string) error {
+	rigs := args
+	if witnessAll {
+		var err error
+		if rigs, err = allRigNames(); err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	for i, rigName := range rigs {
+		mgr, err := getWitnessManager(rigName)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s\n", style.Bold.Render(rigName))
+		for _, d := range mgr.Diagnose() {
+			c := witnessDiagnosisCheck(d)
+			printRigCheck(c)
+			if c.Status == rigCheckFail {
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("\n%s %d check(s) failed\n", style.ErrorPrefix, failed)
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		return NewSilentExit(1)
+	}
+	fmt.Printf("\n%s No problems found\n", style.SuccessPrefix)
+	return nil
+}
+
+// witnessDiagnosisCheck renders a witness diagnosis as a doctor line.
+func witnessDiagnosisCheck(d witness.Diagnosis) rigCheck {
+	c := rigCheck{Name: d.Name, Message: d.Message, Hint: d.Hint, Status: rigCheckPass}
+	switch d.Status {
+	case witness.DiagnosisWarn:
+		c.Status = rigCheckWarn
+	case witness.DiagnosisFail:
+		c.Status = rigCheckFail
+	}
+	return c
+}
@@ -0,0 +1,165 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness export, which dumps witness stats as
+// CSV for reporting.
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var (
+	witnessExportSince    string
+	witnessExportUntil    string
+	witnessExportOutput   string
+	witnessExportSnapshot bool
+)
+
+var witnessExportCmd = &cobra.Command{
+	Use:   "export <rig>...",
+	Short: "Export witness stats as CSV",
+	Long: `Export witness statistics as CSV, one row per day, rig and polecat, with
+columns date, rig, polecat, checks, nudges and escalations.
+
+Daily rows come from the witness's daily stats history (history_days
+days, 30 by default), and for older days from the check log the monitoring
+loop writes on every pass; --since and --until (YYYY-MM-DD, inclusive)
+limit the dates. Without either, or with --snapshot, each polecat's
+cumulative totals are exported instead, dated today.
+
+The output starts with '#' comment lines saying where the numbers come
+from, followed by the header row. Rows are sorted by date, rig and polecat.
+
+Examples:
+  gt witness export greenplace
+  gt witness export greenplace --since 2026-01-05 --until 2026-01-11 -o week.csv
+  gt witness export --all --snapshot`,
+	Args: witnessRigArgs(cobra.MinimumNArgs(1)),
+	RunE: runWitnessExport,
+}
+
+func init() {
+	witnessExportCmd.Flags().StringVar(&witnessExportSince, "since", "", "First date to export (YYYY-MM-DD)")
+	witnessExportCmd.Flags().StringVar(&witnessExportUntil, "until", "", "Last date to export (YYYY-MM-DD)")
+	witnessExportCmd.Flags().StringVarP(&witnessExportOutput, "output", "o", "", "Write the CSV to this file (default: stdout)")
+	witnessExportCmd.Flags().BoolVar(&witnessExportSnapshot, "snapshot", false, "Export cumulative totals instead of daily history")
+	witnessExportCmd.Flags().BoolVar(&witnessAll, "all", false, "Export every rig in the town")
+	witnessCmd.AddCommand(witnessExportCmd)
+}
+
+func runWitnessExport(cmd *cobra.Command, args []string) error {
+	rigs := args
+	if witnessAll {
+		var err error
+		if rigs, err = allRigNames(); err != nil {
+			return err
+		}
+	}
+
+	var mgrs []*witness.Manager
+	for _, rigName := range rigs {
+		mgr, err := getWitnessManager(rigName)
+		if err != nil {
+			return err
+		}
+		mgrs = append(mgrs, mgr)
+	}
+
+	now := time.Now()
+	rows, comments, err := witnessExportRows(mgrs, now)
+	if err != nil {
+		return err
+	}
+	comments = append([]string{
+		"gt witness export, " + now.Format(time.RFC3339),
+		"rigs: " + strings.Join(rigs, ", "),
+	}, comments...)
+
+	var out io.Writer = os.Stdout
+	if witnessExportOutput != "" {
+		f, err := os.Create(witnessExportOutput)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", witnessExportOutput, err)
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := witness.WriteStatsCSV(out, comments, rows); err != nil {
+		return fmt.Errorf("writing CSV: %w", err)
+	}
+	if witnessExportOutput != "" {
+		fmt.Printf("%s Wrote %d row(s) to %s\n", style.Bold.Render("✓"), len(rows), witnessExportOutput)
+	}
+	return nil
+}
+
+// witnessExportRows collects the rows to export and the comments that
+// describe them: daily history from the check logs when there is any,
+// otherwise the cumulative snapshot.
+func witnessExportRows(mgrs []*witness.Manager, now time.Time) ([]witness.StatsRow, []string, error) {
+	dated := witnessExportSince != "" || witnessExportUntil != ""
+	if !witnessExportSnapshot {
+		var rows []witness.StatsRow
+		for _, mgr := range mgrs {
+			daily, err := mgr.DailyStats(witnessExportSince, witnessExportUntil)
+			if err != nil {
+				return nil, nil, err
+			}
+			rows = append(rows, daily...)
+		}
+		if len(rows) > 0 || (dated && witnessHasHistory(mgrs)) {
+			witness.SortStatsRows(rows)
+			comments := []string{"source: witness daily history and check log"}
+			if dated {
+				comments = append(comments, fmt.Sprintf("dates: %s to %s",
+					orDefault(witnessExportSince, "earliest"), orDefault(witnessExportUntil, "today")))
+			}
+			return rows, comments, nil
+		}
+		if dated {
+			// stderr: stdout may be the CSV
+			fmt.Fprintf(os.Stderr, "%s no daily history; exporting the current snapshot, --since/--until ignored\n",
+				style.WarningPrefix)
+		}
+	}
+
+	var rows []witness.StatsRow
+	for _, mgr := range mgrs {
+		snapshot, err := mgr.SnapshotStats(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, snapshot...)
+	}
+	witness.SortStatsRows(rows)
+	return rows, []string{"source: cumulative totals as of " + now.Format("2006-01-02")}, nil
+}
+
+// witnessHasHistory reports whether any of the rigs has daily stats or a
+// check log, so an empty date range can be told apart from having no
+// history at all.
+func witnessHasHistory(mgrs []*witness.Manager) bool {
+	for _, mgr := range mgrs {
+		if w, err := mgr.Status(); err == nil && (len(w.Stats.History) > 0 || w.Stats.TodayChecks > 0) {
+			return true
+		}
+		if _, err := os.Stat(mgr.LogPath()); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,121 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness plan, which shows the session gt witness
+// start would set up without creating it.
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var witnessPlanJSON bool
+
+var witnessPlanCmd = &cobra.Command{
+	Use:   "plan <rig>",
+	Short: "Show the session gt witness start would set up",
+	Long: `Show what gt witness start would set up for a rig's witness agent, without
+creating anything: the tmux session name, working directory, layout, the
+environment set on the session, the theme, and the command that launches
+the agent.
+
+Everything is worked out the way start does it, from the witness config,
+the config files, the session prefix and the rig's theme settings; the
+start flags that shape the session can be given here too. tmux is never
+touched, so plan works whether or not the witness is running. Use it to
+find out why a session landed in the wrong directory or with the wrong
+theme.
+
+Examples:
+  gt witness plan greenplace
+  gt witness plan greenplace --layout split --env CLAUDE_MODEL=opus
+  gt witness plan greenplace --json`,
+	Args: witnessRigArg(cobra.ExactArgs(1)),
+	RunE: runWitnessPlan,
+}
+
+func init() {
+	witnessPlanCmd.Flags().StringVar(&witnessLayout, "layout", "", "Agent session layout: single, or split to add a gt witness watch pane")
+	witnessPlanCmd.Flags().StringVar(&witnessAgentOverride, "agent", "", "Agent alias to run the Witness with (overrides town default)")
+	witnessPlanCmd.Flags().StringVar(&witnessAgentCommand, "agent-command", "", "Shell command to launch the Witness agent (overrides agent_command)")
+	witnessPlanCmd.Flags().StringArrayVar(&witnessEnvOverrides, "env", nil, "Environment variable override (KEY=VALUE, can be repeated)")
+	witnessPlanCmd.Flags().BoolVar(&witnessPlanJSON, "json", false, "Output as JSON")
+	witnessPlanCmd.MarkFlagsMutuallyExclusive("agent", "agent-command")
+	witnessCmd.AddCommand(witnessPlanCmd)
+}
+
+// witnessPlanView is a session plan with its theme spelled out.
+type witnessPlanView struct {
+	*witness.SessionPlan
+	Theme      string `json:"theme"`
+	ThemeStyle string `json:"theme_style"`
+}
+
+func runWitnessPlan(cmd *cobra.Command, args []string) error {
+	if err := witness.ValidateLayout(witnessLayout); err != nil {
+		return err
+	}
+	rigName := args[0]
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+	mgr.SetAgentCommand(witnessAgentCommand)
+	mgr.SetLayout(witnessLayout)
+	p, err := mgr.Plan(witnessAgentOverride, witnessEnvOverrides)
+	if err != nil {
+		return err
+	}
+
+	view := witnessPlanView{SessionPlan: p, Theme: p.Theme.Name, ThemeStyle: p.Theme.Style()}
+	if witnessPlanJSON {
+		return outputJSON(view)
+	}
+	printWitnessPlan(os.Stdout, view)
+	return nil
+}
+
+// printWitnessPlan renders a session plan for people.
+func printWitnessPlan(w io.Writer, p witnessPlanView) {
+	fmt.Fprintf(w, "%s Witness session plan: %s\n\n", style.Bold.Render(AgentTypeIcons[AgentWitness]), p.Rig)
+	fmt.Fprintf(w, "  Session: %s\n", p.Session)
+	fmt.Fprintf(w, "  Directory: %s\n", p.WorkDir)
+	fmt.Fprintf(w, "  Layout: %s\n", p.Layout)
+	fmt.Fprintf(w, "  Theme: %s %s\n", p.Theme, style.Dim.Render("("+p.ThemeStyle+")"))
+	ready := p.ReadyMarker
+	if ready == "" {
+		ready = style.Dim.Render("(none; a short pause instead)")
+	} else {
+		ready = fmt.Sprintf("%q", ready)
+	}
+	fmt.Fprintf(w, "  Ready marker: %s\n", ready)
+
+	fmt.Fprintf(w, "\n  %s\n", style.Bold.Render("Command:"))
+	fmt.Fprintf(w, "    %s\n", p.Command)
+	if len(p.Panes) > 1 {
+		fmt.Fprintf(w, "\n  %s\n", style.Bold.Render("Other panes:"))
+		for _, pane := range p.Panes[1:] {
+			fmt.Fprintf(w, "    %s\n", pane)
+		}
+	}
+
+	fmt.Fprintf(w, "\n  %s\n", style.Bold.Render("Environment:"))
+	keys := make([]string, 0, len(p.Env))
+	for key := range p.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	width := 0
+	for _, key := range keys {
+		width = max(width, len(key))
+	}
+	for _, key := range keys {
+		fmt.Fprintf(w, "    %s%s = %s\n", key, strings.Repeat(" ", width-len(key)), p.Env[key])
+	}
+}
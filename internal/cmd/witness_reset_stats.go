@@ -0,0 +1,80 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness reset-stats, which clears a witness's
+// counters without touching its config.
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var witnessResetStatsYes bool
+
+var witnessResetStatsCmd = &cobra.Command{
+	Use:   "reset-stats <rig>...",
+	Short: "Zero a witness's statistics, keeping its config",
+	Long: `Zero a rig Witness's statistics: the check, nudge and escalation totals,
+today's counters, the daily history and the per-polecat stats.
+
+Config and run state are kept, so a running witness carries on monitoring
+with the same settings; only the numbers start over. Check log entries
+from before the reset no longer count in gt witness export or
+gt witness since, and the gt witness since bookmark is dropped.
+
+This can't be undone, so it asks for confirmation unless --yes is given.
+
+Examples:
+  gt witness reset-stats greenplace
+  gt witness reset-stats --all --yes`,
+	Args: witnessRigArgs(cobra.MinimumNArgs(1)),
+	RunE: runWitnessResetStats,
+}
+
+func init() {
+	witnessResetStatsCmd.Flags().BoolVarP(&witnessResetStatsYes, "yes", "y", false, "Don't ask for confirmation")
+	witnessResetStatsCmd.Flags().BoolVar(&witnessAll, "all", false, "Reset every rig in the town")
+	witnessCmd.AddCommand(witnessResetStatsCmd)
+}
+
+func runWitnessResetStats(cmd *cobra.Command, args []string) error {
+	rigs := args
+	if witnessAll {
+		var err error
+		if rigs, err = allRigNames(); err != nil {
+			return err
+		}
+	}
+
+	mgrs := make([]*witness.Manager, 0, len(rigs))
+	for _, rigName := range rigs {
+		mgr, err := getWitnessManager(rigName)
+		if err != nil {
+			return err
+		}
+		w, err := mgr.Status()
+		if err != nil {
+			return fmt.Errorf("%s: %w", rigName, err)
+		}
+		fmt.Printf("  %-16s %d checks, %d nudges, %d escalations, %d polecat(s)\n", rigName,
+			w.Stats.TotalChecks, w.Stats.TotalNudges, w.Stats.TotalEscalations, len(w.Stats.PerPolecat))
+		mgrs = append(mgrs, mgr)
+	}
+	fmt.Println()
+	if !witnessResetStatsYes && !promptYesNo(fmt.Sprintf("Reset the witness stats of %d rig(s)?", len(rigs))) {
+		fmt.Println("Aborted")
+		return nil
+	}
+
+	now := time.Now()
+	for i, mgr := range mgrs {
+		if err := mgr.ResetStats(now); err != nil {
+			return fmt.Errorf("%s: resetting stats: %w", rigs[i], err)
+		}
+		fmt.Printf("%s Reset witness stats for %s\n", style.SuccessPrefix, rigs[i])
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements the rig picker behind gt witness attach --select.
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/style"
+	"golang.org/x/term"
+)
+
+// errNoRigSelected is returned when the picker is left without a choice.
+var errNoRigSelected = errors.New("no rig selected")
+
+// canPrompt reports whether both stdin and stdout are terminals, so an
+// interactive prompt has someone to answer it.
+func canPrompt() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// selectWitnessRig lets the user pick one of the rigs whose witness
+// session is running. Without a terminal it fails rather than wait for
+// input that will never come.
+func selectWitnessRig() (string, error) {
+	if !canPrompt() {
+		return "", fmt.Errorf("no terminal to pick a rig from\nUsage: gt witness attach <rig>")
+	}
+	rigs, err := runningWitnessRigs()
+	if err != nil {
+		return "", err
+	}
+	switch len(rigs) {
+	case 0:
+		return "", fmt.Errorf("no witnesses are running\nStart one with: gt witness start <rig>")
+	case 1:
+		fmt.Printf("%s\n", style.Dim.Render("Only "+rigs[0]+" has a running witness"))
+		return rigs[0], nil
+	}
+	i, err := pickFromList(os.Stdin, os.Stdout, "Running witnesses:", rigs)
+	if err != nil {
+		return "", err
+	}
+	return rigs[i], nil
+}
+
+// runningWitnessRigs returns the rigs, sorted by name, whose witness tmux
+// session exists.
+func runningWitnessRigs() ([]string, error) {
+	rigs, err := allRigNames()
+	if err != nil {
+		return nil, err
+	}
+	t := newTmuxClient()
+	var running []string
+	for _, rigName := range rigs {
+		if ok, err := t.HasSession(witnessSessionName(rigName)); err == nil && ok {
+			running = append(running, rigName)
+		}
+	}
+	return running, nil
+}
+
+// pickFromList prints items as a numbered list under title and reads the
+// number of the chosen one from in, asking again after an answer that
+// isn't on the list. An empty answer, "q" or end of input cancels.
+func pickFromList(in io.Reader, out io.Writer, title string, items []string) (int, error) {
+	fmt.Fprintf(out, "%s\n", style.Bold.Render(title))
+	for i, item := range items {
+		fmt.Fprintf(out, "  %s %s\n", style.Info.Render(fmt.Sprintf("%2d)", i+1)), item)
+	}
+
+	reader := bufio.NewReader(in)
+	for {
+		fmt.Fprintf(out, "Pick one [1-%d, q to cancel]: ", len(items))
+		answer, err := reader.ReadString('\n')
+		answer = strings.TrimSpace(answer)
+		if answer == "" || strings.EqualFold(answer, "q") {
+			return 0, errNoRigSelected
+		}
+		if n, convErr := strconv.Atoi(answer); convErr == nil && n >= 1 && n <= len(items) {
+			return n - 1, nil
+		}
+		if err != nil {
+			return 0, errNoRigSelected
+		}
+		fmt.Fprintf(out, "%s %q is not on the list\n", style.WarningPrefix, answer)
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPickFromList(t *testing.T) {
+	items := []string{"alpha", "beta", "gamma"}
+	tests := []struct {
+		input   string
+		want    int
+		wantErr error
+	}{
+		{"2\n", 1, nil},
+		{" 3 \n", 2, nil},
+		{"9\nbeta\n1\n", 0, nil},
+		{"\n", 0, errNoRigSelected},
+		{"q\n", 0, errNoRigSelected},
+		{"", 0, errNoRigSelected},
+		{"7", 0, errNoRigSelected},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		got, err := pickFromList(strings.NewReader(tt.input), &out, "Running witnesses:", items)
+		if !errors.Is(err, tt.wantErr) || (err == nil && got != tt.want) {
+			t.Errorf("pickFromList(%q) = %d, %v; want %d, %v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+		if !strings.Contains(out.String(), "3) gamma") {
+			t.Errorf("pickFromList(%q) output missing the list:\n%s", tt.input, out.String())
+		}
+	}
+
+	var out bytes.Buffer
+	if _, err := pickFromList(strings.NewReader("9\n2\n"), &out, "Running witnesses:", items); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `"9" is not on the list`) {
+		t.Errorf("expected a complaint about 9:\n%s", out.String())
+	}
+}
+
+func TestWitnessAttachSelectNeedsTerminal(t *testing.T) {
+	// go test has no terminal on stdin
+	if canPrompt() {
+		t.Skip("running in a terminal")
+	}
+	if _, err := selectWitnessRig(); err == nil || !strings.Contains(err.Error(), "gt witness attach <rig>") {
+		t.Errorf("selectWitnessRig() without a terminal = %v, want a usage error", err)
+	}
+}
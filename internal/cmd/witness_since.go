@@ -0,0 +1,166 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness since, a quick summary of what the
+// witness did while you were away.
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+var (
+	witnessSinceMark     bool
+	witnessSinceDuration time.Duration
+	witnessSinceJSON     bool
+)
+
+var witnessSinceCmd = &cobra.Command{
+	Use:   "since <rig> [time]",
+	Short: "Summarize what the witness did since a bookmark or time",
+	Long: `Show how many checks, nudges and escalations a rig's Witness made since
+a bookmark, a time or a relative window, with the polecats that were
+nudged or escalated.
+
+--mark bookmarks the witness's running totals; a later 'gt witness since
+<rig>' shows what changed since then. With a previous bookmark, --mark
+first shows the changes since it, then moves it to now.
+
+A time (2026-01-05 13:00, 13:00 for today, or RFC 3339) or --since
+(e.g. 2h) looks at a window ending now instead. The numbers come from the
+check log when it reaches back that far, else from the daily history in
+whole days, starting with the day the window starts on.
+
+Examples:
+  gt witness since greenplace --mark
+  gt witness since greenplace
+  gt witness since greenplace 13:00
+  gt witness since greenplace --since 2h`,
+	Args: witnessRigArg(cobra.RangeArgs(1, 2)),
+	RunE: runWitnessSince,
+}
+
+func init() {
+	witnessSinceCmd.Flags().BoolVar(&witnessSinceMark, "mark", false, "Bookmark the current totals for a later 'gt witness since'")
+	witnessSinceCmd.Flags().DurationVar(&witnessSinceDuration, "since", 0, "Look at the window this long before now (e.g. 2h)")
+	witnessSinceCmd.Flags().BoolVar(&witnessSinceJSON, "json", false, "Output the changes as JSON")
+	witnessCmd.AddCommand(witnessSinceCmd)
+}
+
+func runWitnessSince(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	given := 0
+	for _, set := range []bool{witnessSinceMark, witnessSinceDuration != 0, len(args) > 1} {
+		if set {
+			given++
+		}
+	}
+	if given > 1 {
+		return fmt.Errorf("use one of --mark, --since or a time")
+	}
+	if witnessSinceDuration < 0 {
+		return fmt.Errorf("--since must be positive")
+	}
+
+	mgr, err := getWitnessManager(rigName)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	var delta *witness.StatsDelta
+	switch {
+	case witnessSinceMark:
+		if delta, err = mgr.SinceMark(now); err != nil {
+			return err
+		}
+		if _, err := mgr.Mark(now); err != nil {
+			return fmt.Errorf("saving bookmark: %w", err)
+		}
+		if delta == nil {
+			if witnessSinceJSON {
+				return outputJSON(nil)
+			}
+			fmt.Printf("%s Bookmarked witness stats for %s\n", style.SuccessPrefix, rigName)
+			return nil
+		}
+	case witnessSinceDuration > 0:
+		if delta, err = mgr.Since(now.Add(-witnessSinceDuration), now); err != nil {
+			return err
+		}
+	case len(args) > 1:
+		since, err := parseSinceTime(args[1], now)
+		if err != nil {
+			return err
+		}
+		if delta, err = mgr.Since(since, now); err != nil {
+			return err
+		}
+	default:
+		if delta, err = mgr.SinceMark(now); err != nil {
+			return err
+		}
+		if delta == nil {
+			return fmt.Errorf("no bookmark for %s: run 'gt witness since %s --mark' first, or give a time or --since", rigName, rigName)
+		}
+	}
+
+	if witnessSinceJSON {
+		return outputJSON(delta)
+	}
+	printWitnessDelta(rigName, delta)
+	if witnessSinceMark {
+		fmt.Printf("%s\n", style.Dim.Render("Bookmark moved to now"))
+	}
+	return nil
+}
+
+// parseSinceTime parses the time argument of gt witness since: RFC 3339,
+// "YYYY-MM-DD HH:MM", "YYYY-MM-DD" or "HH:MM" today, in local time.
+func parseSinceTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.ParseInLocation("15:04", s, now.Location()); err == nil {
+		return time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location()), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: want HH:MM, YYYY-MM-DD [HH:MM] or RFC 3339", s)
+}
+
+// printWitnessDelta prints a one-line summary of the changes, then the
+// polecats that were nudged or escalated.
+func printWitnessDelta(rigName string, d *witness.StatsDelta) {
+	fmt.Printf("%s since %s (%s ago): %d checks, %d nudges, %d escalations\n",
+		style.Bold.Render(rigName), d.Since.Local().Format("2006-01-02 15:04"),
+		formatUptime(d.Until.Sub(d.Since)), d.Checks, d.Nudges, d.Escalations)
+
+	names := make([]string, 0, len(d.PerPolecat))
+	for name, pd := range d.PerPolecat {
+		if pd.Nudges > 0 || pd.Escalations > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pd := d.PerPolecat[name]
+		line := fmt.Sprintf("  %-16s %d nudges", name, pd.Nudges)
+		if pd.Escalations > 0 {
+			line += ", " + style.Warning.Render(fmt.Sprintf("%d escalations", pd.Escalations))
+		}
+		fmt.Println(line)
+	}
+	if d.Source == witness.DeltaFromHistory {
+		fmt.Printf("%s\n", style.Dim.Render("From the daily history, in whole days: the check log doesn't reach back that far"))
+	}
+}
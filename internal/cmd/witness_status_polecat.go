@@ -0,0 +1,165 @@
+// Package cmd provides CLI commands for the gt tool.
+// This file implements gt witness status --polecat, which narrows the
+// status of a rig's witness down to a single polecat.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+// witnessPolecatStatus is what gt witness status --polecat shows.
+type witnessPolecatStatus struct {
+	Rig     string `json:"rig"`
+	Polecat string `json:"polecat"`
+
+	// Monitored is whether the witness currently monitors the polecat.
+	// With --force, an unmonitored polecat shows whatever stats are left.
+	Monitored   bool                  `json:"monitored"`
+	ObserveOnly bool                  `json:"observe_only,omitempty"`
+	State       witness.PolecatState  `json:"state,omitempty"`
+	Stats       *witness.PolecatStats `json:"stats,omitempty"`
+
+	// CooldownLeft is how long further escalations of the polecat are held
+	// back, if it was escalated recently.
+	CooldownLeft time.Duration `json:"escalation_cooldown_left,omitempty"`
+
+	// WarmupLeft is how much of its startup grace a freshly started
+	// polecat has left, during which it isn't nudged.
+	WarmupLeft time.Duration `json:"warmup_left,omitempty"`
+}
+
+func runWitnessPolecatStatus(args []string, format outputFormat) error {
+	if witnessAll || len(args) != 1 {
+		fmt.Fprintf(os.Stderr, "Error: --polecat takes a single rig\n")
+		return NewSilentExit(witnessExitError)
+	}
+	rigName := args[0]
+
+	ws, err := loadRigWitnessStatus(rigName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return NewSilentExit(witnessExitError)
+	}
+	ps, err := witnessPolecatStatusFor(rigName, ws.Witness, witnessStatusPolecat, witnessStatusForce, ws.Now)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return NewSilentExit(witnessExitError)
+	}
+
+	if format != formatTable {
+		if err := writeOutput(os.Stdout, format, ps); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return NewSilentExit(witnessExitError)
+		}
+	} else {
+		printWitnessPolecatStatus(ps, ws.State, ws.Now)
+	}
+
+	if code := witnessStatusExitCode([]*witnessStatusView{ws}); code != witnessExitRunning {
+		return NewSilentExit(code)
+	}
+	return nil
+}
+
+// witnessPolecatStatusFor picks one polecat out of a witness state at now. A
+// polecat the witness doesn't monitor is an error unless force is set.
+func witnessPolecatStatusFor(rigName string, w *witness.Witness, name string, force bool, now time.Time) (*witnessPolecatStatus, error) {
+	ps := &witnessPolecatStatus{
+		Rig:         rigName,
+		Polecat:     name,
+		Monitored:   slices.Contains(w.MonitoredPolecats, name),
+		ObserveOnly: w.Config.ObserveOnly(name),
+	}
+	if !ps.Monitored && !force {
+		hint := fmt.Sprintf("run 'gt polecat list %s' to see the rig's polecats", rigName)
+		if len(w.MonitoredPolecats) > 0 {
+			hint = "monitored: " + strings.Join(w.MonitoredPolecats, ", ") + "; " + hint
+		}
+		return nil, fmt.Errorf("polecat %q is not monitored by the %s witness (%s, or use --force)", name, rigName, hint)
+	}
+	if stats, ok := w.Stats.PerPolecat[name]; ok {
+		ps.Stats = &stats
+		ps.State = stats.LastState
+		ps.CooldownLeft = stats.EscalationCooldownLeft(now, w.Config.Cooldown())
+		ps.WarmupLeft = stats.WarmupLeft(now, w.Config.StartupGrace)
+	}
+	return ps, nil
+}
+
+// printWitnessPolecatStatus renders the human-readable status of one
+// polecat. witnessState is the state of the witness monitoring it.
+func printWitnessPolecatStatus(ps *witnessPolecatStatus, witnessState witness.State, now time.Time) {
+	fmt.Printf("%s Polecat: %s/%s\n\n", style.Bold.Render(AgentTypeIcons[AgentPolecat]), ps.Rig, ps.Polecat)
+
+	fmt.Printf("  Witness: %s\n", style.AgentState(string(witnessState)))
+	if !ps.Monitored {
+		fmt.Printf("  %s not monitored by the witness; showing what is left\n", style.WarningPrefix)
+	}
+	state := style.Dim.Render("(not checked yet)")
+	if ps.State != "" {
+		state = witnessPolecatStateLabel(ps.State)
+	}
+	if ps.WarmupLeft > 0 {
+		state += " " + style.Dim.Render(witnessWarmingUpLabel(ps.WarmupLeft))
+	}
+	if ps.ObserveOnly {
+		state += " " + style.Dim.Render("(observe-only)")
+	}
+	fmt.Printf("  State: %s\n", state)
+
+	s := ps.Stats
+	if s == nil {
+		fmt.Printf("\n  %s\n", style.Dim.Render("(no stats for this polecat)"))
+		return
+	}
+	fmt.Printf("  Last action: %s\n", witnessLastAction(s.LastAction, now))
+	if s.LastActiveAt != nil {
+		fmt.Printf("  Last active: %s\n", s.LastActiveAt.Format("2006-01-02 15:04:05"))
+	}
+	if s.EscalationBead != "" {
+		fmt.Printf("  Escalation: %s\n", s.EscalationBead)
+	}
+	if ps.CooldownLeft > 0 {
+		fmt.Printf("  Escalated: %s\n", style.Warning.Render(witnessEscalatedLabel(ps.CooldownLeft)))
+	}
+
+	fmt.Printf("\n  %s\n", style.Bold.Render("Statistics:"))
+	fmt.Printf("    Checks:      %d\n", s.Checks)
+	fmt.Printf("    Nudges:      %d\n", s.Nudges)
+	fmt.Printf("    Escalations: %d\n", s.Escalations)
+	if s.HeldNudges > 0 {
+		fmt.Printf("    Held nudges: %d\n", s.HeldNudges)
+	}
+	if s.BudgetHeldNudges > 0 {
+		fmt.Printf("    Nudges held by budget: %d\n", s.BudgetHeldNudges)
+	}
+	if s.SuppressedEscalations > 0 {
+		fmt.Printf("    Escalations held by cooldown: %d\n", s.SuppressedEscalations)
+	}
+	if s.WouldNudges > 0 || s.WouldEscalations > 0 {
+		fmt.Printf("    Would-nudges:      %d\n", s.WouldNudges)
+		fmt.Printf("    Would-escalations: %d\n", s.WouldEscalations)
+	}
+
+	fmt.Printf("\n  %s\n", style.Bold.Render("Recent Nudges:"))
+	if len(s.RecentNudges) == 0 {
+		last := "(none since it last made progress)"
+		if s.LastNudgeAt != nil {
+			last = fmt.Sprintf("(none since it last made progress; last nudged %s)",
+				s.LastNudgeAt.Format("2006-01-02 15:04:05"))
+		}
+		fmt.Printf("    %s\n", style.Dim.Render(last))
+		return
+	}
+	for _, t := range s.RecentNudges {
+		fmt.Printf("    • %s %s\n", t.Format("2006-01-02 15:04:05"),
+			style.Dim.Render(fmt.Sprintf("(%s ago)", formatUptime(now.Sub(t)))))
+	}
+}
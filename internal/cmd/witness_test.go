@@ -1,8 +1,23 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/tmux/tmuxtest"
+	"github.com/steveyegge/gastown/internal/witness"
 )
 
 func TestWitnessRestartAgentFlag(t *testing.T) {
@@ -30,3 +45,578 @@ func TestWitnessStartAgentFlag(t *testing.T) {
 		t.Errorf("expected --agent usage to mention overrides town default, got %q", flag.Usage)
 	}
 }
+
+func TestWitnessAgentFlagsExclusive(t *testing.T) {
+	for _, c := range []*cobra.Command{witnessStartCmd, witnessRestartCmd, witnessPlanCmd} {
+		flags := c.Flags()
+		_ = flags.Set("agent", "codex")
+		_ = flags.Set("agent-command", "my-agent")
+		if err := c.ValidateFlagGroups(); err == nil {
+			t.Errorf("%s accepted --agent with --agent-command", c.Name())
+		}
+		for _, name := range []string{"agent", "agent-command"} {
+			f := flags.Lookup(name)
+			_ = f.Value.Set(f.DefValue)
+			f.Changed = false
+		}
+	}
+}
+
+func TestReconcileWitnessState(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-30 * time.Second)
+
+	tests := []struct {
+		name           string
+		witness        witness.Witness
+		sessionRunning bool
+		want           witness.State
+	}{
+		{"session up, state stopped", witness.Witness{State: witness.StateStopped}, true, witness.StateRunning},
+		{"session up, paused kept", witness.Witness{State: witness.StatePaused}, true, witness.StatePaused},
+		{"session gone, running", witness.Witness{State: witness.StateRunning}, false, witness.StateStopped},
+		{"session gone, paused", witness.Witness{State: witness.StatePaused}, false, witness.StateStopped},
+		{"foreground loop alive", witness.Witness{State: witness.StatePaused, Foreground: true, LastCheckAt: &recent}, false, witness.StatePaused},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := tt.witness
+			reconcileWitnessState(tmuxtest.NewFakeTmux(), &w, tt.sessionRunning, now)
+			if w.State != tt.want {
+				t.Errorf("state = %s, want %s", w.State, tt.want)
+			}
+		})
+	}
+}
+
+func TestWitnessStatusExitCode(t *testing.T) {
+	view := func(state witness.State) *witnessStatusView {
+		return &witnessStatusView{Witness: &witness.Witness{State: state}}
+	}
+	tests := []struct {
+		name   string
+		states []witness.State
+		want   int
+	}{
+		{"running", []witness.State{witness.StateRunning}, witnessExitRunning},
+		{"stopped", []witness.State{witness.StateStopped}, witnessExitStopped},
+		{"paused", []witness.State{witness.StatePaused}, witnessExitPaused},
+		{"stopped beats paused", []witness.State{witness.StatePaused, witness.StateStopped, witness.StateRunning}, witnessExitStopped},
+		{"paused beats running", []witness.State{witness.StateRunning, witness.StatePaused}, witnessExitPaused},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var views []*witnessStatusView
+			for _, s := range tt.states {
+				views = append(views, view(s))
+			}
+			if got := witnessStatusExitCode(views); got != tt.want {
+				t.Errorf("witnessStatusExitCode = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatUptime(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{45 * time.Second, "45s"},
+		{13*time.Minute + 20*time.Second, "13m"},
+		{2*time.Hour + 13*time.Minute, "2h13m"},
+		{3*24*time.Hour + 4*time.Hour + 30*time.Minute, "3d4h"},
+	}
+	for _, tt := range tests {
+		if got := formatUptime(tt.d); got != tt.want {
+			t.Errorf("formatUptime(%s) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestNewPaneLines(t *testing.T) {
+	tests := []struct {
+		name string
+		prev []string
+		cur  []string
+		want []string
+	}{
+		{"unchanged", []string{"a", "b", "c"}, []string{"a", "b", "c"}, nil},
+		{"appended", []string{"a", "b"}, []string{"a", "b", "c", "d"}, []string{"c", "d"}},
+		{"scrolled", []string{"a", "b", "c"}, []string{"b", "c", "d"}, []string{"d"}},
+		{"no overlap", []string{"a", "b"}, []string{"x", "y"}, []string{"x", "y"}},
+		{"first capture", nil, []string{"a"}, []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newPaneLines(tt.prev, tt.cur)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("newPaneLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaneLines_TrimsPadding(t *testing.T) {
+	got := paneLines("one\ntwo\n\n   \n")
+	if strings.Join(got, "|") != "one|two" {
+		t.Errorf("paneLines() = %q", got)
+	}
+}
+
+func TestDryRunPolecat(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"greenplace/Toast", "Toast"},
+		{"otherrig/Toast", ""},
+		{"greenplace/crew/max", ""},
+		{"greenplace/witness", ""},
+		{"mayor", ""},
+	}
+	for _, tt := range tests {
+		if got := dryRunPolecat("greenplace", tt.target); got != tt.want {
+			t.Errorf("dryRunPolecat(%q) = %q, want %q", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestEscalationStatusAndAge(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	issue := &beads.Issue{Status: "open", Labels: []string{"gt:escalation", "acked"}, CreatedAt: "2026-01-02T09:30:00Z"}
+	if got := escalationStatus(issue); got != "acked" {
+		t.Errorf("escalationStatus(acked) = %q, want acked", got)
+	}
+	if got := escalationAge(issue, now); got != "2h30m" {
+		t.Errorf("escalationAge = %q, want 2h30m", got)
+	}
+
+	issue.Status = "closed"
+	issue.CreatedAt = "yesterday"
+	if got := escalationStatus(issue); got != "closed" {
+		t.Errorf("escalationStatus(closed) = %q, want closed", got)
+	}
+	if got := escalationAge(issue, now); got != "?" {
+		t.Errorf("escalationAge(bad timestamp) = %q, want ?", got)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]int{0, 1, 2, 4, 8}); got != "▁▁▂▄█" {
+		t.Errorf("sparkline = %q", got)
+	}
+	if got := sparkline([]int{0, 0}); got != "▁▁" {
+		t.Errorf("sparkline(zeros) = %q", got)
+	}
+}
+
+func TestParseSinceTime(t *testing.T) {
+	now := time.Date(2026, 1, 5, 15, 30, 0, 0, time.Local)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"13:00", time.Date(2026, 1, 5, 13, 0, 0, 0, time.Local)},
+		{"2026-01-04", time.Date(2026, 1, 4, 0, 0, 0, 0, time.Local)},
+		{"2026-01-04 22:15", time.Date(2026, 1, 4, 22, 15, 0, 0, time.Local)},
+		{"2026-01-04T08:00:00Z", time.Date(2026, 1, 4, 8, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseSinceTime(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseSinceTime(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := parseSinceTime("lunch", now); err == nil {
+		t.Error("parseSinceTime(lunch) should fail")
+	}
+}
+
+func TestCheckPrintSessionFlags(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		c := &cobra.Command{}
+		for _, name := range []string{"read-only", "reconnect", "new-window", "all", "if-running", "select"} {
+			c.Flags().Bool(name, false, "")
+		}
+		return c
+	}
+
+	for _, name := range []string{"if-running", "select"} {
+		c := newCmd()
+		_ = c.Flags().Set(name, "true")
+		if err := checkPrintSessionFlags(c); err != nil {
+			t.Errorf("--print-session --%s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"read-only", "reconnect", "new-window", "all"} {
+		c := newCmd()
+		_ = c.Flags().Set(name, "true")
+		if err := checkPrintSessionFlags(c); err == nil || !strings.Contains(err.Error(), "--"+name) {
+			t.Errorf("--print-session --%s: err = %v, want it rejected", name, err)
+		}
+	}
+}
+
+func TestAttachReconnecting(t *testing.T) {
+	defer func(d time.Duration) { attachReconnectDelay = d }(attachReconnectDelay)
+	attachReconnectDelay = time.Millisecond
+
+	exit := func(code string) error { return exec.Command("sh", "-c", "exit "+code).Run() }
+	alive := func() bool { return true }
+
+	tests := []struct {
+		name      string
+		results   []string // exit codes of successive attaches
+		alive     func() bool
+		wantCalls int
+		wantErr   bool
+	}{
+		{"clean detach", []string{"0"}, alive, 1, false},
+		{"drop then detach", []string{"1", "1", "0"}, alive, 3, false},
+		{"session gone", []string{"1"}, func() bool { return false }, 1, true},
+		{"gives up", []string{"1", "1", "1", "1", "1", "1", "1"}, alive, attachReconnectTries + 1, true},
+	}
+	for _, tt := range tests {
+		calls := 0
+		err := attachReconnecting(func() error {
+			code := tt.results[calls]
+			calls++
+			return exit(code)
+		}, tt.alive)
+		if calls != tt.wantCalls || (err != nil) != tt.wantErr {
+			t.Errorf("%s: %d attach(es), err %v; want %d, error %v", tt.name, calls, err, tt.wantCalls, tt.wantErr)
+		}
+	}
+
+	// Errors that aren't a tmux exit, e.g. tmux missing, aren't retried
+	calls := 0
+	err := attachReconnecting(func() error { calls++; return errors.New("tmux not found") }, alive)
+	if err == nil || calls != 1 {
+		t.Errorf("non-exit error: %d attach(es), err %v; want 1 and the error", calls, err)
+	}
+}
+
+func TestWitnessLastAction(t *testing.T) {
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	if got := witnessLastAction(nil, now); got != "-" {
+		t.Errorf("witnessLastAction(nil) = %q, want -", got)
+	}
+	a := &witness.PolecatAction{Action: witness.ActionNudged, At: now.Add(-3 * time.Minute), Reason: "no activity for 8m0s"}
+	if got, want := witnessLastAction(a, now), "nudged 3m ago (no activity for 8m0s)"; got != want {
+		t.Errorf("witnessLastAction = %q, want %q", got, want)
+	}
+}
+
+// useFakeTmux points the witness commands at f for the rest of the test.
+func useFakeTmux(t *testing.T, f *tmuxtest.FakeTmux) {
+	t.Helper()
+	orig := newTmuxClient
+	newTmuxClient = func() tmux.Client { return f }
+	t.Cleanup(func() { newTmuxClient = orig })
+}
+
+func TestForceStopWitness(t *testing.T) {
+	session := witnessSessionName("testrig")
+	f := tmuxtest.NewFakeTmux(session)
+	useFakeTmux(t, f)
+	mgr := witness.NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	if err := forceStopWitness(mgr, "testrig"); err != nil {
+		t.Fatalf("forceStopWitness: %v", err)
+	}
+	want := []string{"HasSession " + session, "KillSession " + session}
+	if got := f.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+	if f.Session(session) != nil {
+		t.Error("session still running after force stop")
+	}
+}
+
+func TestLoadWitnessStatus_SessionFromTmux(t *testing.T) {
+	session := witnessSessionName("testrig")
+	f := tmuxtest.NewFakeTmux(session)
+	started := time.Now()
+	f.Now = func() time.Time { return started.Add(5 * time.Minute) }
+	useFakeTmux(t, f)
+	mgr := witness.NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	ws, err := loadWitnessStatus(mgr, "testrig")
+	if err != nil {
+		t.Fatalf("loadWitnessStatus: %v", err)
+	}
+	if !ws.SessionRunning || ws.SessionName != session {
+		t.Errorf("session = %q running=%v, want %q running", ws.SessionName, ws.SessionRunning, session)
+	}
+	if ws.Uptime < 5*time.Minute || ws.Uptime > 6*time.Minute {
+		t.Errorf("uptime = %v, want about 5m", ws.Uptime)
+	}
+	want := []string{"HasSession " + session, "PaneIsDead " + session, "SessionUptime " + session}
+	if got := f.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
+
+func TestLoadWitnessStatus_DeadPane(t *testing.T) {
+	session := witnessSessionName("testrig")
+	f := tmuxtest.NewFakeTmux(session)
+	f.Session(session).Dead = true
+	useFakeTmux(t, f)
+	mgr := witness.NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	ws, err := loadWitnessStatus(mgr, "testrig")
+	if err != nil {
+		t.Fatalf("loadWitnessStatus: %v", err)
+	}
+	// The session is there, but nothing runs in it
+	if ws.SessionRunning || !ws.PaneDead {
+		t.Errorf("running=%v paneDead=%v, want a dead pane that isn't running", ws.SessionRunning, ws.PaneDead)
+	}
+	if ws.State != witness.StateStopped {
+		t.Errorf("state = %s, want %s", ws.State, witness.StateStopped)
+	}
+	if ws.Uptime != 0 {
+		t.Errorf("uptime = %v, want none for a dead pane", ws.Uptime)
+	}
+}
+
+func TestWitnessSessionName_Prefix(t *testing.T) {
+	t.Setenv(session.PrefixEnv, "gt-alice-")
+	const want = "gt-alice-testrig-witness"
+	if got := witnessSessionName("testrig"); got != want {
+		t.Fatalf("witnessSessionName = %q, want %q", got, want)
+	}
+	mgr := witness.NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if got := mgr.SessionName(); got != want {
+		t.Errorf("Manager.SessionName = %q, want %q", got, want)
+	}
+
+	// Another user's witness for a rig of the same name is left alone
+	f := tmuxtest.NewFakeTmux("gt-testrig-witness")
+	useFakeTmux(t, f)
+	if created, err := f.EnsureSessionWithCommand(witnessSessionName("testrig"), "/town", "cmd"); err != nil || !created {
+		t.Fatalf("EnsureSessionWithCommand = %v, %v; want created", created, err)
+	}
+	ws, err := loadWitnessStatus(mgr, "testrig")
+	if err != nil {
+		t.Fatalf("loadWitnessStatus: %v", err)
+	}
+	if ws.SessionName != want || !ws.SessionRunning {
+		t.Errorf("status session = %q running=%v, want %q running", ws.SessionName, ws.SessionRunning, want)
+	}
+	if err := forceStopWitness(mgr, "testrig"); err != nil {
+		t.Fatalf("forceStopWitness: %v", err)
+	}
+	if f.Session(want) != nil {
+		t.Errorf("%s still running after stop", want)
+	}
+	if f.Session("gt-testrig-witness") == nil {
+		t.Error("stop killed the unprefixed session")
+	}
+	if id, err := session.ParseSessionName(want); err != nil || id.Role != session.RoleWitness || id.Rig != "testrig" {
+		t.Errorf("ParseSessionName(%q) = %+v, %v; want testrig witness", want, id, err)
+	}
+}
+
+func TestEnsureWitnessForAttach_IfRunning(t *testing.T) {
+	witnessIfRunning = true
+	t.Cleanup(func() { witnessIfRunning = false })
+	mgr := witness.NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	sessionName := witnessSessionName("testrig")
+
+	f := tmuxtest.NewFakeTmux()
+	useFakeTmux(t, f)
+	err := ensureWitnessForAttach(mgr, "testrig")
+	if !errors.Is(err, witness.ErrNotRunning) {
+		t.Fatalf("err = %v, want ErrNotRunning", err)
+	}
+	if got, want := f.Calls(), []string{"HasSession " + sessionName}; !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q: nothing may be started", got, want)
+	}
+
+	f = tmuxtest.NewFakeTmux(sessionName)
+	useFakeTmux(t, f)
+	if err := ensureWitnessForAttach(mgr, "testrig"); err != nil {
+		t.Errorf("running witness: err = %v, want nil", err)
+	}
+}
+
+func TestWitnessPolecatStatusFor(t *testing.T) {
+	w := &witness.Witness{
+		MonitoredPolecats: []string{"Toast"},
+		Stats: witness.WitnessStats{PerPolecat: map[string]witness.PolecatStats{
+			"Toast":  {Checks: 5, Nudges: 2, LastState: witness.PolecatStuck},
+			"Gravel": {Checks: 3, Stale: true},
+		}},
+	}
+
+	ps, err := witnessPolecatStatusFor("greenplace", w, "Toast", false, time.Now())
+	if err != nil {
+		t.Fatalf("monitored polecat: %v", err)
+	}
+	if !ps.Monitored || ps.State != witness.PolecatStuck || ps.Stats == nil || ps.Stats.Nudges != 2 {
+		t.Errorf("Toast status = %+v, want monitored, stuck, 2 nudges", ps)
+	}
+
+	_, err = witnessPolecatStatusFor("greenplace", w, "Gravel", false, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "gt polecat list greenplace") {
+		t.Errorf("unmonitored polecat err = %v, want a hint to list polecats", err)
+	}
+
+	ps, err = witnessPolecatStatusFor("greenplace", w, "Gravel", true, time.Now())
+	if err != nil {
+		t.Fatalf("unmonitored polecat with force: %v", err)
+	}
+	if ps.Monitored || ps.Stats == nil || ps.Stats.Checks != 3 {
+		t.Errorf("Gravel status = %+v, want unmonitored with its stale stats", ps)
+	}
+
+	ps, err = witnessPolecatStatusFor("greenplace", w, "Nobody", true, time.Now())
+	if err != nil || ps.Stats != nil {
+		t.Errorf("unknown polecat with force = %+v, %v, want no stats and no error", ps, err)
+	}
+}
+
+func TestMigrateLegacySession(t *testing.T) {
+	const legacy = "gt-testrig-witness"
+
+	t.Run("default prefix leaves sessions alone", func(t *testing.T) {
+		f := tmuxtest.NewFakeTmux(legacy)
+		if renamed, err := migrateLegacySession(f, witnessSessionName("testrig")); err != nil || renamed {
+			t.Fatalf("migrateLegacySession = %v, %v; want no rename", renamed, err)
+		}
+		if len(f.Calls()) != 0 {
+			t.Errorf("calls = %q, want none", f.Calls())
+		}
+	})
+
+	t.Setenv(session.PrefixEnv, "gt-alice-")
+	current := witnessSessionName("testrig")
+
+	t.Run("renames a legacy session", func(t *testing.T) {
+		f := tmuxtest.NewFakeTmux(legacy)
+		if renamed, err := migrateLegacySession(f, current); err != nil || !renamed {
+			t.Fatalf("migrateLegacySession = %v, %v; want renamed", renamed, err)
+		}
+		if f.Session(legacy) != nil || f.Session(current) == nil {
+			t.Errorf("after migration: legacy %v, current %v; want only current",
+				f.Session(legacy) != nil, f.Session(current) != nil)
+		}
+	})
+
+	t.Run("keeps both when the new name is taken", func(t *testing.T) {
+		f := tmuxtest.NewFakeTmux(legacy, current)
+		if renamed, err := migrateLegacySession(f, current); err != nil || renamed {
+			t.Fatalf("migrateLegacySession = %v, %v; want no rename", renamed, err)
+		}
+		if f.Session(legacy) == nil {
+			t.Error("legacy session was touched")
+		}
+	})
+
+	t.Run("no legacy session", func(t *testing.T) {
+		f := tmuxtest.NewFakeTmux()
+		if renamed, err := migrateLegacySession(f, current); err != nil || renamed {
+			t.Fatalf("migrateLegacySession = %v, %v; want no rename", renamed, err)
+		}
+	})
+
+	t.Run("status picks up the renamed session", func(t *testing.T) {
+		f := tmuxtest.NewFakeTmux(legacy)
+		useFakeTmux(t, f)
+		mgr := witness.NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+		ws, err := loadWitnessStatus(mgr, "testrig")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ws.SessionRunning || ws.SessionName != current {
+			t.Errorf("status session = %s running=%v, want %s running", ws.SessionName, ws.SessionRunning, current)
+		}
+	})
+}
+
+func TestPrintWitnessPlan(t *testing.T) {
+	p := &witness.SessionPlan{
+		Rig:     "greenplace",
+		Session: "gt-greenplace-witness",
+		WorkDir: "/town/greenplace/witness/rig",
+		Command: "claude",
+		Layout:  witness.LayoutSplit,
+		Panes:   []string{"claude", "gt witness watch greenplace"},
+		Env:     map[string]string{"GT_ROLE": "witness", "BD_ACTOR": "greenplace/witness"},
+	}
+	var buf bytes.Buffer
+	printWitnessPlan(&buf, witnessPlanView{SessionPlan: p, Theme: "ocean", ThemeStyle: "bg=#1e3a5f,fg=#e0e0e0"})
+	out := buf.String()
+	for _, want := range []string{
+		"Session: gt-greenplace-witness",
+		"Directory: /town/greenplace/witness/rig",
+		"Theme: ocean",
+		"gt witness watch greenplace",
+		"BD_ACTOR = greenplace/witness",
+		"GT_ROLE  = witness",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("plan output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "BD_ACTOR") > strings.Index(out, "GT_ROLE") {
+		t.Errorf("environment not sorted:\n%s", out)
+	}
+}
+
+// argsEcho writes a stand-in gt that prints each argument on its own line.
+func argsEcho(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "gt dir", "gt")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("#!/bin/sh\nprintf '%s\\n' \"$@\"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestWitnessGroupCommand_QuotesNames(t *testing.T) {
+	gt := argsEcho(t)
+	command, err := witnessGroupCommand(gt, "it's $(date)", []string{"green place", "`id`"})
+	if err != nil {
+		t.Fatalf("witnessGroupCommand: %v", err)
+	}
+	out, err := exec.Command("sh", "-c", command).Output()
+	if err != nil {
+		t.Fatalf("running %q: %v", command, err)
+	}
+	got := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	want := []string{"witness", "start", "--foreground", "--name", "it's $(date)", "green place", "`id`"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("group session got args %q, want %q", got, want)
+	}
+}
+
+func TestWitnessGroupCommand_QuotesFlags(t *testing.T) {
+	defer func(addr, events string) {
+		witnessMetricsAddr, witnessEventsPath = addr, events
+	}(witnessMetricsAddr, witnessEventsPath)
+	witnessMetricsAddr = "localhost:9090; touch pwned"
+	witnessEventsPath = filepath.Join(t.TempDir(), "it's events.jsonl")
+
+	gt := argsEcho(t)
+	command, err := witnessGroupCommand(gt, "all", []string{"greenplace"})
+	if err != nil {
+		t.Fatalf("witnessGroupCommand: %v", err)
+	}
+	out, err := exec.Command("sh", "-c", command).Output()
+	if err != nil {
+		t.Fatalf("running %q: %v", command, err)
+	}
+	got := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	want := []string{"witness", "start", "--foreground",
+		"--metrics-addr=" + witnessMetricsAddr, "--events=" + witnessEventsPath,
+		"--name", "all", "greenplace"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("group session got args %q, want %q", got, want)
+	}
+}
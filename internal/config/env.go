@@ -6,6 +6,8 @@ import (
 	"os"
 	"sort"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/util"
 )
 
 // AgentEnvConfig specifies the configuration for generating agent environment variables.
@@ -119,6 +121,41 @@ func AgentEnvSimple(role, rig, agentName string) map[string]string {
 	})
 }
 
+// ParseEnvAssignments parses KEY=VALUE pairs, such as repeated --env
+// flags, into a map; a later pair for a key wins. The value may be empty
+// or contain "=", but the key must be a shell variable name, so a typo
+// like "--env API_KEY" fails here rather than being dropped.
+func ParseEnvAssignments(pairs []string) (map[string]string, error) {
+	env := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid env %q: want KEY=VALUE", pair)
+		}
+		if !IsEnvName(key) {
+			return nil, fmt.Errorf("invalid env %q: %q is not a valid variable name", pair, key)
+		}
+		env[key] = value
+	}
+	return env, nil
+}
+
+// IsEnvName reports whether s is a valid shell variable name.
+func IsEnvName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // ExportPrefix builds an export statement prefix for shell commands.
 // Returns a string like "export GT_ROLE=mayor BD_ACTOR=mayor && "
 // The keys are sorted for deterministic output.
@@ -136,12 +173,35 @@ func ExportPrefix(env map[string]string) string {
 
 	var parts []string
 	for _, k := range keys {
-		parts = append(parts, fmt.Sprintf("%s=%s", k, env[k]))
+		parts = append(parts, exportAssignment(k, env[k]))
 	}
 
 	return "export " + strings.Join(parts, " ") + " && "
 }
 
+// exportAssignment formats KEY=value for an export statement. Values with
+// shell metacharacters (a rig named "x;rm -rf ~", say) are single-quoted so
+// they stay data; plain values are left bare to keep commands readable.
+func exportAssignment(key, value string) string {
+	if strings.IndexFunc(value, isUnsafeShellRune) < 0 {
+		return key + "=" + value
+	}
+	return key + "=" + util.ShellQuote(value)
+}
+
+// isUnsafeShellRune reports whether r means something to the shell in an
+// assignment. "~" does: it is expanded at the start of the value and
+// after each ":".
+func isUnsafeShellRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	case strings.ContainsRune("_-./:@%+,=", r):
+		return false
+	}
+	return true
+}
+
 // BuildStartupCommandWithEnv builds a startup command with the given environment variables.
 // This combines the export prefix with the agent command and optional prompt.
 func BuildStartupCommandWithEnv(env map[string]string, agentCmd, prompt string) string {
@@ -201,6 +201,21 @@ func TestExportPrefix(t *testing.T) {
 			},
 			expected: "export AAA=first MMM=middle ZZZ=last && ",
 		},
+		{
+			name:     "shell metacharacters quoted",
+			env:      map[string]string{"GT_RIG": "a;b $(x)", "GT_ROOT": "/town/hq"},
+			expected: "export GT_RIG='a;b $(x)' GT_ROOT=/town/hq && ",
+		},
+		{
+			name:     "embedded single quote",
+			env:      map[string]string{"NAME": "it's"},
+			expected: `export NAME='it'\''s' && `,
+		},
+		{
+			name:     "tilde not expanded",
+			env:      map[string]string{"A": "~/x", "B": "a:~/b"},
+			expected: `export A='~/x' B='a:~/b' && `,
+		},
 	}
 
 	for _, tt := range tests {
@@ -324,3 +339,20 @@ func assertNotSet(t *testing.T, env map[string]string, key string) {
 		t.Errorf("env[%q] should not be set, but is %q", key, env[key])
 	}
 }
+
+func TestParseEnvAssignments(t *testing.T) {
+	t.Parallel()
+	env, err := ParseEnvAssignments([]string{"API_KEY=abc", "MODEL=a=b", "EMPTY=", "API_KEY=def"})
+	if err != nil {
+		t.Fatalf("ParseEnvAssignments: %v", err)
+	}
+	assertEnv(t, env, "API_KEY", "def")
+	assertEnv(t, env, "MODEL", "a=b")
+	assertEnv(t, env, "EMPTY", "")
+
+	for _, bad := range []string{"API_KEY", "=value", "1KEY=x", "MY-KEY=x", "MY KEY=x"} {
+		if _, err := ParseEnvAssignments([]string{bad}); err == nil {
+			t.Errorf("ParseEnvAssignments(%q) = nil error, want error", bad)
+		}
+	}
+}
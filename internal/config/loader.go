@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/constants"
@@ -113,6 +114,11 @@ func SaveRigsConfig(path string, config *RigsConfig) error {
 		return fmt.Errorf("writing config: %w", err)
 	}
 
+	// The registry changed; forget any prefixes read from it.
+	prefixCacheMu.Lock()
+	delete(prefixCache, filepath.Clean(path))
+	prefixCacheMu.Unlock()
+
 	return nil
 }
 
@@ -1256,7 +1262,7 @@ func BuildStartupCommand(envVars map[string]string, rigPath, prompt string) stri
 	// Build environment export prefix
 	var exports []string
 	for k, v := range resolvedEnv {
-		exports = append(exports, fmt.Sprintf("%s=%s", k, v))
+		exports = append(exports, exportAssignment(k, v))
 	}
 
 	// Sort for deterministic output
@@ -1285,7 +1291,7 @@ func PrependEnv(command string, envVars map[string]string) string {
 
 	var exports []string
 	for k, v := range envVars {
-		exports = append(exports, fmt.Sprintf("%s=%s", k, v))
+		exports = append(exports, exportAssignment(k, v))
 	}
 
 	sort.Strings(exports)
@@ -1357,7 +1363,7 @@ func BuildStartupCommandWithAgentOverride(envVars map[string]string, rigPath, pr
 	// Build environment export prefix
 	var exports []string
 	for k, v := range resolvedEnv {
-		exports = append(exports, fmt.Sprintf("%s=%s", k, v))
+		exports = append(exports, exportAssignment(k, v))
 	}
 	sort.Strings(exports)
 
@@ -1487,28 +1493,85 @@ func GetDefaultFormula(rigPath string) string {
 	return settings.Workflow.DefaultFormula
 }
 
+// Rig prefix cache. Flows that touch many beads would otherwise re-read
+// rigs.json for every lookup. Entries are keyed on the file's modification
+// time and size, so a rig added while a long-running process (the daemon)
+// is up is picked up by the next lookup.
+var (
+	// prefixCacheMu protects prefixCache.
+	prefixCacheMu sync.RWMutex
+	// prefixCache maps a rigs.json path to its parsed prefix table.
+	prefixCache = make(map[string]rigPrefixes)
+)
+
+// rigPrefixes is a rig name -> prefix table and the rigs.json it came from.
+type rigPrefixes struct {
+	modTime  time.Time
+	size     int64
+	prefixes map[string]string
+}
+
 // GetRigPrefix returns the beads prefix for a rig from rigs.json.
 // Falls back to "gt" if the rig isn't found or has no prefix configured.
 // townRoot is the path to the town directory (e.g., ~/gt).
+// Results are cached until rigs.json changes; a missing or unreadable file
+// is not cached.
 func GetRigPrefix(townRoot, rigName string) string {
-	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
-	rigsConfig, err := LoadRigsConfig(rigsConfigPath)
+	if prefix, ok := rigPrefixTable(filepath.Join(townRoot, "mayor", "rigs.json"))[rigName]; ok {
+		return prefix
+	}
+	return "gt" // fallback
+}
+
+// rigPrefixTable returns the prefix table for rigsConfigPath, re-reading the
+// file when it has changed since it was cached.
+func rigPrefixTable(rigsConfigPath string) map[string]string {
+	info, err := os.Stat(rigsConfigPath)
 	if err != nil {
-		return "gt" // fallback
+		return nil
 	}
 
-	entry, ok := rigsConfig.Rigs[rigName]
-	if !ok {
-		return "gt" // fallback
+	prefixCacheMu.RLock()
+	cached, ok := prefixCache[rigsConfigPath]
+	prefixCacheMu.RUnlock()
+	if ok && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.prefixes
 	}
 
-	if entry.BeadsConfig == nil || entry.BeadsConfig.Prefix == "" {
-		return "gt" // fallback
+	prefixes, err := loadRigPrefixes(rigsConfigPath)
+	if err != nil {
+		return nil
 	}
+	prefixCacheMu.Lock()
+	prefixCache[rigsConfigPath] = rigPrefixes{modTime: info.ModTime(), size: info.Size(), prefixes: prefixes}
+	prefixCacheMu.Unlock()
+	return prefixes
+}
+
+// loadRigPrefixes reads every configured rig prefix from rigs.json.
+func loadRigPrefixes(rigsConfigPath string) (map[string]string, error) {
+	rigsConfig, err := LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make(map[string]string)
+	for name, entry := range rigsConfig.Rigs {
+		if entry.BeadsConfig == nil || entry.BeadsConfig.Prefix == "" {
+			continue
+		}
+		// Strip trailing hyphen if present (prefix stored as "gt-" but used as "gt")
+		prefixes[name] = strings.TrimSuffix(entry.BeadsConfig.Prefix, "-")
+	}
+	return prefixes, nil
+}
 
-	// Strip trailing hyphen if present (prefix stored as "gt-" but used as "gt")
-	prefix := entry.BeadsConfig.Prefix
-	return strings.TrimSuffix(prefix, "-")
+// ResetPrefixCache drops all cached rig prefixes so the next GetRigPrefix
+// re-reads rigs.json. Intended for tests.
+func ResetPrefixCache() {
+	prefixCacheMu.Lock()
+	defer prefixCacheMu.Unlock()
+	prefixCache = make(map[string]rigPrefixes)
 }
 
 // EscalationConfigPath returns the standard path for escalation config in a town.
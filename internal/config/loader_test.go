@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -893,25 +894,31 @@ func TestRuntimeConfigBuildCommandWithPrompt(t *testing.T) {
 			name:   "with prompt",
 			rc:     DefaultRuntimeConfig(),
 			prompt: "gt prime",
-			want:   `claude --dangerously-skip-permissions "gt prime"`,
+			want:   `claude --dangerously-skip-permissions 'gt prime'`,
 		},
 		{
 			name:   "prompt with quotes",
 			rc:     DefaultRuntimeConfig(),
 			prompt: `Hello "world"`,
-			want:   `claude --dangerously-skip-permissions "Hello \"world\""`,
+			want:   `claude --dangerously-skip-permissions 'Hello "world"'`,
+		},
+		{
+			name:   "prompt with shell syntax",
+			rc:     DefaultRuntimeConfig(),
+			prompt: `it's $HOME`,
+			want:   `claude --dangerously-skip-permissions 'it'\''s $HOME'`,
 		},
 		{
 			name:   "config initial prompt used if no override",
 			rc:     &RuntimeConfig{Command: "aider", Args: []string{}, InitialPrompt: "/help"},
 			prompt: "",
-			want:   `aider "/help"`,
+			want:   `aider '/help'`,
 		},
 		{
 			name:   "override takes precedence over config",
 			rc:     &RuntimeConfig{Command: "aider", Args: []string{}, InitialPrompt: "/help"},
 			prompt: "custom prompt",
-			want:   `aider "custom prompt"`,
+			want:   `aider 'custom prompt'`,
 		},
 	}
 
@@ -2596,3 +2603,104 @@ func TestBuildStartupCommandWithAgentOverride_IncludesGTRoot(t *testing.T) {
 		t.Errorf("expected GT_ROOT=%s in command, got: %q", townRoot, cmd)
 	}
 }
+
+func TestGetRigPrefix_Cached(t *testing.T) {
+	ResetPrefixCache()
+	t.Cleanup(ResetPrefixCache)
+
+	townRoot := t.TempDir()
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	writeRigs := func(prefix string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(rigsPath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		data := `{"version":1,"rigs":{"gastown":{"git_url":"x","beads":{"repo":"local","prefix":"` + prefix + `"}}}}`
+		if err := os.WriteFile(rigsPath, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeRigs("gs-")
+	if got := GetRigPrefix(townRoot, "gastown"); got != "gs" {
+		t.Fatalf("GetRigPrefix = %q, want gs", got)
+	}
+	if got := GetRigPrefix(townRoot, "unknown"); got != "gt" {
+		t.Errorf("GetRigPrefix(unknown) = %q, want gt", got)
+	}
+
+	// Edits by another process are seen on the next lookup.
+	writeRigs("zz")
+	if got := GetRigPrefix(townRoot, "gastown"); got != "zz" {
+		t.Errorf("GetRigPrefix after external edit = %q, want zz", got)
+	}
+
+	// Saving through SaveRigsConfig invalidates the cache for that town.
+	cfg, err := LoadRigsConfig(rigsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := cfg.Rigs["gastown"]
+	entry.BeadsConfig.Prefix = "gn"
+	cfg.Rigs["gastown"] = entry
+	if err := SaveRigsConfig(rigsPath, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetRigPrefix(townRoot, "gastown"); got != "gn" {
+		t.Errorf("GetRigPrefix after save = %q, want gn", got)
+	}
+}
+
+func TestGetRigPrefix_MissingFileNotCached(t *testing.T) {
+	ResetPrefixCache()
+	t.Cleanup(ResetPrefixCache)
+
+	townRoot := t.TempDir()
+	if got := GetRigPrefix(townRoot, "gastown"); got != "gt" {
+		t.Fatalf("GetRigPrefix without rigs.json = %q, want gt", got)
+	}
+
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	if err := os.MkdirAll(filepath.Dir(rigsPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"version":1,"rigs":{"gastown":{"git_url":"x","beads":{"repo":"local","prefix":"gs"}}}}`
+	if err := os.WriteFile(rigsPath, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetRigPrefix(townRoot, "gastown"); got != "gs" {
+		t.Errorf("GetRigPrefix after rigs.json appears = %q, want gs", got)
+	}
+}
+
+func TestGetRigPrefix_Concurrent(t *testing.T) {
+	ResetPrefixCache()
+	t.Cleanup(ResetPrefixCache)
+
+	townRoot := t.TempDir()
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	if err := os.MkdirAll(filepath.Dir(rigsPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"version":1,"rigs":{"gastown":{"git_url":"x","beads":{"repo":"local","prefix":"gs"}}}}`
+	if err := os.WriteFile(rigsPath, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 32)
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := GetRigPrefix(townRoot, "gastown"); got != "gs" {
+				errs <- got
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for got := range errs {
+		t.Errorf("concurrent GetRigPrefix = %q, want gs", got)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/util"
 )
 
 // TownConfig represents the main town identity (mayor/town.json).
@@ -61,6 +63,13 @@ type TownSettings struct {
 	// Agent addresses like "gastown/crew/jack" become "gastown.crew.jack@{domain}".
 	// Default: "gastown.local"
 	AgentEmailDomain string `json:"agent_email_domain,omitempty"`
+
+	// SessionPrefix is the prefix of rig-level tmux session names. "{user}"
+	// is replaced by the username, so with "gt-{user}-" users sharing a
+	// host don't clobber each other's sessions. GT_SESSION_PREFIX
+	// overrides it.
+	// Default: "gt-"
+	SessionPrefix string `json:"session_prefix,omitempty"`
 }
 
 // NewTownSettings creates a new TownSettings with defaults.
@@ -364,7 +373,7 @@ func (rc *RuntimeConfig) BuildCommandWithPrompt(prompt string) string {
 	}
 
 	// Quote the prompt for shell safety
-	return base + " " + quoteForShell(p)
+	return base + " " + util.ShellQuote(p)
 }
 
 // BuildArgsWithPrompt returns the runtime command and args suitable for exec.
@@ -577,14 +586,6 @@ func defaultInstructionsFile(provider string) string {
 	return "CLAUDE.md"
 }
 
-// quoteForShell quotes a string for safe shell usage.
-func quoteForShell(s string) string {
-	// Simple quoting: wrap in double quotes, escape internal quotes
-	escaped := strings.ReplaceAll(s, `\`, `\\`)
-	escaped = strings.ReplaceAll(escaped, `"`, `\"`)
-	return `"` + escaped + `"`
-}
-
 // ThemeConfig represents tmux theme settings for a rig.
 type ThemeConfig struct {
 	// Name picks from the default palette (e.g., "ocean", "forest").
@@ -610,6 +611,10 @@ type TownThemeConfig struct {
 	// RoleDefaults sets default themes for roles across all rigs.
 	// Keys: "witness", "refinery", "crew", "polecat"
 	RoleDefaults map[string]string `json:"role_defaults,omitempty"`
+
+	// RigThemes pins rigs to palette themes by rig name, overriding the
+	// hash-based assignment (gt theme set <rig> <theme>).
+	RigThemes map[string]string `json:"rig_themes,omitempty"`
 }
 
 // BuiltinRoleThemes returns the default themes for each role.
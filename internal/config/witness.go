@@ -0,0 +1,166 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// WitnessConfigSource says which witness config file a setting comes from.
+type WitnessConfigSource string
+
+const (
+	// WitnessSourceTown is the town's settings/witness.toml (or .json).
+	WitnessSourceTown WitnessConfigSource = "town"
+
+	// WitnessSourceRig is the rig's .gastown/witness.toml (or .json), which
+	// lives in the rig's repo so its thresholds travel with it.
+	WitnessSourceRig WitnessConfigSource = "rig"
+)
+
+// WitnessSetting is one witness setting read from a config file.
+type WitnessSetting struct {
+	Value  string              `json:"value"`
+	Source WitnessConfigSource `json:"source"`
+	Path   string              `json:"path"`
+}
+
+// witnessConfigExts are the witness config file formats, in the order
+// they are looked for.
+var witnessConfigExts = []string{".toml", ".json"}
+
+// TownWitnessConfigPath returns the base path (without extension) of the
+// town's witness config file.
+func TownWitnessConfigPath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", "witness")
+}
+
+// RigWitnessConfigPath returns the base path (without extension) of a
+// rig's witness config file.
+func RigWitnessConfigPath(rigPath string) string {
+	return filepath.Join(rigPath, ".gastown", "witness")
+}
+
+// LoadWitnessConfig reads the town and rig witness config files and merges
+// them, the rig's settings winning over the town's. Either file may be
+// missing; an empty townRoot skips the town file. The files are flat
+// tables of witness config keys (see gt witness config list), e.g.
+//
+//	stuck_threshold = "45m"
+//	escalation_threshold = 5
+//	nudge_denylist = ["scratch-*"]
+//
+// Values come back as the strings gt witness config set takes: numbers
+// and booleans formatted, lists joined with commas. The keys themselves
+// are checked by the witness, which knows them.
+func LoadWitnessConfig(rigPath, townRoot string) (map[string]WitnessSetting, error) {
+	merged := make(map[string]WitnessSetting)
+	layers := []struct {
+		base   string
+		source WitnessConfigSource
+	}{
+		{TownWitnessConfigPath(townRoot), WitnessSourceTown},
+		{RigWitnessConfigPath(rigPath), WitnessSourceRig},
+	}
+	for _, layer := range layers {
+		if layer.source == WitnessSourceTown && townRoot == "" {
+			continue
+		}
+		path, values, err := loadWitnessConfigFile(layer.base)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range values {
+			merged[key] = WitnessSetting{Value: value, Source: layer.source, Path: path}
+		}
+	}
+	return merged, nil
+}
+
+// loadWitnessConfigFile reads base.toml or base.json, whichever exists,
+// returning its path and values. Neither existing is not an error.
+func loadWitnessConfigFile(base string) (string, map[string]string, error) {
+	var found []string
+	for _, ext := range witnessConfigExts {
+		if _, err := os.Stat(base + ext); err == nil {
+			found = append(found, base+ext)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", nil, nil
+	case 1:
+	default:
+		return "", nil, fmt.Errorf("both %s and %s exist; keep one", found[0], found[1])
+	}
+
+	path := found[0]
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return "", nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	raw := make(map[string]interface{})
+	if filepath.Ext(path) == ".toml" {
+		err = toml.Unmarshal(data, &raw)
+	} else {
+		err = json.Unmarshal(data, &raw)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(raw))
+	for key, v := range raw {
+		s, err := witnessSettingString(v)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %s: %w", path, key, err)
+		}
+		values[key] = s
+	}
+	return path, values, nil
+}
+
+// witnessSettingString formats a decoded config value the way gt witness
+// config set takes it.
+func witnessSettingString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, err := witnessSettingString(item)
+			if err != nil {
+				return "", err
+			}
+			if strings.Contains(s, ",") {
+				return "", fmt.Errorf("list item %q contains a comma", s)
+			}
+			items = append(items, s)
+		}
+		return strings.Join(items, ","), nil
+	}
+	return "", fmt.Errorf("want a string, number, boolean or list, got %T", v)
+}
+
+// WitnessSettingKeys returns the keys of settings, sorted.
+func WitnessSettingKeys(settings map[string]WitnessSetting) []string {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeWitnessConfig(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadWitnessConfig(t *testing.T) {
+	const townTOML = `stuck_threshold = "40m"
+idle_threshold = "5m"
+escalation_threshold = 4
+`
+	const rigTOML = `stuck_threshold = "45m"
+adaptive_interval = true
+nudge_denylist = ["scratch-*", "tmp"]
+`
+	tests := []struct {
+		name      string
+		town, rig string // file contents; "" means no file
+		rigExt    string
+		want      map[string]WitnessSetting
+	}{
+		{name: "no files", want: map[string]WitnessSetting{}},
+		{
+			name: "town only",
+			town: townTOML,
+			want: map[string]WitnessSetting{
+				"stuck_threshold":      {Value: "40m", Source: WitnessSourceTown},
+				"idle_threshold":       {Value: "5m", Source: WitnessSourceTown},
+				"escalation_threshold": {Value: "4", Source: WitnessSourceTown},
+			},
+		},
+		{
+			name: "rig only",
+			rig:  rigTOML,
+			want: map[string]WitnessSetting{
+				"stuck_threshold":   {Value: "45m", Source: WitnessSourceRig},
+				"adaptive_interval": {Value: "true", Source: WitnessSourceRig},
+				"nudge_denylist":    {Value: "scratch-*,tmp", Source: WitnessSourceRig},
+			},
+		},
+		{
+			name: "rig wins over town",
+			town: townTOML,
+			rig:  rigTOML,
+			want: map[string]WitnessSetting{
+				"stuck_threshold":      {Value: "45m", Source: WitnessSourceRig},
+				"idle_threshold":       {Value: "5m", Source: WitnessSourceTown},
+				"escalation_threshold": {Value: "4", Source: WitnessSourceTown},
+				"adaptive_interval":    {Value: "true", Source: WitnessSourceRig},
+				"nudge_denylist":       {Value: "scratch-*,tmp", Source: WitnessSourceRig},
+			},
+		},
+		{
+			name:   "json rig file",
+			town:   townTOML,
+			rig:    `{"stuck_threshold": "50m", "escalation_threshold": 2}`,
+			rigExt: ".json",
+			want: map[string]WitnessSetting{
+				"stuck_threshold":      {Value: "50m", Source: WitnessSourceRig},
+				"idle_threshold":       {Value: "5m", Source: WitnessSourceTown},
+				"escalation_threshold": {Value: "2", Source: WitnessSourceRig},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			townRoot, rigPath := t.TempDir(), t.TempDir()
+			paths := map[WitnessConfigSource]string{}
+			if tt.town != "" {
+				paths[WitnessSourceTown] = TownWitnessConfigPath(townRoot) + ".toml"
+				writeWitnessConfig(t, paths[WitnessSourceTown], tt.town)
+			}
+			if tt.rig != "" {
+				ext := tt.rigExt
+				if ext == "" {
+					ext = ".toml"
+				}
+				paths[WitnessSourceRig] = RigWitnessConfigPath(rigPath) + ext
+				writeWitnessConfig(t, paths[WitnessSourceRig], tt.rig)
+			}
+
+			got, err := LoadWitnessConfig(rigPath, townRoot)
+			if err != nil {
+				t.Fatalf("LoadWitnessConfig: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got %d settings, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for key, want := range tt.want {
+				want.Path = paths[want.Source]
+				if got[key] != want {
+					t.Errorf("%s = %+v, want %+v", key, got[key], want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadWitnessConfig_NoTownRoot(t *testing.T) {
+	rigPath := t.TempDir()
+	writeWitnessConfig(t, RigWitnessConfigPath(rigPath)+".toml", `idle_threshold = "3m"`)
+	got, err := LoadWitnessConfig(rigPath, "")
+	if err != nil || got["idle_threshold"].Value != "3m" {
+		t.Errorf("LoadWitnessConfig = %+v, %v; want the rig's idle_threshold", got, err)
+	}
+}
+
+func TestLoadWitnessConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{"both formats", map[string]string{".toml": `idle_threshold = "3m"`, ".json": `{}`}, "keep one"},
+		{"nested table", map[string]string{".toml": "[quiet_hours]\nstart = \"22:00\"\n"}, "want a string"},
+		{"bad toml", map[string]string{".toml": "idle_threshold = "}, "parsing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rigPath := t.TempDir()
+			for ext, content := range tt.files {
+				writeWitnessConfig(t, RigWitnessConfigPath(rigPath)+ext, content)
+			}
+			_, err := LoadWitnessConfig(rigPath, "")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
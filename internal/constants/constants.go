@@ -16,6 +16,10 @@ const (
 	// ShellReadyTimeout is how long to wait for shell prompt after command.
 	ShellReadyTimeout = 5 * time.Second
 
+	// PromptWaitTimeout is how long SendKeysConfirm callers wait for an
+	// agent's input prompt before giving up on confirmation.
+	PromptWaitTimeout = 15 * time.Second
+
 	// DefaultDebounceMs is the default debounce for SendKeys operations.
 	// 500ms is required for Claude Code to reliably process paste before Enter.
 	// See NudgeSession comment: "Wait 500ms for paste to complete (tested, required)"
@@ -108,12 +112,10 @@ const (
 
 // Tmux session names.
 // Mayor and Deacon use hq- prefix: hq-mayor, hq-deacon (town-level, one per machine).
-// Rig-level services use gt- prefix: gt-<rig>-witness, gt-<rig>-refinery, etc.
+// Rig-level services use the configurable session.RigPrefix() (gt- by
+// default): gt-<rig>-witness, gt-<rig>-refinery, etc.
 // Use session.MayorSessionName() and session.DeaconSessionName().
 const (
-	// SessionPrefix is the prefix for rig-level Gas Town tmux sessions.
-	SessionPrefix = "gt-"
-
 	// HQSessionPrefix is the prefix for town-level services (Mayor, Deacon).
 	HQSessionPrefix = "hq-"
 )
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -410,7 +409,8 @@ func (m *Manager) Pristine(name string) (*PristineResult, error) {
 
 // runBdSync runs bd sync in the given directory.
 func (m *Manager) runBdSync(dir string) error {
-	cmd := exec.Command("bd", "sync")
+	cmd, cancel := beads.Command("sync")
+	defer cancel()
 	cmd.Dir = dir
 	return cmd.Run()
 }
@@ -434,7 +434,7 @@ func (m *Manager) setupSharedBeads(crewPath string) error {
 
 // SessionName returns the tmux session name for a crew member.
 func (m *Manager) SessionName(name string) string {
-	return fmt.Sprintf("gt-%s-crew-%s", m.rig.Name, name)
+	return session.CrewSessionName(m.rig.Name, name)
 }
 
 // Start creates and starts a tmux session for a crew member.
@@ -536,7 +536,7 @@ func (m *Manager) Start(name string, opts StartOptions) error {
 	}
 
 	// Apply rig-based theming (non-fatal: theming failure doesn't affect operation)
-	theme := tmux.AssignTheme(m.rig.Name)
+	theme := tmux.AssignThemeForTown(townRoot, m.rig.Name)
 	_ = t.ConfigureGasTownSession(sessionID, theme, m.rig.Name, name, "crew")
 
 	// Set up C-b n/p keybindings for crew session cycling (non-fatal)
@@ -3,6 +3,7 @@ package daemon
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -414,10 +415,14 @@ func (d *Daemon) ensureWitnessRunning(rigName string) {
 	mgr := witness.NewManager(r)
 
 	if err := mgr.Start(false, "", nil); err != nil {
-		if err == witness.ErrAlreadyRunning {
+		if errors.Is(err, witness.ErrAlreadyRunning) {
 			// Already running - nothing to do
 			return
 		}
+		if errors.Is(err, witness.ErrCrashLoop) {
+			d.logger.Printf("Not restarting witness for %s: crash loop (gt witness start %s to retry)", rigName, rigName)
+			return
+		}
 		d.logger.Printf("Error starting witness for %s: %v", rigName, err)
 		return
 	}
@@ -736,7 +741,7 @@ func listPolecatWorktrees(polecatsDir string) ([]string, error) {
 // If the polecat has work-on-hook but the tmux session is dead, it's restarted.
 func (d *Daemon) checkPolecatHealth(rigName, polecatName string) {
 	// Build the expected tmux session name
-	sessionName := fmt.Sprintf("gt-%s-%s", rigName, polecatName)
+	sessionName := session.PolecatSessionName(rigName, polecatName)
 
 	// Check if tmux session exists
 	sessionAlive, err := d.tmux.HasSession(sessionName)
@@ -882,7 +887,7 @@ func (d *Daemon) restartPolecatSession(rigName, polecatName, sessionName string)
 	}
 
 	// Apply theme
-	theme := tmux.AssignTheme(rigName)
+	theme := tmux.AssignThemeForTown(d.config.TownRoot, rigName)
 	_ = d.tmux.ConfigureGasTownSession(sessionName, theme, rigName, polecatName, "polecat")
 
 	// Set pane-died hook for future crash detection
@@ -322,11 +322,11 @@ func (d *Daemon) identityToSession(identity string) string {
 	case "deacon":
 		return session.DeaconSessionName()
 	case "witness", "refinery":
-		return fmt.Sprintf("gt-%s-%s", parsed.RigName, parsed.RoleType)
+		return fmt.Sprintf("%s%s-%s", session.RigPrefix(), parsed.RigName, parsed.RoleType)
 	case "crew":
-		return fmt.Sprintf("gt-%s-crew-%s", parsed.RigName, parsed.AgentName)
+		return session.CrewSessionName(parsed.RigName, parsed.AgentName)
 	case "polecat":
-		return fmt.Sprintf("gt-%s-%s", parsed.RigName, parsed.AgentName)
+		return fmt.Sprintf("%s%s-%s", session.RigPrefix(), parsed.RigName, parsed.AgentName)
 	default:
 		return ""
 	}
@@ -544,7 +544,7 @@ func (d *Daemon) applySessionTheme(sessionName string, parsed *ParsedIdentity) {
 		theme := tmux.MayorTheme()
 		_ = d.tmux.ConfigureGasTownSession(sessionName, theme, "", "Mayor", "coordinator")
 	} else if parsed.RigName != "" {
-		theme := tmux.AssignTheme(parsed.RigName)
+		theme := tmux.AssignThemeForTown(d.config.TownRoot, parsed.RigName)
 		_ = d.tmux.ConfigureGasTownSession(sessionName, theme, parsed.RigName, parsed.RoleType, parsed.RoleType)
 	}
 }
@@ -602,7 +602,8 @@ func (d *Daemon) syncWorkspace(workDir string) {
 	stderr.Reset()
 
 	// Sync beads
-	bdCmd := exec.Command("bd", "sync")
+	bdCmd, cancel := beads.Command("sync")
+	defer cancel()
 	bdCmd.Dir = workDir
 	bdCmd.Stderr = &stderr
 	if err := bdCmd.Run(); err != nil {
@@ -657,7 +658,8 @@ func (d *Daemon) getAgentBeadState(agentBeadID string) (string, error) {
 
 // getAgentBeadInfo fetches and parses an agent bead by ID.
 func (d *Daemon) getAgentBeadInfo(agentBeadID string) (*AgentBeadInfo, error) {
-	cmd := exec.Command("bd", "show", agentBeadID, "--json")
+	cmd, cancel := beads.Command("show", agentBeadID, "--json")
+	defer cancel()
 	cmd.Dir = d.config.TownRoot
 
 	output, err := cmd.Output()
@@ -795,7 +797,8 @@ func (d *Daemon) checkGUPPViolations() {
 func (d *Daemon) checkRigGUPPViolations(rigName string) {
 	// List polecat agent beads for this rig
 	// Pattern: <prefix>-<rig>-polecat-<name> (e.g., gt-gastown-polecat-Toast)
-	cmd := exec.Command("bd", "list", "--type=agent", "--json")
+	cmd, cancel := beads.Command("list", "--type=agent", "--json")
+	defer cancel()
 	cmd.Dir = d.config.TownRoot
 
 	output, err := cmd.Output()
@@ -836,7 +839,7 @@ func (d *Daemon) checkRigGUPPViolations(rigName string) {
 		// Per gt-zecmc: derive running state from tmux, not agent_state
 		// Extract polecat name from agent ID (<prefix>-<rig>-polecat-<name> -> <name>)
 		polecatName := strings.TrimPrefix(agent.ID, prefix)
-		sessionName := fmt.Sprintf("gt-%s-%s", rigName, polecatName)
+		sessionName := session.PolecatSessionName(rigName, polecatName)
 
 		// Check if tmux session exists and Claude is running
 		if d.tmux.IsClaudeRunning(sessionName) {
@@ -893,7 +896,8 @@ func (d *Daemon) checkOrphanedWork() {
 
 // checkRigOrphanedWork checks polecats in a specific rig for orphaned work.
 func (d *Daemon) checkRigOrphanedWork(rigName string) {
-	cmd := exec.Command("bd", "list", "--type=agent", "--json")
+	cmd, cancel := beads.Command("list", "--type=agent", "--json")
+	defer cancel()
 	cmd.Dir = d.config.TownRoot
 
 	output, err := cmd.Output()
@@ -928,7 +932,7 @@ func (d *Daemon) checkRigOrphanedWork(rigName string) {
 
 		// Check if tmux session is alive (derive state from tmux, not bead)
 		polecatName := strings.TrimPrefix(agent.ID, prefix)
-		sessionName := fmt.Sprintf("gt-%s-%s", rigName, polecatName)
+		sessionName := session.PolecatSessionName(rigName, polecatName)
 
 		// Session running = not orphaned (work is being processed)
 		if d.tmux.IsClaudeRunning(sessionName) {
@@ -4,10 +4,10 @@ package deacon
 import (
 	"encoding/json"
 	"fmt"
-	"os/exec"
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
 )
@@ -122,7 +122,8 @@ func ScanStaleHooks(townRoot string, cfg *StaleHookConfig) (*StaleHookScanResult
 
 // listHookedBeads returns all beads with status=hooked.
 func listHookedBeads(townRoot string) ([]*HookedBead, error) {
-	cmd := exec.Command("bd", "list", "--status=hooked", "--json", "--limit=0")
+	cmd, cancel := beads.Command("list", "--status=hooked", "--json", "--limit=0")
+	defer cancel()
 	cmd.Dir = townRoot
 
 	output, err := cmd.Output()
@@ -167,7 +168,7 @@ func assigneeToSessionName(assignee string) string {
 		rig, role := parts[0], parts[1]
 		switch role {
 		case "witness", "refinery":
-			return fmt.Sprintf("gt-%s-%s", rig, role)
+			return fmt.Sprintf("%s%s-%s", session.RigPrefix(), rig, role)
 		default:
 			return ""
 		}
@@ -176,9 +177,9 @@ func assigneeToSessionName(assignee string) string {
 		rig, agentType, name := parts[0], parts[1], parts[2]
 		switch agentType {
 		case "polecats":
-			return fmt.Sprintf("gt-%s-%s", rig, name)
+			return session.PolecatSessionName(rig, name)
 		case "crew":
-			return fmt.Sprintf("gt-%s-crew-%s", rig, name)
+			return session.CrewSessionName(rig, name)
 		default:
 			return ""
 		}
@@ -189,7 +190,8 @@ func assigneeToSessionName(assignee string) string {
 
 // unhookBead sets a bead's status back to 'open'.
 func unhookBead(townRoot, beadID string) error {
-	cmd := exec.Command("bd", "update", beadID, "--status=open")
+	cmd, cancel := beads.Command("update", beadID, "--status=open")
+	defer cancel()
 	cmd.Dir = townRoot
 	return cmd.Run()
 }
@@ -2,8 +2,9 @@ package doctor
 
 import (
 	"bytes"
-	"os/exec"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // BdDaemonCheck verifies that the bd (beads) daemon is running and healthy.
@@ -29,7 +30,8 @@ func NewBdDaemonCheck() *BdDaemonCheck {
 // Run checks if the bd daemon is running and healthy.
 func (c *BdDaemonCheck) Run(ctx *CheckContext) *CheckResult {
 	// Check daemon status
-	cmd := exec.Command("bd", "daemon", "--status")
+	cmd, cancel := beads.Command("daemon", "--status")
+	defer cancel()
 	cmd.Dir = ctx.TownRoot
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -41,7 +43,8 @@ func (c *BdDaemonCheck) Run(ctx *CheckContext) *CheckResult {
 	// Check if daemon is running
 	if err == nil && strings.Contains(output, "Daemon is running") {
 		// Daemon is running, now check health
-		healthCmd := exec.Command("bd", "daemon", "--health")
+		healthCmd, cancel := beads.Command("daemon", "--health")
+		defer cancel()
 		healthCmd.Dir = ctx.TownRoot
 		var healthOut bytes.Buffer
 		healthCmd.Stdout = &healthOut
@@ -82,7 +85,8 @@ func (c *BdDaemonCheck) Run(ctx *CheckContext) *CheckResult {
 
 // tryStartDaemon attempts to start the bd daemon and returns any error output.
 func (c *BdDaemonCheck) tryStartDaemon(ctx *CheckContext) *startError {
-	cmd := exec.Command("bd", "daemon", "--start")
+	cmd, cancel := beads.Command("daemon", "--start")
+	defer cancel()
 	cmd.Dir = ctx.TownRoot
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -194,20 +198,23 @@ func (c *BdDaemonCheck) Fix(ctx *CheckContext) error {
 	if strings.Contains(startErr.output, "LEGACY DATABASE") ||
 		strings.Contains(startErr.output, "DATABASE MISMATCH") {
 
-		migrateCmd := exec.Command("bd", "migrate", "--update-repo-id", "--yes")
+		migrateCmd, cancel := beads.Command("migrate", "--update-repo-id", "--yes")
+		defer cancel()
 		migrateCmd.Dir = ctx.TownRoot
 		if err := migrateCmd.Run(); err != nil {
 			return err
 		}
 
 		// Try starting again
-		startCmd := exec.Command("bd", "daemon", "--start")
+		startCmd, cancel := beads.Command("daemon", "--start")
+		defer cancel()
 		startCmd.Dir = ctx.TownRoot
 		return startCmd.Run()
 	}
 
 	// For other errors, just try to start
-	startCmd := exec.Command("bd", "daemon", "--start")
+	startCmd, cancel := beads.Command("daemon", "--start")
+	defer cancel()
 	startCmd.Dir = ctx.TownRoot
 	return startCmd.Run()
 }
@@ -129,7 +129,8 @@ func (c *BeadsDatabaseCheck) Fix(ctx *CheckContext) error {
 		}
 
 		// Run bd sync to rebuild from JSONL
-		cmd := exec.Command("bd", "sync", "--from-main")
+		cmd, cancel := beads.Command("sync", "--from-main")
+		defer cancel()
 		cmd.Dir = ctx.TownRoot
 		var stderr bytes.Buffer
 		cmd.Stderr = &stderr
@@ -152,7 +153,8 @@ func (c *BeadsDatabaseCheck) Fix(ctx *CheckContext) error {
 				return err
 			}
 
-			cmd := exec.Command("bd", "sync", "--from-main")
+			cmd, cancel := beads.Command("sync", "--from-main")
+			defer cancel()
 			cmd.Dir = ctx.RigPath()
 			var stderr bytes.Buffer
 			cmd.Stderr = &stderr
@@ -453,7 +455,8 @@ type labelAdder interface {
 type realLabelAdder struct{}
 
 func (r *realLabelAdder) AddLabel(townRoot, id, label string) error {
-	cmd := exec.Command("bd", "label", "add", id, label)
+	cmd, cancel := beads.Command("label", "add", id, label)
+	defer cancel()
 	cmd.Dir = townRoot
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("adding %s label to %s: %s", label, id, strings.TrimSpace(string(output)))
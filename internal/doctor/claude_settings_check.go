@@ -204,7 +204,7 @@ func (c *ClaudeSettingsCheck) findSettingsFiles(townRoot string) []staleSettings
 				path:        witnessSettings,
 				agentType:   "witness",
 				rigName:     rigName,
-				sessionName: fmt.Sprintf("gt-%s-witness", rigName),
+				sessionName: session.WitnessSessionName(rigName),
 			})
 		}
 		witnessWrongSettings := filepath.Join(rigPath, "witness", "rig", ".claude", "settings.json")
@@ -213,7 +213,7 @@ func (c *ClaudeSettingsCheck) findSettingsFiles(townRoot string) []staleSettings
 				path:          witnessWrongSettings,
 				agentType:     "witness",
 				rigName:       rigName,
-				sessionName:   fmt.Sprintf("gt-%s-witness", rigName),
+				sessionName:   session.WitnessSessionName(rigName),
 				wrongLocation: true,
 			})
 		}
@@ -226,7 +226,7 @@ func (c *ClaudeSettingsCheck) findSettingsFiles(townRoot string) []staleSettings
 				path:        refinerySettings,
 				agentType:   "refinery",
 				rigName:     rigName,
-				sessionName: fmt.Sprintf("gt-%s-refinery", rigName),
+				sessionName: session.RefinerySessionName(rigName),
 			})
 		}
 		refineryWrongSettings := filepath.Join(rigPath, "refinery", "rig", ".claude", "settings.json")
@@ -235,7 +235,7 @@ func (c *ClaudeSettingsCheck) findSettingsFiles(townRoot string) []staleSettings
 				path:          refineryWrongSettings,
 				agentType:     "refinery",
 				rigName:       rigName,
-				sessionName:   fmt.Sprintf("gt-%s-refinery", rigName),
+				sessionName:   session.RefinerySessionName(rigName),
 				wrongLocation: true,
 			})
 		}
@@ -264,7 +264,7 @@ func (c *ClaudeSettingsCheck) findSettingsFiles(townRoot string) []staleSettings
 						path:          crewWrongSettings,
 						agentType:     "crew",
 						rigName:       rigName,
-						sessionName:   fmt.Sprintf("gt-%s-crew-%s", rigName, crewEntry.Name()),
+						sessionName:   session.CrewSessionName(rigName, crewEntry.Name()),
 						wrongLocation: true,
 					})
 				}
@@ -302,7 +302,7 @@ func (c *ClaudeSettingsCheck) findSettingsFiles(townRoot string) []staleSettings
 							path:          pcWrongSettings,
 							agentType:     "polecat",
 							rigName:       rigName,
-							sessionName:   fmt.Sprintf("gt-%s-%s", rigName, pcEntry.Name()),
+							sessionName:   session.PolecatSessionName(rigName, pcEntry.Name()),
 							wrongLocation: true,
 						})
 					}
@@ -1,5 +1,7 @@
 package doctor
 
+import "fmt"
+
 // Doctor manages and executes health checks.
 type Doctor struct {
 	checks []Check
@@ -37,7 +39,24 @@ func (d *Doctor) Run(ctx *CheckContext) *Report {
 	report := NewReport()
 
 	for _, check := range d.checks {
-		result := check.Run(ctx)
+		report.Add(runCheck(check, ctx))
+	}
+
+	return report
+}
+
+// runCheck runs one check and fills in its name and category. A check that
+// panics is reported as an error rather than taking the other checks down
+// with it.
+func runCheck(check Check, ctx *CheckContext) (result *CheckResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &CheckResult{
+				Name:    check.Name(),
+				Status:  StatusError,
+				Message: fmt.Sprintf("check crashed: %v", r),
+			}
+		}
 		// Ensure check name is populated
 		if result.Name == "" {
 			result.Name = check.Name()
@@ -46,10 +65,18 @@ func (d *Doctor) Run(ctx *CheckContext) *Report {
 		if cg, ok := check.(categoryGetter); ok && result.Category == "" {
 			result.Category = cg.Category()
 		}
-		report.Add(result)
-	}
+	}()
+	return check.Run(ctx)
+}
 
-	return report
+// fixCheck runs a check's fix, turning a panic into an error.
+func fixCheck(check Check, ctx *CheckContext) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("fix crashed: %v", r)
+		}
+	}()
+	return check.Fix(ctx)
 }
 
 // Fix runs all checks with auto-fix enabled where possible.
@@ -58,28 +85,14 @@ func (d *Doctor) Fix(ctx *CheckContext) *Report {
 	report := NewReport()
 
 	for _, check := range d.checks {
-		result := check.Run(ctx)
-		if result.Name == "" {
-			result.Name = check.Name()
-		}
-		// Set category from check if available
-		if cg, ok := check.(categoryGetter); ok && result.Category == "" {
-			result.Category = cg.Category()
-		}
+		result := runCheck(check, ctx)
 
 		// Attempt fix if check failed and is fixable
 		if result.Status != StatusOK && check.CanFix() {
-			err := check.Fix(ctx)
+			err := fixCheck(check, ctx)
 			if err == nil {
 				// Re-run check to verify fix worked
-				result = check.Run(ctx)
-				if result.Name == "" {
-					result.Name = check.Name()
-				}
-				// Set category again after re-run
-				if cg, ok := check.(categoryGetter); ok && result.Category == "" {
-					result.Category = cg.Category()
-				}
+				result = runCheck(check, ctx)
 				// Update message to indicate fix was applied
 				if result.Status == StatusOK {
 					result.Message = result.Message + " (fixed)"
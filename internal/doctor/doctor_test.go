@@ -2,6 +2,8 @@ package doctor
 
 import (
 	"bytes"
+	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -362,3 +364,65 @@ func TestFixableCheck(t *testing.T) {
 		t.Error("FixableCheck.CanFix() should return true")
 	}
 }
+
+// panicCheck is a test check whose Run panics.
+type panicCheck struct {
+	BaseCheck
+}
+
+func (p *panicCheck) Run(ctx *CheckContext) *CheckResult {
+	panic("boom")
+}
+
+func TestDoctor_RunRecoversPanic(t *testing.T) {
+	d := NewDoctor()
+	d.Register(&panicCheck{BaseCheck{CheckName: "crashy", CheckCategory: CategoryRig}})
+	d.Register(newMockCheck("after", StatusOK))
+
+	report := d.Run(&CheckContext{TownRoot: "/test"})
+	if report.Summary.Total != 2 || report.Summary.OK != 1 || report.Summary.Errors != 1 {
+		t.Fatalf("Run() summary = %+v, want the crash as an error and the next check run", report.Summary)
+	}
+	crashed := report.Checks[0]
+	if crashed.Name != "crashy" || crashed.Category != CategoryRig || !strings.Contains(crashed.Message, "boom") {
+		t.Errorf("crashed check result = %+v", crashed)
+	}
+}
+
+func TestReport_AddAll(t *testing.T) {
+	rig := NewReport()
+	rig.Add(&CheckResult{Name: "rig-is-git-repo", Status: StatusError})
+	report := NewReport()
+	report.Add(&CheckResult{Name: "tmux-available", Status: StatusOK})
+
+	report.AddAll("greenplace", rig)
+	if report.Summary.Total != 2 || report.Summary.Errors != 1 {
+		t.Errorf("summary = %+v, want 2 checks with 1 error", report.Summary)
+	}
+	if got := report.Checks[1].Name; got != "greenplace/rig-is-git-repo" {
+		t.Errorf("merged check name = %q", got)
+	}
+}
+
+func TestReport_JSON(t *testing.T) {
+	report := NewReport()
+	report.Add(&CheckResult{Name: "bd-available", Status: StatusWarning, Message: "slow", Category: CategoryInfrastructure})
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got struct {
+		Checks  []map[string]any `json:"checks"`
+		Summary map[string]int   `json:"summary"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(got.Checks) != 1 || got.Checks[0]["status"] != "warning" || got.Checks[0]["name"] != "bd-available" {
+		t.Errorf("checks = %v", got.Checks)
+	}
+	if got.Summary["warnings"] != 1 || got.Summary["total"] != 1 {
+		t.Errorf("summary = %v", got.Summary)
+	}
+}
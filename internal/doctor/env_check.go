@@ -72,7 +72,7 @@ func (c *EnvVarsCheck) Run(ctx *CheckContext) *CheckResult {
 	// Filter to Gas Town sessions only (gt-* and hq-*)
 	var gtSessions []string
 	for _, sess := range sessions {
-		if strings.HasPrefix(sess, "gt-") || strings.HasPrefix(sess, "hq-") {
+		if strings.HasPrefix(sess, session.RigPrefix()) || strings.HasPrefix(sess, "hq-") {
 			gtSessions = append(gtSessions, sess)
 		}
 	}
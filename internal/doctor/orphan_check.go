@@ -96,7 +96,7 @@ func (c *OrphanSessionCheck) Run(ctx *CheckContext) *CheckResult {
 		}
 
 		// Only check gt-* sessions (Gas Town sessions)
-		if !strings.HasPrefix(sess, "gt-") {
+		if !strings.HasPrefix(sess, session.RigPrefix()) {
 			continue
 		}
 
@@ -160,14 +160,11 @@ func (c *OrphanSessionCheck) Fix(ctx *CheckContext) error {
 
 // isCrewSession returns true if the session name matches the crew pattern.
 // Crew sessions are gt-<rig>-crew-<name> and are protected from auto-cleanup.
-func isCrewSession(session string) bool {
+func isCrewSession(sess string) bool {
 	// Pattern: gt-<rig>-crew-<name>
 	// Example: gt-gastown-crew-joe
-	parts := strings.Split(session, "-")
-	if len(parts) >= 4 && parts[0] == "gt" && parts[2] == "crew" {
-		return true
-	}
-	return false
+	id, err := session.ParseSessionName(sess)
+	return err == nil && id.Role == session.RoleCrew
 }
 
 // getValidRigs returns a list of valid rig names from the workspace.
@@ -220,13 +217,14 @@ func (c *OrphanSessionCheck) isValidSession(sess string, validRigs []string, may
 
 	// For rig-specific sessions, extract rig name
 	// Pattern: gt-<rig>-<role>
-	parts := strings.SplitN(sess, "-", 3)
-	if len(parts) < 3 {
+	rest, ok := strings.CutPrefix(sess, session.RigPrefix())
+	parts := strings.SplitN(rest, "-", 2)
+	if !ok || len(parts) < 2 {
 		// Invalid format - must be gt-<rig>-<something>
 		return false
 	}
 
-	rigName := parts[1]
+	rigName := parts[0]
 
 	// Check if this rig exists
 	rigFound := false
@@ -242,7 +240,7 @@ func (c *OrphanSessionCheck) isValidSession(sess string, validRigs []string, may
 		return false
 	}
 
-	role := parts[2]
+	role := parts[1]
 
 	// witness and refinery are valid roles
 	if role == "witness" || role == "refinery" {
@@ -373,11 +371,11 @@ func (c *OrphanProcessCheck) getTmuxSessionPIDs() (map[int]bool, error) { //noli
 	sessions, _ := t.ListSessions()
 	for _, session := range sessions {
 		// Get pane PIDs for this session
-		out, err := exec.Command("tmux", "list-panes", "-t", session, "-F", "#{pane_pid}").Output()
+		out, err := t.Run("list-panes", "-t", session, "-F", "#{pane_pid}")
 		if err != nil {
 			continue
 		}
-		for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		for _, line := range strings.Split(out, "\n") {
 			var pid int
 			if _, err := fmt.Sscanf(line, "%d", &pid); err == nil {
 				pids[pid] = true
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/session"
 )
 
 // mockSessionLister allows deterministic testing of orphan session detection.
@@ -146,6 +148,27 @@ func TestOrphanSessionCheck_IsValidSession(t *testing.T) {
 	}
 }
 
+// TestOrphanSessionCheck_CustomPrefix checks that sessions under a configured
+// session prefix are parsed after the prefix, not as gt-<rig>-<role>.
+func TestOrphanSessionCheck_CustomPrefix(t *testing.T) {
+	t.Setenv(session.PrefixEnv, "gt-alice-")
+	check := NewOrphanSessionCheck()
+	validRigs := []string{"gastown"}
+
+	for sess, want := range map[string]bool{
+		"gt-alice-gastown-witness":  true,
+		"gt-alice-gastown-crew-max": true,
+		"gt-alice-unknown-witness":  false,
+	} {
+		if got := check.isValidSession(sess, validRigs, "hq-mayor", "hq-deacon"); got != want {
+			t.Errorf("isValidSession(%q) = %v, want %v", sess, got, want)
+		}
+	}
+	if !isCrewSession("gt-alice-gastown-crew-max") {
+		t.Error("isCrewSession(gt-alice-gastown-crew-max) = false, want true")
+	}
+}
+
 // TestOrphanSessionCheck_IsValidSession_EdgeCases tests edge cases that have caused
 // false positives in production - sessions incorrectly detected as orphans.
 func TestOrphanSessionCheck_IsValidSession_EdgeCases(t *testing.T) {
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -93,7 +92,8 @@ func (c *PatrolMoleculesExistCheck) Run(ctx *CheckContext) *CheckResult {
 // checkPatrolMolecules returns missing patrol molecule titles for a rig.
 func (c *PatrolMoleculesExistCheck) checkPatrolMolecules(rigPath string) []string {
 	// List molecules using bd
-	cmd := exec.Command("bd", "list", "--type=molecule")
+	cmd, cancel := beads.Command("list", "--type=molecule")
+	defer cancel()
 	cmd.Dir = rigPath
 	output, err := cmd.Output()
 	if err != nil {
@@ -116,12 +116,13 @@ func (c *PatrolMoleculesExistCheck) Fix(ctx *CheckContext) error {
 		rigPath := filepath.Join(ctx.TownRoot, rigName)
 		for _, mol := range missing {
 			desc := getPatrolMoleculeDesc(mol)
-			cmd := exec.Command("bd", "create", //nolint:gosec // G204: args are constructed internally
+			cmd, cancel := beads.Command("create",
 				"--type=molecule",
 				"--title="+mol,
 				"--description="+desc,
 				"--priority=2",
 			)
+			defer cancel()
 			cmd.Dir = rigPath
 			if err := cmd.Run(); err != nil {
 				return fmt.Errorf("creating %s in %s: %w", mol, rigName, err)
@@ -84,7 +84,8 @@ func (c *RepoFingerprintCheck) Run(ctx *CheckContext) *CheckResult {
 // checkBeadsDir checks a single beads directory for repo fingerprint using bd doctor.
 func (c *RepoFingerprintCheck) checkBeadsDir(workDir, location string) *CheckResult {
 	// Run bd doctor --json to get fingerprint status
-	cmd := exec.Command("bd", "doctor", "--json")
+	cmd, cancel := beads.Command("doctor", "--json")
+	defer cancel()
 	cmd.Dir = workDir
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -163,7 +164,8 @@ func (c *RepoFingerprintCheck) Fix(ctx *CheckContext) error {
 	}
 
 	// Run bd migrate --update-repo-id
-	cmd := exec.Command("bd", "migrate", "--update-repo-id")
+	cmd, cancel := beads.Command("migrate", "--update-repo-id")
+	defer cancel()
 	cmd.Dir = filepath.Dir(c.beadsDir) // Parent of .beads directory
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 )
@@ -832,7 +833,8 @@ func (c *BeadsConfigValidCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	// Check if bd command works
-	cmd := exec.Command("bd", "stats", "--json")
+	cmd, cancel := beads.Command("stats", "--json")
+	defer cancel()
 	cmd.Dir = c.rigPath
 	if err := cmd.Run(); err != nil {
 		return &CheckResult{
@@ -845,7 +847,8 @@ func (c *BeadsConfigValidCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	// Check sync status
-	cmd = exec.Command("bd", "sync", "--status")
+	cmd, cancel = beads.Command("sync", "--status")
+	defer cancel()
 	cmd.Dir = c.rigPath
 	output, err := cmd.CombinedOutput()
 	c.needsSync = false
@@ -877,7 +880,8 @@ func (c *BeadsConfigValidCheck) Fix(ctx *CheckContext) error {
 		return nil
 	}
 
-	cmd := exec.Command("bd", "sync")
+	cmd, cancel := beads.Command("sync")
+	defer cancel()
 	cmd.Dir = c.rigPath
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -1043,7 +1047,8 @@ func (c *BeadsRedirectCheck) Fix(ctx *CheckContext) error {
 		}
 
 		// Run bd init with the configured prefix
-		cmd := exec.Command("bd", "init", "--prefix", prefix)
+		cmd, cancel := beads.Command("init", "--prefix", prefix)
+		defer cancel()
 		cmd.Dir = rigPath
 		if output, err := cmd.CombinedOutput(); err != nil {
 			// bd might not be installed - create minimal config.yaml
@@ -1056,7 +1061,8 @@ func (c *BeadsRedirectCheck) Fix(ctx *CheckContext) error {
 		} else {
 			_ = output // bd init succeeded
 			// Configure custom types for Gas Town (beads v0.46.0+)
-			configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+			configCmd, cancel := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
+			defer cancel()
 			configCmd.Dir = rigPath
 			_, _ = configCmd.CombinedOutput() // Ignore errors - older beads don't need this
 		}
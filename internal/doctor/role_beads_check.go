@@ -2,7 +2,6 @@ package doctor
 
 import (
 	"fmt"
-	"os/exec"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/beads"
@@ -100,12 +99,13 @@ func (c *RoleBeadsCheck) Fix(ctx *CheckContext) error {
 		}
 
 		// Create role bead using bd create --type=role
-		cmd := exec.Command("bd", "create",
+		cmd, cancel := beads.Command("create",
 			"--type=role",
 			"--id="+role.ID,
 			"--title="+role.Title,
 			"--description="+role.Desc,
 		)
+		defer cancel()
 		cmd.Dir = ctx.TownRoot
 		if output, err := cmd.CombinedOutput(); err != nil {
 			return fmt.Errorf("creating %s: %s", role.ID, strings.TrimSpace(string(output)))
@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
 )
 
@@ -44,7 +45,7 @@ func (c *ThemeCheck) Run(ctx *CheckContext) *CheckResult {
 	// Check for Gas Town sessions
 	var gtSessions []string
 	for _, s := range sessions {
-		if strings.HasPrefix(s, "gt-") {
+		if strings.HasPrefix(s, session.RigPrefix()) {
 			gtSessions = append(gtSessions, s)
 		}
 	}
@@ -100,13 +101,11 @@ func (c *ThemeCheck) Fix(ctx *CheckContext) error {
 
 // getSessionStatusLeft retrieves the status-left setting for a tmux session.
 func getSessionStatusLeft(session string) (string, error) {
-	cmd := exec.Command("tmux", "show-options", "-t", session, "status-left")
-	output, err := cmd.Output()
+	line, err := tmux.NewTmux().Run("show-options", "-t", session, "status-left")
 	if err != nil {
 		return "", err
 	}
 	// Parse: status-left "value"
-	line := strings.TrimSpace(string(output))
 	if idx := strings.Index(line, "\""); idx != -1 {
 		end := strings.LastIndex(line, "\"")
 		if end > idx {
@@ -2,7 +2,6 @@ package doctor
 
 import (
 	"fmt"
-	"os/exec"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/session"
@@ -45,9 +44,9 @@ func (c *LinkedPaneCheck) Run(ctx *CheckContext) *CheckResult {
 
 	// Filter to gt-* sessions only
 	var gtSessions []string
-	for _, session := range sessions {
-		if strings.HasPrefix(session, "gt-") {
-			gtSessions = append(gtSessions, session)
+	for _, sess := range sessions {
+		if strings.HasPrefix(sess, session.RigPrefix()) {
+			gtSessions = append(gtSessions, sess)
 		}
 	}
 
@@ -136,13 +135,13 @@ func (c *LinkedPaneCheck) getSessionPanes(session string) ([]string, error) {
 	// Get pane IDs using tmux list-panes with format
 	// Using #{pane_id} which gives us the unique pane identifier like %123
 	// Note: -s flag lists all panes in all windows of this session (not -a which is global)
-	out, err := exec.Command("tmux", "list-panes", "-t", session, "-s", "-F", "#{pane_id}").Output()
+	out, err := tmux.NewTmux().Run("list-panes", "-t", session, "-s", "-F", "#{pane_id}")
 	if err != nil {
 		return nil, err
 	}
 
 	var panes []string
-	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+	for _, line := range strings.Split(out, "\n") {
 		if line != "" {
 			panes = append(panes, line)
 		}
@@ -0,0 +1,122 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// bdVersionTimeout bounds how long the bd check waits for bd to answer.
+const bdVersionTimeout = 10 * time.Second
+
+// TmuxAvailableCheck verifies that tmux can be run and is recent enough.
+type TmuxAvailableCheck struct {
+	BaseCheck
+}
+
+// NewTmuxAvailableCheck creates a new tmux availability check.
+func NewTmuxAvailableCheck() *TmuxAvailableCheck {
+	return &TmuxAvailableCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "tmux-available",
+			CheckDescription: "Check tmux is installed and recent enough",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks that tmux -V works and compares the version against the
+// minimum known-good release.
+func (c *TmuxAvailableCheck) Run(ctx *CheckContext) *CheckResult {
+	out, err := tmux.NewTmux().Available()
+	if err != nil {
+		hint := "Install tmux (e.g. brew install tmux, apt install tmux)"
+		if !errors.Is(err, tmux.ErrNotInstalled) {
+			hint = "Check the tmux installation, or the --tmux-cmd wrapper if one is set"
+		}
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: err.Error(),
+			FixHint: hint,
+		}
+	}
+	v, err := tmux.ParseVersion(out)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("could not parse tmux version %q", out),
+		}
+	}
+	if !v.AtLeast(tmux.MinVersionMajor, tmux.MinVersionMinor) {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%s is older than tmux %d.%d; some gt features may not work", v, tmux.MinVersionMajor, tmux.MinVersionMinor),
+			FixHint: "Upgrade tmux",
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: v.String(),
+	}
+}
+
+// BdAvailableCheck verifies that the bd CLI is on PATH and runs.
+type BdAvailableCheck struct {
+	BaseCheck
+}
+
+// NewBdAvailableCheck creates a new bd availability check.
+func NewBdAvailableCheck() *BdAvailableCheck {
+	return &BdAvailableCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "bd-available",
+			CheckDescription: "Check the bd (beads) CLI is on PATH and runs",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks that bd is on PATH and that bd version succeeds.
+func (c *BdAvailableCheck) Run(ctx *CheckContext) *CheckResult {
+	path, err := exec.LookPath("bd")
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "bd not found on PATH",
+			FixHint: "Install beads (bd) and make sure it is on PATH",
+		}
+	}
+
+	cmdCtx, cancel := context.WithTimeout(context.Background(), bdVersionTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(cmdCtx, path, "version").CombinedOutput() //nolint:gosec // G204: path is from LookPath
+	if err != nil {
+		detail := strings.TrimSpace(string(out))
+		result := &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: fmt.Sprintf("bd found at %s but 'bd version' failed: %v", path, err),
+			FixHint: "Reinstall beads (bd)",
+		}
+		if detail != "" {
+			result.Details = []string{detail}
+		}
+		return result
+	}
+	version, _, _ := strings.Cut(strings.TrimSpace(string(out)), "\n")
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: version,
+	}
+}
@@ -0,0 +1,45 @@
+package doctor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBdAvailableCheck(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("PATH", dir)
+	check := NewBdAvailableCheck()
+
+	if r := check.Run(&CheckContext{}); r.Status != StatusError || !strings.Contains(r.Message, "not found") {
+		t.Errorf("without bd: %+v", r)
+	}
+
+	bd := filepath.Join(dir, "bd")
+	if err := os.WriteFile(bd, []byte("#!/bin/sh\necho 'bd version 0.30.0'\necho extra\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if r := check.Run(&CheckContext{}); r.Status != StatusOK || r.Message != "bd version 0.30.0" {
+		t.Errorf("with bd: %+v", r)
+	}
+
+	if err := os.WriteFile(bd, []byte("#!/bin/sh\necho 'database locked' >&2\nexit 2\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	r := check.Run(&CheckContext{})
+	if r.Status != StatusError || len(r.Details) != 1 || r.Details[0] != "database locked" {
+		t.Errorf("with failing bd: %+v", r)
+	}
+}
+
+func TestTmuxAvailableCheck(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	r := NewTmuxAvailableCheck().Run(&CheckContext{})
+	if r.Status == StatusError || !strings.HasPrefix(r.Message, "tmux") {
+		t.Errorf("with tmux installed: %+v", r)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/ui"
@@ -58,6 +59,12 @@ func (s CheckStatus) String() string {
 	}
 }
 
+// MarshalText encodes the status as "ok", "warning" or "error", for
+// JSON reports.
+func (s CheckStatus) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(s.String())), nil
+}
+
 // CheckContext provides context for running checks.
 type CheckContext struct {
 	TownRoot        string // Root directory of the Gas Town workspace
@@ -77,12 +84,12 @@ func (ctx *CheckContext) RigPath() string {
 
 // CheckResult represents the outcome of a health check.
 type CheckResult struct {
-	Name     string      // Check name
-	Status   CheckStatus // Result status
-	Message  string      // Primary result message
-	Details  []string    // Additional information
-	FixHint  string      // Suggestion if not auto-fixable
-	Category string      // Category for grouping (e.g., CategoryCore)
+	Name     string      `json:"name"`               // Check name
+	Status   CheckStatus `json:"status"`             // Result status
+	Message  string      `json:"message,omitempty"`  // Primary result message
+	Details  []string    `json:"details,omitempty"`  // Additional information
+	FixHint  string      `json:"fix_hint,omitempty"` // Suggestion if not auto-fixable
+	Category string      `json:"category,omitempty"` // Category for grouping (e.g., CategoryCore)
 }
 
 // Check defines the interface for a health check.
@@ -106,17 +113,17 @@ type Check interface {
 
 // ReportSummary summarizes the results of all checks.
 type ReportSummary struct {
-	Total    int
-	OK       int
-	Warnings int
-	Errors   int
+	Total    int `json:"total"`
+	OK       int `json:"ok"`
+	Warnings int `json:"warnings"`
+	Errors   int `json:"errors"`
 }
 
 // Report contains all check results and a summary.
 type Report struct {
-	Timestamp time.Time
-	Checks    []*CheckResult
-	Summary   ReportSummary
+	Timestamp time.Time      `json:"timestamp"`
+	Checks    []*CheckResult `json:"checks"`
+	Summary   ReportSummary  `json:"summary"`
 }
 
 // NewReport creates an empty report with the current timestamp.
@@ -142,6 +149,15 @@ func (r *Report) Add(result *CheckResult) {
 	}
 }
 
+// AddAll adds every result of another report, with prefix and "/" put in
+// front of its name, e.g. to tell apart the same check run for several rigs.
+func (r *Report) AddAll(prefix string, other *Report) {
+	for _, result := range other.Checks {
+		result.Name = prefix + "/" + result.Name
+		r.Add(result)
+	}
+}
+
 // HasErrors returns true if any check reported an error.
 func (r *Report) HasErrors() bool {
 	return r.Summary.Errors > 0
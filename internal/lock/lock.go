@@ -18,6 +18,8 @@ import (
 	"path/filepath"
 	"syscall"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 // Common errors
@@ -340,6 +342,12 @@ type execCmdWrapper struct {
 }
 
 func (c *execCmdWrapper) Output() ([]byte, error) {
+	if c.name == "tmux" {
+		// Through the tmux package, so a --tmux-cmd override and the
+		// call timeout apply
+		out, err := tmux.NewTmux().Run(c.args...)
+		return []byte(out), err
+	}
 	cmd := exec.Command(c.name, c.args...) //nolint:gosec // G204: command args are controlled internally
 	return cmd.Output()
 }
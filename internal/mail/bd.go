@@ -2,8 +2,9 @@ package mail
 
 import (
 	"bytes"
-	"os/exec"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // bdError represents an error from running a bd command.
@@ -40,7 +41,8 @@ func (e *bdError) ContainsError(substr string) bool {
 // extraEnv contains additional environment variables to set (e.g., "BD_IDENTITY=...").
 // Returns stdout bytes on success, or a *bdError on failure.
 func runBdCommand(args []string, workDir, beadsDir string, extraEnv ...string) ([]byte, error) {
-	cmd := exec.Command("bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Dir = workDir
 
 	env := append(cmd.Environ(), "BEADS_DIR="+beadsDir)
@@ -906,5 +906,5 @@ func addressToSessionID(address string) string {
 
 	// Polecat: gt-rig-polecat
 	// Refinery: gt-rig-refinery (if refinery has its own session)
-	return fmt.Sprintf("gt-%s-%s", rig, target)
+	return fmt.Sprintf("%s%s-%s", session.RigPrefix(), rig, target)
 }
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 	"time"
 
@@ -77,7 +76,8 @@ func (r *Recorder) RecordRun(record PluginRunRecord) (string, error) {
 		args = append(args, "--description="+record.Body)
 	}
 
-	cmd := exec.Command("bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Dir = r.townRoot
 	// Set BEADS_DIR explicitly to prevent inherited env vars from causing
 	// prefix mismatches when redirects are in play.
@@ -143,7 +143,8 @@ func (r *Recorder) queryRuns(pluginName string, limit int, since string) ([]*Plu
 		args = append(args, "--created-after="+sinceArg)
 	}
 
-	cmd := exec.Command("bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd, cancel := beads.Command(args...)
+	defer cancel()
 	cmd.Dir = r.townRoot
 	// Set BEADS_DIR explicitly to prevent inherited env vars from causing
 	// prefix mismatches when redirects are in play.
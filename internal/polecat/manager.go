@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -16,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -657,7 +657,7 @@ func (m *Manager) ReconcilePool() {
 	if m.tmux != nil {
 		poolNames := m.namePool.getNames()
 		for _, name := range poolNames {
-			sessionName := fmt.Sprintf("gt-%s-%s", m.rig.Name, name)
+			sessionName := session.PolecatSessionName(m.rig.Name, name)
 			hasSession, _ := m.tmux.HasSession(sessionName)
 			if hasSession {
 				namesWithSessions = append(namesWithSessions, name)
@@ -691,7 +691,7 @@ func (m *Manager) ReconcilePoolWith(namesWithDirs, namesWithSessions []string) {
 	if m.tmux != nil {
 		for _, name := range namesWithSessions {
 			if !dirSet[name] {
-				sessionName := fmt.Sprintf("gt-%s-%s", m.rig.Name, name)
+				sessionName := session.PolecatSessionName(m.rig.Name, name)
 				_ = m.tmux.KillSession(sessionName)
 			}
 		}
@@ -999,8 +999,8 @@ func (m *Manager) DetectStalePolecats(threshold int) ([]*StalenessInfo, error) {
 
 		// Check for active tmux session
 		// Session name follows pattern: gt-<rig>-<polecat>
-		sessionName := fmt.Sprintf("gt-%s-%s", m.rig.Name, p.Name)
-		info.HasActiveSession = checkTmuxSession(sessionName)
+		sessionName := session.PolecatSessionName(m.rig.Name, p.Name)
+		info.HasActiveSession = m.hasTmuxSession(sessionName)
 
 		// Check how far behind main
 		polecatGit := git.NewGit(p.ClonePath)
@@ -1027,11 +1027,15 @@ func (m *Manager) DetectStalePolecats(threshold int) ([]*StalenessInfo, error) {
 	return results, nil
 }
 
-// checkTmuxSession checks if a tmux session exists.
-func checkTmuxSession(sessionName string) bool {
-	// Use has-session command which returns 0 if session exists
-	cmd := exec.Command("tmux", "has-session", "-t", sessionName) //nolint:gosec // G204: sessionName is constructed internally
-	return cmd.Run() == nil
+// hasTmuxSession checks if a tmux session exists. A manager made without
+// tmux (just listing) still checks, through a fresh wrapper.
+func (m *Manager) hasTmuxSession(sessionName string) bool {
+	t := m.tmux
+	if t == nil {
+		t = tmux.NewTmux()
+	}
+	has, _ := t.HasSession(sessionName)
+	return has
 }
 
 // countCommitsBehind counts how many commits a worktree is behind origin/<defaultBranch>.
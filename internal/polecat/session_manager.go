@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -62,6 +62,11 @@ type SessionStartOptions struct {
 	// RuntimeConfigDir is resolved config directory for the runtime account.
 	// If set, this is injected as an environment variable.
 	RuntimeConfigDir string
+
+	// Env lists extra KEY=VALUE variables for the agent, such as API keys
+	// or model overrides. They are set on top of the agent environment
+	// and win over it.
+	Env []string
 }
 
 // SessionInfo contains information about a running polecat session.
@@ -93,7 +98,7 @@ type SessionInfo struct {
 
 // SessionName generates the tmux session name for a polecat.
 func (m *SessionManager) SessionName(polecat string) string {
-	return fmt.Sprintf("gt-%s-%s", m.rig.Name, polecat)
+	return session.PolecatSessionName(m.rig.Name, polecat)
 }
 
 // polecatDir returns the parent directory for a polecat.
@@ -142,6 +147,11 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		return fmt.Errorf("%w: %s", ErrPolecatNotFound, polecat)
 	}
 
+	extraEnv, err := config.ParseEnvAssignments(opts.Env)
+	if err != nil {
+		return err
+	}
+
 	sessionID := m.SessionName(polecat)
 
 	// Check if session already exists
@@ -179,6 +189,10 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	if runtimeConfig.Session != nil && runtimeConfig.Session.ConfigDirEnv != "" && opts.RuntimeConfigDir != "" {
 		command = config.PrependEnv(command, map[string]string{runtimeConfig.Session.ConfigDirEnv: opts.RuntimeConfigDir})
 	}
+	// The session environment set below only reaches processes started
+	// after it, such as a respawned agent, so the first one gets the extra
+	// variables exported in its command.
+	command = config.PrependEnv(command, extraEnv)
 
 	// Create session with command directly to avoid send-keys race condition.
 	// See: https://github.com/anthropics/gastown/issues/280
@@ -197,6 +211,9 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		RuntimeConfigDir: opts.RuntimeConfigDir,
 		BeadsNoDaemon:    true,
 	})
+	for k, v := range extraEnv {
+		envVars[k] = v
+	}
 	for k, v := range envVars {
 		debugSession("SetEnvironment "+k, m.tmux.SetEnvironment(sessionID, k, v))
 	}
@@ -210,7 +227,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	}
 
 	// Apply theme (non-fatal)
-	theme := tmux.AssignTheme(m.rig.Name)
+	theme := tmux.AssignThemeForTown(townRoot, m.rig.Name)
 	debugSession("ConfigureGasTownSession", m.tmux.ConfigureGasTownSession(sessionID, theme, m.rig.Name, polecat, "polecat"))
 
 	// Set pane-died hook for crash detection (non-fatal)
@@ -278,7 +295,8 @@ func (m *SessionManager) Stop(polecat string, force bool) error {
 
 // syncBeads runs bd sync in the given directory.
 func (m *SessionManager) syncBeads(workDir string) error {
-	cmd := exec.Command("bd", "sync")
+	cmd, cancel := beads.Command("sync")
+	defer cancel()
 	cmd.Dir = workDir
 	return cmd.Run()
 }
@@ -349,7 +367,7 @@ func (m *SessionManager) List() ([]SessionInfo, error) {
 		return nil, err
 	}
 
-	prefix := fmt.Sprintf("gt-%s-", m.rig.Name)
+	prefix := fmt.Sprintf("%s%s-", session.RigPrefix(), m.rig.Name)
 	var infos []SessionInfo
 
 	for _, sessionID := range sessions {
@@ -451,7 +469,8 @@ func (m *SessionManager) StopAll(force bool) error {
 
 // hookIssue pins an issue to a polecat's hook using bd update.
 func (m *SessionManager) hookIssue(issueID, agentID, workDir string) error {
-	cmd := exec.Command("bd", "update", issueID, "--status=hooked", "--assignee="+agentID) //nolint:gosec
+	cmd, cancel := beads.Command("update", issueID, "--status=hooked", "--assignee="+agentID)
+	defer cancel()
 	cmd.Dir = workDir
 	cmd.Stderr = os.Stderr
 	if err := cmd.Run(); err != nil {
@@ -59,7 +59,7 @@ func (m *Manager) stateFile() string {
 
 // SessionName returns the tmux session name for this refinery.
 func (m *Manager) SessionName() string {
-	return fmt.Sprintf("gt-%s-refinery", m.rig.Name)
+	return session.RefinerySessionName(m.rig.Name)
 }
 
 // loadState loads refinery state from disk.
@@ -210,7 +210,7 @@ func (m *Manager) Start(foreground bool, agentOverride string) error {
 	}
 
 	// Apply theme (non-fatal: theming failure doesn't affect operation)
-	theme := tmux.AssignTheme(m.rig.Name)
+	theme := tmux.AssignThemeForTown(filepath.Dir(m.rig.Path), m.rig.Name)
 	_ = t.ConfigureGasTownSession(sessionID, theme, m.rig.Name, "refinery", "refinery")
 
 	// Update state to running
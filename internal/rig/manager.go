@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -371,13 +371,15 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		// beads.db is gitignored so it won't exist after clone - we need to create it.
 		// bd init --prefix will create the database and auto-import from issues.jsonl.
 		if _, err := os.Stat(sourceBeadsDB); os.IsNotExist(err) {
-			cmd := exec.Command("bd", "init", "--prefix", opts.BeadsPrefix) // opts.BeadsPrefix validated earlier
+			cmd, cancel := beads.Command("init", "--prefix", opts.BeadsPrefix) // opts.BeadsPrefix validated earlier
+			defer cancel()
 			cmd.Dir = mayorRigPath
 			if output, err := cmd.CombinedOutput(); err != nil {
 				fmt.Printf("  Warning: Could not init bd database: %v (%s)\n", err, strings.TrimSpace(string(output)))
 			}
 			// Configure custom types for Gas Town (beads v0.46.0+)
-			configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+			configCmd, cancel := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
+			defer cancel()
 			configCmd.Dir = mayorRigPath
 			_, _ = configCmd.CombinedOutput() // Ignore errors - older beads don't need this
 		}
@@ -604,7 +606,8 @@ func (m *Manager) initBeads(rigPath, prefix string) error {
 	filteredEnv = append(filteredEnv, "BEADS_DIR="+beadsDir)
 
 	// Run bd init if available
-	cmd := exec.Command("bd", "init", "--prefix", prefix)
+	cmd, cancel := beads.Command("init", "--prefix", prefix)
+	defer cancel()
 	cmd.Dir = rigPath
 	cmd.Env = filteredEnv
 	_, err := cmd.CombinedOutput()
@@ -620,7 +623,8 @@ func (m *Manager) initBeads(rigPath, prefix string) error {
 
 	// Configure custom types for Gas Town (agent, role, rig, convoy).
 	// These were extracted from beads core in v0.46.0 and now require explicit config.
-	configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+	configCmd, cancel := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
+	defer cancel()
 	configCmd.Dir = rigPath
 	configCmd.Env = filteredEnv
 	// Ignore errors - older beads versions don't need this
@@ -629,7 +633,8 @@ func (m *Manager) initBeads(rigPath, prefix string) error {
 	// Ensure database has repository fingerprint (GH #25).
 	// This is idempotent - safe on both new and legacy (pre-0.17.5) databases.
 	// Without fingerprint, the bd daemon fails to start silently.
-	migrateCmd := exec.Command("bd", "migrate", "--update-repo-id")
+	migrateCmd, cancel := beads.Command("migrate", "--update-repo-id")
+	defer cancel()
 	migrateCmd.Dir = rigPath
 	migrateCmd.Env = filteredEnv
 	// Ignore errors - fingerprint is optional for functionality
@@ -912,6 +917,31 @@ func (m *Manager) ListRigNames() []string {
 	return names
 }
 
+// ListRigs returns a listing for every registered rig, sorted by name.
+// Rigs whose directory no longer exists are included with PathExists false.
+func (m *Manager) ListRigs() []RigListing {
+	names := m.ListRigNames()
+	sort.Strings(names)
+
+	listings := make([]RigListing, 0, len(names))
+	for _, name := range names {
+		entry := m.config.Rigs[name]
+		l := RigListing{
+			Name: name,
+			Path: filepath.Join(m.townRoot, name),
+		}
+		if entry.BeadsConfig != nil {
+			l.Prefix = entry.BeadsConfig.Prefix
+		}
+		if r, err := m.loadRig(name, entry); err == nil {
+			l.PathExists = true
+			l.PolecatCount = len(r.Polecats)
+		}
+		listings = append(listings, l)
+	}
+	return listings
+}
+
 // createRoleCLAUDEmd creates a minimal bootstrap pointer CLAUDE.md file.
 // Full context is injected ephemerally by `gt prime` at session start.
 // This keeps on-disk files small (<30 lines) per the priming architecture.
@@ -1046,7 +1076,8 @@ func (m *Manager) createPatrolHooks(workspacePath string, runtimeConfig *config.
 // These molecules define the work loops for Deacon, Witness, and Refinery roles.
 func (m *Manager) seedPatrolMolecules(rigPath string) error {
 	// Use bd command to seed molecules (more reliable than internal API)
-	cmd := exec.Command("bd", "mol", "seed", "--patrol")
+	cmd, cancel := beads.Command("mol", "seed", "--patrol")
+	defer cancel()
 	cmd.Dir = rigPath
 	if err := cmd.Run(); err != nil {
 		// Fallback: bd mol seed might not support --patrol yet
@@ -1079,7 +1110,8 @@ func (m *Manager) seedPatrolMoleculesManually(rigPath string) error {
 
 	for _, mol := range patrolMols {
 		// Check if already exists by title
-		checkCmd := exec.Command("bd", "list", "--type=molecule", "--format=json")
+		checkCmd, cancel := beads.Command("list", "--type=molecule", "--format=json")
+		defer cancel()
 		checkCmd.Dir = rigPath
 		output, _ := checkCmd.Output()
 		if strings.Contains(string(output), mol.title) {
@@ -1087,12 +1119,13 @@ func (m *Manager) seedPatrolMoleculesManually(rigPath string) error {
 		}
 
 		// Create the molecule
-		cmd := exec.Command("bd", "create", //nolint:gosec // G204: bd is a trusted internal tool
+		cmd, cancel := beads.Command("create",
 			"--type=molecule",
 			"--title="+mol.title,
 			"--description="+mol.desc,
 			"--priority=2",
 		)
+		defer cancel()
 		cmd.Dir = rigPath
 		if err := cmd.Run(); err != nil {
 			// Non-fatal, continue with others
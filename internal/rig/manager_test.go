@@ -230,6 +230,40 @@ func TestListRigNames(t *testing.T) {
 	}
 }
 
+func TestListRigs(t *testing.T) {
+	root, rigsConfig := setupTestTown(t)
+	createTestRig(t, root, "present")
+	rigsConfig.Rigs["present"] = config.RigEntry{BeadsConfig: &config.BeadsConfig{Prefix: "pr"}}
+	rigsConfig.Rigs["gone"] = config.RigEntry{}
+
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	listings := manager.ListRigs()
+	if len(listings) != 2 {
+		t.Fatalf("listings count = %d, want 2", len(listings))
+	}
+
+	gone, present := listings[0], listings[1]
+	if gone.Name != "gone" || present.Name != "present" {
+		t.Fatalf("listings not sorted by name: %q, %q", gone.Name, present.Name)
+	}
+	if gone.PathExists {
+		t.Error("expected PathExists = false for missing rig directory")
+	}
+	if gone.Path != filepath.Join(root, "gone") {
+		t.Errorf("Path = %q, want %q", gone.Path, filepath.Join(root, "gone"))
+	}
+	if !present.PathExists {
+		t.Error("expected PathExists = true")
+	}
+	if present.Prefix != "pr" {
+		t.Errorf("Prefix = %q, want pr", present.Prefix)
+	}
+	if present.PolecatCount != 2 {
+		t.Errorf("PolecatCount = %d, want 2", present.PolecatCount)
+	}
+}
+
 func TestRigSummary(t *testing.T) {
 	rig := &Rig{
 		Name:        "test",
@@ -69,6 +69,16 @@ func (r *Rig) Summary() RigSummary {
 	}
 }
 
+// RigListing is one registry entry as reported by ListRigs. Unlike Rig it
+// is produced even when the rig directory has gone missing.
+type RigListing struct {
+	Name         string `json:"name"`
+	Prefix       string `json:"prefix"`
+	Path         string `json:"path"`
+	PathExists   bool   `json:"path_exists"`
+	PolecatCount int    `json:"polecat_count"`
+}
+
 // BeadsPath returns the path to use for beads operations.
 // Always returns the rig root path where .beads/ contains either:
 //   - A local beads database (when repo doesn't track .beads/)
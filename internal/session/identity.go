@@ -51,12 +51,13 @@ func ParseSessionName(session string) (*AgentIdentity, error) {
 		return nil, fmt.Errorf("invalid session name %q: unknown hq- role", session)
 	}
 
-	// Rig-level roles use gt- prefix
-	if !strings.HasPrefix(session, Prefix) {
-		return nil, fmt.Errorf("invalid session name %q: missing %q or %q prefix", session, HQPrefix, Prefix)
+	// Rig-level roles use the rig prefix (gt- by default)
+	prefix := RigPrefix()
+	if !strings.HasPrefix(session, prefix) {
+		return nil, fmt.Errorf("invalid session name %q: missing %q or %q prefix", session, HQPrefix, prefix)
 	}
 
-	suffix := strings.TrimPrefix(session, Prefix)
+	suffix := strings.TrimPrefix(session, prefix)
 	if suffix == "" {
 		return nil, fmt.Errorf("invalid session name %q: empty after prefix", session)
 	}
@@ -4,12 +4,124 @@ package session
 import (
 	"fmt"
 	"os"
+	"os/user"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
 )
 
-// Prefix is the common prefix for rig-level Gas Town tmux sessions.
-const Prefix = "gt-"
+// DefaultPrefix is the session prefix used when none is configured. Users
+// sharing a host (and a tmux server) can opt in to their own sessions
+// with a prefix holding {user}, e.g. session_prefix "gt-{user}-".
+const DefaultPrefix = "gt-"
+
+// PrefixEnv overrides the rig-level session prefix, taking precedence
+// over the town's session_prefix setting.
+const PrefixEnv = "GT_SESSION_PREFIX"
+
+// userPlaceholder in a configured prefix is replaced by the username, so
+// one setting gives each user on a shared host their own sessions.
+const userPlaceholder = "{user}"
+
+var (
+	prefixMu      sync.RWMutex
+	configuredPfx string
+
+	validPrefix = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// SetPrefix sets the town's configured session prefix (settings
+// session_prefix); empty restores the default. Session names are built
+// from it unless GT_SESSION_PREFIX is set.
+func SetPrefix(prefix string) error {
+	if prefix != "" {
+		if _, err := expandPrefix(prefix); err != nil {
+			return err
+		}
+	}
+	prefixMu.Lock()
+	defer prefixMu.Unlock()
+	configuredPfx = prefix
+	return nil
+}
+
+// RigPrefix returns the prefix of rig-level session names: GT_SESSION_PREFIX
+// if set, else the town's session_prefix, else DefaultPrefix. Every
+// rig-level session name is built from and parsed with it, so gt commands,
+// the daemon and the agents agree on names. An invalid GT_SESSION_PREFIX is
+// ignored, as checking it everywhere a name is built isn't practical;
+// ValidatePrefix reports it.
+func RigPrefix() string {
+	if env := os.Getenv(PrefixEnv); env != "" {
+		if p, err := expandPrefix(env); err == nil {
+			return p
+		}
+	}
+	prefixMu.RLock()
+	configured := configuredPfx
+	prefixMu.RUnlock()
+	if configured != "" {
+		if p, err := expandPrefix(configured); err == nil {
+			return p
+		}
+	}
+	return DefaultPrefix
+}
+
+// LegacyName returns what a rig-level session name is under DefaultPrefix,
+// so sessions started before a custom prefix was configured can be found
+// and renamed. Returns "" when the prefix is the default or name doesn't
+// start with it.
+func LegacyName(name string) string {
+	p := RigPrefix()
+	rest, ok := strings.CutPrefix(name, p)
+	if p == DefaultPrefix || !ok {
+		return ""
+	}
+	return DefaultPrefix + rest
+}
+
+// ValidatePrefix checks a session prefix as it would be configured.
+func ValidatePrefix(prefix string) error {
+	_, err := expandPrefix(prefix)
+	return err
+}
+
+// expandPrefix substitutes the username for {user} and ensures the prefix
+// ends in "-". Only letters, digits, "_" and "-" are allowed: tmux reads
+// ":" and "." in a target as window and pane separators.
+func expandPrefix(prefix string) (string, error) {
+	p := prefix
+	if strings.Contains(p, userPlaceholder) {
+		p = strings.ReplaceAll(p, userPlaceholder, currentUsername())
+	}
+	if !strings.HasSuffix(p, "-") {
+		p += "-"
+	}
+	if !validPrefix.MatchString(p) || p == HQPrefix {
+		return "", fmt.Errorf("invalid session prefix %q: use letters, digits, '_' and '-' (e.g. gt-{user}-)", prefix)
+	}
+	return p, nil
+}
+
+// currentUsername returns the login name with characters a session name
+// can't hold replaced by "_".
+func currentUsername() string {
+	name := os.Getenv("USER")
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		name = u.Username
+	}
+	if name == "" {
+		name = "user"
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
 
 // HQPrefix is the prefix for town-level services (Mayor, Deacon).
 const HQPrefix = "hq-"
@@ -28,22 +140,22 @@ func DeaconSessionName() string {
 
 // WitnessSessionName returns the session name for a rig's Witness agent.
 func WitnessSessionName(rig string) string {
-	return fmt.Sprintf("%s%s-witness", Prefix, rig)
+	return fmt.Sprintf("%s%s-witness", RigPrefix(), rig)
 }
 
 // RefinerySessionName returns the session name for a rig's Refinery agent.
 func RefinerySessionName(rig string) string {
-	return fmt.Sprintf("%s%s-refinery", Prefix, rig)
+	return fmt.Sprintf("%s%s-refinery", RigPrefix(), rig)
 }
 
 // CrewSessionName returns the session name for a crew worker in a rig.
 func CrewSessionName(rig, name string) string {
-	return fmt.Sprintf("%s%s-crew-%s", Prefix, rig, name)
+	return fmt.Sprintf("%s%s-crew-%s", RigPrefix(), rig, name)
 }
 
 // PolecatSessionName returns the session name for a polecat in a rig.
 func PolecatSessionName(rig, name string) string {
-	return fmt.Sprintf("%s%s-%s", Prefix, rig, name)
+	return fmt.Sprintf("%s%s-%s", RigPrefix(), rig, name)
 }
 
 // PropulsionNudge generates the GUPP (Gas Town Universal Propulsion Principle) nudge.
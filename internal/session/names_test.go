@@ -103,10 +103,64 @@ func TestPolecatSessionName(t *testing.T) {
 	}
 }
 
-func TestPrefix(t *testing.T) {
+func TestDefaultPrefix(t *testing.T) {
 	want := "gt-"
-	if Prefix != want {
-		t.Errorf("Prefix = %q, want %q", Prefix, want)
+	if DefaultPrefix != want {
+		t.Errorf("DefaultPrefix = %q, want %q", DefaultPrefix, want)
+	}
+}
+
+func TestRigPrefix(t *testing.T) {
+	t.Cleanup(func() { _ = SetPrefix("") })
+	user := currentUsername()
+
+	tests := []struct {
+		name       string
+		env        string
+		configured string
+		want       string
+	}{
+		{"default", "", "", "gt-"},
+		{"explicit default", "", "gt-", "gt-"},
+		{"town setting", "", "team-", "team-"},
+		{"trailing dash added", "", "team", "team-"},
+		{"username placeholder", "", "gt-{user}-", "gt-" + user + "-"},
+		{"env wins over town", "mine-", "team-", "mine-"},
+		{"invalid env ignored", "bad:prefix", "team-", "team-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(PrefixEnv, tt.env)
+			if err := SetPrefix(tt.configured); err != nil {
+				t.Fatalf("SetPrefix(%q): %v", tt.configured, err)
+			}
+			if got := RigPrefix(); got != tt.want {
+				t.Errorf("RigPrefix() = %q, want %q", got, tt.want)
+			}
+			if got, want := WitnessSessionName("gastown"), tt.want+"gastown-witness"; got != want {
+				t.Errorf("WitnessSessionName = %q, want %q", got, want)
+			}
+			id, err := ParseSessionName(tt.want + "gastown-crew-max")
+			if err != nil || id.Rig != "gastown" || id.Name != "max" {
+				t.Errorf("ParseSessionName = %+v, %v; want crew max in gastown", id, err)
+			}
+		})
+	}
+}
+
+func TestValidatePrefix(t *testing.T) {
+	for _, p := range []string{"gt-", "gt-{user}-", "alice", "a_b-"} {
+		if err := ValidatePrefix(p); err != nil {
+			t.Errorf("ValidatePrefix(%q) = %v, want nil", p, err)
+		}
+	}
+	for _, p := range []string{"gt:", "gt.x-", "has space-", "hq-"} {
+		if err := ValidatePrefix(p); err == nil {
+			t.Errorf("ValidatePrefix(%q) = nil, want error", p)
+		}
+	}
+	if err := SetPrefix("bad.prefix"); err == nil {
+		t.Error("SetPrefix accepted an invalid prefix")
 	}
 }
 
@@ -169,3 +223,16 @@ func TestPropulsionNudgeForRole_AllRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestLegacyName(t *testing.T) {
+	if got := LegacyName("gt-gastown-witness"); got != "" {
+		t.Errorf("LegacyName with default prefix = %q, want empty", got)
+	}
+	t.Setenv(PrefixEnv, "gt-alice-")
+	if got, want := LegacyName("gt-alice-gastown-witness"), "gt-gastown-witness"; got != want {
+		t.Errorf("LegacyName = %q, want %q", got, want)
+	}
+	if got := LegacyName("hq-mayor"); got != "" {
+		t.Errorf("LegacyName(hq-mayor) = %q, want empty", got)
+	}
+}
@@ -50,6 +50,44 @@ var (
 	ArrowPrefix = Info.Render("→")
 )
 
+// ColorMode selects when styled output carries ANSI escapes.
+type ColorMode string
+
+const (
+	// ColorAuto uses color only on a TTY and when NO_COLOR is unset.
+	ColorAuto ColorMode = "auto"
+	// ColorAlways forces color even when piped.
+	ColorAlways ColorMode = "always"
+	// ColorNever renders plain text.
+	ColorNever ColorMode = "never"
+)
+
+// SetColorMode switches every style, including the prefix glyphs, to mode.
+func SetColorMode(mode ColorMode) error {
+	switch mode {
+	case ColorAuto:
+		ui.SetColorEnabled(ui.ShouldUseColor())
+	case ColorAlways:
+		ui.SetColorEnabled(true)
+	case ColorNever:
+		ui.SetColorEnabled(false)
+	default:
+		return fmt.Errorf("invalid color mode %q: want auto, always or never", mode)
+	}
+
+	// The prefixes are pre-rendered, so redo them under the new profile.
+	renderPrefixes()
+	return nil
+}
+
+// renderPrefixes renders the prefix glyphs with the current styles.
+func renderPrefixes() {
+	SuccessPrefix = Success.Render(ui.IconPass)
+	WarningPrefix = Warning.Render(ui.IconWarn)
+	ErrorPrefix = Error.Render(ui.IconFail)
+	ArrowPrefix = Info.Render("→")
+}
+
 // PrintWarning prints a warning message with consistent formatting.
 // The format and args work like fmt.Printf.
 func PrintWarning(format string, args ...interface{}) {
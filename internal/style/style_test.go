@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -167,3 +168,91 @@ func ExamplePrintWarning() {
 	PrintWarning("This is a warning message")
 	PrintWarning("Warning with value: %d", 42)
 }
+
+func TestSetColorMode(t *testing.T) {
+	t.Cleanup(func() { _ = SetColorMode(ColorAuto) })
+
+	if err := SetColorMode(ColorNever); err != nil {
+		t.Fatalf("SetColorMode(never): %v", err)
+	}
+	for name, got := range map[string]string{
+		"Bold":          Bold.Render("x"),
+		"Dim":           Dim.Render("x"),
+		"SuccessPrefix": SuccessPrefix,
+		"ErrorPrefix":   ErrorPrefix,
+	} {
+		if strings.Contains(got, "\x1b[") {
+			t.Errorf("%s with color never = %q, want no ANSI escapes", name, got)
+		}
+	}
+
+	if err := SetColorMode(ColorAlways); err != nil {
+		t.Fatalf("SetColorMode(always): %v", err)
+	}
+	if got := Bold.Render("x"); !strings.Contains(got, "\x1b[") {
+		t.Errorf("Bold with color always = %q, want ANSI escapes", got)
+	}
+	if !strings.Contains(SuccessPrefix, "\x1b[") {
+		t.Errorf("SuccessPrefix with color always = %q, want ANSI escapes", SuccessPrefix)
+	}
+
+	// NO_COLOR wins in auto mode.
+	t.Setenv("NO_COLOR", "1")
+	if err := SetColorMode(ColorAuto); err != nil {
+		t.Fatalf("SetColorMode(auto): %v", err)
+	}
+	if got := Dim.Render("x"); got != "x" {
+		t.Errorf("Dim with NO_COLOR = %q, want plain", got)
+	}
+
+	if err := SetColorMode("sometimes"); err == nil {
+		t.Error("expected error for invalid color mode")
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetTheme(ThemeDefault)
+		_ = SetColorMode(ColorAuto)
+	})
+	if err := SetColorMode(ColorAlways); err != nil {
+		t.Fatalf("SetColorMode(always): %v", err)
+	}
+
+	defaultSuccess := Success.Render("x")
+	if got := AgentState("paused"); !strings.Contains(got, "⏸ paused") {
+		t.Errorf("default AgentState(paused) = %q", got)
+	}
+
+	if err := SetTheme(ThemeAccessible); err != nil {
+		t.Fatalf("SetTheme(accessible): %v", err)
+	}
+	if CurrentTheme() != ThemeAccessible {
+		t.Errorf("CurrentTheme() = %q, want accessible", CurrentTheme())
+	}
+	if Success.Render("x") == defaultSuccess {
+		t.Error("accessible Success renders like the default palette")
+	}
+	if !strings.Contains(SuccessPrefix, "\x1b[") {
+		t.Errorf("SuccessPrefix = %q, want it re-rendered in color", SuccessPrefix)
+	}
+	for state, want := range map[string]string{"running": "● running", "stopped": "○ stopped", "paused": "‖ paused", "odd": "odd"} {
+		if got := AgentState(state); !strings.Contains(got, want) {
+			t.Errorf("accessible AgentState(%s) = %q, want %q", state, got, want)
+		}
+	}
+
+	// GT_ACCESSIBLE picks the theme when none is given
+	t.Setenv(EnvAccessible, "0")
+	if err := SetTheme(""); err != nil || CurrentTheme() != ThemeDefault {
+		t.Errorf("SetTheme(\"\") with %s=0 = %v, theme %q; want default", EnvAccessible, err, CurrentTheme())
+	}
+	t.Setenv(EnvAccessible, "1")
+	if err := SetTheme(""); err != nil || CurrentTheme() != ThemeAccessible {
+		t.Errorf("SetTheme(\"\") with %s=1 = %v, theme %q; want accessible", EnvAccessible, err, CurrentTheme())
+	}
+
+	if err := SetTheme("neon"); err == nil {
+		t.Error("expected error for invalid theme")
+	}
+}
@@ -0,0 +1,115 @@
+package style
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// Theme selects the palette styled output is drawn in.
+type Theme string
+
+const (
+	// ThemeDefault is the Ayu palette.
+	ThemeDefault Theme = "default"
+	// ThemeAccessible is a colorblind-safe palette (Okabe-Ito) that leans
+	// on weight, underlines and text rather than hue to tell states apart.
+	ThemeAccessible Theme = "accessible"
+)
+
+// EnvAccessible selects the accessible theme when set to anything but ""
+// or "0", unless a theme is given explicitly.
+const EnvAccessible = "GT_ACCESSIBLE"
+
+// Okabe-Ito colors, distinguishable with the common color vision
+// deficiencies. Each pair is the light/dark terminal variant.
+var (
+	accessibleBlue = lipgloss.AdaptiveColor{
+		Light: "#0072b2", // blue
+		Dark:  "#56b4e9", // sky blue
+	}
+	accessibleOrange = lipgloss.AdaptiveColor{
+		Light: "#b86e00", // orange, darkened for light backgrounds
+		Dark:  "#e69f00", // orange
+	}
+	accessibleVermillion = lipgloss.AdaptiveColor{
+		Light: "#d55e00", // vermillion
+		Dark:  "#f07a2e", // vermillion, lightened for dark backgrounds
+	}
+	accessibleMuted = lipgloss.AdaptiveColor{
+		Light: "#595959", // higher-contrast gray than the default muted
+		Dark:  "#a0a0a0",
+	}
+)
+
+var currentTheme = ThemeDefault
+
+// CurrentTheme returns the theme in use.
+func CurrentTheme() Theme {
+	return currentTheme
+}
+
+// SetTheme switches every style, including the prefix glyphs, to theme.
+// An empty theme is the accessible one if GT_ACCESSIBLE is set, else the
+// default.
+func SetTheme(theme Theme) error {
+	if theme == "" {
+		theme = ThemeDefault
+		if v := os.Getenv(EnvAccessible); v != "" && v != "0" {
+			theme = ThemeAccessible
+		}
+	}
+
+	switch theme {
+	case ThemeDefault:
+		Success = lipgloss.NewStyle().Foreground(ui.ColorPass).Bold(true)
+		Warning = lipgloss.NewStyle().Foreground(ui.ColorWarn).Bold(true)
+		Error = lipgloss.NewStyle().Foreground(ui.ColorFail).Bold(true)
+		Info = lipgloss.NewStyle().Foreground(ui.ColorAccent)
+		Dim = lipgloss.NewStyle().Foreground(ui.ColorMuted)
+		Bold = lipgloss.NewStyle().Bold(true)
+	case ThemeAccessible:
+		// Success and failure never differ by hue alone: failures are
+		// also underlined, and every state keeps its glyph.
+		Success = lipgloss.NewStyle().Foreground(accessibleBlue).Bold(true)
+		Warning = lipgloss.NewStyle().Foreground(accessibleOrange).Bold(true)
+		Error = lipgloss.NewStyle().Foreground(accessibleVermillion).Bold(true).Underline(true)
+		Info = lipgloss.NewStyle().Foreground(accessibleBlue)
+		Dim = lipgloss.NewStyle().Foreground(accessibleMuted)
+		Bold = lipgloss.NewStyle().Bold(true)
+	default:
+		return fmt.Errorf("invalid theme %q: want default or accessible", theme)
+	}
+	currentTheme = theme
+	renderPrefixes()
+	return nil
+}
+
+// AgentState renders an agent's run state as a glyph and word: running,
+// stopped or paused; any other state is shown as is. The default theme
+// sets running in bold and the rest dim; the accessible theme gives each
+// state its own shape and color, so none is told apart by shade alone.
+func AgentState(state string) string {
+	if currentTheme == ThemeAccessible {
+		switch state {
+		case "running":
+			return Success.Render("● running")
+		case "stopped":
+			return Dim.Render("○ stopped")
+		case "paused":
+			return Warning.Render("‖ paused")
+		}
+		return state
+	}
+	switch state {
+	case "running":
+		return Bold.Render("● running")
+	case "stopped":
+		return Dim.Render("○ stopped")
+	case "paused":
+		return Dim.Render("⏸ paused")
+	}
+	return state
+}
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/rig"
 )
 
@@ -41,7 +42,8 @@ func NewManager(r *rig.Rig) *Manager {
 // This is the canonical way to get swarm state - no in-memory caching.
 func (m *Manager) LoadSwarm(epicID string) (*Swarm, error) {
 	// Query beads for the epic
-	cmd := exec.Command("bd", "show", epicID, "--json")
+	cmd, cancel := beads.Command("show", epicID, "--json")
+	defer cancel()
 	cmd.Dir = m.beadsDir
 
 	var stdout, stderr bytes.Buffer
@@ -127,7 +129,8 @@ func (m *Manager) GetSwarm(id string) (*Swarm, error) {
 // GetReadyTasks returns tasks ready to be assigned by querying beads.
 func (m *Manager) GetReadyTasks(swarmID string) ([]SwarmTask, error) {
 	// Use bd swarm status to get ready front
-	cmd := exec.Command("bd", "swarm", "status", swarmID, "--json")
+	cmd, cancel := beads.Command("swarm", "status", swarmID, "--json")
+	defer cancel()
 	cmd.Dir = m.beadsDir
 
 	var stdout bytes.Buffer
@@ -164,7 +167,8 @@ func (m *Manager) GetReadyTasks(swarmID string) ([]SwarmTask, error) {
 
 // IsComplete checks if all tasks are closed by querying beads.
 func (m *Manager) IsComplete(swarmID string) (bool, error) {
-	cmd := exec.Command("bd", "swarm", "status", swarmID, "--json")
+	cmd, cancel := beads.Command("swarm", "status", swarmID, "--json")
+	defer cancel()
 	cmd.Dir = m.beadsDir
 
 	var stdout bytes.Buffer
@@ -214,7 +218,8 @@ func isValidTransition(from, to SwarmState) bool {
 // loadTasksFromBeads loads child issues from beads CLI.
 func (m *Manager) loadTasksFromBeads(epicID string) ([]SwarmTask, error) {
 	// Run: bd show <epicID> --json to get epic with children
-	cmd := exec.Command("bd", "show", epicID, "--json")
+	cmd, cancel := beads.Command("show", epicID, "--json")
+	defer cancel()
 	cmd.Dir = m.beadsDir
 
 	var stdout, stderr bytes.Buffer
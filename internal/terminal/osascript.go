@@ -0,0 +1,54 @@
+package terminal
+
+import (
+	"os"
+	"strings"
+)
+
+// OSAScriptLauncher opens a window in macOS Terminal or iTerm through
+// AppleScript.
+type OSAScriptLauncher struct {
+	// ITerm selects iTerm instead of Terminal.
+	ITerm bool
+}
+
+// Name implements Launcher.
+func (l *OSAScriptLauncher) Name() string {
+	if l.ITerm {
+		return "iTerm"
+	}
+	return "Terminal"
+}
+
+// Launch implements Launcher.
+func (l *OSAScriptLauncher) Launch(argv []string) error {
+	return start(append([]string{"osascript"}, l.script(argv)...))
+}
+
+// script returns the osascript -e arguments that open a window running
+// argv.
+func (l *OSAScriptLauncher) script(argv []string) []string {
+	cmdline := appleString(shellJoin(argv))
+	if l.ITerm {
+		return []string{
+			"-e", `tell application "iTerm" to create window with default profile command ` + cmdline,
+			"-e", `tell application "iTerm" to activate`,
+		}
+	}
+	return []string{
+		"-e", `tell application "Terminal" to do script ` + cmdline,
+		"-e", `tell application "Terminal" to activate`,
+	}
+}
+
+// appleString quotes s as an AppleScript string literal.
+func appleString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// inITerm reports whether gt is running inside iTerm, so new windows open
+// in the terminal the user already uses.
+func inITerm() bool {
+	return os.Getenv("TERM_PROGRAM") == "iTerm.app"
+}
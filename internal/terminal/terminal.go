@@ -0,0 +1,80 @@
+// Package terminal opens commands in new terminal windows, for attaching
+// to agent sessions without taking over the current shell.
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// EnvTerminal names the environment variable holding the command that
+// opens a terminal window running a command, e.g. "gnome-terminal --",
+// "kitty" or "alacritty -e". The command to run is appended to it.
+const EnvTerminal = "GT_TERMINAL"
+
+// ErrNoLauncher is returned by Default where gt doesn't know how to open
+// a terminal window and none is configured.
+var ErrNoLauncher = errors.New("no terminal launcher configured; set " + EnvTerminal +
+	` to a command that runs a program in a new window (e.g. "gnome-terminal --", "kitty", "alacritty -e")`)
+
+// Launcher opens a command in a new terminal window.
+type Launcher interface {
+	// Name describes the launcher for messages.
+	Name() string
+
+	// Launch opens a window running argv and returns without waiting
+	// for it to finish.
+	Launch(argv []string) error
+}
+
+// Default returns the launcher for this platform: the GT_TERMINAL command
+// if set, otherwise the platform's own (Terminal or iTerm on macOS), or
+// ErrNoLauncher where there is none.
+func Default() (Launcher, error) {
+	if fields := strings.Fields(os.Getenv(EnvTerminal)); len(fields) > 0 {
+		return &CommandLauncher{Prefix: fields}, nil
+	}
+	return platformLauncher()
+}
+
+// CommandLauncher runs a terminal program with the command appended to
+// its arguments.
+type CommandLauncher struct {
+	Prefix []string
+}
+
+// Name implements Launcher.
+func (l *CommandLauncher) Name() string {
+	return strings.Join(l.Prefix, " ")
+}
+
+// Launch implements Launcher.
+func (l *CommandLauncher) Launch(argv []string) error {
+	return start(l.argv(argv))
+}
+
+func (l *CommandLauncher) argv(argv []string) []string {
+	return append(append([]string(nil), l.Prefix...), argv...)
+}
+
+// start runs argv detached from gt: the window outlives this process.
+func start(argv []string) error {
+	cmd := exec.Command(argv[0], argv[1:]...) //nolint:gosec // G204: argv comes from operator config
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("opening terminal with %s: %w", argv[0], err)
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
+
+// shellJoin quotes argv into one POSIX shell command line.
+func shellJoin(argv []string) string {
+	quoted := make([]string, len(argv))
+	for i, arg := range argv {
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,5 @@
+package terminal
+
+func platformLauncher() (Launcher, error) {
+	return &OSAScriptLauncher{ITerm: inITerm()}, nil
+}
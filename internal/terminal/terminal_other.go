@@ -0,0 +1,7 @@
+//go:build !darwin
+
+package terminal
+
+func platformLauncher() (Launcher, error) {
+	return nil, ErrNoLauncher
+}
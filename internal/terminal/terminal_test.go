@@ -0,0 +1,60 @@
+package terminal
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestDefaultFromEnv(t *testing.T) {
+	t.Setenv(EnvTerminal, "gnome-terminal --")
+	l, err := Default()
+	if err != nil {
+		t.Fatalf("Default: %v", err)
+	}
+	cl, ok := l.(*CommandLauncher)
+	if !ok {
+		t.Fatalf("Default = %T, want *CommandLauncher", l)
+	}
+	got := cl.argv([]string{"tmux", "attach-session", "-t", "gt-x-witness"})
+	want := []string{"gnome-terminal", "--", "tmux", "attach-session", "-t", "gt-x-witness"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("argv = %q, want %q", got, want)
+	}
+}
+
+func TestDefaultWithoutEnv(t *testing.T) {
+	t.Setenv(EnvTerminal, "")
+	l, err := Default()
+	if runtime.GOOS == "darwin" {
+		if err != nil {
+			t.Fatalf("Default on macOS: %v", err)
+		}
+		if _, ok := l.(*OSAScriptLauncher); !ok {
+			t.Errorf("Default on macOS = %T, want *OSAScriptLauncher", l)
+		}
+		return
+	}
+	if !errors.Is(err, ErrNoLauncher) {
+		t.Errorf("Default = %v, %v; want ErrNoLauncher", l, err)
+	}
+}
+
+func TestOSAScript(t *testing.T) {
+	argv := []string{"ssh", "-t", "build host", "tmux", "attach-session", "-t", `it's"here`}
+	script := (&OSAScriptLauncher{}).script(argv)
+	if len(script) != 4 || script[0] != "-e" {
+		t.Fatalf("script = %q", script)
+	}
+	want := `tell application "Terminal" to do script "'ssh' '-t' 'build host' 'tmux' 'attach-session' '-t' 'it'\\''s\"here'"`
+	if script[1] != want {
+		t.Errorf("script = %s\nwant     %s", script[1], want)
+	}
+
+	iterm := (&OSAScriptLauncher{ITerm: true}).script([]string{"tmux"})
+	if !strings.HasPrefix(iterm[1], `tell application "iTerm"`) {
+		t.Errorf("iTerm script = %q", iterm)
+	}
+}
@@ -0,0 +1,68 @@
+package tmux
+
+import "time"
+
+// Client is the part of Tmux that commands use to manage agent sessions.
+// Code that takes a Client instead of a *Tmux can be tested against a fake
+// such as tmuxtest.FakeTmux, without a tmux server.
+type Client interface {
+	// HasSession reports whether a session exists (exact match).
+	HasSession(name string) (bool, error)
+
+	// NewSession creates a new detached session.
+	NewSession(name, workDir string) error
+
+	// NewSessionWithCommand creates a new detached session running command.
+	NewSessionWithCommand(name, workDir, command string) error
+
+	// EnsureSession creates a detached session unless it already exists,
+	// and reports whether it created it.
+	EnsureSession(name, workDir string) (created bool, err error)
+
+	// EnsureSessionWithCommand is EnsureSession for a session running
+	// command.
+	EnsureSessionWithCommand(name, workDir, command string) (created bool, err error)
+
+	// ListSessions returns the names of all sessions.
+	ListSessions() ([]string, error)
+
+	// ListSessionInfo returns every session's details, keyed by name, in
+	// one call.
+	ListSessionInfo() (map[string]*SessionInfo, error)
+
+	// KillSession terminates a session.
+	KillSession(name string) error
+
+	// KillSessionGraceful interrupts a session's process and waits up to
+	// timeout for it to exit before killing the session.
+	KillSessionGraceful(name string, timeout time.Duration) error
+
+	// RenameSession renames a session, keeping what runs in it.
+	RenameSession(oldName, newName string) error
+
+	// SendKeys sends keystrokes to a session and presses Enter.
+	SendKeys(session, keys string) error
+
+	// NudgeSession sends a message to an agent session reliably.
+	NudgeSession(session, message string) error
+
+	// IsClaudeRunning reports whether an agent is running in the session.
+	IsClaudeRunning(session string) bool
+
+	// CapturePane returns the last lines of a session's active pane.
+	CapturePane(session string, lines int) (string, error)
+
+	// SessionUptime returns how long ago the session was created.
+	SessionUptime(session string) (time.Duration, error)
+
+	// PaneIsDead reports whether a session's pane outlived its process.
+	PaneIsDead(session string) (bool, error)
+
+	// SupportsReadOnlyAttach reports whether attach-session takes -r.
+	SupportsReadOnlyAttach() bool
+
+	// Available returns the tmux version, or why tmux can't be run.
+	Available() (string, error)
+}
+
+var _ Client = (*Tmux)(nil)
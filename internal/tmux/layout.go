@@ -0,0 +1,82 @@
+package tmux
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Layout describes the panes of a multi-pane session. The first pane is
+// the main one: it is created with the session and stays the active pane,
+// so send-keys, capture-pane and agent detection, which target a session's
+// active pane, keep reaching it. The other panes are split off it.
+type Layout struct {
+	Panes []Pane
+
+	// Vertical stacks the panes top to bottom instead of side by side.
+	Vertical bool
+}
+
+// Pane is one pane of a Layout.
+type Pane struct {
+	// Command is the pane's initial process.
+	Command string
+
+	// Percent is how much of the window a split-off pane takes; zero
+	// splits the remaining space in half. Ignored for the main pane.
+	Percent int
+}
+
+// SplitLayout returns a two-pane layout: main on the left and side on the
+// right, taking sidePercent of the width.
+func SplitLayout(main, side string, sidePercent int) Layout {
+	return Layout{Panes: []Pane{{Command: main}, {Command: side, Percent: sidePercent}}}
+}
+
+// NewSessionWithLayout creates a detached session with the panes of
+// layout, all starting in workDir. If a pane can't be created the session
+// is killed, so a failed call never leaves a half-built session behind.
+func (t *Tmux) NewSessionWithLayout(name, workDir string, layout Layout) error {
+	if len(layout.Panes) == 0 {
+		return fmt.Errorf("layout for session %s has no panes", name)
+	}
+	if err := t.NewSessionWithCommand(name, workDir, layout.Panes[0].Command); err != nil {
+		return err
+	}
+	for i, pane := range layout.Panes[1:] {
+		if _, err := t.run(splitArgs(name, workDir, pane, layout.Vertical, t.supports(3, 1))...); err != nil {
+			_ = t.KillSession(name)
+			return fmt.Errorf("creating pane %d: %w", i+2, err)
+		}
+	}
+	return nil
+}
+
+// EnsureSessionWithLayout is NewSessionWithLayout for a session that may
+// already exist; see EnsureSession. An existing session is left as it is,
+// whatever its panes.
+func (t *Tmux) EnsureSessionWithLayout(name, workDir string, layout Layout) (created bool, err error) {
+	return t.ensureSession(name, func() error { return t.NewSessionWithLayout(name, workDir, layout) })
+}
+
+// splitArgs builds the split-window command for one extra pane. -d keeps
+// the main pane active. Sizes are given as "-l N%" from tmux 3.1 and as
+// the older "-p N" before that.
+func splitArgs(session, workDir string, pane Pane, vertical, percentLength bool) []string {
+	args := []string{"split-window", "-d", "-t", session}
+	if vertical {
+		args = append(args, "-v")
+	} else {
+		args = append(args, "-h")
+	}
+	if pane.Percent > 0 && pane.Percent < 100 {
+		if percentLength {
+			args = append(args, "-l", strconv.Itoa(pane.Percent)+"%")
+		} else {
+			args = append(args, "-p", strconv.Itoa(pane.Percent))
+		}
+	}
+	if workDir != "" {
+		args = append(args, "-c", workDir)
+	}
+	return append(args, pane.Command)
+}
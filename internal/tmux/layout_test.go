@@ -0,0 +1,60 @@
+package tmux
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitArgs(t *testing.T) {
+	pane := Pane{Command: "gt witness watch", Percent: 35}
+	got := splitArgs("gt-x-witness", "/tmp/w", pane, false, true)
+	want := []string{"split-window", "-d", "-t", "gt-x-witness", "-h", "-l", "35%", "-c", "/tmp/w", "gt witness watch"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitArgs = %q, want %q", got, want)
+	}
+
+	got = splitArgs("s", "", Pane{Command: "top", Percent: 35}, true, false)
+	want = []string{"split-window", "-d", "-t", "s", "-v", "-p", "35", "top"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitArgs (old tmux) = %q, want %q", got, want)
+	}
+
+	got = splitArgs("s", "", Pane{Command: "top"}, false, true)
+	want = []string{"split-window", "-d", "-t", "s", "-h", "top"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitArgs (no size) = %q, want %q", got, want)
+	}
+}
+
+func TestNewSessionWithLayout(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-layout-" + t.Name()
+	_ = tm.KillSession(sessionName)
+
+	layout := SplitLayout("sleep 60", "sleep 61", 35)
+	if err := tm.NewSessionWithLayout(sessionName, "", layout); err != nil {
+		t.Fatalf("NewSessionWithLayout: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	out, err := tm.run("list-panes", "-t", sessionName, "-F", "#{pane_index} #{pane_active}")
+	if err != nil {
+		t.Fatalf("list-panes: %v", err)
+	}
+	panes := strings.Split(strings.TrimSpace(out), "\n")
+	if len(panes) != 2 {
+		t.Fatalf("got %d panes, want 2: %q", len(panes), out)
+	}
+	if !strings.HasSuffix(panes[0], " 1") {
+		t.Errorf("main pane should stay active, got %q", out)
+	}
+
+	if err := tm.NewSessionWithLayout("gt-test-empty-layout", "", Layout{}); err == nil {
+		t.Error("a layout without panes should be rejected")
+	}
+}
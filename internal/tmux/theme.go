@@ -4,6 +4,10 @@ package tmux
 import (
 	"fmt"
 	"hash/fnv"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 )
 
 // Theme represents a tmux status bar color scheme.
@@ -57,6 +61,36 @@ func AssignTheme(rigName string) Theme {
 	return AssignThemeFromPalette(rigName, DefaultPalette)
 }
 
+// AssignThemeForTown picks a rig's theme, honoring explicit choices before
+// the hash-based default: the rig's own theme setting
+// (<rig>/settings/config.json), then a town-level pin in
+// mayor/config.json (theme.rig_themes). Unknown theme names are ignored.
+func AssignThemeForTown(townRoot, rigName string) Theme {
+	if townRoot != "" {
+		if settings, err := config.LoadRigSettings(config.RigSettingsPath(filepath.Join(townRoot, rigName))); err == nil && settings.Theme != nil {
+			if theme := GetThemeByName(settings.Theme.Name); theme != nil {
+				return *theme
+			}
+		}
+		if name := TownRigTheme(townRoot, rigName); name != "" {
+			if theme := GetThemeByName(name); theme != nil {
+				return *theme
+			}
+		}
+	}
+	return AssignTheme(rigName)
+}
+
+// TownRigTheme returns the theme name a rig is pinned to in the town's
+// mayor/config.json, or "" if it isn't pinned.
+func TownRigTheme(townRoot, rigName string) string {
+	cfg, err := config.LoadMayorConfig(constants.MayorConfigPath(townRoot))
+	if err != nil || cfg.Theme == nil {
+		return ""
+	}
+	return cfg.Theme.RigThemes[rigName]
+}
+
 // AssignThemeFromPalette picks a theme using a custom palette.
 func AssignThemeFromPalette(rigName string, palette []Theme) Theme {
 	if len(palette) == 0 {
@@ -1,7 +1,12 @@
 package tmux
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 )
 
 func TestAssignTheme_Deterministic(t *testing.T) {
@@ -125,3 +130,52 @@ func TestAssignThemeFromPalette_CustomPalette(t *testing.T) {
 		t.Errorf("AssignThemeFromPalette returned %q, want one of custom themes", theme.Name)
 	}
 }
+
+func TestAssignThemeForTown(t *testing.T) {
+	townRoot := t.TempDir()
+	rigName := "gastown"
+	if err := os.MkdirAll(filepath.Join(townRoot, rigName), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// No config: falls back to the hash-based theme.
+	if got, want := AssignThemeForTown(townRoot, rigName), AssignTheme(rigName); got.Name != want.Name {
+		t.Errorf("unpinned rig got %q, want %q", got.Name, want.Name)
+	}
+
+	// Pick a theme that differs from the default so the pin is visible.
+	pinned := "ocean"
+	if AssignTheme(rigName).Name == pinned {
+		pinned = "forest"
+	}
+	mayorCfg := config.NewMayorConfig()
+	mayorCfg.Theme = &config.TownThemeConfig{RigThemes: map[string]string{rigName: pinned}}
+	if err := config.SaveMayorConfig(constants.MayorConfigPath(townRoot), mayorCfg); err != nil {
+		t.Fatal(err)
+	}
+	if got := AssignThemeForTown(townRoot, rigName); got.Name != pinned {
+		t.Errorf("pinned rig got %q, want %q", got.Name, pinned)
+	}
+	if got := TownRigTheme(townRoot, "other"); got != "" {
+		t.Errorf("TownRigTheme(other) = %q, want empty", got)
+	}
+
+	// Rig settings take precedence over the town pin.
+	settings := config.NewRigSettings()
+	settings.Theme = &config.ThemeConfig{Name: "rust"}
+	if err := config.SaveRigSettings(config.RigSettingsPath(filepath.Join(townRoot, rigName)), settings); err != nil {
+		t.Fatal(err)
+	}
+	if got := AssignThemeForTown(townRoot, rigName); got.Name != "rust" {
+		t.Errorf("rig settings theme got %q, want rust", got.Name)
+	}
+
+	// Unknown pinned names are ignored.
+	mayorCfg.Theme.RigThemes["other"] = "no-such-theme"
+	if err := config.SaveMayorConfig(constants.MayorConfigPath(townRoot), mayorCfg); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := AssignThemeForTown(townRoot, "other"), AssignTheme("other"); got.Name != want.Name {
+		t.Errorf("unknown pin got %q, want %q", got.Name, want.Name)
+	}
+}
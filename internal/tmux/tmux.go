@@ -3,16 +3,23 @@ package tmux
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
 )
 
 // versionPattern matches Claude Code version numbers like "2.0.76"
@@ -26,8 +33,112 @@ var (
 	ErrNoServer        = errors.New("no tmux server running")
 	ErrSessionExists   = errors.New("session already exists")
 	ErrSessionNotFound = errors.New("session not found")
+	ErrPromptNotFound  = errors.New("prompt did not appear")
 )
 
+// EnvTmuxCmd names the environment variable that overrides the tmux command.
+// Example: GT_TMUX_CMD="ssh build-host tmux" drives a remote tmux server.
+const EnvTmuxCmd = "GT_TMUX_CMD"
+
+var (
+	commandMu       sync.RWMutex
+	commandOverride []string
+	callTimeout     time.Duration
+	rigPrefix       = "gt-"
+)
+
+// SetCommand overrides the command used for every tmux invocation.
+// The value is split on whitespace, so "ssh build-host tmux" runs tmux on
+// build-host for HasSession, CapturePane, SendKeys and friends.
+// An empty string restores the default (GT_TMUX_CMD, then plain "tmux").
+// Every call is then an ssh round trip; ListSessionInfo batches the
+// per-session lookups, and ssh connection sharing (ControlMaster and
+// ControlPersist in ~/.ssh/config) saves the handshake on each call.
+func SetCommand(cmdline string) error {
+	fields := strings.Fields(cmdline)
+	if cmdline != "" && len(fields) == 0 {
+		return fmt.Errorf("invalid tmux command %q", cmdline)
+	}
+	commandMu.Lock()
+	commandOverride = fields
+	commandMu.Unlock()
+	return nil
+}
+
+// SetCallTimeout bounds every tmux invocation: one still running after d
+// is killed and returns a timed-out error (gt's --call-timeout). Zero, the
+// default, sets no bound.
+func SetCallTimeout(d time.Duration) {
+	commandMu.Lock()
+	callTimeout = d
+	commandMu.Unlock()
+}
+
+// SetRigSessionPrefix sets the prefix of rig-level Gas Town session names
+// (session.RigPrefix), which the key bindings use to tell Gas Town
+// sessions from others. Town-level "hq-" sessions always count.
+func SetRigSessionPrefix(prefix string) {
+	commandMu.Lock()
+	rigPrefix = prefix
+	commandMu.Unlock()
+}
+
+// gasTownSessionTest returns an if-shell condition that holds when the
+// session a key was pressed in is a Gas Town session.
+func gasTownSessionTest() string {
+	commandMu.RLock()
+	prefix := rigPrefix
+	commandMu.RUnlock()
+	return fmt.Sprintf("echo '#{session_name}' | grep -Eq '^(hq-|%s)'", prefix)
+}
+
+// commandPrefix returns the argv prefix used to invoke tmux.
+func commandPrefix() []string {
+	commandMu.RLock()
+	override := commandOverride
+	commandMu.RUnlock()
+	if len(override) > 0 {
+		return override
+	}
+	if fields := strings.Fields(os.Getenv(EnvTmuxCmd)); len(fields) > 0 {
+		return fields
+	}
+	return []string{"tmux"}
+}
+
+// IsRemote reports whether tmux is invoked through an ssh wrapper.
+func IsRemote() bool {
+	return filepath.Base(commandPrefix()[0]) == "ssh"
+}
+
+// commandArgv builds the full argv for a tmux invocation.
+// ssh joins its arguments into a single remote shell command line, so when
+// the wrapper is ssh each tmux argument is shell-quoted to survive the trip.
+func commandArgv(args []string) []string {
+	prefix := commandPrefix()
+	argv := make([]string, 0, len(prefix)+len(args))
+	argv = append(argv, prefix...)
+	if filepath.Base(prefix[0]) == "ssh" {
+		for _, arg := range args {
+			argv = append(argv, util.ShellQuote(arg))
+		}
+		return argv
+	}
+	return append(argv, args...)
+}
+
+// Command returns an *exec.Cmd that runs tmux with the given arguments,
+// honoring any SetCommand/GT_TMUX_CMD override. Use it for interactive
+// invocations (attach-session) that need the caller's stdio.
+func Command(args ...string) *exec.Cmd {
+	argv := commandArgv(args)
+	if filepath.Base(argv[0]) == "ssh" {
+		// Interactive attach over ssh needs a pseudo-terminal on the remote side.
+		argv = append([]string{argv[0], "-t"}, argv[1:]...)
+	}
+	return exec.Command(argv[0], argv[1:]...) //nolint:gosec // G204: argv comes from operator config
+}
+
 // Tmux wraps tmux operations.
 type Tmux struct{}
 
@@ -38,19 +149,40 @@ func NewTmux() *Tmux {
 
 // run executes a tmux command and returns stdout.
 func (t *Tmux) run(args ...string) (string, error) {
-	cmd := exec.Command("tmux", args...)
+	ctx := context.Background()
+	commandMu.RLock()
+	timeout := callTimeout
+	commandMu.RUnlock()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	argv := commandArgv(args)
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...) //nolint:gosec // G204: argv comes from operator config
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
 	err := cmd.Run()
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return "", fmt.Errorf("tmux %s: timed out: %w", args[0], ctxErr)
+		}
 		return "", t.wrapError(err, stderr.String(), args)
 	}
 
 	return strings.TrimSpace(stdout.String()), nil
 }
 
+// Run executes a tmux command the way Tmux's own methods do, through any
+// SetCommand/GT_TMUX_CMD override and within the call timeout, and returns
+// trimmed stdout. Use it for queries no method covers, such as list-panes
+// with a custom format.
+func (t *Tmux) Run(args ...string) (string, error) {
+	return t.run(args...)
+}
+
 // wrapError wraps tmux errors with context.
 func (t *Tmux) wrapError(err error, stderr string, args []string) error {
 	stderr = strings.TrimSpace(stderr)
@@ -100,6 +232,39 @@ func (t *Tmux) NewSessionWithCommand(name, workDir, command string) error {
 	return err
 }
 
+// EnsureSession creates a detached session unless one by that name already
+// exists, and reports whether it created it. A "duplicate session" error
+// from new-session means another caller created it between the check and
+// the create; that counts as existing, not as a failure, so of two racing
+// callers exactly one sees created=true.
+func (t *Tmux) EnsureSession(name, workDir string) (created bool, err error) {
+	return t.ensureSession(name, func() error { return t.NewSession(name, workDir) })
+}
+
+// EnsureSessionWithCommand is EnsureSession for a session that runs
+// command as its initial process (see NewSessionWithCommand).
+func (t *Tmux) EnsureSessionWithCommand(name, workDir, command string) (created bool, err error) {
+	return t.ensureSession(name, func() error { return t.NewSessionWithCommand(name, workDir, command) })
+}
+
+// ensureSession runs create unless the session already exists.
+func (t *Tmux) ensureSession(name string, create func() error) (bool, error) {
+	exists, err := t.HasSession(name)
+	if err != nil {
+		return false, fmt.Errorf("checking session: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+	if err := create(); err != nil {
+		if errors.Is(err, ErrSessionExists) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // EnsureSessionFresh ensures a session is available and healthy.
 // If the session exists but is a zombie (Claude not running), it kills the session first.
 // This prevents "session already exists" errors when trying to restart dead agents.
@@ -140,6 +305,81 @@ func (t *Tmux) KillSession(name string) error {
 	return err
 }
 
+// gracefulKillPoll is how often KillSessionGraceful checks whether the
+// interrupted process has exited.
+const gracefulKillPoll = 100 * time.Millisecond
+
+// secondInterruptAfter is how long KillSessionGraceful waits before
+// sending a second Ctrl-C; agents like Claude ask for one to exit.
+const secondInterruptAfter = 500 * time.Millisecond
+
+// KillSessionGraceful gives the foreground process of a session's active
+// pane the chance to exit cleanly before the session is killed, so an
+// agent can flush its on-disk state. It sends Ctrl-C (twice, if the first
+// doesn't do it) and waits up to timeout for the process to exit or drop
+// back to a shell, then kills the session, with a hard kill if it didn't.
+// A pane already at a shell prompt has nothing to interrupt and is killed
+// at once; a session that closes by itself counts as killed.
+func (t *Tmux) KillSessionGraceful(name string, timeout time.Duration) error {
+	if has, err := t.HasSession(name); err != nil {
+		return err
+	} else if !has {
+		return ErrSessionNotFound
+	}
+	out, err := t.run("list-panes", "-t", "="+name, "-F", "#{pane_active} #{pane_id}")
+	if err != nil {
+		return err
+	}
+	var pane string
+	for _, line := range strings.Split(out, "\n") {
+		if id, ok := strings.CutPrefix(strings.TrimSpace(line), "1 "); ok {
+			pane = id
+		}
+	}
+
+	if !t.paneExited(pane) {
+		_, _ = t.run("send-keys", "-t", pane, "C-c")
+		sent := time.Now()
+		deadline := sent.Add(timeout)
+		second := false
+		for !t.paneExited(pane) && time.Now().Before(deadline) {
+			if !second && time.Since(sent) >= secondInterruptAfter {
+				_, _ = t.run("send-keys", "-t", pane, "C-c")
+				second = true
+			}
+			time.Sleep(gracefulKillPoll)
+		}
+	}
+
+	if err := t.KillSession(name); err != nil &&
+		!errors.Is(err, ErrSessionNotFound) && !errors.Is(err, ErrNoServer) {
+		return err
+	}
+	return nil
+}
+
+// paneExited reports whether a pane no longer runs a foreground process:
+// the pane is gone or dead, or back at a shell prompt.
+func (t *Tmux) paneExited(pane string) bool {
+	// display-message falls back to another pane when the target is gone,
+	// so the pane id is checked too.
+	out, err := t.run("display-message", "-p", "-t", pane, "#{pane_id} #{pane_dead} #{pane_current_command}")
+	if err != nil {
+		return true
+	}
+	fields := strings.Fields(out)
+	if len(fields) < 3 || fields[0] != pane || fields[1] == "1" {
+		return true
+	}
+	command := fields[2]
+	for _, shell := range constants.SupportedShells {
+		if command == shell {
+			return true
+		}
+	}
+	return false
+}
+
 // KillSessionWithProcesses explicitly kills all processes in a session before terminating it.
 // This prevents orphan processes that survive tmux kill-session due to SIGHUP being ignored.
 //
@@ -152,7 +392,15 @@ func (t *Tmux) KillSession(name string) error {
 // 6. Kill the tmux session
 //
 // This ensures Claude processes and all their children are properly terminated.
+//
+// When tmux runs on another host (see IsRemote), pane PIDs belong to that
+// host and signalling them here would hit unrelated local processes, so
+// only the session is killed.
 func (t *Tmux) KillSessionWithProcesses(name string) error {
+	if IsRemote() {
+		return t.KillSession(name)
+	}
+
 	// Get the pane PID
 	pid, err := t.GetPanePID(name)
 	if err != nil {
@@ -213,10 +461,77 @@ func (t *Tmux) KillServer() error {
 	return err
 }
 
+// ErrNotInstalled is returned by Available when tmux isn't on PATH.
+var ErrNotInstalled = errors.New("tmux is required but not found; install it with your package manager " +
+	"(e.g. 'brew install tmux' or 'apt install tmux') and make sure it is on PATH")
+
+var (
+	availableMu    sync.Mutex
+	availableCache = make(map[string]availability)
+)
+
+// availability is the cached outcome of Available for one tmux command.
+type availability struct {
+	version string
+	err     error
+}
+
+// Available checks once per process that tmux can be run, and returns its
+// version (e.g. "tmux 3.4"). Call it at the top of commands that need tmux
+// so a missing install fails early with an actionable message instead of a
+// low-level exec error halfway through. With a GT_TMUX_CMD/--tmux-cmd
+// wrapper the wrapper's command is what must be found.
+func (t *Tmux) Available() (string, error) {
+	prefix := commandPrefix()
+	key := strings.Join(prefix, " ")
+
+	availableMu.Lock()
+	defer availableMu.Unlock()
+	if a, ok := availableCache[key]; ok {
+		return a.version, a.err
+	}
+
+	var a availability
+	if path, err := exec.LookPath(prefix[0]); err != nil {
+		if prefix[0] == "tmux" {
+			a.err = ErrNotInstalled
+		} else {
+			a.err = fmt.Errorf("tmux command %q not found: %w", key, err)
+		}
+	} else if out, err := t.run("-V"); err != nil {
+		a.err = fmt.Errorf("tmux found at %s but failed to run: %w", path, err)
+	} else {
+		a.version = strings.TrimSpace(out)
+		warnIfOld(a.version)
+	}
+	availableCache[key] = a
+	return a.version, a.err
+}
+
 // IsAvailable checks if tmux is installed and can be invoked.
 func (t *Tmux) IsAvailable() bool {
-	cmd := exec.Command("tmux", "-V")
-	return cmd.Run() == nil
+	_, err := t.run("-V")
+	return err == nil
+}
+
+// SupportsReadOnlyAttach reports whether this tmux can attach a client with
+// input disabled (attach-session -r), judging by the command's usage line.
+func (t *Tmux) SupportsReadOnlyAttach() bool {
+	out, err := t.run("list-commands", "attach-session")
+	return err == nil && usageHasFlag(out, 'r')
+}
+
+// usageHasFlag reports whether a tmux usage line such as
+// "attach-session (attach) [-dErx] [-c working-directory]" lists the
+// boolean flag.
+func usageHasFlag(usage string, flag rune) bool {
+	for _, field := range strings.Fields(usage) {
+		if strings.HasPrefix(field, "[-") && strings.HasSuffix(field, "]") &&
+			strings.ContainsRune(field[2:len(field)-1], flag) {
+			return true
+		}
+	}
+	return false
 }
 
 // HasSession checks if a session exists (exact match).
@@ -233,6 +548,20 @@ func (t *Tmux) HasSession(name string) (bool, error) {
 	return true, nil
 }
 
+// CurrentSession returns the name of the session this process runs in,
+// or "" when it isn't running inside tmux.
+func (t *Tmux) CurrentSession() string {
+	pane := os.Getenv("TMUX_PANE")
+	if pane == "" {
+		return ""
+	}
+	out, err := t.run("display-message", "-t", pane, "-p", "#{session_name}")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(out)
+}
+
 // ListSessions returns all session names.
 func (t *Tmux) ListSessions() ([]string, error) {
 	out, err := t.run("list-sessions", "-F", "#{session_name}")
@@ -250,6 +579,43 @@ func (t *Tmux) ListSessions() ([]string, error) {
 	return strings.Split(out, "\n"), nil
 }
 
+// SessionEntry is a session name with the time the session was created.
+type SessionEntry struct {
+	Name    string
+	Created time.Time
+}
+
+// ListSessionsCreated returns all sessions with their creation times.
+// Like ListSessions, no server means no sessions.
+func (t *Tmux) ListSessionsCreated() ([]SessionEntry, error) {
+	out, err := t.run("list-sessions", "-F", "#{session_name}|#{session_created}")
+	if err != nil {
+		if errors.Is(err, ErrNoServer) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseSessionEntries(out), nil
+}
+
+// parseSessionEntries parses "name|unix-created" lines. A creation time
+// that doesn't parse is left zero.
+func parseSessionEntries(out string) []SessionEntry {
+	var entries []SessionEntry
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		name, created, _ := strings.Cut(line, "|")
+		entry := SessionEntry{Name: name}
+		if secs, err := strconv.ParseInt(created, 10, 64); err == nil {
+			entry.Created = time.Unix(secs, 0)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
 // SessionSet provides O(1) session existence checks by caching session names.
 // Use this when you need to check multiple sessions to avoid N+1 subprocess calls.
 type SessionSet struct {
@@ -408,6 +774,71 @@ func (t *Tmux) SendKeysDelayed(session, keys string, delayMs int) error {
 	return t.SendKeys(session, keys)
 }
 
+// SendKeysConfirm waits for expectPrompt to appear in the pane, then sends
+// keystrokes and presses Enter. Unlike SendKeysDelayed it doesn't guess how
+// long the process takes to start, so input isn't lost on a slow pane.
+// If the prompt doesn't appear within timeout, nothing is sent and an error
+// wrapping ErrPromptNotFound is returned; callers decide whether to send anyway.
+// An empty expectPrompt sends immediately.
+func (t *Tmux) SendKeysConfirm(session, keys, expectPrompt string, timeout time.Duration) error {
+	if err := t.waitForPrompt(session, expectPrompt, nil, timeout); err != nil {
+		return err
+	}
+	return t.SendKeys(session, keys)
+}
+
+// SendKeysConfirmAfter is SendKeysConfirm for a pane that was just sent
+// other input. before is a CapturePaneLines(session, 10) taken before that
+// input; the prompt only counts once the pane has moved on from before and
+// stopped changing, so a prompt still on screen from before the earlier
+// input doesn't let the keys through while the agent is busy with it.
+func (t *Tmux) SendKeysConfirmAfter(session, keys, expectPrompt string, before []string, timeout time.Duration) error {
+	if before == nil {
+		before = []string{}
+	}
+	if err := t.waitForPrompt(session, expectPrompt, before, timeout); err != nil {
+		return err
+	}
+	return t.SendKeys(session, keys)
+}
+
+// waitForPrompt polls the bottom of the pane until a line starts with prompt.
+// With a non-nil before, the pane must also differ from before and match
+// the previous poll.
+func (t *Tmux) waitForPrompt(session, prompt string, before []string, timeout time.Duration) error {
+	if prompt == "" {
+		return nil
+	}
+	deadline := time.Now().Add(timeout)
+	var last []string
+	for {
+		if lines, err := t.CapturePaneLines(session, 10); err == nil {
+			if PaneHasPrompt(lines, prompt) &&
+				(before == nil || (!slices.Equal(lines, before) && slices.Equal(lines, last))) {
+				return nil
+			}
+			last = lines
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("%w: %q in %s after %s", ErrPromptNotFound, prompt, session, timeout)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// PaneHasPrompt reports whether any captured line starts with prompt.
+// A bare prompt (trailing whitespace trimmed by the terminal) also matches.
+func PaneHasPrompt(lines []string, prompt string) bool {
+	bare := strings.TrimSpace(prompt)
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, prompt) || (bare != "" && trimmed == bare) {
+			return true
+		}
+	}
+	return false
+}
+
 // SendKeysDelayedDebounced sends keystrokes after a pre-delay, with a custom debounce before Enter.
 // Use this when sending input to a process that needs time to initialize AND the message
 // needs extra time between paste and Enter (e.g., Claude prompt injection).
@@ -667,8 +1098,54 @@ func (t *Tmux) SelectWindow(session string, index int) error {
 
 // SetEnvironment sets an environment variable in the session.
 func (t *Tmux) SetEnvironment(session, key, value string) error {
-	_, err := t.run("set-environment", "-t", session, key, value)
-	return err
+	return t.SetEnvironmentBatch(session, map[string]string{key: value})
+}
+
+// SetEnvironmentBatch sets several environment variables in the session
+// with a single tmux invocation, chaining set-environment commands with
+// tmux's ";" separator instead of spawning a process per variable.
+// Keys that aren't valid variable names are skipped, and if the batch
+// fails the variables are set one at a time, so one bad entry doesn't cost
+// the rest. The returned error names every variable that wasn't set.
+func (t *Tmux) SetEnvironmentBatch(session string, vars map[string]string) error {
+	var errs []error
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		if !config.IsEnvName(k) {
+			errs = append(errs, fmt.Errorf("setting %q: not a valid variable name", k))
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) == 0 {
+		return errors.Join(errs...)
+	}
+
+	args := make([]string, 0, 6*len(keys))
+	for i, k := range keys {
+		if i > 0 {
+			args = append(args, ";")
+		}
+		args = append(args, "set-environment", "-t", session, k, escapeCommandEnd(vars[k]))
+	}
+	if _, err := t.run(args...); err != nil {
+		for _, k := range keys {
+			if _, err := t.run("set-environment", "-t", session, k, escapeCommandEnd(vars[k])); err != nil {
+				errs = append(errs, fmt.Errorf("setting %s: %w", k, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// escapeCommandEnd keeps tmux from reading an argument that ends in ";" as
+// the end of a command: a trailing "\;" is passed through as a literal ";".
+func escapeCommandEnd(arg string) string {
+	if strings.HasSuffix(arg, ";") {
+		return arg[:len(arg)-1] + `\;`
+	}
+	return arg
 }
 
 // GetEnvironment gets an environment variable from the session.
@@ -707,9 +1184,16 @@ func (t *Tmux) GetAllEnvironment(session string) (map[string]string, error) {
 	return env, nil
 }
 
-// RenameSession renames a session.
+// RenameSession renames session oldName to newName, keeping everything
+// running in it. Returns ErrSessionExists if newName is taken and
+// ErrSessionNotFound if oldName doesn't exist.
 func (t *Tmux) RenameSession(oldName, newName string) error {
-	_, err := t.run("rename-session", "-t", oldName, newName)
+	if taken, err := t.HasSession(newName); err != nil {
+		return err
+	} else if taken {
+		return ErrSessionExists
+	}
+	_, err := t.run("rename-session", "-t", "="+oldName, newName)
 	return err
 }
 
@@ -926,41 +1410,59 @@ func (t *Tmux) WaitForRuntimeReady(session string, rc *config.RuntimeConfig, tim
 		return nil
 	}
 
-	deadline := time.Now().Add(timeout)
-	for time.Now().Before(deadline) {
-		// Capture last few lines of the pane
-		lines, err := t.CapturePaneLines(session, 10)
-		if err != nil {
-			time.Sleep(200 * time.Millisecond)
-			continue
-		}
-		// Look for runtime prompt indicator at start of line
-		for _, line := range lines {
-			trimmed := strings.TrimSpace(line)
-			prefix := strings.TrimSpace(rc.Tmux.ReadyPromptPrefix)
-			if strings.HasPrefix(trimmed, rc.Tmux.ReadyPromptPrefix) || (prefix != "" && trimmed == prefix) {
-				return nil
-			}
-		}
-		time.Sleep(200 * time.Millisecond)
+	if err := t.waitForPrompt(session, rc.Tmux.ReadyPromptPrefix, nil, timeout); err != nil {
+		return fmt.Errorf("timeout waiting for runtime prompt")
 	}
-	return fmt.Errorf("timeout waiting for runtime prompt")
+	return nil
 }
 
+// sessionInfoFormat is the list-sessions format parseSessionInfo reads.
+const sessionInfoFormat = "#{session_name}|#{session_windows}|#{session_created_string}|#{session_attached}|#{session_activity}|#{session_last_attached}"
+
 // GetSessionInfo returns detailed information about a session.
 func (t *Tmux) GetSessionInfo(name string) (*SessionInfo, error) {
-	format := "#{session_name}|#{session_windows}|#{session_created_string}|#{session_attached}|#{session_activity}|#{session_last_attached}"
-	out, err := t.run("list-sessions", "-F", format, "-f", fmt.Sprintf("#{==:#{session_name},%s}", name))
+	out, err := t.run("list-sessions", "-F", sessionInfoFormat, "-f", fmt.Sprintf("#{==:#{session_name},%s}", name))
 	if err != nil {
 		return nil, err
 	}
 	if out == "" {
 		return nil, ErrSessionNotFound
 	}
+	return parseSessionInfo(out)
+}
+
+// ListSessionInfo returns information about every session, keyed by name,
+// from a single tmux call. Code looking at many sessions at once, like the
+// witness checking a rig's polecats, uses it rather than GetSessionInfo
+// per session: against a remote server each call is an ssh round trip.
+func (t *Tmux) ListSessionInfo() (map[string]*SessionInfo, error) {
+	out, err := t.run("list-sessions", "-F", sessionInfoFormat)
+	if err != nil {
+		if errors.Is(err, ErrNoServer) {
+			return map[string]*SessionInfo{}, nil
+		}
+		return nil, err
+	}
 
-	parts := strings.Split(out, "|")
+	infos := make(map[string]*SessionInfo)
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		info, err := parseSessionInfo(line)
+		if err != nil {
+			return nil, err
+		}
+		infos[info.Name] = info
+	}
+	return infos, nil
+}
+
+// parseSessionInfo parses one line of sessionInfoFormat output.
+func parseSessionInfo(line string) (*SessionInfo, error) {
+	parts := strings.Split(line, "|")
 	if len(parts) < 4 {
-		return nil, fmt.Errorf("unexpected session info format: %s", out)
+		return nil, fmt.Errorf("unexpected session info format: %s", line)
 	}
 
 	windows := 0
@@ -984,9 +1486,53 @@ func (t *Tmux) GetSessionInfo(name string) (*SessionInfo, error) {
 	return info, nil
 }
 
-// ApplyTheme sets the status bar style for a session.
+// SessionUptime returns how long ago tmux created the session.
+func (t *Tmux) SessionUptime(session string) (time.Duration, error) {
+	// display-message rather than list-sessions -f, which needs tmux 3.1.
+	// It prints nothing for a missing session, hence the name check.
+	out, err := t.run("display-message", "-p", "-t", "="+session+":", "#{session_name} #{session_created}")
+	if err != nil {
+		if errors.Is(err, ErrNoServer) {
+			return 0, ErrSessionNotFound
+		}
+		return 0, err
+	}
+	fields := strings.Fields(out)
+	if len(fields) != 2 || fields[0] != session {
+		return 0, ErrSessionNotFound
+	}
+	secs, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected session creation time %q", out)
+	}
+	return time.Since(time.Unix(secs, 0)), nil
+}
+
+// PaneIsDead reports whether the process in a session's active pane has
+// exited while tmux kept the pane (remain-on-exit). HasSession still finds
+// such a session, though nothing runs in it.
+func (t *Tmux) PaneIsDead(session string) (bool, error) {
+	out, err := t.run("display-message", "-p", "-t", "="+session+":", "#{session_name} #{pane_dead}")
+	if err != nil {
+		if errors.Is(err, ErrNoServer) {
+			return false, ErrSessionNotFound
+		}
+		return false, err
+	}
+	fields := strings.Fields(out)
+	if len(fields) != 2 || fields[0] != session {
+		return false, ErrSessionNotFound
+	}
+	return fields[1] == "1", nil
+}
+
+// ApplyTheme sets the status bar style for a session, and colors the
+// active pane border to match so multi-pane sessions carry the theme too.
 func (t *Tmux) ApplyTheme(session string, theme Theme) error {
-	_, err := t.run("set-option", "-t", session, "status-style", theme.Style())
+	if _, err := t.run("set-option", "-t", session, "status-style", theme.Style()); err != nil {
+		return err
+	}
+	_, err := t.run("set-option", "-w", "-t", session, "pane-active-border-style", "fg="+theme.BG)
 	return err
 }
 
@@ -1102,10 +1648,16 @@ func IsInsideTmux() bool {
 // SetMailClickBinding configures left-click on status-right to show mail preview.
 // This creates a popup showing the first unread message when clicking the mail icon area.
 func (t *Tmux) SetMailClickBinding(session string) error {
+	const peek = "gt mail peek || echo 'No unread mail'"
+	if !t.supports(3, 2) {
+		// No display-popup before tmux 3.2; show the output in view mode
+		_, err := t.run("bind-key", "-T", "root", "MouseDown1StatusRight", "run-shell", peek)
+		return err
+	}
 	// Bind left-click on status-right to show mail popup
 	// The popup runs gt mail peek and closes on any key
 	_, err := t.run("bind-key", "-T", "root", "MouseDown1StatusRight",
-		"display-popup", "-E", "-w", "60", "-h", "15", "gt mail peek || echo 'No unread mail'")
+		"display-popup", "-E", "-w", "60", "-h", "15", peek)
 	return err
 }
 
@@ -1157,7 +1709,8 @@ func (t *Tmux) SetTownCycleBindings(session string) error {
 // - Crew sessions: All crew members in the same rig
 //
 // IMPORTANT: These bindings are conditional - they only run gt cycle for
-// Gas Town sessions (those starting with "gt-" or "hq-"). For non-GT sessions,
+// Gas Town sessions (those starting with the rig session prefix, see
+// SetRigSessionPrefix, or "hq-"). For non-GT sessions,
 // the default tmux behavior (next-window/previous-window) is preserved.
 // See: https://github.com/steveyegge/gastown/issues/13
 //
@@ -1166,16 +1719,16 @@ func (t *Tmux) SetTownCycleBindings(session string) error {
 // resolution time (when the key is pressed), giving us the correct session.
 func (t *Tmux) SetCycleBindings(session string) error {
 	// C-b n → gt cycle next for GT sessions, next-window otherwise
-	// The if-shell checks if session name starts with "gt-" or "hq-"
+	// The if-shell checks if session name starts with the prefix or "hq-"
 	if _, err := t.run("bind-key", "-T", "prefix", "n",
-		"if-shell", "echo '#{session_name}' | grep -Eq '^(gt|hq)-'",
+		"if-shell", gasTownSessionTest(),
 		"run-shell 'gt cycle next --session #{session_name}'",
 		"next-window"); err != nil {
 		return err
 	}
 	// C-b p → gt cycle prev for GT sessions, previous-window otherwise
 	if _, err := t.run("bind-key", "-T", "prefix", "p",
-		"if-shell", "echo '#{session_name}' | grep -Eq '^(gt|hq)-'",
+		"if-shell", gasTownSessionTest(),
 		"run-shell 'gt cycle prev --session #{session_name}'",
 		"previous-window"); err != nil {
 		return err
@@ -1188,12 +1741,13 @@ func (t *Tmux) SetCycleBindings(session string) error {
 // Uses `gt feed --window` which handles both creation and switching.
 //
 // IMPORTANT: This binding is conditional - it only runs for Gas Town sessions
-// (those starting with "gt-" or "hq-"). For non-GT sessions, a help message is shown.
+// (those starting with the rig session prefix or "hq-"). For non-GT
+// sessions, a help message is shown.
 // See: https://github.com/steveyegge/gastown/issues/13
 func (t *Tmux) SetFeedBinding(session string) error {
 	// C-b a → gt feed --window for GT sessions, help message otherwise
 	_, err := t.run("bind-key", "-T", "prefix", "a",
-		"if-shell", "echo '#{session_name}' | grep -Eq '^(gt|hq)-'",
+		"if-shell", gasTownSessionTest(),
 		"run-shell 'gt feed --window'",
 		"display-message 'C-b a is for Gas Town sessions only'")
 	return err
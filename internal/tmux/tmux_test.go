@@ -1,10 +1,16 @@
 package tmux
 
 import (
+	"context"
+	"errors"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 func hasTmux() bool {
@@ -27,6 +33,23 @@ func TestListSessionsNoServer(t *testing.T) {
 	_ = sessions
 }
 
+func TestParseSessionEntries(t *testing.T) {
+	out := "gt-greenplace-witness|1767225600\nhq-mayor|bogus\n\nplain|1767229200"
+	entries := parseSessionEntries(out)
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3: %+v", len(entries), entries)
+	}
+	if entries[0].Name != "gt-greenplace-witness" || !entries[0].Created.Equal(time.Unix(1767225600, 0)) {
+		t.Errorf("entries[0] = %+v", entries[0])
+	}
+	if entries[1].Name != "hq-mayor" || !entries[1].Created.IsZero() {
+		t.Errorf("entries[1] = %+v, want zero creation time", entries[1])
+	}
+	if parseSessionEntries("") != nil {
+		t.Error("empty output should yield no entries")
+	}
+}
+
 func TestHasSessionNoServer(t *testing.T) {
 	if !hasTmux() {
 		t.Skip("tmux not installed")
@@ -123,6 +146,168 @@ func TestDuplicateSession(t *testing.T) {
 	}
 }
 
+func TestAvailable(t *testing.T) {
+	resetAvailable := func() {
+		availableMu.Lock()
+		availableCache = make(map[string]availability)
+		availableMu.Unlock()
+	}
+	resetAvailable()
+	t.Cleanup(resetAvailable)
+
+	t.Run("not installed", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+		t.Setenv(EnvTmuxCmd, "")
+		defer resetAvailable()
+		if _, err := NewTmux().Available(); !errors.Is(err, ErrNotInstalled) {
+			t.Errorf("Available() = %v, want ErrNotInstalled", err)
+		}
+	})
+
+	t.Run("wrapper not found", func(t *testing.T) {
+		t.Setenv(EnvTmuxCmd, "no-such-tmux-wrapper tmux")
+		defer resetAvailable()
+		_, err := NewTmux().Available()
+		if err == nil || !strings.Contains(err.Error(), "no-such-tmux-wrapper") {
+			t.Errorf("Available() = %v, want an error naming the wrapper", err)
+		}
+	})
+
+	t.Run("installed", func(t *testing.T) {
+		if !hasTmux() {
+			t.Skip("tmux not installed")
+		}
+		version, err := NewTmux().Available()
+		if err != nil {
+			t.Fatalf("Available: %v", err)
+		}
+		if !strings.HasPrefix(version, "tmux ") {
+			t.Errorf("version = %q, want tmux -V output", version)
+		}
+	})
+}
+
+func TestSessionUptime(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-uptime-" + t.Name()
+	_ = tm.KillSession(sessionName)
+
+	if err := tm.NewSession(sessionName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	uptime, err := tm.SessionUptime(sessionName)
+	if err != nil {
+		t.Fatalf("SessionUptime: %v", err)
+	}
+	if uptime < 0 || uptime > time.Minute {
+		t.Errorf("uptime of a new session = %s, want under a minute", uptime)
+	}
+
+	if _, err := tm.SessionUptime(sessionName + "-missing"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("SessionUptime(missing) = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestPaneIsDead(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-dead-" + t.Name()
+	_ = tm.KillSession(sessionName)
+
+	if err := tm.NewSessionWithCommand(sessionName, "", "sleep 1"); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+	if _, err := tm.run("set-option", "-t", sessionName, "remain-on-exit", "on"); err != nil {
+		t.Fatalf("set remain-on-exit: %v", err)
+	}
+
+	if dead, err := tm.PaneIsDead(sessionName); err != nil || dead {
+		t.Fatalf("PaneIsDead(running) = %v, %v; want false", dead, err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		dead, err := tm.PaneIsDead(sessionName)
+		if err != nil {
+			t.Fatalf("PaneIsDead: %v", err)
+		}
+		if dead {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("pane not dead after its command exited")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if running, _ := tm.HasSession(sessionName); !running {
+		t.Error("HasSession = false for a session kept by remain-on-exit")
+	}
+
+	if _, err := tm.PaneIsDead(sessionName + "-missing"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("PaneIsDead(missing) = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestSetEnvironmentBatch(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-env-" + t.Name()
+	_ = tm.KillSession(sessionName)
+	if err := tm.NewSession(sessionName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	vars := map[string]string{
+		"GT_TEST_A":    "one",
+		"GT_TEST_B":    "two words",
+		"GT_TEST_SEMI": "ends;",
+	}
+	if err := tm.SetEnvironmentBatch(sessionName, vars); err != nil {
+		t.Fatalf("SetEnvironmentBatch: %v", err)
+	}
+	if err := tm.SetEnvironment(sessionName, "GT_TEST_C", "three"); err != nil {
+		t.Fatalf("SetEnvironment: %v", err)
+	}
+	vars["GT_TEST_C"] = "three"
+
+	for k, want := range vars {
+		got, err := tm.GetEnvironment(sessionName, k)
+		if err != nil {
+			t.Errorf("GetEnvironment(%s): %v", k, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+
+	if err := tm.SetEnvironmentBatch(sessionName, nil); err != nil {
+		t.Errorf("SetEnvironmentBatch(nil) = %v, want nil", err)
+	}
+
+	// A bad key is reported without costing the others.
+	err := tm.SetEnvironmentBatch(sessionName, map[string]string{"GT_TEST_D": "four", "BAD=KEY": "x"})
+	if err == nil || !strings.Contains(err.Error(), "BAD=KEY") {
+		t.Errorf("SetEnvironmentBatch with a bad key = %v, want an error naming it", err)
+	}
+	if got, err := tm.GetEnvironment(sessionName, "GT_TEST_D"); err != nil || got != "four" {
+		t.Errorf("GT_TEST_D = %q, %v; want four despite the bad key", got, err)
+	}
+}
+
 func TestSendKeysAndCapture(t *testing.T) {
 	if !hasTmux() {
 		t.Skip("tmux not installed")
@@ -159,6 +344,121 @@ func TestSendKeysAndCapture(t *testing.T) {
 	}
 }
 
+func TestPaneHasPrompt(t *testing.T) {
+	tests := []struct {
+		lines  []string
+		prompt string
+		want   bool
+	}{
+		{[]string{"Welcome", "> "}, "> ", true},
+		{[]string{"Welcome", ">"}, "> ", true},
+		{[]string{"  > try \"fix lint\""}, "> ", true},
+		{[]string{"loading..."}, "> ", false},
+		{nil, "> ", false},
+		{[]string{"a -> b"}, "> ", false},
+	}
+	for _, tt := range tests {
+		if got := PaneHasPrompt(tt.lines, tt.prompt); got != tt.want {
+			t.Errorf("PaneHasPrompt(%q, %q) = %v, want %v", tt.lines, tt.prompt, got, tt.want)
+		}
+	}
+}
+
+func TestUsageHasFlag(t *testing.T) {
+	usage := "attach-session (attach) [-dErx] [-c working-directory] [-f flags] [-t target-session]"
+	if !usageHasFlag(usage, 'r') {
+		t.Errorf("usageHasFlag(%q, 'r') = false, want true", usage)
+	}
+	if usageHasFlag(usage, 'c') {
+		t.Errorf("usageHasFlag(%q, 'c') = true; -c takes an argument", usage)
+	}
+	if usageHasFlag("attach-session (attach) [-d] [-t target-session]", 'r') {
+		t.Error("usageHasFlag found -r in a usage line without it")
+	}
+}
+
+func TestSendKeysConfirm(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-confirm-" + t.Name()
+	_ = tm.KillSession(sessionName)
+	if err := tm.NewSessionWithCommand(sessionName, "", "printf 'ready> '; cat"); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	// A prompt that never shows up sends nothing.
+	err := tm.SendKeysConfirm(sessionName, "LOST_MARKER", "never>", 300*time.Millisecond)
+	if !errors.Is(err, ErrPromptNotFound) {
+		t.Fatalf("SendKeysConfirm with missing prompt = %v, want ErrPromptNotFound", err)
+	}
+
+	if err := tm.SendKeysConfirm(sessionName, "CONFIRM_MARKER", "ready>", 5*time.Second); err != nil {
+		t.Fatalf("SendKeysConfirm: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	output, err := tm.CapturePane(sessionName, 50)
+	if err != nil {
+		t.Fatalf("CapturePane: %v", err)
+	}
+	if strings.Contains(output, "LOST_MARKER") {
+		t.Errorf("keys were sent despite missing prompt: %q", output)
+	}
+	if !strings.Contains(output, "CONFIRM_MARKER") {
+		t.Errorf("keys not sent after prompt appeared: %q", output)
+	}
+}
+
+func TestSendKeysConfirmAfter(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-confirm-after-" + t.Name()
+	_ = tm.KillSession(sessionName)
+	script := `printf 'ready> '; while read l; do sleep 0.5; echo "done $l"; printf 'ready> '; done`
+	if err := tm.NewSessionWithCommand(sessionName, "", script); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+	if err := tm.waitForPrompt(sessionName, "ready>", nil, 5*time.Second); err != nil {
+		t.Fatalf("initial prompt: %v", err)
+	}
+
+	// The prompt already on screen before the earlier input doesn't count.
+	before, err := tm.CapturePaneLines(sessionName, 10)
+	if err != nil {
+		t.Fatalf("CapturePaneLines: %v", err)
+	}
+	err = tm.SendKeysConfirmAfter(sessionName, "LOST_MARKER", "ready>", before, 500*time.Millisecond)
+	if !errors.Is(err, ErrPromptNotFound) {
+		t.Fatalf("SendKeysConfirmAfter on an unchanged pane = %v, want ErrPromptNotFound", err)
+	}
+
+	// Once the earlier input is processed and the prompt is back, keys go.
+	if err := tm.SendKeys(sessionName, "beacon"); err != nil {
+		t.Fatalf("SendKeys: %v", err)
+	}
+	if err := tm.SendKeysConfirmAfter(sessionName, "CONFIRM_MARKER", "ready>", before, 5*time.Second); err != nil {
+		t.Fatalf("SendKeysConfirmAfter: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	output, err := tm.CapturePane(sessionName, 50)
+	if err != nil {
+		t.Fatalf("CapturePane: %v", err)
+	}
+	if strings.Contains(output, "LOST_MARKER") {
+		t.Errorf("keys were sent before the pane changed: %q", output)
+	}
+	if !strings.Contains(output, "done beacon") || !strings.Contains(output, "CONFIRM_MARKER") {
+		t.Errorf("keys not sent after the prompt came back: %q", output)
+	}
+}
+
 func TestGetSessionInfo(t *testing.T) {
 	if !hasTmux() {
 		t.Skip("tmux not installed")
@@ -189,6 +489,32 @@ func TestGetSessionInfo(t *testing.T) {
 	}
 }
 
+func TestListSessionInfo(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-listinfo-" + t.Name()
+	_ = tm.KillSession(sessionName)
+	if err := tm.NewSession(sessionName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	infos, err := tm.ListSessionInfo()
+	if err != nil {
+		t.Fatalf("ListSessionInfo: %v", err)
+	}
+	info, ok := infos[sessionName]
+	if !ok {
+		t.Fatalf("ListSessionInfo() has no %s: %v", sessionName, infos)
+	}
+	if info.Name != sessionName || info.Windows < 1 || info.Activity == "" {
+		t.Errorf("info = %+v, want the session with a window and an activity time", info)
+	}
+}
+
 func TestWrapError(t *testing.T) {
 	tm := NewTmux()
 
@@ -211,6 +537,111 @@ func TestWrapError(t *testing.T) {
 	}
 }
 
+func TestCommandArgv(t *testing.T) {
+	t.Setenv(EnvTmuxCmd, "")
+	defer func() { _ = SetCommand("") }()
+
+	tests := []struct {
+		name    string
+		cmdline string
+		args    []string
+		want    []string
+	}{
+		{"default", "", []string{"has-session", "-t", "=gt-x"}, []string{"tmux", "has-session", "-t", "=gt-x"}},
+		{"local wrapper", "sudo -u agent tmux", []string{"list-sessions"}, []string{"sudo", "-u", "agent", "tmux", "list-sessions"}},
+		{"ssh quotes args", "ssh box tmux", []string{"send-keys", "-t", "s", "-l", "it's done"},
+			[]string{"ssh", "box", "tmux", "'send-keys'", "'-t'", "'s'", "'-l'", `'it'\''s done'`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetCommand(tt.cmdline); err != nil {
+				t.Fatalf("SetCommand(%q): %v", tt.cmdline, err)
+			}
+			got := commandArgv(tt.args)
+			if strings.Join(got, "\x00") != strings.Join(tt.want, "\x00") {
+				t.Errorf("commandArgv() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_CallTimeoutKillsCommand(t *testing.T) {
+	t.Setenv(EnvTmuxCmd, "")
+	stub := filepath.Join(t.TempDir(), "tmux")
+	if err := os.WriteFile(stub, []byte("#!/bin/sh\nexec sleep 10\n"), 0755); err != nil {
+		t.Fatalf("write tmux stub: %v", err)
+	}
+	if err := SetCommand(stub); err != nil {
+		t.Fatalf("SetCommand: %v", err)
+	}
+	defer func() { _ = SetCommand("") }()
+	SetCallTimeout(100 * time.Millisecond)
+	defer SetCallTimeout(0)
+
+	start := time.Now()
+	_, err := NewTmux().run("list-sessions")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("run past the call timeout = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("run took %v; tmux should have been killed at the call timeout", elapsed)
+	}
+}
+
+func TestCommandArgv_EnvOverride(t *testing.T) {
+	_ = SetCommand("")
+	t.Setenv(EnvTmuxCmd, "ssh remote tmux")
+
+	if !IsRemote() {
+		t.Error("expected IsRemote() with ssh GT_TMUX_CMD")
+	}
+	got := commandArgv([]string{"-V"})
+	if len(got) != 4 || got[0] != "ssh" || got[3] != "'-V'" {
+		t.Errorf("commandArgv() = %q, want ssh remote tmux '-V'", got)
+	}
+}
+
+func TestKillSessionWithProcesses_RemoteLeavesLocalProcesses(t *testing.T) {
+	// A local process tree standing in for whatever shares the remote
+	// pane's PID on this host.
+	parent := exec.Command("sh", "-c", "sleep 30 & wait")
+	if err := parent.Start(); err != nil {
+		t.Fatalf("start local process: %v", err)
+	}
+	defer func() {
+		_ = exec.Command("pkill", "-P", strconv.Itoa(parent.Process.Pid)).Run()
+		_ = parent.Process.Kill()
+		_ = parent.Wait()
+	}()
+	time.Sleep(100 * time.Millisecond)
+	children := getAllDescendants(strconv.Itoa(parent.Process.Pid))
+	if len(children) == 0 {
+		t.Fatal("local process has no children")
+	}
+
+	// An ssh wrapper that reports the local PID as the remote pane's.
+	ssh := filepath.Join(t.TempDir(), "ssh")
+	script := "#!/bin/sh\ncase \"$*\" in *pane_pid*) echo " + strconv.Itoa(parent.Process.Pid) + ";; esac\n"
+	if err := os.WriteFile(ssh, []byte(script), 0755); err != nil {
+		t.Fatalf("write ssh stub: %v", err)
+	}
+	t.Setenv(EnvTmuxCmd, "")
+	if err := SetCommand(ssh + " remote tmux"); err != nil {
+		t.Fatalf("SetCommand: %v", err)
+	}
+	defer func() { _ = SetCommand("") }()
+
+	if err := NewTmux().KillSessionWithProcesses("gt-gastown-witness"); err != nil {
+		t.Fatalf("KillSessionWithProcesses: %v", err)
+	}
+	for _, pid := range children {
+		if err := exec.Command("kill", "-0", pid).Run(); err != nil {
+			t.Errorf("local process %s was signalled for a remote session", pid)
+		}
+	}
+}
+
 func TestEnsureSessionFresh_NoExistingSession(t *testing.T) {
 	if !hasTmux() {
 		t.Skip("tmux not installed")
@@ -605,3 +1036,169 @@ func TestSessionSet(t *testing.T) {
 		t.Errorf("SessionSet.Names() doesn't contain %q", sessionName)
 	}
 }
+
+func TestEnsureSession(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-ensure-" + t.Name()
+	_ = tm.KillSession(sessionName)
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	created, err := tm.EnsureSession(sessionName, "")
+	if err != nil {
+		t.Fatalf("EnsureSession: %v", err)
+	}
+	if !created {
+		t.Error("first EnsureSession should create the session")
+	}
+
+	created, err = tm.EnsureSession(sessionName, "")
+	if err != nil {
+		t.Fatalf("EnsureSession (existing): %v", err)
+	}
+	if created {
+		t.Error("second EnsureSession should find the existing session")
+	}
+}
+
+func TestEnsureSessionConcurrent(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-ensure-" + t.Name()
+	_ = tm.KillSession(sessionName)
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	const n = 4
+	results := make(chan bool, n)
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			created, err := tm.EnsureSession(sessionName, "")
+			errs <- err
+			results <- created
+		}()
+	}
+	createdCount := 0
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("EnsureSession: %v", err)
+		}
+		if <-results {
+			createdCount++
+		}
+	}
+	if createdCount != 1 {
+		t.Errorf("%d callers created the session, want exactly 1", createdCount)
+	}
+}
+
+func TestKillSessionGraceful(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+	tm := NewTmux()
+
+	tests := []struct {
+		name    string
+		command string
+		maxWait time.Duration
+	}{
+		// Nothing in the foreground: killed without waiting
+		{"shell", "", 2 * time.Second},
+		// Exits on the interrupt, well before the timeout
+		{"interruptible", "sleep 60", 2 * time.Second},
+		// Ignores the interrupt: hard-killed at the timeout
+		{"stubborn", `sh -c 'trap "" INT; exec sleep 60'`, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := "gt-test-graceful-" + tt.name
+			_ = tm.KillSession(session)
+			var err error
+			if tt.command == "" {
+				err = tm.NewSession(session, "")
+			} else {
+				err = tm.NewSessionWithCommand(session, "", tt.command)
+			}
+			if err != nil {
+				t.Fatalf("creating session: %v", err)
+			}
+			defer func() { _ = tm.KillSession(session) }()
+			time.Sleep(300 * time.Millisecond) // let the command start
+			if has, _ := tm.HasSession(session); !has {
+				t.Fatal("session exited before the test could stop it")
+			}
+
+			start := time.Now()
+			if err := tm.KillSessionGraceful(session, 2*time.Second); err != nil {
+				t.Fatalf("KillSessionGraceful: %v", err)
+			}
+			took := time.Since(start)
+			if took > tt.maxWait {
+				t.Errorf("took %s, want under %s", took, tt.maxWait)
+			}
+			if tt.name == "stubborn" && took < 2*time.Second {
+				t.Errorf("took %s; a process ignoring Ctrl-C should be waited for until the timeout", took)
+			}
+			if has, _ := tm.HasSession(session); has {
+				t.Error("session still exists")
+			}
+		})
+	}
+
+	if err := tm.KillSessionGraceful("gt-test-graceful-missing", time.Second); err == nil {
+		t.Error("a missing session should be an error")
+	}
+}
+
+func TestRenameSession(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	oldName := "gt-test-rename-old"
+	newName := "gt-test-rename-new"
+	taken := "gt-test-rename-taken"
+	for _, name := range []string{oldName, newName, taken} {
+		_ = tm.KillSession(name)
+		defer func(name string) { _ = tm.KillSession(name) }(name)
+	}
+
+	if err := tm.NewSession(oldName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	if err := tm.RenameSession(oldName, newName); err != nil {
+		t.Fatalf("RenameSession: %v", err)
+	}
+	if has, _ := tm.HasSession(oldName); has {
+		t.Error("old session name still exists after rename")
+	}
+	if has, _ := tm.HasSession(newName); !has {
+		t.Error("new session name missing after rename")
+	}
+
+	if err := tm.NewSession(taken, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	if err := tm.RenameSession(newName, taken); !errors.Is(err, ErrSessionExists) {
+		t.Errorf("RenameSession onto a taken name = %v, want ErrSessionExists", err)
+	}
+	if err := tm.RenameSession(oldName, "gt-test-rename-other"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("RenameSession of a missing session = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestGasTownSessionTest_UsesRigPrefix(t *testing.T) {
+	t.Cleanup(func() { SetRigSessionPrefix("gt-") })
+	SetRigSessionPrefix("gt-alice-")
+	if got, want := gasTownSessionTest(), "echo '#{session_name}' | grep -Eq '^(hq-|gt-alice-)'"; got != want {
+		t.Errorf("gasTownSessionTest() = %q, want %q", got, want)
+	}
+}
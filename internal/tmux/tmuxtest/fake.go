@@ -0,0 +1,360 @@
+// Package tmuxtest provides an in-memory tmux.Client for tests.
+package tmuxtest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// FakeSession is a session of a FakeTmux.
+type FakeSession struct {
+	WorkDir string
+	Command string
+
+	// Pane is what CapturePane returns; SendKeys appends to it.
+	Pane string
+
+	// Created is when the session was created, for SessionUptime.
+	Created time.Time
+
+	// Dead is what PaneIsDead reports: the session's process exited but
+	// tmux kept its pane.
+	Dead bool
+
+	// Activity is the session's last activity time as tmux reports it (a
+	// unix timestamp string), for ListSessionInfo.
+	Activity string
+
+	// NoAgent makes IsClaudeRunning report false for a live pane, as for
+	// a session whose agent exited back to a shell.
+	NoAgent bool
+}
+
+// FakeTmux is a tmux.Client that keeps its sessions in memory and records
+// every call, so tests can assert which tmux operations a command ran and
+// in what order. Like tmux, creating a session that exists fails with
+// tmux.ErrSessionExists and addressing one that doesn't with
+// tmux.ErrSessionNotFound.
+type FakeTmux struct {
+	mu       sync.Mutex
+	sessions map[string]*FakeSession
+	calls    []string
+
+	// Errors makes a method fail: a call to the named method (e.g.
+	// "KillSession") returns the error instead of doing anything.
+	Errors map[string]error
+
+	// ReadOnlyAttach is what SupportsReadOnlyAttach reports.
+	ReadOnlyAttach bool
+
+	// Version is what Available reports.
+	Version string
+
+	// Now is the clock for session creation and uptime (default time.Now).
+	Now func() time.Time
+}
+
+var _ tmux.Client = (*FakeTmux)(nil)
+
+// NewFakeTmux returns a FakeTmux with the named sessions already running.
+func NewFakeTmux(sessions ...string) *FakeTmux {
+	f := &FakeTmux{
+		sessions:       make(map[string]*FakeSession),
+		Errors:         make(map[string]error),
+		ReadOnlyAttach: true,
+		Version:        "tmux 3.4",
+	}
+	for _, name := range sessions {
+		f.sessions[name] = &FakeSession{Created: f.now()}
+	}
+	return f
+}
+
+// Calls returns the calls made so far, each as the method name followed by
+// its arguments, e.g. "HasSession gt-greenplace-witness".
+func (f *FakeTmux) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.calls...)
+}
+
+// Session returns the named session, or nil if it isn't running.
+func (f *FakeTmux) Session(name string) *FakeSession {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sessions[name]
+}
+
+func (f *FakeTmux) now() time.Time {
+	if f.Now != nil {
+		return f.Now()
+	}
+	return time.Now()
+}
+
+// record logs a call and returns the error injected for the method, if
+// any. The caller must hold f.mu.
+func (f *FakeTmux) record(method string, args ...string) error {
+	f.calls = append(f.calls, strings.Join(append([]string{method}, args...), " "))
+	return f.Errors[method]
+}
+
+// HasSession reports whether the session exists.
+func (f *FakeTmux) HasSession(name string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("HasSession", name); err != nil {
+		return false, err
+	}
+	return f.sessions[name] != nil, nil
+}
+
+// NewSession creates the session.
+func (f *FakeTmux) NewSession(name, workDir string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("NewSession", name, workDir); err != nil {
+		return err
+	}
+	return f.create(name, workDir, "")
+}
+
+// NewSessionWithCommand creates the session, noting its command.
+func (f *FakeTmux) NewSessionWithCommand(name, workDir, command string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("NewSessionWithCommand", name, workDir, command); err != nil {
+		return err
+	}
+	return f.create(name, workDir, command)
+}
+
+func (f *FakeTmux) create(name, workDir, command string) error {
+	if f.sessions[name] != nil {
+		return tmux.ErrSessionExists
+	}
+	f.sessions[name] = &FakeSession{WorkDir: workDir, Command: command, Created: f.now()}
+	return nil
+}
+
+// EnsureSession creates the session unless it exists. It goes through
+// HasSession and NewSession, so those are the calls recorded and the
+// errors injected for them apply.
+func (f *FakeTmux) EnsureSession(name, workDir string) (bool, error) {
+	return f.ensure(name, func() error { return f.NewSession(name, workDir) })
+}
+
+// EnsureSessionWithCommand is EnsureSession through NewSessionWithCommand.
+func (f *FakeTmux) EnsureSessionWithCommand(name, workDir, command string) (bool, error) {
+	return f.ensure(name, func() error { return f.NewSessionWithCommand(name, workDir, command) })
+}
+
+// ensure runs create unless the session exists; like tmux.Tmux, losing the
+// race to another creator counts as existing.
+func (f *FakeTmux) ensure(name string, create func() error) (bool, error) {
+	exists, err := f.HasSession(name)
+	if err != nil {
+		return false, fmt.Errorf("checking session: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+	if err := create(); err != nil {
+		if errors.Is(err, tmux.ErrSessionExists) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ListSessions returns the session names, sorted.
+func (f *FakeTmux) ListSessions() ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("ListSessions"); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(f.sessions))
+	for name := range f.sessions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ListSessionInfo returns each session's name, creation and activity time.
+func (f *FakeTmux) ListSessionInfo() (map[string]*tmux.SessionInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("ListSessionInfo"); err != nil {
+		return nil, err
+	}
+	infos := make(map[string]*tmux.SessionInfo, len(f.sessions))
+	for name, s := range f.sessions {
+		infos[name] = &tmux.SessionInfo{
+			Name:     name,
+			Windows:  1,
+			Created:  fmt.Sprint(s.Created.Unix()),
+			Activity: s.Activity,
+		}
+	}
+	return infos, nil
+}
+
+// KillSession removes the session.
+func (f *FakeTmux) KillSession(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("KillSession", name); err != nil {
+		return err
+	}
+	return f.kill(name)
+}
+
+// KillSessionGraceful removes the session; there is no process to wait for.
+func (f *FakeTmux) KillSessionGraceful(name string, timeout time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("KillSessionGraceful", name, timeout.String()); err != nil {
+		return err
+	}
+	return f.kill(name)
+}
+
+func (f *FakeTmux) kill(name string) error {
+	if f.sessions[name] == nil {
+		return tmux.ErrSessionNotFound
+	}
+	delete(f.sessions, name)
+	return nil
+}
+
+// RenameSession moves the session to its new name.
+func (f *FakeTmux) RenameSession(oldName, newName string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("RenameSession", oldName, newName); err != nil {
+		return err
+	}
+	s := f.sessions[oldName]
+	if s == nil {
+		return tmux.ErrSessionNotFound
+	}
+	if f.sessions[newName] != nil {
+		return tmux.ErrSessionExists
+	}
+	delete(f.sessions, oldName)
+	f.sessions[newName] = s
+	return nil
+}
+
+// SendKeys appends keys and a newline to the session's pane.
+func (f *FakeTmux) SendKeys(session, keys string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("SendKeys", session, keys); err != nil {
+		return err
+	}
+	s := f.sessions[session]
+	if s == nil {
+		return tmux.ErrSessionNotFound
+	}
+	s.Pane += keys + "\n"
+	return nil
+}
+
+// NudgeSession appends message and a newline to the session's pane.
+func (f *FakeTmux) NudgeSession(session, message string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("NudgeSession", session, message); err != nil {
+		return err
+	}
+	s := f.sessions[session]
+	if s == nil {
+		return tmux.ErrSessionNotFound
+	}
+	s.Pane += message + "\n"
+	return nil
+}
+
+// IsClaudeRunning reports whether the session exists with a live pane and
+// without NoAgent.
+func (f *FakeTmux) IsClaudeRunning(session string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_ = f.record("IsClaudeRunning", session)
+	s := f.sessions[session]
+	return s != nil && !s.Dead && !s.NoAgent
+}
+
+// CapturePane returns the last lines of the session's pane.
+func (f *FakeTmux) CapturePane(session string, lines int) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("CapturePane", session, fmt.Sprint(lines)); err != nil {
+		return "", err
+	}
+	s := f.sessions[session]
+	if s == nil {
+		return "", tmux.ErrSessionNotFound
+	}
+	all := strings.Split(strings.TrimSuffix(s.Pane, "\n"), "\n")
+	if lines > 0 && len(all) > lines {
+		all = all[len(all)-lines:]
+	}
+	return strings.Join(all, "\n"), nil
+}
+
+// SessionUptime returns how long ago the session was created.
+func (f *FakeTmux) SessionUptime(session string) (time.Duration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("SessionUptime", session); err != nil {
+		return 0, err
+	}
+	s := f.sessions[session]
+	if s == nil {
+		return 0, tmux.ErrSessionNotFound
+	}
+	return f.now().Sub(s.Created), nil
+}
+
+// PaneIsDead returns the session's Dead.
+func (f *FakeTmux) PaneIsDead(session string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("PaneIsDead", session); err != nil {
+		return false, err
+	}
+	s := f.sessions[session]
+	if s == nil {
+		return false, tmux.ErrSessionNotFound
+	}
+	return s.Dead, nil
+}
+
+// SupportsReadOnlyAttach returns ReadOnlyAttach.
+func (f *FakeTmux) SupportsReadOnlyAttach() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_ = f.record("SupportsReadOnlyAttach")
+	return f.ReadOnlyAttach
+}
+
+// Available returns Version, or the error injected for "Available".
+func (f *FakeTmux) Available() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("Available"); err != nil {
+		return "", err
+	}
+	return f.Version, nil
+}
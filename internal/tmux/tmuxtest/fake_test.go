@@ -0,0 +1,60 @@
+package tmuxtest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestFakeTmux_EnsureSessionWithCommand(t *testing.T) {
+	const session = "gt-witness-group-night"
+
+	t.Run("creates a missing session", func(t *testing.T) {
+		f := NewFakeTmux()
+		created, err := f.EnsureSessionWithCommand(session, "/town", "gt witness start --foreground")
+		if err != nil || !created {
+			t.Fatalf("EnsureSessionWithCommand = %v, %v; want created", created, err)
+		}
+		want := []string{
+			"HasSession " + session,
+			"NewSessionWithCommand " + session + " /town gt witness start --foreground",
+		}
+		if got := f.Calls(); !reflect.DeepEqual(got, want) {
+			t.Errorf("calls = %q, want %q", got, want)
+		}
+		if s := f.Session(session); s == nil || s.Command != "gt witness start --foreground" {
+			t.Errorf("session = %+v, want it running the command", s)
+		}
+	})
+
+	t.Run("leaves a running session alone", func(t *testing.T) {
+		f := NewFakeTmux(session)
+		created, err := f.EnsureSessionWithCommand(session, "/town", "gt witness start --foreground")
+		if err != nil || created {
+			t.Fatalf("EnsureSessionWithCommand = %v, %v; want not created", created, err)
+		}
+		if got, want := f.Calls(), []string{"HasSession " + session}; !reflect.DeepEqual(got, want) {
+			t.Errorf("calls = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("lost race counts as running", func(t *testing.T) {
+		f := NewFakeTmux()
+		f.Errors["NewSessionWithCommand"] = tmux.ErrSessionExists
+		created, err := f.EnsureSessionWithCommand(session, "/town", "cmd")
+		if err != nil || created {
+			t.Fatalf("EnsureSessionWithCommand = %v, %v; want not created", created, err)
+		}
+	})
+
+	t.Run("reports a failed create", func(t *testing.T) {
+		f := NewFakeTmux()
+		boom := errors.New("no space left")
+		f.Errors["NewSessionWithCommand"] = boom
+		if _, err := f.EnsureSessionWithCommand(session, "/town", "cmd"); !errors.Is(err, boom) {
+			t.Fatalf("err = %v, want %v", err, boom)
+		}
+	})
+}
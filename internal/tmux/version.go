@@ -0,0 +1,95 @@
+package tmux
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Minimum tmux release gt is known to work well with. Older versions still
+// run, with a one-time warning, since most commands don't depend on it.
+const (
+	MinVersionMajor = 3
+	MinVersionMinor = 0
+)
+
+// Version is a parsed tmux version. tmux numbers releases major.minor with
+// an optional patch letter (3.3a) or pre-release tag (3.2-rc2), and
+// development builds report "next-3.5" or "master".
+type Version struct {
+	Major  int
+	Minor  int
+	Suffix string // patch letter or pre-release tag, e.g. "a" or "-rc2"
+	Dev    bool   // development build: next-X.Y or master
+	Raw    string // the version as tmux printed it, e.g. "3.3a"
+}
+
+// tmuxVersionRe matches the version in tmux -V output, e.g. "3.4",
+// "3.3a", "next-3.5" or "3.2-rc2".
+var tmuxVersionRe = regexp.MustCompile(`^(next-)?(\d+)\.(\d+)(.*)$`)
+
+// ParseVersion parses tmux -V output such as "tmux 3.3a" or "tmux next-3.5".
+func ParseVersion(out string) (Version, error) {
+	raw := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(out), "tmux"))
+	if raw == "master" {
+		return Version{Dev: true, Raw: raw}, nil
+	}
+	m := tmuxVersionRe.FindStringSubmatch(raw)
+	if m == nil {
+		return Version{}, fmt.Errorf("unrecognized tmux version %q", strings.TrimSpace(out))
+	}
+	major, _ := strconv.Atoi(m[2])
+	minor, _ := strconv.Atoi(m[3])
+	return Version{Major: major, Minor: minor, Suffix: m[4], Dev: m[1] != "", Raw: raw}, nil
+}
+
+// AtLeast reports whether v is major.minor or newer. A master build is
+// assumed to have every released feature.
+func (v Version) AtLeast(major, minor int) bool {
+	if v.Dev && v.Major == 0 {
+		return true
+	}
+	if v.Major != major {
+		return v.Major > major
+	}
+	return v.Minor >= minor
+}
+
+func (v Version) String() string {
+	return "tmux " + v.Raw
+}
+
+// Version returns the parsed version of the tmux gt runs.
+func (t *Tmux) Version() (Version, error) {
+	out, err := t.Available()
+	if err != nil {
+		return Version{}, err
+	}
+	return ParseVersion(out)
+}
+
+// supports reports whether tmux is at least major.minor. If the version
+// can't be determined the feature is assumed to be there, so an odd
+// version string never disables behaviour that works.
+func (t *Tmux) supports(major, minor int) bool {
+	v, err := t.Version()
+	return err != nil || v.AtLeast(major, minor)
+}
+
+var oldVersionWarning sync.Once
+
+// warnIfOld prints a one-time warning when tmux is older than the minimum
+// known-good release.
+func warnIfOld(out string) {
+	v, err := ParseVersion(out)
+	if err != nil || v.AtLeast(MinVersionMajor, MinVersionMinor) {
+		return
+	}
+	oldVersionWarning.Do(func() {
+		fmt.Fprintf(os.Stderr, "warning: %s is older than tmux %d.%d; some gt features may not work\n",
+			v, MinVersionMajor, MinVersionMinor)
+	})
+}
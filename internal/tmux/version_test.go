@@ -0,0 +1,61 @@
+package tmux
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		out          string
+		major, minor int
+		suffix       string
+		dev          bool
+	}{
+		{"tmux 3.4\n", 3, 4, "", false},
+		{"tmux 3.3a", 3, 3, "a", false},
+		{"tmux 2.9", 2, 9, "", false},
+		{"tmux 3.2-rc2", 3, 2, "-rc2", false},
+		{"tmux next-3.5", 3, 5, "", true},
+		{"tmux next-3.4a", 3, 4, "a", true},
+		{"tmux master", 0, 0, "", true},
+	}
+	for _, tt := range tests {
+		v, err := ParseVersion(tt.out)
+		if err != nil {
+			t.Errorf("ParseVersion(%q): %v", tt.out, err)
+			continue
+		}
+		if v.Major != tt.major || v.Minor != tt.minor || v.Suffix != tt.suffix || v.Dev != tt.dev {
+			t.Errorf("ParseVersion(%q) = %+v, want %d.%d%s dev=%v", tt.out, v, tt.major, tt.minor, tt.suffix, tt.dev)
+		}
+	}
+
+	for _, bad := range []string{"", "tmux", "screen 4.9", "tmux openbsd-7.4"} {
+		if _, err := ParseVersion(bad); err == nil {
+			t.Errorf("ParseVersion(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestVersion_AtLeast(t *testing.T) {
+	tests := []struct {
+		out          string
+		major, minor int
+		want         bool
+	}{
+		{"tmux 3.4", 3, 2, true},
+		{"tmux 3.2", 3, 2, true},
+		{"tmux 3.1c", 3, 2, false},
+		{"tmux 2.9a", 3, 0, false},
+		{"tmux 10.0", 3, 2, true},
+		{"tmux next-3.5", 3, 5, true},
+		{"tmux master", 9, 9, true},
+	}
+	for _, tt := range tests {
+		v, err := ParseVersion(tt.out)
+		if err != nil {
+			t.Fatalf("ParseVersion(%q): %v", tt.out, err)
+		}
+		if got := v.AtLeast(tt.major, tt.minor); got != tt.want {
+			t.Errorf("%s AtLeast(%d, %d) = %v, want %v", tt.out, tt.major, tt.minor, got, tt.want)
+		}
+	}
+}
@@ -13,7 +13,12 @@ import (
 )
 
 func init() {
-	if !ShouldUseColor() {
+	SetColorEnabled(ShouldUseColor())
+}
+
+// SetColorEnabled switches all lipgloss rendering between color and plain text.
+func SetColorEnabled(enabled bool) {
+	if !enabled {
 		// disable colors when not appropriate (non-TTY, NO_COLOR, etc.)
 		lipgloss.SetColorProfile(termenv.Ascii)
 	} else {
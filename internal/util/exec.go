@@ -47,3 +47,18 @@ func ExecRun(workDir, cmd string, args ...string) error {
 
 	return nil
 }
+
+// ShellQuote wraps s in single quotes for POSIX shells, so it reaches the
+// command as one argument whatever it contains.
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// ShellJoin quotes each argument with ShellQuote and joins them with spaces.
+func ShellJoin(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = ShellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
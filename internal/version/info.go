@@ -0,0 +1,59 @@
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// Info is what a gt binary knows about its own build.
+type Info struct {
+	Version   string `json:"version"`
+	Build     string `json:"build"`
+	Commit    string `json:"commit,omitempty"`
+	Branch    string `json:"branch,omitempty"`
+	BuildDate string `json:"build_date,omitempty"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+}
+
+// NewInfo returns build info for the given ldflags values, filling in the
+// Go version and platform from the runtime.
+func NewInfo(version, build, commit, branch, buildDate string) Info {
+	return Info{
+		Version:   version,
+		Build:     build,
+		Commit:    commit,
+		Branch:    branch,
+		BuildDate: buildDate,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+}
+
+// Summary is the one-line form, e.g. "gt version 0.2.6 (dev: main@0123456789ab)".
+func (i Info) Summary() string {
+	switch {
+	case i.Commit != "" && i.Branch != "":
+		return fmt.Sprintf("gt version %s (%s: %s@%s)", i.Version, i.Build, i.Branch, ShortCommit(i.Commit))
+	case i.Commit != "":
+		return fmt.Sprintf("gt version %s (%s: %s)", i.Version, i.Build, ShortCommit(i.Commit))
+	default:
+		return fmt.Sprintf("gt version %s (%s)", i.Version, i.Build)
+	}
+}
+
+// String is the summary followed by the commit, build date and Go version,
+// one per line. Fields the build didn't record are left out.
+func (i Info) String() string {
+	var b strings.Builder
+	b.WriteString(i.Summary())
+	if i.Commit != "" {
+		fmt.Fprintf(&b, "\n  commit: %s", i.Commit)
+	}
+	if i.BuildDate != "" {
+		fmt.Fprintf(&b, "\n  built:  %s", i.BuildDate)
+	}
+	fmt.Fprintf(&b, "\n  go:     %s %s", i.GoVersion, i.Platform)
+	return b.String()
+}
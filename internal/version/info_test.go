@@ -0,0 +1,37 @@
+package version
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInfoSummary(t *testing.T) {
+	tests := []struct {
+		info Info
+		want string
+	}{
+		{Info{Version: "0.2.6", Build: "dev"}, "gt version 0.2.6 (dev)"},
+		{Info{Version: "0.2.6", Build: "dev", Commit: "0123456789abcdef"}, "gt version 0.2.6 (dev: 0123456789ab)"},
+		{Info{Version: "0.2.6", Build: "abc", Commit: "0123456789abcdef", Branch: "main"}, "gt version 0.2.6 (abc: main@0123456789ab)"},
+	}
+	for _, tt := range tests {
+		if got := tt.info.Summary(); got != tt.want {
+			t.Errorf("Summary() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestInfoString(t *testing.T) {
+	info := NewInfo("0.2.6", "dev", "0123456789abcdef", "", "2026-01-05T10:00:00Z")
+	s := info.String()
+	for _, want := range []string{"gt version 0.2.6", "commit: 0123456789abcdef", "built:  2026-01-05T10:00:00Z", "go:     go"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("String() missing %q:\n%s", want, s)
+		}
+	}
+
+	s = NewInfo("0.2.6", "dev", "", "", "").String()
+	if strings.Contains(s, "commit:") || strings.Contains(s, "built:") {
+		t.Errorf("String() should leave out unknown fields:\n%s", s)
+	}
+}
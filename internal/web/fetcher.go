@@ -10,6 +10,9 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/activity"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -35,7 +38,8 @@ func NewLiveConvoyFetcher() (*LiveConvoyFetcher, error) {
 func (f *LiveConvoyFetcher) FetchConvoys() ([]ConvoyRow, error) {
 	// List all open convoy-type issues
 	listArgs := []string{"list", "--type=convoy", "--status=open", "--json"}
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd, cancel := beads.Command(listArgs...)
+	defer cancel()
 	listCmd.Dir = f.townBeads
 
 	var stdout bytes.Buffer
@@ -238,7 +242,8 @@ func (f *LiveConvoyFetcher) getIssueDetailsBatch(issueIDs []string) map[string]*
 	args = append(args, "--json")
 
 	// #nosec G204 -- bd is a trusted internal tool, args are issue IDs
-	showCmd := exec.Command("bd", args...)
+	showCmd, cancel := beads.Command(args...)
+	defer cancel()
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
 
@@ -331,20 +336,13 @@ func (f *LiveConvoyFetcher) getSessionActivityForAssignee(assignee string) *time
 	polecat := parts[2]
 
 	// Construct session name
-	sessionName := fmt.Sprintf("gt-%s-%s", rig, polecat)
+	sessionName := session.PolecatSessionName(rig, polecat)
 
 	// Query tmux for session activity
 	// Format: session_activity returns unix timestamp
-	cmd := exec.Command("tmux", "list-sessions", "-F", "#{session_name}|#{session_activity}",
+	output, err := tmux.NewTmux().Run("list-sessions", "-F", "#{session_name}|#{session_activity}",
 		"-f", fmt.Sprintf("#{==:#{session_name},%s}", sessionName))
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-	if err := cmd.Run(); err != nil {
-		return nil
-	}
-
-	output := strings.TrimSpace(stdout.String())
-	if output == "" {
+	if err != nil || output == "" {
 		return nil
 	}
 
@@ -369,15 +367,13 @@ func (f *LiveConvoyFetcher) getSessionActivityForAssignee(assignee string) *time
 func (f *LiveConvoyFetcher) getAllPolecatActivity() *time.Time {
 	// List all tmux sessions matching gt-*-* pattern (polecat sessions)
 	// Format: gt-{rig}-{polecat}
-	cmd := exec.Command("tmux", "list-sessions", "-F", "#{session_name}|#{session_activity}")
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-	if err := cmd.Run(); err != nil {
+	out, err := tmux.NewTmux().Run("list-sessions", "-F", "#{session_name}|#{session_activity}")
+	if err != nil {
 		return nil
 	}
 
 	var mostRecent time.Time
-	for _, line := range strings.Split(stdout.String(), "\n") {
+	for _, line := range strings.Split(out, "\n") {
 		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
@@ -391,8 +387,9 @@ func (f *LiveConvoyFetcher) getAllPolecatActivity() *time.Time {
 		sessionName := parts[0]
 		// Check if it's a polecat session (gt-{rig}-{polecat}, not gt-{rig}-witness/refinery)
 		// Polecat sessions have exactly 3 parts when split by "-" and the middle part is the rig
-		nameParts := strings.Split(sessionName, "-")
-		if len(nameParts) < 3 || nameParts[0] != "gt" {
+		rest, ok := strings.CutPrefix(sessionName, session.RigPrefix())
+		nameParts := strings.Split(rest, "-")
+		if !ok || len(nameParts) < 2 {
 			continue
 		}
 		// Skip witness, refinery, mayor, deacon sessions
@@ -595,10 +592,8 @@ func determineColorClass(ciStatus, mergeable string) string {
 // FetchPolecats fetches all running polecat and refinery sessions with activity data.
 func (f *LiveConvoyFetcher) FetchPolecats() ([]PolecatRow, error) {
 	// Query all tmux sessions with window_activity for more accurate timing
-	cmd := exec.Command("tmux", "list-sessions", "-F", "#{session_name}|#{window_activity}")
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-	if err := cmd.Run(); err != nil {
+	out, err := tmux.NewTmux().Run("list-sessions", "-F", "#{session_name}|#{window_activity}")
+	if err != nil {
 		// tmux not running or no sessions
 		return nil, nil
 	}
@@ -607,7 +602,7 @@ func (f *LiveConvoyFetcher) FetchPolecats() ([]PolecatRow, error) {
 	mergeQueueCount := f.getMergeQueueCount()
 
 	var polecats []PolecatRow
-	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	lines := strings.Split(out, "\n")
 
 	for _, line := range lines {
 		if line == "" {
@@ -622,17 +617,11 @@ func (f *LiveConvoyFetcher) FetchPolecats() ([]PolecatRow, error) {
 		sessionName := parts[0]
 
 		// Filter for gt-<rig>-<polecat> pattern
-		if !strings.HasPrefix(sessionName, "gt-") {
-			continue
-		}
-
 		// Parse session name: gt-roxas-dag -> rig=roxas, polecat=dag
-		nameParts := strings.SplitN(sessionName, "-", 3)
-		if len(nameParts) != 3 {
+		rig, polecat, ok := parsePolecatSessionName(sessionName)
+		if !ok {
 			continue
 		}
-		rig := nameParts[1]
-		polecat := nameParts[2]
 
 		// Skip non-worker sessions (witness, mayor, deacon, boot)
 		// Note: refinery is included to show idle/processing status
@@ -669,15 +658,13 @@ func (f *LiveConvoyFetcher) FetchPolecats() ([]PolecatRow, error) {
 
 // getPolecatStatusHint captures the last non-empty line from a polecat's pane.
 func (f *LiveConvoyFetcher) getPolecatStatusHint(sessionName string) string {
-	cmd := exec.Command("tmux", "capture-pane", "-t", sessionName, "-p", "-J")
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-	if err := cmd.Run(); err != nil {
+	out, err := tmux.NewTmux().Run("capture-pane", "-t", sessionName, "-p", "-J")
+	if err != nil {
 		return ""
 	}
 
 	// Get last non-empty line
-	lines := strings.Split(stdout.String(), "\n")
+	lines := strings.Split(out, "\n")
 	for i := len(lines) - 1; i >= 0; i-- {
 		line := strings.TrimSpace(lines[i])
 		if line != "" {
@@ -723,14 +710,15 @@ func truncateStatusHint(line string) string {
 // Format: gt-<rig>-<polecat> -> (rig, polecat, true)
 // Returns ("", "", false) if the format is invalid.
 func parsePolecatSessionName(sessionName string) (rig, polecat string, ok bool) {
-	if !strings.HasPrefix(sessionName, "gt-") {
+	rest, found := strings.CutPrefix(sessionName, session.RigPrefix())
+	if !found {
 		return "", "", false
 	}
-	parts := strings.SplitN(sessionName, "-", 3)
-	if len(parts) != 3 {
+	rig, polecat, ok = strings.Cut(rest, "-")
+	if !ok || rig == "" || polecat == "" {
 		return "", "", false
 	}
-	return parts[1], parts[2], true
+	return rig, polecat, true
 }
 
 // isWorkerSession returns true if the polecat name represents a worker session.
@@ -0,0 +1,50 @@
+package witness
+
+import "time"
+
+// Action is a decision the monitoring loop made about a stuck polecat,
+// reported to the OnAction handler as the check makes it.
+type Action struct {
+	Rig     string `json:"rig"`
+	Polecat string `json:"polecat"`
+
+	// Type is one of the Action constants: nudged, would-nudge, or one of
+	// the ways a nudge is skipped (held, suppressed, observe-only).
+	Type string `json:"type"`
+
+	// Escalated is set on a nudge that also escalated the polecat.
+	Escalated bool `json:"escalated,omitempty"`
+
+	State  PolecatState `json:"state"`
+	Reason string       `json:"reason,omitempty"`
+	Time   time.Time    `json:"time"`
+}
+
+// OnAction registers fn to be called with every action the monitoring
+// loop takes, for programs embedding the witness that want to react to
+// its decisions in-process. nil removes the handler.
+//
+// fn runs synchronously on the goroutine running Check, in the middle of
+// the check: it must be fast, and must not call back into the manager.
+// A handler with slow work to do should hand it off, e.g. to a buffered
+// channel it drains elsewhere.
+func (m *Manager) OnAction(fn func(Action)) {
+	m.onAction = fn
+}
+
+// notifyAction reports the action a check took about a polecat to the
+// OnAction handler. Checks that took none report nothing.
+func (m *Manager) notifyAction(pc PolecatCheck, now time.Time) {
+	if m.onAction == nil || pc.Action == "" || pc.Action == ActionNone {
+		return
+	}
+	m.onAction(Action{
+		Rig:       m.rig.Name,
+		Polecat:   pc.Name,
+		Type:      pc.Action,
+		Escalated: pc.Escalated,
+		State:     pc.State,
+		Reason:    pc.Reason,
+		Time:      now,
+	})
+}
@@ -0,0 +1,39 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestNotifyAction(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	now := time.Now()
+
+	// No handler: nothing to call
+	mgr.notifyAction(PolecatCheck{Name: "toast", Action: ActionNudged}, now)
+
+	var got []Action
+	mgr.OnAction(func(a Action) { got = append(got, a) })
+	mgr.notifyAction(PolecatCheck{Name: "toast", State: PolecatActive, Action: ActionNone}, now)
+	mgr.notifyAction(PolecatCheck{Name: "toast", State: PolecatStuck, Action: ActionHeld, Reason: "too soon"}, now)
+
+	want := Action{Rig: "testrig", Polecat: "toast", Type: ActionHeld, State: PolecatStuck, Reason: "too soon", Time: now}
+	if len(got) != 1 || got[0] != want {
+		t.Fatalf("actions = %+v, want just %+v", got, want)
+	}
+}
+
+func TestCheck_ReportsActions(t *testing.T) {
+	mgr := stuckPolecatManager(t, &fakeNudger{})
+	var got []Action
+	mgr.OnAction(func(a Action) { got = append(got, a) })
+
+	if _, err := mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(got) != 1 || got[0].Polecat != "toast" || got[0].Type != ActionNudged {
+		t.Errorf("actions = %+v, want a nudge of toast", got)
+	}
+}
@@ -0,0 +1,58 @@
+package witness
+
+import "time"
+
+// nudgeBudgetWindow is the rolling window RigNudgeBudget applies to.
+const nudgeBudgetWindow = time.Hour
+
+// recentRigNudges returns the rig's nudges within the window before now,
+// oldest first.
+func (w *Witness) recentRigNudges(now time.Time) []time.Time {
+	cutoff := now.Add(-nudgeBudgetWindow)
+	var recent []time.Time
+	for _, t := range w.RigNudges {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	return recent
+}
+
+// NudgesLastHour returns how many nudges the rig's polecats were sent in
+// the hour before now, against RigNudgeBudget.
+func (w *Witness) NudgesLastHour(now time.Time) int {
+	return len(w.recentRigNudges(now))
+}
+
+// NudgeBudgetExhausted reports whether the rig's nudge budget is used up
+// at now, and if so how long until a nudge may be sent again. It never is
+// without a RigNudgeBudget.
+func (w *Witness) NudgeBudgetExhausted(now time.Time) (bool, time.Duration) {
+	budget := w.Config.RigNudgeBudget
+	if budget <= 0 {
+		return false, 0
+	}
+	recent := w.recentRigNudges(now)
+	if len(recent) < budget {
+		return false, 0
+	}
+	// A nudge may go out once enough of the recent ones have aged out
+	return true, recent[len(recent)-budget].Add(nudgeBudgetWindow).Sub(now)
+}
+
+// recordRigNudge notes a nudge sent at now against the rig's budget,
+// forgetting nudges older than the window.
+func (w *Witness) recordRigNudge(now time.Time) {
+	w.RigNudges = append(w.recentRigNudges(now), now)
+}
+
+// recordBudgetHeldNudge counts a nudge held back by the rig's nudge budget.
+// Unlike a nudge held by the minimum interval, it joins the unanswered-nudge
+// streak and is spaced like a sent one, so an exhausted budget can't keep a
+// stuck polecat from reaching the escalation threshold.
+func (s *WitnessStats) recordBudgetHeldNudge(ps *PolecatStats, now time.Time) {
+	s.TotalBudgetHeldNudges++
+	ps.BudgetHeldNudges++
+	ps.ConsecutiveNudges++
+	ps.LastNudgeAt = &now
+}
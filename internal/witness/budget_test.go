@@ -0,0 +1,121 @@
+package witness
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNudgeBudgetExhausted(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	w := &Witness{}
+
+	// No budget: never exhausted
+	for i := 0; i < 5; i++ {
+		w.recordRigNudge(now.Add(-time.Duration(i) * time.Minute))
+	}
+	if exhausted, _ := w.NudgeBudgetExhausted(now); exhausted {
+		t.Error("exhausted without a budget")
+	}
+
+	w = &Witness{Config: WitnessConfig{RigNudgeBudget: 2}}
+	w.recordRigNudge(now.Add(-90 * time.Minute)) // aged out of the window
+	w.recordRigNudge(now.Add(-40 * time.Minute))
+	if exhausted, _ := w.NudgeBudgetExhausted(now); exhausted {
+		t.Error("exhausted after one nudge in the last hour, budget 2")
+	}
+	w.recordRigNudge(now.Add(-10 * time.Minute))
+	if got := w.NudgesLastHour(now); got != 2 {
+		t.Errorf("NudgesLastHour = %d, want 2", got)
+	}
+	if len(w.RigNudges) != 2 {
+		t.Errorf("RigNudges = %v, want nudges outside the window forgotten", w.RigNudges)
+	}
+	exhausted, wait := w.NudgeBudgetExhausted(now)
+	if !exhausted || wait != 20*time.Minute {
+		t.Errorf("NudgeBudgetExhausted = %v, %s; want true, 20m until the oldest nudge ages out", exhausted, wait)
+	}
+	if exhausted, _ := w.NudgeBudgetExhausted(now.Add(20 * time.Minute)); exhausted {
+		t.Error("still exhausted once the oldest nudge aged out")
+	}
+}
+
+func TestCheck_NudgeBudget(t *testing.T) {
+	nudger := &fakeNudger{}
+	esc := &fakeEscalator{}
+	mgr := stuckPolecatManager(t, nudger)
+	mgr.SetEscalator(esc)
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) {
+		c.RigNudgeBudget = 1
+		c.EscalationThreshold = 1
+		c.MinNudgeInterval = time.Nanosecond
+	}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	if _, err := mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if pc := result.Polecats[0]; pc.Action != ActionOverBudget {
+		t.Errorf("second check action = %q, want %q", pc.Action, ActionOverBudget)
+	}
+	if n := len(nudger.nudged["toast"]); n != 1 {
+		t.Errorf("nudged %d times, want 1 within the budget", n)
+	}
+	// The budget holds nudges, not escalations
+	if len(esc.escalated) != 1 {
+		t.Errorf("escalated %d times, want 1", len(esc.escalated))
+	}
+
+	w, _ := mgr.Status()
+	if w.Stats.TotalBudgetHeldNudges != 1 || w.Stats.PerPolecat["toast"].BudgetHeldNudges != 1 {
+		t.Errorf("stats = %+v, want one nudge held by the budget", w.Stats)
+	}
+	if got := w.NudgesLastHour(time.Now()); got != 1 {
+		t.Errorf("NudgesLastHour = %d, want 1", got)
+	}
+}
+
+func TestCheck_OverBudgetCountsTowardEscalation(t *testing.T) {
+	nudger := &fakeNudger{}
+	esc := &fakeEscalator{}
+	mgr := stuckPolecatManager(t, nudger)
+	mgr.SetEscalator(esc)
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) {
+		c.RigNudgeBudget = 1
+		c.EscalationThreshold = 2
+		c.MinNudgeInterval = time.Nanosecond
+	}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	// One nudge spends the budget, leaving the polecat one short of the threshold
+	if _, err := mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(esc.escalated) != 0 {
+		t.Fatalf("escalated %d times after one nudge, want 0", len(esc.escalated))
+	}
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	pc := result.Polecats[0]
+	if pc.Action != ActionOverBudget {
+		t.Errorf("second check action = %q, want %q", pc.Action, ActionOverBudget)
+	}
+	if !pc.Escalated || len(esc.escalated) != 1 {
+		t.Errorf("escalated = %v with %d deliveries, want the held nudge to reach the threshold", pc.Escalated, len(esc.escalated))
+	}
+	if n := len(nudger.nudged["toast"]); n != 1 {
+		t.Errorf("nudged %d times, want 1 within the budget", n)
+	}
+
+	w, _ := mgr.Status()
+	if ps := w.Stats.PerPolecat["toast"]; ps.ConsecutiveNudges != 2 || ps.Escalations != 1 {
+		t.Errorf("stats = %+v, want a streak of 2 and one escalation", ps)
+	}
+}
@@ -0,0 +1,262 @@
+package witness
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout is the layout used for quiet dates and stats rollover.
+const dateLayout = "2006-01-02"
+
+// QuietCalendar decides whether a time falls inside a planned quiet period
+// (holidays, weekends, downtime). While it's quiet the witness keeps
+// checking polecats but suppresses nudges and escalations.
+type QuietCalendar struct {
+	dates    map[string]bool
+	weekdays map[time.Weekday]bool
+	periods  []quietPeriod
+}
+
+// quietPeriod is a timed calendar event: quiet from start up to end.
+type quietPeriod struct {
+	start, end time.Time
+}
+
+// weekdayNames maps weekday rules to the days they cover.
+var weekdayNames = map[string][]time.Weekday{
+	"sun": {time.Sunday}, "sunday": {time.Sunday},
+	"mon": {time.Monday}, "monday": {time.Monday},
+	"tue": {time.Tuesday}, "tuesday": {time.Tuesday},
+	"wed": {time.Wednesday}, "wednesday": {time.Wednesday},
+	"thu": {time.Thursday}, "thursday": {time.Thursday},
+	"fri": {time.Friday}, "friday": {time.Friday},
+	"sat": {time.Saturday}, "saturday": {time.Saturday},
+	"weekend":  {time.Saturday, time.Sunday},
+	"weekends": {time.Saturday, time.Sunday},
+	"weekday":  {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+}
+
+// NewQuietCalendar builds a calendar from the quiet_dates config entries and
+// the optional calendar file. Returns an error for entries that don't parse
+// so misconfiguration is caught at start time rather than mid-loop.
+func NewQuietCalendar(entries []string, file string) (*QuietCalendar, error) {
+	c := &QuietCalendar{
+		dates:    make(map[string]bool),
+		weekdays: make(map[time.Weekday]bool),
+	}
+	for _, entry := range entries {
+		if err := c.add(entry); err != nil {
+			return nil, err
+		}
+	}
+	if file != "" {
+		if err := c.loadFile(file); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// add parses a single date or weekday rule.
+func (c *QuietCalendar) add(entry string) error {
+	entry = strings.ToLower(strings.TrimSpace(entry))
+	if entry == "" {
+		return nil
+	}
+	if days, ok := weekdayNames[entry]; ok {
+		for _, d := range days {
+			c.weekdays[d] = true
+		}
+		return nil
+	}
+	d, err := time.Parse(dateLayout, entry)
+	if err != nil {
+		return fmt.Errorf("invalid quiet date %q: want YYYY-MM-DD or a weekday (e.g. sat, weekends)", entry)
+	}
+	c.dates[d.Format(dateLayout)] = true
+	return nil
+}
+
+// loadFile reads quiet dates from an ICS calendar or a plain date list.
+func (c *QuietCalendar) loadFile(path string) error {
+	f, err := os.Open(path) //nolint:gosec // G304: path is operator-supplied config
+	if err != nil {
+		return fmt.Errorf("reading quiet dates file: %w", err)
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".ics") {
+		return c.loadICS(f)
+	}
+
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if err := c.add(line); err != nil {
+			return fmt.Errorf("%s:%d: %w", path, lineNo, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// loadICS adds the events in an ICS calendar. All-day events make whole
+// days quiet, covering DTSTART up to (but not including) DTEND per RFC
+// 5545; timed events are quiet only between their start and end.
+func (c *QuietCalendar) loadICS(f *os.File) error {
+	var start, end time.Time
+	var allDay bool
+	var duration time.Duration
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "BEGIN:VEVENT":
+			start, end, allDay, duration = time.Time{}, time.Time{}, false, 0
+		case strings.HasPrefix(line, "DTSTART"):
+			start, allDay = parseICSTime(line)
+		case strings.HasPrefix(line, "DTEND"):
+			end, _ = parseICSTime(line)
+		case strings.HasPrefix(line, "DURATION"):
+			_, value, _ := strings.Cut(line, ":")
+			duration = parseICSDuration(value)
+		case line == "END:VEVENT":
+			if start.IsZero() {
+				continue
+			}
+			if end.IsZero() && duration > 0 {
+				end = start.Add(duration)
+			}
+			if !allDay {
+				if end.After(start) {
+					c.periods = append(c.periods, quietPeriod{start: start, end: end})
+				}
+				continue
+			}
+			if !end.After(start) {
+				end = start.AddDate(0, 0, 1)
+			}
+			for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+				c.dates[d.Format(dateLayout)] = true
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// parseICSTime parses a DTSTART/DTEND property line. A date such as
+// "DTSTART;VALUE=DATE:20261225" is an all-day value; a date-time is in UTC
+// ("DTSTART:20261225T090000Z"), in its TZID zone
+// ("DTSTART;TZID=Europe/Berlin:20261225T090000"), or else in local time.
+// Returns the zero time for a value that doesn't parse.
+func parseICSTime(line string) (t time.Time, allDay bool) {
+	params, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return time.Time{}, false
+	}
+	if len(value) == len("20060102") {
+		d, err := time.ParseInLocation("20060102", value, time.Local)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return d, true
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, false
+	}
+	loc := time.Local
+	for _, param := range strings.Split(params, ";") {
+		if tzid, ok := strings.CutPrefix(param, "TZID="); ok {
+			if l, err := time.LoadLocation(strings.Trim(tzid, `"`)); err == nil {
+				loc = l
+			}
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, false
+}
+
+// parseICSDuration parses an RFC 5545 DURATION value such as "PT30M",
+// "PT1H30M" or "P1D". Returns 0 for a value that doesn't parse.
+func parseICSDuration(value string) time.Duration {
+	rest, ok := strings.CutPrefix(strings.TrimPrefix(value, "+"), "P")
+	if !ok {
+		return 0
+	}
+	var total time.Duration
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+	for rest != "" {
+		if rest[0] == 'T' {
+			units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+			rest = rest[1:]
+			continue
+		}
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return 0
+		}
+		n, err := strconv.Atoi(rest[:i])
+		unit, known := units[rest[i]]
+		if err != nil || !known {
+			return 0
+		}
+		total += time.Duration(n) * unit
+		rest = rest[i+1:]
+	}
+	return total
+}
+
+// IsQuiet reports whether t falls on a quiet date or weekday, or inside a
+// timed quiet event.
+func (c *QuietCalendar) IsQuiet(t time.Time) bool {
+	if c == nil {
+		return false
+	}
+	if c.weekdays[t.Weekday()] || c.dates[t.Format(dateLayout)] {
+		return true
+	}
+	for _, p := range c.periods {
+		if !t.Before(p.start) && t.Before(p.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// QuietReason returns why actions are suppressed at t, or "" when the
+// witness may act. Config that fails to parse is treated as not quiet;
+// Manager.Start validates it up front.
+func (c *WitnessConfig) QuietReason(t time.Time) string {
+	if c.QuietHours.Contains(t) {
+		return "hours " + c.QuietHours.Window()
+	}
+	if len(c.QuietDates) == 0 && c.QuietDatesFile == "" {
+		return ""
+	}
+	cal, err := NewQuietCalendar(c.QuietDates, c.QuietDatesFile)
+	if err != nil {
+		return ""
+	}
+	if cal.IsQuiet(t) {
+		return "calendar"
+	}
+	return ""
+}
@@ -0,0 +1,188 @@
+package witness
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestQuietCalendar_Entries(t *testing.T) {
+	cal, err := NewQuietCalendar([]string{"2026-12-25", "weekends", " Wed "}, "")
+	if err != nil {
+		t.Fatalf("NewQuietCalendar: %v", err)
+	}
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2026-12-25", true},  // explicit date (Friday)
+		{"2026-12-26", true},  // Saturday
+		{"2026-12-27", true},  // Sunday
+		{"2026-12-28", false}, // Monday
+		{"2026-12-30", true},  // Wednesday
+	}
+	for _, tt := range tests {
+		d, _ := time.ParseInLocation(dateLayout, tt.date, time.Local)
+		if got := cal.IsQuiet(d.Add(13 * time.Hour)); got != tt.want {
+			t.Errorf("IsQuiet(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestQuietCalendar_InvalidEntry(t *testing.T) {
+	if _, err := NewQuietCalendar([]string{"12/25/2026"}, ""); err == nil {
+		t.Error("expected error for non-ISO date")
+	}
+	if _, err := NewQuietCalendar([]string{"funday"}, ""); err == nil {
+		t.Error("expected error for unknown weekday")
+	}
+}
+
+func TestQuietCalendar_DateListFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holidays.txt")
+	content := "# company holidays\n2026-01-01\n\n2026-07-04 # independence day\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cal, err := NewQuietCalendar(nil, path)
+	if err != nil {
+		t.Fatalf("NewQuietCalendar: %v", err)
+	}
+	for _, date := range []string{"2026-01-01", "2026-07-04"} {
+		d, _ := time.Parse(dateLayout, date)
+		if !cal.IsQuiet(d) {
+			t.Errorf("expected %s to be quiet", date)
+		}
+	}
+}
+
+func TestQuietCalendar_ICSFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holidays.ics")
+	content := `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Christmas break
+DTSTART;VALUE=DATE:20261224
+DTEND;VALUE=DATE:20261227
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Offsite
+DTSTART;VALUE=DATE:20260310
+END:VEVENT
+END:VCALENDAR
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cal, err := NewQuietCalendar(nil, path)
+	if err != nil {
+		t.Fatalf("NewQuietCalendar: %v", err)
+	}
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2026-12-23", false},
+		{"2026-12-24", true},
+		{"2026-12-26", true},
+		{"2026-12-27", false}, // DTEND is exclusive
+		{"2026-03-10", true},
+	}
+	for _, tt := range tests {
+		d, _ := time.Parse(dateLayout, tt.date)
+		if got := cal.IsQuiet(d); got != tt.want {
+			t.Errorf("IsQuiet(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestQuietCalendar_ICSTimedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "meetings.ics")
+	content := `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Standup
+DTSTART:20260310T233000Z
+DTEND:20260311T000000Z
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Review
+DTSTART;TZID=Asia/Tokyo:20260312T100000
+DURATION:PT1H
+END:VEVENT
+END:VCALENDAR
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cal, err := NewQuietCalendar(nil, path)
+	if err != nil {
+		t.Fatalf("NewQuietCalendar: %v", err)
+	}
+
+	// Asked in another zone, so the UTC times have to be converted
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("no tz data: %v", err)
+	}
+	tests := []struct {
+		at   time.Time
+		want bool
+	}{
+		{time.Date(2026, 3, 10, 19, 29, 0, 0, ny), false},
+		{time.Date(2026, 3, 10, 19, 30, 0, 0, ny), true}, // 23:30 UTC
+		{time.Date(2026, 3, 10, 19, 59, 0, 0, ny), true},
+		{time.Date(2026, 3, 10, 20, 0, 0, 0, ny), false},      // DTEND is exclusive
+		{time.Date(2026, 3, 10, 12, 0, 0, 0, ny), false},      // not the whole day
+		{time.Date(2026, 3, 12, 1, 30, 0, 0, time.UTC), true}, // 10:30 in Tokyo
+		{time.Date(2026, 3, 12, 2, 0, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		if got := cal.IsQuiet(tt.at); got != tt.want {
+			t.Errorf("IsQuiet(%s) = %v, want %v", tt.at.UTC().Format(time.RFC3339), got, tt.want)
+		}
+	}
+}
+
+func TestParseICSDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"PT30M", 30 * time.Minute},
+		{"PT1H30M", 90 * time.Minute},
+		{"P1D", 24 * time.Hour},
+		{"P1DT2H", 26 * time.Hour},
+		{"P1W", 7 * 24 * time.Hour},
+		{"30M", 0},
+		{"PT", 0},
+		{"PT5X", 0},
+	}
+	for _, tt := range tests {
+		if got := parseICSDuration(tt.value); got != tt.want {
+			t.Errorf("parseICSDuration(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestWitnessConfig_QuietReason(t *testing.T) {
+	sat := time.Date(2026, 12, 26, 12, 0, 0, 0, time.Local)
+	mon := time.Date(2026, 12, 28, 12, 0, 0, 0, time.Local)
+
+	cfg := WitnessConfig{QuietDates: []string{"sat"}}
+	if got := cfg.QuietReason(sat); got != "calendar" {
+		t.Errorf("QuietReason(sat) = %q, want %q", got, "calendar")
+	}
+	if got := cfg.QuietReason(mon); got != "" {
+		t.Errorf("QuietReason(mon) = %q, want empty", got)
+	}
+
+	var empty WitnessConfig
+	if got := empty.QuietReason(sat); got != "" {
+		t.Errorf("QuietReason with no config = %q, want empty", got)
+	}
+}
@@ -0,0 +1,123 @@
+package witness
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultLogMaxSizeMB is the size at which the check log is rotated.
+const DefaultLogMaxSizeMB = 10
+
+// CheckLogEntry is one line of the witness check log: the outcome of
+// checking a single polecat on a single monitoring pass.
+type CheckLogEntry struct {
+	Timestamp time.Time     `json:"ts"`
+	Polecat   string        `json:"polecat"`
+	State     PolecatState  `json:"state"`
+	Action    string        `json:"action"`
+	Escalated bool          `json:"escalated,omitempty"`
+	Reason    string        `json:"reason,omitempty"`
+	IdleFor   time.Duration `json:"idle_for,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// LogPath returns the path of the newline-delimited JSON check log.
+// Defaults to witness.log beside the witness state file.
+func (m *Manager) LogPath() string {
+	w, err := m.loadState()
+	if err != nil {
+		return m.logPath(&WitnessConfig{})
+	}
+	return m.logPath(&w.Config)
+}
+
+// logPath returns the check log path configured in cfg, or the default.
+func (m *Manager) logPath(cfg *WitnessConfig) string {
+	if cfg.LogFile != "" {
+		return cfg.LogFile
+	}
+	return filepath.Join(filepath.Dir(m.stateFile()), "witness.log")
+}
+
+// SetLogTee sets a writer that receives a copy of every check log entry,
+// e.g. stderr when running in the foreground.
+func (m *Manager) SetLogTee(w io.Writer) {
+	m.logTee = w
+}
+
+// logMaxSize returns the rotation size in bytes for cfg.
+func logMaxSize(cfg *WitnessConfig) int64 {
+	mb := cfg.LogMaxSizeMB
+	if mb <= 0 {
+		mb = DefaultLogMaxSizeMB
+	}
+	return int64(mb) << 20
+}
+
+// writeCheckLog appends one entry per polecat check to the log at path,
+// rotating it first if it has grown past maxSize.
+func (m *Manager) writeCheckLog(path string, maxSize int64, r *CheckResult) error {
+	var buf []byte
+	for _, pc := range r.Polecats {
+		data, err := json.Marshal(CheckLogEntry{
+			Timestamp: r.CheckedAt,
+			Polecat:   pc.Name,
+			State:     pc.State,
+			Action:    pc.Action,
+			Escalated: pc.Escalated,
+			Reason:    pc.Reason,
+			IdleFor:   pc.IdleFor,
+			Error:     pc.Error,
+		})
+		if err != nil {
+			return fmt.Errorf("marshaling log entry: %w", err)
+		}
+		buf = append(buf, data...)
+		buf = append(buf, '\n')
+	}
+	if len(buf) == 0 {
+		return nil
+	}
+
+	if m.logTee != nil {
+		_, _ = m.logTee.Write(buf)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating log directory: %w", err)
+	}
+	if err := rotateLog(path, maxSize); err != nil {
+		return fmt.Errorf("rotating log: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) //nolint:gosec // G304: path is from witness config
+	if err != nil {
+		return fmt.Errorf("opening log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(buf); err != nil {
+		return fmt.Errorf("writing log: %w", err)
+	}
+	return nil
+}
+
+// rotateLog moves path to path.1 (replacing any older rotation) once it
+// reaches maxSize, so the log is bounded at roughly twice that size.
+func rotateLog(path string, maxSize int64) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if info.Size() < maxSize {
+		return nil
+	}
+	return os.Rename(path, path+".1")
+}
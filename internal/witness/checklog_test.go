@@ -0,0 +1,97 @@
+package witness
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestWriteCheckLog(t *testing.T) {
+	dir := t.TempDir()
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: dir})
+	var tee bytes.Buffer
+	mgr.SetLogTee(&tee)
+
+	result := &CheckResult{
+		CheckedAt: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		Polecats: []PolecatCheck{
+			{Name: "toast", State: PolecatStuck, Action: ActionNudged, Reason: "no activity for 45m0s"},
+			{Name: "nux", State: PolecatActive, Action: ActionNone},
+		},
+	}
+
+	path := mgr.LogPath()
+	if want := filepath.Join(dir, ".runtime", "witness.log"); path != want {
+		t.Errorf("LogPath() = %q, want %q", path, want)
+	}
+	if err := mgr.writeCheckLog(path, logMaxSize(&WitnessConfig{}), result); err != nil {
+		t.Fatalf("writeCheckLog: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening log: %v", err)
+	}
+	defer f.Close()
+
+	var entries []CheckLogEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e CheckLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("unmarshaling %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, e)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if entries[0].Polecat != "toast" || entries[0].Action != ActionNudged || entries[0].Reason == "" {
+		t.Errorf("first entry = %+v", entries[0])
+	}
+	if !entries[0].Timestamp.Equal(result.CheckedAt) {
+		t.Errorf("Timestamp = %v, want %v", entries[0].Timestamp, result.CheckedAt)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !bytes.Equal(tee.Bytes(), data) {
+		t.Errorf("tee got %q, want %q", tee.String(), data)
+	}
+}
+
+func TestRotateLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "witness.log")
+
+	if err := rotateLog(path, 10); err != nil {
+		t.Fatalf("rotateLog(missing) = %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("short"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := rotateLog(path, 10); err != nil {
+		t.Fatalf("rotateLog(small) = %v", err)
+	}
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Error("small log was rotated")
+	}
+
+	if err := os.WriteFile(path, []byte("long enough to rotate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := rotateLog(path, 10); err != nil {
+		t.Fatalf("rotateLog(large) = %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("large log still in place after rotation")
+	}
+	if _, err := os.Stat(path + ".1"); err != nil {
+		t.Errorf("rotated log missing: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package witness
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// CheckNow runs a monitoring pass right away rather than at the next
+// interval, e.g. after unsticking a polecat. A running monitoring loop
+// (foreground or group) is asked through the state file, the same way
+// StopGraceful asks it to stop, and CheckNow waits up to timeout for it
+// to finish the pass. The result is read back from the check log, so it
+// only lists polecats when the log could be written. A witness running
+// as an agent session owns the rig's polecats but has no loop to ask;
+// checking here beside it could double its nudges, so CheckNow fails with
+// ErrCheckInSession instead.
+func (m *Manager) CheckNow(timeout time.Duration) (*CheckResult, error) {
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	t := m.tmux
+	if !w.LoopAlive(t, time.Now()) {
+		running, _ := t.HasSession(m.SessionName())
+		if w.State == StateStopped || !running {
+			return nil, m.stateError("check", StateStopped, StateRunning, ErrNotRunning)
+		}
+		return nil, ErrCheckInSession
+	}
+
+	// Only the request is written: the loop may be saving the state too
+	requested := time.Now()
+	if err := m.updateState(func(w *Witness) error {
+		// A later request also answers earlier ones still waiting
+		if w.CheckRequestedAt == nil || w.CheckRequestedAt.Before(requested) {
+			w.CheckRequestedAt = &requested
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	deadline := requested.Add(timeout)
+	for {
+		w, err := m.loadState()
+		if err != nil {
+			return nil, err
+		}
+		if w.LastCheckAt != nil && !w.LastCheckAt.Before(requested) {
+			return m.loggedCheck(&w.Config, *w.LastCheckAt, w.QuietReason(*w.LastCheckAt))
+		}
+		if w.State == StateStopped {
+			return nil, m.stateError("check", StateStopped, StateRunning, ErrNotRunning)
+		}
+		if !time.Now().Before(deadline) {
+			return nil, ErrCheckTimeout
+		}
+		time.Sleep(stopPollInterval)
+	}
+}
+
+// checkRequested reports whether CheckNow has asked the loop for a check.
+func (m *Manager) checkRequested() bool {
+	w, err := m.loadState()
+	return err == nil && w.CheckRequestedAt != nil
+}
+
+// loggedCheck rebuilds the result of the check made at checkedAt from the
+// check log entries it wrote.
+func (m *Manager) loggedCheck(cfg *WitnessConfig, checkedAt time.Time, quiet string) (*CheckResult, error) {
+	result := &CheckResult{CheckedAt: checkedAt, Quiet: quiet}
+	err := readCheckLog(m.logPath(cfg), func(e CheckLogEntry) {
+		if !e.Timestamp.Equal(checkedAt) {
+			return
+		}
+		result.Polecats = append(result.Polecats, PolecatCheck{
+			Name:      e.Polecat,
+			Session:   session.PolecatSessionName(m.rig.Name, e.Polecat),
+			State:     e.State,
+			IdleFor:   e.IdleFor,
+			Action:    e.Action,
+			Escalated: e.Escalated,
+			Reason:    e.Reason,
+			Error:     e.Error,
+		})
+	})
+	return result, err
+}
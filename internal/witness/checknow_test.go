@@ -0,0 +1,134 @@
+package witness
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux/tmuxtest"
+)
+
+func TestManager_CheckNow_NotRunning(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if _, err := mgr.CheckNow(time.Second); !errors.Is(err, ErrNotRunning) {
+		t.Fatalf("CheckNow() on stopped witness = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestManager_CheckNow_AgentSession(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	mgr.SetTmux(tmuxtest.NewFakeTmux(mgr.SessionName()))
+	if err := mgr.updateState(func(w *Witness) error {
+		w.State = StateRunning
+		return nil
+	}); err != nil {
+		t.Fatalf("updateState: %v", err)
+	}
+
+	if _, err := mgr.CheckNow(time.Second); !errors.Is(err, ErrCheckInSession) {
+		t.Fatalf("CheckNow() beside an agent session = %v, want ErrCheckInSession", err)
+	}
+	if w, _ := mgr.loadState(); w.LastCheckAt != nil {
+		t.Errorf("CheckNow() checked at %v beside the agent session", w.LastCheckAt)
+	}
+}
+
+func TestManager_CheckNow_Loop(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	mgr.SetOutput(io.Discard)
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = mgr.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// Wait for the loop's first pass; the next one is a minute away
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		if w, _ := mgr.loadState(); w.LastCheckAt != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("loop never checked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	w, _ := mgr.loadState()
+	first := *w.LastCheckAt
+
+	before := time.Now()
+	result, err := mgr.CheckNow(10 * time.Second)
+	if err != nil {
+		t.Fatalf("CheckNow() = %v", err)
+	}
+	if result.CheckedAt.Before(before) {
+		t.Errorf("CheckNow() returned the check at %v, from before the request", result.CheckedAt)
+	}
+	w, _ = mgr.loadState()
+	if !w.LastCheckAt.After(first) || w.CheckRequestedAt != nil {
+		t.Errorf("after CheckNow: LastCheckAt = %v (first %v), CheckRequestedAt = %v", w.LastCheckAt, first, w.CheckRequestedAt)
+	}
+}
+
+func TestMergeCheck_CheckRequests(t *testing.T) {
+	started := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	checked := &Witness{LastCheckAt: &started}
+
+	before := started.Add(-time.Second)
+	w := &Witness{CheckRequestedAt: &before}
+	w.mergeCheck(checked)
+	if w.CheckRequestedAt != nil {
+		t.Errorf("request from before the check still pending: %v", w.CheckRequestedAt)
+	}
+
+	during := started.Add(time.Second)
+	w = &Witness{CheckRequestedAt: &during}
+	w.mergeCheck(checked)
+	if w.CheckRequestedAt == nil || !w.CheckRequestedAt.Equal(during) {
+		t.Errorf("request made during the check = %v, want it kept for the next check", w.CheckRequestedAt)
+	}
+	if w.LastCheckAt == nil || !w.LastCheckAt.Equal(started) {
+		t.Errorf("LastCheckAt = %v, want %v", w.LastCheckAt, started)
+	}
+}
+
+func TestManager_LoggedCheck(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	cfg := &WitnessConfig{}
+	earlier := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Minute)
+	for _, r := range []*CheckResult{
+		{CheckedAt: earlier, Polecats: []PolecatCheck{{Name: "toast", State: PolecatStuck, Action: ActionNudged}}},
+		{CheckedAt: later, Polecats: []PolecatCheck{
+			{Name: "toast", State: PolecatActive, Action: ActionNone},
+			{Name: "nux", State: PolecatStuck, Action: ActionHeld, Reason: "nudged recently"},
+		}},
+	} {
+		if err := mgr.writeCheckLog(mgr.logPath(cfg), logMaxSize(cfg), r); err != nil {
+			t.Fatalf("writeCheckLog: %v", err)
+		}
+	}
+
+	result, err := mgr.loggedCheck(cfg, later, "")
+	if err != nil {
+		t.Fatalf("loggedCheck: %v", err)
+	}
+	if len(result.Polecats) != 2 {
+		t.Fatalf("loggedCheck found %d polecats, want 2: %+v", len(result.Polecats), result.Polecats)
+	}
+	if pc := result.Polecats[1]; pc.Name != "nux" || pc.Action != ActionHeld || pc.Reason != "nudged recently" ||
+		pc.Session != "gt-testrig-nux" {
+		t.Errorf("nux = %+v", pc)
+	}
+}
@@ -0,0 +1,114 @@
+package witness
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Config precedence, lowest first:
+//
+//  1. built-in defaults
+//  2. the town's settings/witness.toml (SourceTown)
+//  3. the rig's .gastown/witness.toml (SourceRig)
+//  4. the witness state file, which gt witness start flags and gt witness
+//     config set write (SourceOverride)
+//
+// The files are read once, by NewManager. Their values fill the keys the
+// state file leaves unset whenever the state is loaded, and are left out
+// again when it is saved, so they never turn into overrides.
+
+// loadFileConfig reads the town and rig witness config files for a rig,
+// checking every key and normalizing every value to what the key's get
+// returns. Settings that normalize to "" (unset) are dropped.
+func loadFileConfig(rigPath, townRoot string) (map[string]config.WitnessSetting, error) {
+	settings, err := config.LoadWitnessConfig(rigPath, townRoot)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range config.WitnessSettingKeys(settings) {
+		s := settings[key]
+		k, err := lookupConfigKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", s.Path, err)
+		}
+		var scratch WitnessConfig
+		if err := k.set(&scratch, s.Value); err != nil {
+			return nil, fmt.Errorf("%s: invalid %s: %w", s.Path, key, err)
+		}
+		if s.Value = k.get(&scratch); s.Value == "" {
+			delete(settings, key)
+			continue
+		}
+		settings[key] = s
+	}
+	return settings, nil
+}
+
+// townRootOf returns the town a rig belongs to, or "" outside a town.
+func townRootOf(rigPath string) string {
+	townRoot, err := workspace.Find(rigPath)
+	if err != nil {
+		return ""
+	}
+	return townRoot
+}
+
+// applyFileConfig fills the keys c leaves unset from the config files,
+// recording which ones it filled.
+func (m *Manager) applyFileConfig(c *WitnessConfig) {
+	c.filled = nil
+	for _, k := range configKeys {
+		if s, ok := m.fileConfig[k.name]; ok && k.get(c) == "" {
+			_ = k.set(c, s.Value) // checked by loadFileConfig
+			if c.filled == nil {
+				c.filled = make(map[string]string)
+			}
+			c.filled[k.name] = s.Value
+		}
+	}
+}
+
+// withoutFileConfig returns a copy of c for saving, without the values
+// applyFileConfig filled in. Keys the state file set are kept even when a
+// config file has the same value, and so is a filled key changed since:
+// it is an override now.
+func (m *Manager) withoutFileConfig(c WitnessConfig) WitnessConfig {
+	if len(c.filled) == 0 {
+		return c
+	}
+	if c.QuietHours != nil {
+		// The quiet hours keys are set through the pointer
+		q := *c.QuietHours
+		c.QuietHours = &q
+	}
+	for _, k := range configKeys {
+		if v, ok := c.filled[k.name]; ok && k.get(&c) == v {
+			_ = k.set(&c, "")
+		}
+	}
+	c.filled = nil
+	dropEmptyQuietHours(&c)
+	return c
+}
+
+// updateOverrides applies fn to the overrides in c, the keys the state
+// file itself sets, then fills the rest from the config files again.
+// Whatever fn sets is an override, even a value a config file also has.
+func (m *Manager) updateOverrides(c *WitnessConfig, fn func(*WitnessConfig) error) error {
+	*c = m.withoutFileConfig(*c)
+	if err := fn(c); err != nil {
+		return err
+	}
+	m.applyFileConfig(c)
+	return nil
+}
+
+// fileSource returns the source of a key set in a config file.
+func fileSource(s config.WitnessSetting) ConfigSource {
+	if s.Source == config.WitnessSourceRig {
+		return SourceRig
+	}
+	return SourceTown
+}
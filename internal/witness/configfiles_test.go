@@ -0,0 +1,148 @@
+package witness
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// newTownRig makes a town with a rig in it, writing the given town and rig
+// witness.toml contents ("" for no file).
+func newTownRig(t *testing.T, townTOML, rigTOML string) *rig.Rig {
+	t.Helper()
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+	files := map[string]string{filepath.Join(townRoot, "mayor", "town.json"): "{}"}
+	if townTOML != "" {
+		files[config.TownWitnessConfigPath(townRoot)+".toml"] = townTOML
+	}
+	if rigTOML != "" {
+		files[config.RigWitnessConfigPath(rigPath)+".toml"] = rigTOML
+	}
+	for path, content := range files {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(rigPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	return &rig.Rig{Name: "testrig", Path: rigPath}
+}
+
+func TestConfigPrecedence(t *testing.T) {
+	mgr := NewManager(newTownRig(t,
+		"idle_threshold = \"5m\"\nstuck_threshold = \"40m\"\nescalation_threshold = 4\n",
+		"stuck_threshold = \"45m\"\n"))
+
+	// Flags and gt witness config set write overrides to the state file
+	if err := mgr.SetConfigValue("escalation_threshold", "6"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key    string
+		value  string
+		source ConfigSource
+	}{
+		{"check_interval", DefaultCheckInterval.String(), SourceDefault},
+		{"idle_threshold", "5m0s", SourceTown},
+		{"stuck_threshold", "45m0s", SourceRig},
+		{"escalation_threshold", "6", SourceOverride},
+	}
+	for _, tt := range tests {
+		v, err := mgr.ConfigValue(tt.key)
+		if err != nil {
+			t.Fatalf("ConfigValue(%s): %v", tt.key, err)
+		}
+		if v.Value != tt.value || v.Source != tt.source {
+			t.Errorf("%s = %q (%s), want %q (%s)", tt.key, v.Value, v.Source, tt.value, tt.source)
+		}
+		if (v.File != "") != (tt.source == SourceTown || tt.source == SourceRig) {
+			t.Errorf("%s file = %q", tt.key, v.File)
+		}
+	}
+
+	w, err := mgr.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idle, stuck := w.Config.Thresholds(); idle != 5*time.Minute || stuck != 45*time.Minute {
+		t.Errorf("effective thresholds = %s, %s; want 5m, 45m", idle, stuck)
+	}
+	if got := w.Config.EscalationLimit(); got != 6 {
+		t.Errorf("effective escalation limit = %d, want 6", got)
+	}
+
+	// File values stay in the files: saving doesn't turn them into overrides
+	data, err := os.ReadFile(mgr.stateFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "idle_threshold") || strings.Contains(string(data), "stuck_threshold") {
+		t.Errorf("state file picked up config file values:\n%s", data)
+	}
+
+	// Resetting an override falls back to the files
+	if err := mgr.SetConfigValue("escalation_threshold", ""); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := mgr.ConfigValue("escalation_threshold"); v.Value != "4" || v.Source != SourceTown {
+		t.Errorf("after reset escalation_threshold = %+v, want 4 from town", v)
+	}
+}
+
+func TestConfigPrecedence_OverrideEqualToFile(t *testing.T) {
+	r := newTownRig(t, "idle_threshold = \"5m\"\nstuck_threshold = \"40m\"\n", "")
+	mgr := NewManager(r)
+
+	// Set explicitly, to the values the town file has, by both writers
+	if err := mgr.SetConfigValue("idle_threshold", "5m"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) { c.StuckThreshold = 40 * time.Minute }); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"idle_threshold", "stuck_threshold"} {
+		if v, _ := mgr.ConfigValue(key); v.Source != SourceOverride {
+			t.Errorf("%s = %+v, want an override", key, v)
+		}
+	}
+
+	// It stays put when the file changes
+	if err := os.WriteFile(config.TownWitnessConfigPath(townRootOf(r.Path))+".toml", []byte("idle_threshold = \"9m\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mgr = NewManager(r)
+	if v, _ := mgr.ConfigValue("idle_threshold"); v.Value != "5m0s" || v.Source != SourceOverride {
+		t.Errorf("after the file changed idle_threshold = %+v, want the 5m override", v)
+	}
+}
+
+func TestConfigPrecedence_OnlyRigFile(t *testing.T) {
+	mgr := NewManager(newTownRig(t, "", "idle_threshold = \"3m\"\n"))
+	if v, _ := mgr.ConfigValue("idle_threshold"); v.Value != "3m0s" || v.Source != SourceRig {
+		t.Errorf("idle_threshold = %+v, want 3m from rig", v)
+	}
+	if v, _ := mgr.ConfigValue("stuck_threshold"); v.Source != SourceDefault {
+		t.Errorf("stuck_threshold = %+v, want default", v)
+	}
+}
+
+func TestConfigFiles_InvalidKey(t *testing.T) {
+	mgr := NewManager(newTownRig(t, "", "stuck_treshold = \"45m\"\n"))
+	if _, err := mgr.ConfigValues(); err == nil || !strings.Contains(err.Error(), "stuck_treshold") {
+		t.Errorf("ConfigValues err = %v, want the unknown key", err)
+	}
+	if err := mgr.Start(true, "", nil); err == nil || !strings.Contains(err.Error(), "witness.toml") {
+		t.Errorf("Start err = %v, want the config file named", err)
+	}
+}
@@ -0,0 +1,519 @@
+package witness
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigSource says where an effective config value comes from.
+type ConfigSource string
+
+const (
+	// SourceDefault means the key is unset and the built-in default applies.
+	SourceDefault ConfigSource = "default"
+
+	// SourceTown means the key is set in the town's settings/witness.toml.
+	SourceTown ConfigSource = "town"
+
+	// SourceRig means the key is set in the rig's .gastown/witness.toml.
+	SourceRig ConfigSource = "rig"
+
+	// SourceOverride means the key is set in the witness state file.
+	SourceOverride ConfigSource = "override"
+)
+
+// ConfigValue is the effective value of one witness config key.
+type ConfigValue struct {
+	Key         string       `json:"key"`
+	Value       string       `json:"value"`
+	Source      ConfigSource `json:"source"`
+	Description string       `json:"description"`
+
+	// File is the config file the value comes from, for SourceTown and
+	// SourceRig.
+	File string `json:"file,omitempty"`
+}
+
+// configKey describes a settable witness config key. get returns the
+// configured value, or "" when unset.
+type configKey struct {
+	name        string
+	description string
+	get         func(c *WitnessConfig) string
+	set         func(c *WitnessConfig, value string) error
+	def         func(m *Manager) string
+}
+
+// configKeys lists the keys gt witness config can view and edit, in
+// display order.
+var configKeys = []configKey{
+	{
+		name:        "idle_threshold",
+		description: "inactivity before a polecat counts as idle",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.IdleThreshold) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.IdleThreshold) },
+		def:         func(*Manager) string { return DefaultIdleThreshold.String() },
+	},
+	{
+		name:        "stuck_threshold",
+		description: "inactivity before a polecat counts as stuck and is nudged",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.StuckThreshold) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.StuckThreshold) },
+		def:         func(*Manager) string { return DefaultStuckThreshold.String() },
+	},
+	{
+		name:        "startup_grace",
+		description: "time after a polecat's session starts before it may be nudged",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.StartupGrace) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.StartupGrace) },
+		def:         func(*Manager) string { return "none" },
+	},
+	{
+		name:        "min_nudge_interval",
+		description: "least time between two nudges to the same polecat",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.MinNudgeInterval) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.MinNudgeInterval) },
+		def:         func(*Manager) string { return DefaultMinNudgeInterval.String() },
+	},
+	{
+		name:        "rig_nudge_budget",
+		description: "most nudges to the rig's polecats in any rolling hour",
+		get:         func(c *WitnessConfig) string { return formatInt(c.RigNudgeBudget) },
+		set:         func(c *WitnessConfig, v string) error { return parseInt(v, &c.RigNudgeBudget) },
+		def:         func(*Manager) string { return "unlimited" },
+	},
+	{
+		name:        "escalation_threshold",
+		description: "unanswered nudges before escalating to the mayor",
+		get:         func(c *WitnessConfig) string { return formatInt(c.EscalationThreshold) },
+		set:         func(c *WitnessConfig, v string) error { return parseInt(v, &c.EscalationThreshold) },
+		def:         func(*Manager) string { return strconv.Itoa(DefaultEscalationThreshold) },
+	},
+	{
+		name:        "escalation_cooldown",
+		description: "least time between two escalations of a polecat that stays stuck",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.EscalationCooldown) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.EscalationCooldown) },
+		def:         func(*Manager) string { return DefaultEscalationCooldown.String() },
+	},
+	{
+		name:        "check_interval",
+		description: "time between monitoring passes (the start in adaptive mode)",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.CheckInterval) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.CheckInterval) },
+		def:         func(*Manager) string { return DefaultCheckInterval.String() },
+	},
+	{
+		name:        "adaptive_interval",
+		description: "back off the check interval while idle, speed it up on activity",
+		get:         func(c *WitnessConfig) string { return formatBool(c.AdaptiveInterval) },
+		set:         func(c *WitnessConfig, v string) error { return parseBool(v, &c.AdaptiveInterval) },
+		def:         func(*Manager) string { return "false" },
+	},
+	{
+		name:        "min_check_interval",
+		description: "shortest check interval in adaptive mode",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.MinCheckInterval) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.MinCheckInterval) },
+		def:         func(*Manager) string { return DefaultMinCheckInterval.String() },
+	},
+	{
+		name:        "max_check_interval",
+		description: "longest check interval in adaptive mode",
+		get:         func(c *WitnessConfig) string { return formatDuration(c.MaxCheckInterval) },
+		set:         func(c *WitnessConfig, v string) error { return parseDuration(v, &c.MaxCheckInterval) },
+		def:         func(*Manager) string { return DefaultMaxCheckInterval.String() },
+	},
+	{
+		name:        "webhook_url",
+		description: "http(s) URL escalations are POSTed to as JSON",
+		get:         func(c *WitnessConfig) string { return c.WebhookURL },
+		set: func(c *WitnessConfig, v string) error {
+			c.WebhookURL = v
+			return nil
+		},
+		def: func(*Manager) string { return "" },
+	},
+	{
+		name:        "escalation_delivery",
+		description: "where escalations go: beads, webhook or both",
+		get:         func(c *WitnessConfig) string { return c.EscalationDelivery },
+		set: func(c *WitnessConfig, v string) error {
+			c.EscalationDelivery = v
+			return nil
+		},
+		def: func(*Manager) string { return DeliveryBeads + " (both with a webhook_url)" },
+	},
+	{
+		name:        "crash_loop_threshold",
+		description: "agent crashes within 30m before the witness is held stopped",
+		get:         func(c *WitnessConfig) string { return formatInt(c.CrashLoopThreshold) },
+		set:         func(c *WitnessConfig, v string) error { return parseInt(v, &c.CrashLoopThreshold) },
+		def:         func(*Manager) string { return strconv.Itoa(DefaultCrashLoopThreshold) },
+	},
+	{
+		name:        "nudge_allowlist",
+		description: "comma-separated polecat name globs the witness may nudge (default: all)",
+		get:         func(c *WitnessConfig) string { return strings.Join(c.NudgeAllowlist, ",") },
+		set:         func(c *WitnessConfig, v string) error { return parseList(v, &c.NudgeAllowlist) },
+		def:         func(*Manager) string { return "" },
+	},
+	{
+		name:        "nudge_denylist",
+		description: "comma-separated polecat name globs never nudged or escalated (wins over the allowlist)",
+		get:         func(c *WitnessConfig) string { return strings.Join(c.NudgeDenylist, ",") },
+		set:         func(c *WitnessConfig, v string) error { return parseList(v, &c.NudgeDenylist) },
+		def:         func(*Manager) string { return "" },
+	},
+	{
+		name:        "agent_command",
+		description: "shell command that launches the witness agent ($GT_RIG names the rig)",
+		get:         func(c *WitnessConfig) string { return c.AgentCommand },
+		set: func(c *WitnessConfig, v string) error {
+			c.AgentCommand = v
+			return nil
+		},
+		def: func(*Manager) string { return "" },
+	},
+	{
+		name:        "env",
+		description: "comma-separated KEY=VALUE variables for the witness agent session",
+		get:         func(c *WitnessConfig) string { return strings.Join(c.Env, ",") },
+		set:         func(c *WitnessConfig, v string) error { return parseList(v, &c.Env) },
+		def:         func(*Manager) string { return "" },
+	},
+	{
+		name:        "ready_marker",
+		description: "start of a line in the witness agent's pane that shows it is ready for the startup nudges",
+		get:         func(c *WitnessConfig) string { return c.ReadyMarker },
+		set: func(c *WitnessConfig, v string) error {
+			c.ReadyMarker = v
+			return nil
+		},
+		def: func(m *Manager) string { return witnessReadyPrompt(m.rig.Path) },
+	},
+	{
+		name:        "nudge_template",
+		description: "text/template for nudges to stuck polecats",
+		get:         func(c *WitnessConfig) string { return c.NudgeTemplate },
+		set: func(c *WitnessConfig, v string) error {
+			c.NudgeTemplate = v
+			return nil
+		},
+		def: func(*Manager) string { return DefaultNudgeTemplate },
+	},
+	{
+		name:        "awaiting_input_pattern",
+		description: "regexp on a quiet polecat's pane for a prompt waiting on a human (not nudged)",
+		get:         func(c *WitnessConfig) string { return c.AwaitingInputPattern },
+		set: func(c *WitnessConfig, v string) error {
+			c.AwaitingInputPattern = v
+			return nil
+		},
+		def: func(*Manager) string { return DefaultAwaitingInputPattern },
+	},
+	{
+		name:        "in_tool_pattern",
+		description: "regexp on a quiet polecat's pane for a running tool call (not nudged)",
+		get:         func(c *WitnessConfig) string { return c.InToolPattern },
+		set: func(c *WitnessConfig, v string) error {
+			c.InToolPattern = v
+			return nil
+		},
+		def: func(*Manager) string { return DefaultInToolPattern },
+	},
+	{
+		name:        "errored_pattern",
+		description: "regexp on a quiet polecat's pane for an agent error (not nudged)",
+		get:         func(c *WitnessConfig) string { return c.ErroredPattern },
+		set: func(c *WitnessConfig, v string) error {
+			c.ErroredPattern = v
+			return nil
+		},
+		def: func(*Manager) string { return DefaultErroredPattern },
+	},
+	{
+		name:        "quiet_dates",
+		description: "comma-separated dates or weekday rules on which not to act",
+		get:         func(c *WitnessConfig) string { return strings.Join(c.QuietDates, ",") },
+		set:         func(c *WitnessConfig, v string) error { return parseList(v, &c.QuietDates) },
+		def:         func(*Manager) string { return "" },
+	},
+	{
+		name:        "quiet_dates_file",
+		description: "ICS calendar or date-list file of quiet dates",
+		get:         func(c *WitnessConfig) string { return c.QuietDatesFile },
+		set: func(c *WitnessConfig, v string) error {
+			c.QuietDatesFile = v
+			return nil
+		},
+		def: func(*Manager) string { return "" },
+	},
+	{
+		name:        "quiet_hours",
+		description: "daily HH:MM-HH:MM window in which not to act (may wrap past midnight)",
+		get:         func(c *WitnessConfig) string { return c.QuietHours.Window() },
+		set: func(c *WitnessConfig, v string) error {
+			if v == "" {
+				c.QuietHours = nil
+				return nil
+			}
+			start, end, err := ParseQuietWindow(v)
+			if err != nil {
+				return err
+			}
+			q := quietHours(c)
+			q.Start, q.End = start, end
+			return nil
+		},
+		def: func(*Manager) string { return "" },
+	},
+	{
+		name:        "quiet_hours_days",
+		description: "comma-separated weekday rules quiet hours start on (default: every day)",
+		get: func(c *WitnessConfig) string {
+			if c.QuietHours == nil {
+				return ""
+			}
+			return strings.Join(c.QuietHours.Days, ",")
+		},
+		set: func(c *WitnessConfig, v string) error {
+			q := quietHours(c)
+			q.Days = nil
+			for _, day := range strings.Split(v, ",") {
+				if day = strings.TrimSpace(day); day != "" {
+					q.Days = append(q.Days, day)
+				}
+			}
+			dropEmptyQuietHours(c)
+			return nil
+		},
+		def: func(*Manager) string { return "" },
+	},
+	{
+		name:        "quiet_hours_timezone",
+		description: "IANA timezone of quiet hours, e.g. Europe/Berlin (default: local)",
+		get: func(c *WitnessConfig) string {
+			if c.QuietHours == nil {
+				return ""
+			}
+			return c.QuietHours.Timezone
+		},
+		set: func(c *WitnessConfig, v string) error {
+			quietHours(c).Timezone = v
+			dropEmptyQuietHours(c)
+			return nil
+		},
+		def: func(*Manager) string { return "" },
+	},
+	{
+		name:        "log_file",
+		description: "path of the JSON check log",
+		get:         func(c *WitnessConfig) string { return c.LogFile },
+		set: func(c *WitnessConfig, v string) error {
+			c.LogFile = v
+			return nil
+		},
+		def: func(m *Manager) string { return m.logPath(&WitnessConfig{}) },
+	},
+	{
+		name:        "log_max_size_mb",
+		description: "size in MB at which the check log is rotated",
+		get:         func(c *WitnessConfig) string { return formatInt(c.LogMaxSizeMB) },
+		set:         func(c *WitnessConfig, v string) error { return parseInt(v, &c.LogMaxSizeMB) },
+		def:         func(*Manager) string { return strconv.Itoa(DefaultLogMaxSizeMB) },
+	},
+	{
+		name:        "history_days",
+		description: "past days of stats kept in the daily history",
+		get:         func(c *WitnessConfig) string { return formatInt(c.HistoryDays) },
+		set:         func(c *WitnessConfig, v string) error { return parseInt(v, &c.HistoryDays) },
+		def:         func(*Manager) string { return strconv.Itoa(DefaultHistoryDays) },
+	},
+}
+
+// ConfigKeys returns the names of the witness config keys.
+func ConfigKeys() []string {
+	names := make([]string, 0, len(configKeys))
+	for _, k := range configKeys {
+		names = append(names, k.name)
+	}
+	return names
+}
+
+// lookupConfigKey finds a config key by name.
+func lookupConfigKey(name string) (configKey, error) {
+	for _, k := range configKeys {
+		if k.name == name {
+			return k, nil
+		}
+	}
+	return configKey{}, fmt.Errorf("unknown witness config key %q (valid: %s)", name, strings.Join(ConfigKeys(), ", "))
+}
+
+// ConfigValues returns the effective value and source of every config key.
+func (m *Manager) ConfigValues() ([]ConfigValue, error) {
+	w, err := m.loadOverrides()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]ConfigValue, 0, len(configKeys))
+	for _, k := range configKeys {
+		values = append(values, m.configValue(k, &w.Config))
+	}
+	return values, nil
+}
+
+// ConfigValue returns the effective value and source of one config key.
+func (m *Manager) ConfigValue(key string) (ConfigValue, error) {
+	k, err := lookupConfigKey(key)
+	if err != nil {
+		return ConfigValue{}, err
+	}
+	w, err := m.loadOverrides()
+	if err != nil {
+		return ConfigValue{}, err
+	}
+	return m.configValue(k, &w.Config), nil
+}
+
+// loadOverrides loads the witness state with only the config the state
+// file itself sets, so configValue can tell overrides from file settings.
+func (m *Manager) loadOverrides() (*Witness, error) {
+	if m.fileConfigErr != nil {
+		return nil, fmt.Errorf("witness config: %w", m.fileConfigErr)
+	}
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+	w.Config = m.withoutFileConfig(w.Config)
+	return w, nil
+}
+
+// configValue returns a key's effective value given the overrides in c.
+func (m *Manager) configValue(k configKey, c *WitnessConfig) ConfigValue {
+	v := ConfigValue{Key: k.name, Value: k.get(c), Source: SourceOverride, Description: k.description}
+	if v.Value != "" {
+		return v
+	}
+	if s, ok := m.fileConfig[k.name]; ok {
+		v.Value, v.Source, v.File = s.Value, fileSource(s), s.Path
+		return v
+	}
+	v.Value, v.Source = k.def(m), SourceDefault
+	return v
+}
+
+// SetConfigValue parses and sets one config key, validating the resulting
+// config before saving it. An empty value resets the key to its default.
+func (m *Manager) SetConfigValue(key, value string) error {
+	k, err := lookupConfigKey(key)
+	if err != nil {
+		return err
+	}
+	return m.updateState(func(w *Witness) error {
+		err := m.updateOverrides(&w.Config, func(c *WitnessConfig) error {
+			return k.set(c, value)
+		})
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+		return validateConfig(&w.Config)
+	})
+}
+
+// quietHours returns the config's quiet hours, creating them if unset.
+func quietHours(c *WitnessConfig) *QuietHours {
+	if c.QuietHours == nil {
+		c.QuietHours = &QuietHours{}
+	}
+	return c.QuietHours
+}
+
+// dropEmptyQuietHours unsets quiet hours once all their keys are reset.
+func dropEmptyQuietHours(c *WitnessConfig) {
+	q := c.QuietHours
+	if q != nil && q.Start == "" && q.End == "" && len(q.Days) == 0 && q.Timezone == "" {
+		c.QuietHours = nil
+	}
+}
+
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+func formatInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+func formatBool(b bool) string {
+	if !b {
+		return ""
+	}
+	return "true"
+}
+
+func parseDuration(v string, dst *time.Duration) error {
+	if v == "" {
+		*dst = 0
+		return nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return err
+	}
+	if d <= 0 {
+		return fmt.Errorf("must be positive")
+	}
+	*dst = d
+	return nil
+}
+
+func parseInt(v string, dst *int) error {
+	if v == "" {
+		*dst = 0
+		return nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return err
+	}
+	if n <= 0 {
+		return fmt.Errorf("must be positive")
+	}
+	*dst = n
+	return nil
+}
+
+// parseList splits a comma-separated value, dropping empty entries.
+func parseList(v string, dst *[]string) error {
+	*dst = nil
+	for _, entry := range strings.Split(v, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			*dst = append(*dst, entry)
+		}
+	}
+	return nil
+}
+
+func parseBool(v string, dst *bool) error {
+	if v == "" {
+		*dst = false
+		return nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fmt.Errorf("want true or false")
+	}
+	*dst = b
+	return nil
+}
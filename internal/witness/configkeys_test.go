@@ -0,0 +1,129 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestManager_ConfigValues_DefaultsAndOverrides(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	v, err := mgr.ConfigValue("stuck_threshold")
+	if err != nil {
+		t.Fatalf("ConfigValue: %v", err)
+	}
+	if v.Value != DefaultStuckThreshold.String() || v.Source != SourceDefault {
+		t.Errorf("default stuck_threshold = %+v", v)
+	}
+
+	if err := mgr.SetConfigValue("stuck_threshold", "45m"); err != nil {
+		t.Fatalf("SetConfigValue: %v", err)
+	}
+	v, _ = mgr.ConfigValue("stuck_threshold")
+	if v.Value != "45m0s" || v.Source != SourceOverride {
+		t.Errorf("overridden stuck_threshold = %+v", v)
+	}
+	w, _ := mgr.loadState()
+	if w.Config.StuckThreshold != 45*time.Minute {
+		t.Errorf("StuckThreshold = %s, want 45m", w.Config.StuckThreshold)
+	}
+
+	// Empty resets to the default
+	if err := mgr.SetConfigValue("stuck_threshold", ""); err != nil {
+		t.Fatalf("SetConfigValue reset: %v", err)
+	}
+	if v, _ = mgr.ConfigValue("stuck_threshold"); v.Source != SourceDefault {
+		t.Errorf("after reset stuck_threshold = %+v", v)
+	}
+
+	values, err := mgr.ConfigValues()
+	if err != nil {
+		t.Fatalf("ConfigValues: %v", err)
+	}
+	if len(values) != len(ConfigKeys()) {
+		t.Errorf("ConfigValues returned %d keys, want %d", len(values), len(ConfigKeys()))
+	}
+}
+
+func TestManager_SetConfigValue_Validates(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	tests := []struct {
+		key, value string
+	}{
+		{"idle_threshold", "soon"},
+		{"idle_threshold", "-5m"},
+		{"stuck_threshold", "1m"}, // shorter than the idle default
+		{"escalation_threshold", "three"},
+		{"crash_loop_threshold", "1"},
+		{"nudge_template", "{{.Nope}}"},
+		{"quiet_dates", "someday"},
+		{"no_such_key", "1"},
+	}
+	for _, tt := range tests {
+		if err := mgr.SetConfigValue(tt.key, tt.value); err == nil {
+			t.Errorf("SetConfigValue(%q, %q) succeeded, want error", tt.key, tt.value)
+		}
+	}
+
+	// Nothing invalid was saved
+	w, _ := mgr.loadState()
+	if w.Config.IdleThreshold != 0 || w.Config.NudgeTemplate != "" || len(w.Config.QuietDates) != 0 {
+		t.Errorf("invalid values were saved: %+v", w.Config)
+	}
+}
+
+func TestManager_SetConfigValue_QuietDates(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	if err := mgr.SetConfigValue("quiet_dates", "weekends, 2026-12-25"); err != nil {
+		t.Fatalf("SetConfigValue: %v", err)
+	}
+	v, _ := mgr.ConfigValue("quiet_dates")
+	if v.Value != "weekends,2026-12-25" {
+		t.Errorf("quiet_dates = %q", v.Value)
+	}
+}
+
+func TestManager_SetConfigValue_QuietHours(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	if err := mgr.SetConfigValue("quiet_hours_days", "weekdays"); err == nil {
+		t.Error("quiet_hours_days without quiet_hours = nil, want error")
+	}
+	if err := mgr.SetConfigValue("quiet_hours", "22:00-07:00"); err != nil {
+		t.Fatalf("SetConfigValue(quiet_hours): %v", err)
+	}
+	if err := mgr.SetConfigValue("quiet_hours_timezone", "Nowhere/Special"); err == nil {
+		t.Error("invalid quiet_hours_timezone = nil, want error")
+	}
+	if err := mgr.SetConfigValue("quiet_hours_days", "fri, sat"); err != nil {
+		t.Fatalf("SetConfigValue(quiet_hours_days): %v", err)
+	}
+	w, _ := mgr.loadState()
+	if q := w.Config.QuietHours; q == nil || q.Window() != "22:00-07:00" || len(q.Days) != 2 {
+		t.Errorf("QuietHours = %+v", q)
+	}
+
+	if err := mgr.SetConfigValue("quiet_hours", ""); err != nil {
+		t.Fatalf("resetting quiet_hours: %v", err)
+	}
+	w, _ = mgr.loadState()
+	if w.Config.QuietHours != nil {
+		t.Errorf("QuietHours after reset = %+v, want nil", w.Config.QuietHours)
+	}
+}
+
+func TestMergeCheck_KeepsConfigSet(t *testing.T) {
+	now := time.Now()
+	checked := &Witness{LastCheckAt: &now, Config: WitnessConfig{StuckThreshold: 30 * time.Minute}}
+
+	// config set saved while the check ran
+	w := &Witness{Config: WitnessConfig{StuckThreshold: 45 * time.Minute}}
+	w.mergeCheck(checked)
+	if w.Config.StuckThreshold != 45*time.Minute {
+		t.Errorf("StuckThreshold = %s, want the 45m set during the check", w.Config.StuckThreshold)
+	}
+}
@@ -0,0 +1,92 @@
+package witness
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrCrashLoop is returned by Start while the witness is held stopped after
+// its agent crashed repeatedly. An explicit start clears it.
+var ErrCrashLoop = errors.New("witness agent is crash-looping; held stopped")
+
+const (
+	// DefaultCrashLoopThreshold is how many agent crashes within
+	// crashLoopWindow count as a crash loop, unless the rig configures its own.
+	DefaultCrashLoopThreshold = 3
+
+	// crashLoopWindow is how far back crashes count towards a crash loop.
+	// The daemon restarts a dead witness every heartbeat (3m), so a witness
+	// that dies on startup crashes about ten times in this window.
+	crashLoopWindow = 30 * time.Minute
+
+	// crashReasonLines is how much of a dead pane is kept as the crash reason.
+	crashReasonLines = 5
+)
+
+// CrashLoopLimit returns the number of crashes within the window that marks
+// a crash loop. Zero or negative values fall back to the default.
+func (c *WitnessConfig) CrashLoopLimit() int {
+	if c.CrashLoopThreshold <= 0 {
+		return DefaultCrashLoopThreshold
+	}
+	return c.CrashLoopThreshold
+}
+
+// recordCrash notes an agent crash at now and reports whether the recent
+// crashes amount to a crash loop. Crashes older than the window are
+// forgotten, so occasional restarts never add up to one.
+func (w *Witness) recordCrash(now time.Time, reason string) bool {
+	cutoff := now.Add(-crashLoopWindow)
+	recent := w.Crashes[:0]
+	for _, t := range w.Crashes {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	w.Crashes = append(recent, now)
+	w.LastCrashAt = &now
+	if reason != "" {
+		w.LastCrashReason = reason
+	}
+
+	if len(w.Crashes) < w.Config.CrashLoopLimit() {
+		return false
+	}
+	w.CrashLoopAt = &now
+	w.State = StateStopped
+	w.LastError = fmt.Sprintf("crash loop: agent died %d times in %s", len(w.Crashes), crashLoopWindow)
+	return true
+}
+
+// ClearCrashLoop releases a witness held stopped by crash-loop detection so
+// the next Start launches it again. Used by explicit operator starts; the
+// daemon's automatic restarts leave the hold in place.
+func (m *Manager) ClearCrashLoop() error {
+	w, err := m.loadState()
+	if err != nil {
+		return err
+	}
+	if w.CrashLoopAt == nil && len(w.Crashes) == 0 {
+		return nil
+	}
+	w.CrashLoopAt = nil
+	w.Crashes = nil
+	w.LastError = ""
+	return m.saveState(w)
+}
+
+// crashReason extracts the last few non-blank lines of a dead agent pane.
+func crashReason(pane string) string {
+	var lines []string
+	for _, line := range strings.Split(pane, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > crashReasonLines {
+		lines = lines[len(lines)-crashReasonLines:]
+	}
+	return strings.Join(lines, " | ")
+}
@@ -0,0 +1,85 @@
+package witness
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWitness_RecordCrash_SingleRestartIsNotALoop(t *testing.T) {
+	w := &Witness{State: StateRunning}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if w.recordCrash(now, "panic: boom") {
+		t.Fatal("a single crash tripped crash-loop detection")
+	}
+	if w.State != StateRunning || w.CrashLoopAt != nil {
+		t.Errorf("State = %s, CrashLoopAt = %v after one crash", w.State, w.CrashLoopAt)
+	}
+	if w.LastCrashReason != "panic: boom" || !w.LastCrashAt.Equal(now) {
+		t.Errorf("LastCrashReason = %q, LastCrashAt = %v", w.LastCrashReason, w.LastCrashAt)
+	}
+}
+
+func TestWitness_RecordCrash_Loop(t *testing.T) {
+	w := &Witness{State: StateRunning}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < DefaultCrashLoopThreshold-1; i++ {
+		if w.recordCrash(now.Add(time.Duration(i)*3*time.Minute), "") {
+			t.Fatalf("crash %d tripped crash-loop detection", i+1)
+		}
+	}
+	if !w.recordCrash(now.Add(10*time.Minute), "") {
+		t.Fatal("expected crash loop")
+	}
+	if w.State != StateStopped || w.CrashLoopAt == nil || w.LastError == "" {
+		t.Errorf("State = %s, CrashLoopAt = %v, LastError = %q", w.State, w.CrashLoopAt, w.LastError)
+	}
+}
+
+func TestWitness_RecordCrash_OldCrashesForgotten(t *testing.T) {
+	w := &Witness{State: StateRunning}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Crashes spread further apart than the window never add up
+	for i := 0; i < 5; i++ {
+		if w.recordCrash(now.Add(time.Duration(i)*crashLoopWindow), "") {
+			t.Fatalf("crash %d tripped crash-loop detection", i+1)
+		}
+	}
+	if len(w.Crashes) != 1 {
+		t.Errorf("len(Crashes) = %d, want 1", len(w.Crashes))
+	}
+}
+
+func TestWitness_RecordCrash_ConfiguredThreshold(t *testing.T) {
+	w := &Witness{State: StateRunning, Config: WitnessConfig{CrashLoopThreshold: 2}}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if w.recordCrash(now, "") {
+		t.Fatal("first crash tripped crash-loop detection")
+	}
+	if !w.recordCrash(now.Add(time.Minute), "") {
+		t.Fatal("expected crash loop at configured threshold 2")
+	}
+}
+
+func TestValidateConfig_CrashLoopThreshold(t *testing.T) {
+	if err := validateConfig(&WitnessConfig{CrashLoopThreshold: 1}); err == nil {
+		t.Error("expected error for crash loop threshold 1")
+	}
+	if err := validateConfig(&WitnessConfig{CrashLoopThreshold: 2}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCrashReason(t *testing.T) {
+	pane := "line1\n\nline2\nline3\n  \nline4\nline5\nError: API key invalid\n\n"
+	want := "line2 | line3 | line4 | line5 | Error: API key invalid"
+	if got := crashReason(pane); got != want {
+		t.Errorf("crashReason() = %q, want %q", got, want)
+	}
+	if got := crashReason("\n  \n"); got != "" {
+		t.Errorf("crashReason(blank) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,83 @@
+package witness
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// SetDiscover controls how the next Start picks the polecats to monitor.
+// With discovery (the default) every check lists the rig's polecat tmux
+// sessions, so new polecats are picked up and vanished ones go stale
+// without restarting the witness. Without it the rig's polecats at start
+// are monitored until the witness is restarted.
+func (m *Manager) SetDiscover(discover bool) {
+	m.staticPolecats = !discover
+}
+
+// SetDiscover sets polecat discovery for every rig in the group.
+func (g *Group) SetDiscover(discover bool) {
+	for _, m := range g.managers {
+		m.SetDiscover(discover)
+	}
+}
+
+// startPolecats returns the monitored set recorded when the witness
+// starts, and records in w which polecats were asked for explicitly and
+// which discovered. If discovery fails the rig's polecats stand in for
+// what it would have found, until the first check.
+func (m *Manager) startPolecats(w *Witness) []string {
+	polecats := m.rig.Polecats
+	if m.staticPolecats {
+		w.ExplicitPolecats = applyWatchList(polecats, w)
+		w.DiscoveredPolecats = nil
+		return w.ExplicitPolecats
+	}
+	if discovered, err := m.discoverPolecats(); err == nil {
+		polecats = discovered
+	}
+	w.ExplicitPolecats = applyWatchList(nil, w)
+	w.DiscoveredPolecats = polecats
+	return applyWatchList(polecats, w)
+}
+
+// monitoredPolecats returns the polecats a check should cover, noting what
+// discovery found in w.DiscoveredPolecats. If discovery fails the last
+// monitored set is returned with the error, so a tmux hiccup doesn't mark
+// every polecat stale.
+func (m *Manager) monitoredPolecats(w *Witness) ([]string, error) {
+	if w.StaticPolecats {
+		return applyWatchList(w.MonitoredPolecats, w), nil
+	}
+	polecats, err := m.discoverPolecats()
+	if err != nil {
+		return w.MonitoredPolecats, fmt.Errorf("discovering polecats: %w", err)
+	}
+	w.DiscoveredPolecats = polecats
+	return applyWatchList(polecats, w), nil
+}
+
+// discoverPolecats lists the rig's polecats that have a tmux session.
+func (m *Manager) discoverPolecats() ([]string, error) {
+	sessions, err := m.tmux.ListSessions()
+	if err != nil {
+		return nil, err
+	}
+	return polecatsFromSessions(sessions, m.rig.Name), nil
+}
+
+// polecatsFromSessions picks the polecat sessions of rigName out of a tmux
+// session list and returns their polecat names, sorted.
+func polecatsFromSessions(sessions []string, rigName string) []string {
+	polecats := []string{}
+	for _, s := range sessions {
+		id, err := session.ParseSessionName(s)
+		if err != nil || id.Role != session.RolePolecat || id.Rig != rigName {
+			continue
+		}
+		polecats = append(polecats, id.Name)
+	}
+	sort.Strings(polecats)
+	return polecats
+}
@@ -0,0 +1,140 @@
+package witness
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestPolecatsFromSessions(t *testing.T) {
+	sessions := []string{
+		"hq-mayor",
+		"gt-gastown-witness",
+		"gt-gastown-refinery",
+		"gt-gastown-crew-max",
+		"gt-gastown-toast",
+		"gt-gastown-nux",
+		"gt-beads-toast",
+		"gt-witness-1a2b3c4d",
+		"scratch",
+	}
+	got := polecatsFromSessions(sessions, "gastown")
+	if want := []string{"nux", "toast"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("polecatsFromSessions = %v, want %v", got, want)
+	}
+	if got := polecatsFromSessions(nil, "gastown"); len(got) != 0 {
+		t.Errorf("polecatsFromSessions(nil) = %v, want none", got)
+	}
+}
+
+// newPolecatSession starts a tmux session for a polecat and kills it when
+// the test ends. It returns a func that kills it early.
+func newPolecatSession(t *testing.T, rigName, polecat string) func() {
+	t.Helper()
+	tm := tmux.NewTmux()
+	name := session.PolecatSessionName(rigName, polecat)
+	if err := tm.NewSession(name, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	kill := func() { _ = tm.KillSession(name) }
+	t.Cleanup(kill)
+	return kill
+}
+
+func TestCheck_DiscoversPolecats(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	rigName := "discotest" + strconv.Itoa(os.Getpid())
+	mgr := NewManagerWithNudger(&rig.Rig{Name: rigName, Path: t.TempDir()}, &fakeNudger{})
+	mgr.SetOutput(io.Discard)
+
+	killToast := newPolecatSession(t, rigName, "toast")
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if _, err := mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+
+	// A polecat started after the witness is picked up on the next check
+	newPolecatSession(t, rigName, "nux")
+	killToast()
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(result.Polecats) != 1 || result.Polecats[0].Name != "nux" {
+		t.Fatalf("checked %+v, want only nux", result.Polecats)
+	}
+
+	w, err := mgr.Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if !reflect.DeepEqual(w.MonitoredPolecats, []string{"nux"}) {
+		t.Errorf("MonitoredPolecats = %v, want [nux]", w.MonitoredPolecats)
+	}
+	if !w.Stats.PerPolecat["toast"].Stale {
+		t.Error("vanished polecat not marked stale")
+	}
+	if w.Stats.PerPolecat["nux"].Stale {
+		t.Error("discovered polecat marked stale")
+	}
+}
+
+func TestCheck_StaticPolecats(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	rigName := "statictest" + strconv.Itoa(os.Getpid())
+	r := &rig.Rig{Name: rigName, Path: t.TempDir(), Polecats: []string{"toast"}}
+	mgr := NewManagerWithNudger(r, &fakeNudger{})
+	mgr.SetOutput(io.Discard)
+	mgr.SetDiscover(false)
+
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	newPolecatSession(t, rigName, "nux")
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(result.Polecats) != 1 || result.Polecats[0].Name != "toast" {
+		t.Errorf("checked %+v, want only toast: the set is fixed at start", result.Polecats)
+	}
+}
+
+func TestWitness_PolecatSources(t *testing.T) {
+	w := &Witness{
+		MonitoredPolecats: []string{"alpha", "bravo"},
+		ExplicitPolecats:  []string{"bravo"},
+		Stats: WitnessStats{PerPolecat: map[string]PolecatStats{
+			"alpha":   {Checks: 2},
+			"zulu":    {Checks: 1, Stale: true},
+			"charlie": {Checks: 1, Stale: true},
+		}},
+	}
+	want := []SourcedPolecat{
+		{"alpha", SourceDiscovered},
+		{"bravo", SourceExplicit},
+		{"charlie", SourceStale},
+		{"zulu", SourceStale},
+	}
+	if got := w.PolecatSources(); !reflect.DeepEqual(got, want) {
+		t.Errorf("PolecatSources() = %v, want %v", got, want)
+	}
+
+	w.StaticPolecats = true
+	if got := w.PolecatSources(); got[0].Source != SourceExplicit {
+		t.Errorf("with discovery off, %s is %s, want explicit", got[0].Name, got[0].Source)
+	}
+}
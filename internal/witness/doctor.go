@@ -0,0 +1,218 @@
+package witness
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// DiagnosisStatus grades one witness doctor finding.
+type DiagnosisStatus int
+
+const (
+	DiagnosisPass DiagnosisStatus = iota
+	DiagnosisWarn
+	DiagnosisFail
+)
+
+// Diagnosis is one finding of Manager.Diagnose, with a hint on how to fix
+// anything short of a pass.
+type Diagnosis struct {
+	Name    string
+	Status  DiagnosisStatus
+	Message string
+	Hint    string
+}
+
+// Diagnose checks the things a witness needs to do its job: a readable
+// state file in a supported schema, a valid config, tmux, a live session
+// or loop, no crash loop, and bd for escalations. Checks that depend on
+// the state file are skipped when it can't be read.
+func (m *Manager) Diagnose() []Diagnosis {
+	t := m.tmux
+	var ds []Diagnosis
+	state := m.diagnoseStateFile()
+	ds = append(ds, state)
+
+	var w *Witness
+	if state.Status != DiagnosisFail {
+		var err error
+		if w, err = m.loadState(); err != nil {
+			ds = append(ds, Diagnosis{Name: "state", Status: DiagnosisFail, Message: err.Error()})
+			w = nil
+		}
+	}
+	if w != nil {
+		ds = append(ds, m.diagnoseConfig(w))
+	}
+
+	tmuxOK := true
+	if version, err := t.Available(); err != nil {
+		tmuxOK = false
+		ds = append(ds, Diagnosis{
+			Name: "tmux", Status: DiagnosisFail, Message: err.Error(),
+			Hint: "Install tmux 3.0 or newer, or point --tmux-cmd at it",
+		})
+	} else {
+		ds = append(ds, Diagnosis{Name: "tmux", Status: DiagnosisPass, Message: version})
+	}
+
+	if w != nil && tmuxOK {
+		ds = append(ds, m.diagnoseSession(t, w))
+		ds = append(ds, m.diagnoseCrashes(w))
+	}
+
+	if _, err := exec.LookPath("bd"); err != nil {
+		ds = append(ds, Diagnosis{
+			Name: "bd", Status: DiagnosisFail, Message: "bd not found on PATH; stuck polecats can't be escalated",
+			Hint: "Install beads (https://github.com/steveyegge/beads) and make sure bd is on PATH",
+		})
+	} else {
+		ds = append(ds, Diagnosis{Name: "bd", Status: DiagnosisPass, Message: "bd reachable"})
+	}
+	return ds
+}
+
+// diagnoseStateFile checks that the state file reads and parses, and that
+// its schema is one this build understands. A missing file is only a
+// warning: the witness has never been started.
+func (m *Manager) diagnoseStateFile() Diagnosis {
+	d := Diagnosis{Name: "state"}
+	path := m.stateFile()
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is the rig's state file
+	switch {
+	case os.IsNotExist(err):
+		d.Status = DiagnosisWarn
+		d.Message = fmt.Sprintf("no state file at %s; the witness has never run", path)
+		d.Hint = fmt.Sprintf("Start it with 'gt witness start %s'", m.rig.Name)
+		return d
+	case err != nil:
+		d.Status = DiagnosisFail
+		d.Message = fmt.Sprintf("cannot read %s: %v", path, err)
+		d.Hint = "Check the file's permissions"
+		return d
+	}
+
+	var head struct {
+		SchemaVersion int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		d.Status = DiagnosisFail
+		d.Message = fmt.Sprintf("%s is not valid JSON: %v", path, err)
+		d.Hint = "Move the file aside; the witness writes a fresh one on start (stats are lost)"
+		return d
+	}
+	switch {
+	case head.SchemaVersion > StateSchemaVersion:
+		d.Status = DiagnosisFail
+		d.Message = fmt.Sprintf("schema version %d is newer than this gt supports (%d)", head.SchemaVersion, StateSchemaVersion)
+		d.Hint = "Upgrade gt to the release that wrote the file"
+	case head.SchemaVersion < StateSchemaVersion:
+		d.Status = DiagnosisPass
+		d.Message = fmt.Sprintf("schema version %d, upgraded to %d on the next save", head.SchemaVersion, StateSchemaVersion)
+	default:
+		d.Status = DiagnosisPass
+		d.Message = fmt.Sprintf("schema version %d", head.SchemaVersion)
+	}
+	return d
+}
+
+// diagnoseConfig validates the stored config the way gt witness config
+// set does.
+func (m *Manager) diagnoseConfig(w *Witness) Diagnosis {
+	if err := validateConfig(&w.Config); err != nil {
+		return Diagnosis{
+			Name: "config", Status: DiagnosisFail, Message: err.Error(),
+			Hint: fmt.Sprintf("Fix it with 'gt witness config set %s <key> <value>' (an empty value resets a key)", m.rig.Name),
+		}
+	}
+	return Diagnosis{Name: "config", Status: DiagnosisPass, Message: "valid"}
+}
+
+// diagnoseSession checks that whatever should be running is: the agent in
+// its session, or the Go loop making progress for a foreground or group
+// witness.
+func (m *Manager) diagnoseSession(t tmux.Client, w *Witness) Diagnosis {
+	d := Diagnosis{Name: "session"}
+	if w.State != StateStopped && (w.Foreground || w.Group != "") {
+		d.Name = "loop"
+		if w.LastCheckAt == nil || time.Since(*w.LastCheckAt) > w.loopStaleAfter() {
+			last := "never"
+			if w.LastCheckAt != nil {
+				last = time.Since(*w.LastCheckAt).Round(time.Second).String() + " ago"
+			}
+			d.Status = DiagnosisWarn
+			d.Message = fmt.Sprintf("monitoring loop last completed a check %s", last)
+			d.Hint = fmt.Sprintf("The loop may have died; restart it with 'gt witness stop %s' and start it again", m.rig.Name)
+			return d
+		}
+		d.Status = DiagnosisPass
+		d.Message = fmt.Sprintf("monitoring loop checked %s ago", time.Since(*w.LastCheckAt).Round(time.Second))
+		return d
+	}
+
+	session := m.SessionName()
+	running, err := t.HasSession(session)
+	switch {
+	case err != nil:
+		d.Status = DiagnosisFail
+		d.Message = fmt.Sprintf("cannot check session %s: %v", session, err)
+	case !running && w.State == StateStopped:
+		d.Status = DiagnosisWarn
+		d.Message = "witness is stopped"
+		d.Hint = fmt.Sprintf("Start it with 'gt witness start %s'", m.rig.Name)
+	case !running:
+		d.Status = DiagnosisFail
+		d.Message = fmt.Sprintf("state says %s but session %s is gone", w.State, session)
+		d.Hint = fmt.Sprintf("Restart it with 'gt witness restart %s'; the daemon does so on its next heartbeat", m.rig.Name)
+	case !t.IsClaudeRunning(session):
+		d.Status = DiagnosisFail
+		d.Message = fmt.Sprintf("session %s is alive but its agent is not running", session)
+		if pane, err := t.CapturePane(session, paneSampleLines); err == nil {
+			if reason := crashReason(pane); reason != "" {
+				d.Message += ": " + reason
+			}
+		}
+		d.Hint = fmt.Sprintf("Restart it with 'gt witness restart %s'", m.rig.Name)
+	default:
+		d.Status = DiagnosisPass
+		d.Message = fmt.Sprintf("agent running in %s", session)
+	}
+	return d
+}
+
+// diagnoseCrashes reports a crash-loop hold or recent agent crashes.
+func (m *Manager) diagnoseCrashes(w *Witness) Diagnosis {
+	d := Diagnosis{Name: "crashes"}
+	recent := 0
+	cutoff := time.Now().Add(-crashLoopWindow)
+	for _, c := range w.Crashes {
+		if c.After(cutoff) {
+			recent++
+		}
+	}
+	reason := ""
+	if w.LastCrashReason != "" {
+		reason = "; last: " + w.LastCrashReason
+	}
+	switch {
+	case w.CrashLoopAt != nil:
+		d.Status = DiagnosisFail
+		d.Message = fmt.Sprintf("held stopped by crash-loop detection since %s%s",
+			w.CrashLoopAt.Format("2006-01-02 15:04"), reason)
+		d.Hint = fmt.Sprintf("Fix the cause, then 'gt witness start %s' clears the hold", m.rig.Name)
+	case recent > 0:
+		d.Status = DiagnosisWarn
+		d.Message = fmt.Sprintf("agent crashed %d time(s) in the last %s (limit %d)%s",
+			recent, crashLoopWindow, w.Config.CrashLoopLimit(), reason)
+		d.Hint = fmt.Sprintf("Look at the agent with 'gt witness logs %s'", m.rig.Name)
+	default:
+		d.Status = DiagnosisPass
+		d.Message = "no recent crashes"
+	}
+	return d
+}
@@ -0,0 +1,76 @@
+package witness
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestDiagnoseStateFile(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	if d := mgr.diagnoseStateFile(); d.Status != DiagnosisWarn {
+		t.Errorf("missing state file: got %+v, want a warning", d)
+	}
+
+	if err := mgr.saveState(&Witness{RigName: "testrig"}); err != nil {
+		t.Fatal(err)
+	}
+	if d := mgr.diagnoseStateFile(); d.Status != DiagnosisPass {
+		t.Errorf("current state file: got %+v, want a pass", d)
+	}
+
+	write := func(content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(mgr.stateFile()), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(mgr.stateFile(), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"schema_version": 99}`)
+	if d := mgr.diagnoseStateFile(); d.Status != DiagnosisFail || !strings.Contains(d.Message, "newer") {
+		t.Errorf("newer schema: got %+v, want a failure", d)
+	}
+	write(`{"schema_version": `)
+	if d := mgr.diagnoseStateFile(); d.Status != DiagnosisFail {
+		t.Errorf("corrupt state file: got %+v, want a failure", d)
+	}
+}
+
+func TestDiagnoseConfig(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if d := mgr.diagnoseConfig(&Witness{}); d.Status != DiagnosisPass {
+		t.Errorf("default config: got %+v", d)
+	}
+	bad := &Witness{Config: WitnessConfig{IdleThreshold: time.Hour, StuckThreshold: time.Minute}}
+	if d := mgr.diagnoseConfig(bad); d.Status != DiagnosisFail {
+		t.Errorf("stuck < idle: got %+v, want a failure", d)
+	}
+}
+
+func TestDiagnoseCrashes(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	now := time.Now()
+
+	if d := mgr.diagnoseCrashes(&Witness{}); d.Status != DiagnosisPass {
+		t.Errorf("no crashes: got %+v", d)
+	}
+	recent := &Witness{Crashes: []time.Time{now.Add(-time.Minute)}, LastCrashReason: "boom"}
+	if d := mgr.diagnoseCrashes(recent); d.Status != DiagnosisWarn || !strings.Contains(d.Message, "boom") {
+		t.Errorf("recent crash: got %+v, want a warning with the reason", d)
+	}
+	old := &Witness{Crashes: []time.Time{now.Add(-2 * crashLoopWindow)}}
+	if d := mgr.diagnoseCrashes(old); d.Status != DiagnosisPass {
+		t.Errorf("old crash: got %+v, want a pass", d)
+	}
+	held := &Witness{CrashLoopAt: &now}
+	if d := mgr.diagnoseCrashes(held); d.Status != DiagnosisFail {
+		t.Errorf("crash loop: got %+v, want a failure", d)
+	}
+}
@@ -0,0 +1,65 @@
+package witness
+
+import "time"
+
+// SetDryRun makes the next Start run the witness in dry-run mode: stuck
+// polecats are detected as usual, but the nudges and escalations are only
+// logged and counted as would-nudges.
+func (m *Manager) SetDryRun(dryRun bool) {
+	m.dryRun = dryRun
+}
+
+// SetDryRun sets dry-run mode for every rig in the group.
+func (g *Group) SetDryRun(dryRun bool) {
+	for _, m := range g.managers {
+		m.SetDryRun(dryRun)
+	}
+}
+
+// DryRun reports whether the running witness is in dry-run mode.
+func (m *Manager) DryRun() bool {
+	w, err := m.loadState()
+	return err == nil && w.State != StateStopped && w.DryRun
+}
+
+// RecordWouldNudge counts a nudge the witness agent held back in dry-run
+// mode. The agent nudges through gt nudge, which calls this instead of
+// sending; polecat may be "" when the target isn't a polecat.
+func (m *Manager) RecordWouldNudge(polecat string) error {
+	w, err := m.loadState()
+	if err != nil {
+		return err
+	}
+	var ps PolecatStats
+	if polecat != "" {
+		if w.Stats.PerPolecat == nil {
+			w.Stats.PerPolecat = make(map[string]PolecatStats)
+		}
+		ps = w.Stats.PerPolecat[polecat]
+	}
+	w.Stats.rollover(time.Now())
+	w.Stats.recordWouldNudge(&ps)
+	if polecat != "" {
+		w.Stats.PerPolecat[polecat] = ps
+	}
+	return m.saveState(w)
+}
+
+// RecordWouldEscalate counts an escalation the witness agent held back in
+// dry-run mode.
+func (m *Manager) RecordWouldEscalate() error {
+	w, err := m.loadState()
+	if err != nil {
+		return err
+	}
+	w.Stats.TotalWouldEscalations++
+	return m.saveState(w)
+}
+
+// recordWouldNudge counts a held-back nudge in the totals and in ps.
+func (s *WitnessStats) recordWouldNudge(ps *PolecatStats) {
+	s.TotalWouldNudges++
+	s.TodayWouldNudges++
+	ps.WouldNudges++
+	ps.WouldStreak++
+}
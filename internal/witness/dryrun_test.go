@@ -0,0 +1,60 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestManager_RecordWouldNudge(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	w, err := mgr.loadState()
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	if mgr.DryRun() {
+		t.Error("DryRun() on stopped witness = true")
+	}
+	w.State = StateRunning
+	w.DryRun = true
+	if err := mgr.saveState(w); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+	if !mgr.DryRun() {
+		t.Error("DryRun() = false, want true")
+	}
+
+	if err := mgr.RecordWouldNudge("Toast"); err != nil {
+		t.Fatalf("RecordWouldNudge: %v", err)
+	}
+	if err := mgr.RecordWouldNudge(""); err != nil {
+		t.Fatalf("RecordWouldNudge: %v", err)
+	}
+	if err := mgr.RecordWouldEscalate(); err != nil {
+		t.Fatalf("RecordWouldEscalate: %v", err)
+	}
+
+	w, _ = mgr.loadState()
+	if w.Stats.TotalWouldNudges != 2 || w.Stats.TodayWouldNudges != 2 || w.Stats.TotalWouldEscalations != 1 {
+		t.Errorf("stats = %+v", w.Stats)
+	}
+	if w.Stats.TotalNudges != 0 || w.Stats.PerPolecat["Toast"].Nudges != 0 {
+		t.Error("would-nudges counted as real nudges")
+	}
+	if got := w.Stats.PerPolecat["Toast"].WouldNudges; got != 1 {
+		t.Errorf("Toast WouldNudges = %d, want 1", got)
+	}
+	if len(w.Stats.PerPolecat) != 1 {
+		t.Errorf("PerPolecat = %v, want only Toast", w.Stats.PerPolecat)
+	}
+}
+
+func TestWitnessStats_RolloverWouldNudges(t *testing.T) {
+	s := WitnessStats{StatsDate: "2026-01-01", TodayWouldNudges: 4, TotalWouldNudges: 9}
+	s.rollover(time.Date(2026, 1, 2, 9, 0, 0, 0, time.Local))
+	if s.TodayWouldNudges != 0 || s.TotalWouldNudges != 9 {
+		t.Errorf("after rollover: today = %d, total = %d", s.TodayWouldNudges, s.TotalWouldNudges)
+	}
+}
@@ -0,0 +1,52 @@
+package witness
+
+import "fmt"
+
+// StateError is returned when an operation doesn't apply to the witness in
+// its current state, e.g. starting a witness that is already running. It
+// unwraps to the matching sentinel (ErrAlreadyRunning, ErrNotRunning,
+// ErrAlreadyPaused or ErrNotPaused), so errors.Is keeps working; use
+// errors.As when the current state matters, such as a start refused
+// because the witness is running but paused.
+type StateError struct {
+	Rig       string
+	Op        string // "start", "stop", "pause" or "resume"
+	Current   State
+	Requested State
+	Err       error
+}
+
+func (e *StateError) Error() string {
+	return fmt.Sprintf("cannot %s witness for %s: it is %s (%v)", e.Op, e.Rig, e.Current, e.Err)
+}
+
+func (e *StateError) Unwrap() error { return e.Err }
+
+// SessionError is returned when the witness tmux session couldn't be
+// created, killed or waited for.
+type SessionError struct {
+	Session string
+	Op      string // e.g. "creating", "killing zombie"
+	Err     error
+}
+
+func (e *SessionError) Error() string {
+	return fmt.Sprintf("%s session %s: %v", e.Op, e.Session, e.Err)
+}
+
+func (e *SessionError) Unwrap() error { return e.Err }
+
+// stateError builds a StateError for the witness's rig.
+func (m *Manager) stateError(op string, current, requested State, err error) error {
+	return &StateError{Rig: m.rig.Name, Op: op, Current: current, Requested: requested, Err: err}
+}
+
+// liveState is the state to report for a witness whose session or loop is
+// alive: paused if it was paused, running otherwise (the state file may
+// lag behind a session started by hand).
+func liveState(w *Witness) State {
+	if w.State == StatePaused {
+		return StatePaused
+	}
+	return StateRunning
+}
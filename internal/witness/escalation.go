@@ -0,0 +1,185 @@
+package witness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// DefaultEscalationThreshold is how many consecutive unanswered nudges
+// escalate a polecat to the mayor, unless the rig configures its own.
+const DefaultEscalationThreshold = 3
+
+// DefaultEscalationCooldown is how long after escalating a polecat that is
+// still stuck the witness waits before escalating it again, unless the rig
+// configures its own. Without it the escalation would be redelivered with
+// every nudge.
+const DefaultEscalationCooldown = 30 * time.Minute
+
+// maxRecentNudges bounds the nudge history kept per polecat.
+const maxRecentNudges = 10
+
+// EscalationLimit returns the number of unanswered nudges that triggers an
+// escalation. Zero or negative values fall back to the default.
+func (c *WitnessConfig) EscalationLimit() int {
+	if c.EscalationThreshold <= 0 {
+		return DefaultEscalationThreshold
+	}
+	return c.EscalationThreshold
+}
+
+// Cooldown returns how long escalations of a polecat that stays stuck are
+// held back after one is delivered. Zero or negative values fall back to
+// the default.
+func (c *WitnessConfig) Cooldown() time.Duration {
+	if c.EscalationCooldown <= 0 {
+		return DefaultEscalationCooldown
+	}
+	return c.EscalationCooldown
+}
+
+// EscalationCooldownLeft returns how much longer further escalations of
+// the polecat are held back at now, or 0 if it may be escalated.
+func (ps PolecatStats) EscalationCooldownLeft(now time.Time, cooldown time.Duration) time.Duration {
+	if ps.LastEscalatedAt == nil {
+		return 0
+	}
+	if left := ps.LastEscalatedAt.Add(cooldown).Sub(now); left > 0 {
+		return left
+	}
+	return 0
+}
+
+// escalateIfDue escalates a polecat whose unanswered-nudge streak has reached
+// the threshold. Reaching it counts as one escalation; every later nudge in
+// the same streak escalates again, subject to the cooldown.
+func (m *Manager) escalateIfDue(w *Witness, ps *PolecatStats, pc *PolecatCheck, now time.Time) {
+	limit := w.Config.EscalationLimit()
+	if ps.ConsecutiveNudges < limit {
+		return
+	}
+	if ps.ConsecutiveNudges == limit {
+		ps.Escalations++
+		ps.Today.Escalations++
+		w.Stats.TotalEscalations++
+		w.Stats.TodayEscalations++
+		pc.Escalated = true
+	}
+	m.escalate(w, ps, pc, now)
+}
+
+// escalate keeps a polecat past the escalation threshold escalated: the
+// escalation is delivered, or redelivered to keep it current, unless the
+// cooldown since the last delivery is still running.
+func (m *Manager) escalate(w *Witness, ps *PolecatStats, pc *PolecatCheck, now time.Time) {
+	if left := ps.EscalationCooldownLeft(now, w.Config.Cooldown()); left > 0 {
+		ps.SuppressedEscalations++
+		pc.Reason += fmt.Sprintf("; escalated (cooldown %s remaining)", left.Round(time.Second))
+		return
+	}
+	e := &Escalation{
+		Rig:      m.rig.Name,
+		Polecat:  pc.Name,
+		Nudges:   ps.ConsecutiveNudges,
+		IdleFor:  pc.IdleFor,
+		LastSeen: ps.LastActiveAt,
+		Reason:   escalationReason(*ps, pc.IdleFor),
+		Bead:     ps.EscalationBead,
+	}
+	where, err := m.escalatorFor(&w.Config).Escalate(e)
+	ps.EscalationBead = e.Bead
+	if where != "" {
+		pc.Reason += "; escalated to " + where
+	}
+	if err != nil {
+		// A failed delivery is kept for gt witness status; monitoring
+		// carries on, retrying on the next check that escalates
+		pc.Error = err.Error()
+		w.recordDeliveryError(now, pc.Name, err)
+		return
+	}
+	ps.LastEscalatedAt = &now
+	w.DeliveryErrors = nil
+}
+
+// recordNudge notes a nudge sent at t in the polecat's history.
+func (ps *PolecatStats) recordNudge(t time.Time) {
+	ps.Nudges++
+	ps.Today.Nudges++
+	ps.ConsecutiveNudges++
+	ps.LastNudgeAt = &t
+	ps.RecentNudges = append(ps.RecentNudges, t)
+	if n := len(ps.RecentNudges); n > maxRecentNudges {
+		ps.RecentNudges = ps.RecentNudges[n-maxRecentNudges:]
+	}
+}
+
+// resetNudges clears the unanswered-nudge streak after real progress. The
+// escalation cooldown goes with it, so if the polecat gets stuck again the
+// new episode is escalated as soon as it reaches the threshold.
+func (ps *PolecatStats) resetNudges() {
+	ps.ConsecutiveNudges = 0
+	ps.RecentNudges = nil
+	ps.LastEscalatedAt = nil
+}
+
+// escalationTitle is the title of the escalation bead for a stuck polecat.
+func escalationTitle(rigName, polecat string) string {
+	return fmt.Sprintf("Polecat %s/%s stuck: not responding to witness nudges", rigName, polecat)
+}
+
+// escalationReason describes a stuck polecat and its nudge history on a
+// single line, as escalation bead fields are one per line.
+func escalationReason(ps PolecatStats, idleFor time.Duration) string {
+	times := make([]string, 0, len(ps.RecentNudges))
+	for _, t := range ps.RecentNudges {
+		times = append(times, t.Format("15:04"))
+	}
+	reason := fmt.Sprintf("no progress after %d consecutive nudges; idle %s",
+		ps.ConsecutiveNudges, idleFor.Round(time.Minute))
+	if len(times) > 0 {
+		reason += "; nudged at " + strings.Join(times, ", ")
+	}
+	return reason
+}
+
+// EscalationSource is the source recorded on the escalation beads a rig's
+// witness creates, so they can be found again among the town's escalations.
+func EscalationSource(rigName string) string {
+	return "witness:" + rigName
+}
+
+// Escalations lists the escalation beads this rig's witness has created,
+// newest first. With openOnly, closed (resolved) ones are left out.
+func (m *Manager) Escalations(openOnly bool) ([]*beads.Issue, error) {
+	townRoot := m.townRoot()
+	bd := beads.New(beads.ResolveHookDir(townRoot, "hq-", townRoot))
+	issues, err := bd.List(beads.ListOptions{Status: "all", Label: "gt:escalation", Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing escalations: %w", err)
+	}
+	return rigEscalations(issues, m.rig.Name, openOnly), nil
+}
+
+// rigEscalations picks the escalations rigName's witness created out of
+// the town's escalation beads, newest first.
+func rigEscalations(issues []*beads.Issue, rigName string, openOnly bool) []*beads.Issue {
+	source := EscalationSource(rigName)
+	var out []*beads.Issue
+	for _, issue := range issues {
+		if openOnly && issue.Status == "closed" {
+			continue
+		}
+		if beads.ParseEscalationFields(issue.Description).Source != source {
+			continue
+		}
+		out = append(out, issue)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].CreatedAt > out[j].CreatedAt
+	})
+	return out
+}
@@ -0,0 +1,127 @@
+package witness
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestWitnessConfig_EscalationLimit(t *testing.T) {
+	tests := []struct {
+		threshold int
+		want      int
+	}{
+		{0, DefaultEscalationThreshold},
+		{-2, DefaultEscalationThreshold},
+		{5, 5},
+	}
+	for _, tt := range tests {
+		cfg := WitnessConfig{EscalationThreshold: tt.threshold}
+		if got := cfg.EscalationLimit(); got != tt.want {
+			t.Errorf("EscalationLimit() with %d = %d, want %d", tt.threshold, got, tt.want)
+		}
+	}
+}
+
+func TestPolecatStats_RecordNudge(t *testing.T) {
+	var ps PolecatStats
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < maxRecentNudges+3; i++ {
+		ps.recordNudge(start.Add(time.Duration(i) * 30 * time.Minute))
+	}
+
+	if ps.Nudges != maxRecentNudges+3 || ps.ConsecutiveNudges != maxRecentNudges+3 {
+		t.Errorf("Nudges = %d, ConsecutiveNudges = %d, want %d", ps.Nudges, ps.ConsecutiveNudges, maxRecentNudges+3)
+	}
+	if len(ps.RecentNudges) != maxRecentNudges {
+		t.Errorf("len(RecentNudges) = %d, want %d", len(ps.RecentNudges), maxRecentNudges)
+	}
+	if last := ps.RecentNudges[len(ps.RecentNudges)-1]; !ps.LastNudgeAt.Equal(last) {
+		t.Errorf("LastNudgeAt = %v, want %v", ps.LastNudgeAt, last)
+	}
+
+	ps.resetNudges()
+	if ps.ConsecutiveNudges != 0 || len(ps.RecentNudges) != 0 {
+		t.Errorf("after reset: ConsecutiveNudges = %d, RecentNudges = %v", ps.ConsecutiveNudges, ps.RecentNudges)
+	}
+	if ps.Nudges != maxRecentNudges+3 {
+		t.Errorf("reset changed total Nudges to %d", ps.Nudges)
+	}
+}
+
+func TestEscalationReason(t *testing.T) {
+	var ps PolecatStats
+	ps.recordNudge(time.Date(2026, 1, 1, 14, 0, 0, 0, time.Local))
+	ps.recordNudge(time.Date(2026, 1, 1, 14, 30, 0, 0, time.Local))
+	ps.recordNudge(time.Date(2026, 1, 1, 15, 0, 0, 0, time.Local))
+
+	got := escalationReason(ps, 95*time.Minute)
+	for _, want := range []string{"3 consecutive nudges", "idle 1h35m0s", "14:00, 14:30, 15:00"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("escalationReason() = %q, missing %q", got, want)
+		}
+	}
+	if strings.Contains(got, "\n") {
+		t.Errorf("escalationReason() spans lines: %q", got)
+	}
+}
+
+func TestRigEscalations(t *testing.T) {
+	desc := func(source string) string {
+		return beads.FormatEscalationDescription("stuck", &beads.EscalationFields{Source: source})
+	}
+	issues := []*beads.Issue{
+		{ID: "hq-1", Status: "closed", CreatedAt: "2026-01-01T10:00:00Z", Description: desc("witness:greenplace")},
+		{ID: "hq-2", Status: "open", CreatedAt: "2026-01-02T10:00:00Z", Description: desc("witness:greenplace")},
+		{ID: "hq-3", Status: "open", CreatedAt: "2026-01-03T10:00:00Z", Description: desc("witness:otherrig")},
+		{ID: "hq-4", Status: "open", CreatedAt: "2026-01-04T10:00:00Z", Description: desc("plugin:greenplace")},
+	}
+
+	ids := func(list []*beads.Issue) string {
+		var out []string
+		for _, issue := range list {
+			out = append(out, issue.ID)
+		}
+		return strings.Join(out, ",")
+	}
+	if got := ids(rigEscalations(issues, "greenplace", false)); got != "hq-2,hq-1" {
+		t.Errorf("rigEscalations(all) = %s, want hq-2,hq-1", got)
+	}
+	if got := ids(rigEscalations(issues, "greenplace", true)); got != "hq-2" {
+		t.Errorf("rigEscalations(open only) = %s, want hq-2", got)
+	}
+}
+
+func TestPolecatStats_EscalationCooldownLeft(t *testing.T) {
+	now := time.Now()
+	var ps PolecatStats
+	if left := ps.EscalationCooldownLeft(now, time.Hour); left != 0 {
+		t.Errorf("never escalated: cooldown left = %v, want 0", left)
+	}
+
+	escalated := now.Add(-20 * time.Minute)
+	ps.LastEscalatedAt = &escalated
+	if left := ps.EscalationCooldownLeft(now, 30*time.Minute); left != 10*time.Minute {
+		t.Errorf("cooldown left = %v, want 10m", left)
+	}
+	if left := ps.EscalationCooldownLeft(now, 15*time.Minute); left != 0 {
+		t.Errorf("cooldown over: left = %v, want 0", left)
+	}
+
+	// Recovery ends the episode, and the cooldown with it
+	ps.resetNudges()
+	if ps.LastEscalatedAt != nil {
+		t.Error("resetNudges kept LastEscalatedAt")
+	}
+}
+
+func TestWitnessConfig_Cooldown(t *testing.T) {
+	if got := (&WitnessConfig{}).Cooldown(); got != DefaultEscalationCooldown {
+		t.Errorf("default Cooldown() = %v, want %v", got, DefaultEscalationCooldown)
+	}
+	if got := (&WitnessConfig{EscalationCooldown: time.Hour}).Cooldown(); got != time.Hour {
+		t.Errorf("Cooldown() = %v, want 1h", got)
+	}
+}
@@ -0,0 +1,248 @@
+package witness
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Escalation deliveries (WitnessConfig.EscalationDelivery).
+const (
+	DeliveryBeads   = "beads"
+	DeliveryWebhook = "webhook"
+	DeliveryBoth    = "both"
+)
+
+// webhookTimeout bounds a webhook POST so a slow endpoint can't hold up
+// the monitoring loop.
+const webhookTimeout = 10 * time.Second
+
+// maxDeliveryErrors bounds the escalation delivery errors kept in state.
+const maxDeliveryErrors = 10
+
+// Escalation describes a polecat that stayed stuck through the escalation
+// threshold of nudges.
+type Escalation struct {
+	Rig      string
+	Polecat  string
+	Nudges   int           // consecutive unanswered nudges
+	IdleFor  time.Duration // time since the polecat's last activity
+	LastSeen *time.Time    // when the polecat was last seen active, if ever
+	Reason   string        // one-line summary with the nudge history
+
+	// Bead is the escalation bead from the polecat's last escalation, if
+	// any. The bead escalator updates it while it is open and sets it to
+	// the bead it creates otherwise.
+	Bead string
+}
+
+// Escalator delivers an escalation. The monitoring loop goes through it
+// for every escalation, like nudges go through a Nudger. Escalate returns
+// a short description of where the escalation went, for the check log.
+type Escalator interface {
+	Escalate(e *Escalation) (string, error)
+}
+
+// DeliveryError records an escalation that couldn't be delivered.
+type DeliveryError struct {
+	At      time.Time `json:"at"`
+	Polecat string    `json:"polecat"`
+	Error   string    `json:"error"`
+}
+
+// SetEscalator makes the monitoring loop deliver escalations through e
+// instead of the escalators the rig's config selects. A nil e restores
+// the configured ones.
+func (m *Manager) SetEscalator(e Escalator) {
+	m.escalator = e
+}
+
+// escalatorFor returns the escalator for cfg: the one set with
+// SetEscalator, else beads, the webhook or both, per EscalationDelivery.
+func (m *Manager) escalatorFor(cfg *WitnessConfig) Escalator {
+	if m.escalator != nil {
+		return m.escalator
+	}
+	beadEsc := NewBeadEscalator(m.townRoot())
+	switch cfg.Delivery() {
+	case DeliveryWebhook:
+		return NewWebhookEscalator(cfg.WebhookURL)
+	case DeliveryBoth:
+		return multiEscalator{beadEsc, NewWebhookEscalator(cfg.WebhookURL)}
+	}
+	return beadEsc
+}
+
+// Delivery returns how escalations are delivered: as configured, or
+// beads plus the webhook when only a webhook URL is set.
+func (c *WitnessConfig) Delivery() string {
+	if c.EscalationDelivery != "" {
+		return c.EscalationDelivery
+	}
+	if c.WebhookURL != "" {
+		return DeliveryBoth
+	}
+	return DeliveryBeads
+}
+
+// validateDelivery checks the escalation delivery and webhook URL.
+func (c *WitnessConfig) validateDelivery() error {
+	switch c.EscalationDelivery {
+	case "", DeliveryBeads, DeliveryWebhook, DeliveryBoth:
+	default:
+		return fmt.Errorf("invalid escalation delivery %q: want %s, %s or %s",
+			c.EscalationDelivery, DeliveryBeads, DeliveryWebhook, DeliveryBoth)
+	}
+	if c.WebhookURL != "" {
+		u, err := url.Parse(c.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook URL %q: want an http or https URL", c.WebhookURL)
+		}
+	} else if d := c.Delivery(); d == DeliveryWebhook || d == DeliveryBoth {
+		return fmt.Errorf("escalation delivery %s needs a webhook URL", d)
+	}
+	return nil
+}
+
+// recordDeliveryError keeps the latest escalation delivery errors.
+func (w *Witness) recordDeliveryError(at time.Time, polecat string, err error) {
+	w.DeliveryErrors = append(w.DeliveryErrors, DeliveryError{At: at, Polecat: polecat, Error: err.Error()})
+	if n := len(w.DeliveryErrors); n > maxDeliveryErrors {
+		w.DeliveryErrors = w.DeliveryErrors[n-maxDeliveryErrors:]
+	}
+}
+
+// BeadEscalator escalates to the mayor in town-level escalation beads. It
+// is the default Escalator.
+type BeadEscalator struct {
+	townRoot string
+}
+
+// NewBeadEscalator returns an Escalator writing to the beads of townRoot.
+func NewBeadEscalator(townRoot string) *BeadEscalator {
+	return &BeadEscalator{townRoot: townRoot}
+}
+
+// Escalate records the stuck polecat in an escalation bead. The first
+// escalation creates the bead; later ones update it while it is still
+// open, so a polecat that stays stuck doesn't spam new beads.
+func (b *BeadEscalator) Escalate(e *Escalation) (string, error) {
+	actor := fmt.Sprintf("%s/witness", e.Rig)
+	now := time.Now().Format(time.RFC3339)
+
+	if e.Bead != "" {
+		bd := beads.New(beads.ResolveHookDir(b.townRoot, e.Bead, b.townRoot))
+		issue, fields, err := bd.GetEscalationBead(e.Bead)
+		if err == nil && issue != nil && issue.Status != "closed" {
+			fields.Reason = e.Reason
+			fields.ReescalationCount++
+			fields.LastReescalatedAt = now
+			fields.LastReescalatedBy = actor
+			description := beads.FormatEscalationDescription(issue.Title, fields)
+			if err := bd.Update(issue.ID, beads.UpdateOptions{Description: &description}); err != nil {
+				return "", fmt.Errorf("updating escalation %s: %w", issue.ID, err)
+			}
+			return fmt.Sprintf("mayor (%s)", issue.ID), nil
+		}
+		// Closed or gone: the mayor dealt with the last one, open a new one
+	}
+
+	bd := beads.New(beads.ResolveHookDir(b.townRoot, "hq-", b.townRoot))
+	issue, err := bd.CreateEscalationBead(escalationTitle(e.Rig, e.Polecat), &beads.EscalationFields{
+		Severity:    config.SeverityMedium,
+		Reason:      e.Reason,
+		Source:      EscalationSource(e.Rig),
+		EscalatedBy: actor,
+		EscalatedAt: now,
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating escalation: %w", err)
+	}
+	e.Bead = issue.ID
+	return fmt.Sprintf("mayor (%s)", issue.ID), nil
+}
+
+// WebhookPayload is the JSON body a WebhookEscalator POSTs. Text makes it
+// a valid Slack incoming webhook message as it is.
+type WebhookPayload struct {
+	Text     string     `json:"text"`
+	Rig      string     `json:"rig"`
+	Polecat  string     `json:"polecat"`
+	Nudges   int        `json:"nudges"`
+	IdleFor  string     `json:"idle_for"`
+	LastSeen *time.Time `json:"last_seen,omitempty"`
+	Reason   string     `json:"reason"`
+}
+
+// WebhookEscalator escalates by POSTing a WebhookPayload to a URL, such as
+// a Slack incoming webhook.
+type WebhookEscalator struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewWebhookEscalator returns an Escalator posting to url.
+func NewWebhookEscalator(url string) *WebhookEscalator {
+	return &WebhookEscalator{URL: url, Client: &http.Client{Timeout: webhookTimeout}}
+}
+
+// Escalate POSTs the escalation. Any status other than 2xx is an error.
+func (h *WebhookEscalator) Escalate(e *Escalation) (string, error) {
+	body, err := json.Marshal(WebhookPayload{
+		Text:     escalationTitle(e.Rig, e.Polecat) + ": " + e.Reason,
+		Rig:      e.Rig,
+		Polecat:  e.Polecat,
+		Nudges:   e.Nudges,
+		IdleFor:  e.IdleFor.Round(time.Second).String(),
+		LastSeen: e.LastSeen,
+		Reason:   e.Reason,
+	})
+	if err != nil {
+		return "", err
+	}
+	resp, err := h.Client.Post(h.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("webhook: %s returned %s", redactURL(h.URL), resp.Status)
+	}
+	return "webhook", nil
+}
+
+// redactURL drops the path of a webhook URL, which for Slack and most
+// chat services is the secret, so errors can be logged and stored.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "webhook URL"
+	}
+	return u.Scheme + "://" + u.Host + "/..."
+}
+
+// multiEscalator delivers each escalation through all of its escalators,
+// even when one fails.
+type multiEscalator []Escalator
+
+func (m multiEscalator) Escalate(e *Escalation) (string, error) {
+	var delivered []string
+	var errs []error
+	for _, esc := range m {
+		where, err := esc.Escalate(e)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delivered = append(delivered, where)
+	}
+	return strings.Join(delivered, ", "), errors.Join(errs...)
+}
@@ -0,0 +1,203 @@
+package witness
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeEscalator records escalations instead of delivering them.
+type fakeEscalator struct {
+	escalated []Escalation
+	err       error
+}
+
+func (f *fakeEscalator) Escalate(e *Escalation) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	f.escalated = append(f.escalated, *e)
+	return "fake", nil
+}
+
+func TestWebhookEscalator_PostsPayload(t *testing.T) {
+	var got WebhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding payload: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	seen := time.Date(2026, 1, 5, 9, 30, 0, 0, time.UTC)
+	where, err := NewWebhookEscalator(srv.URL).Escalate(&Escalation{
+		Rig: "greenplace", Polecat: "toast", Nudges: 3, IdleFor: 25 * time.Minute,
+		LastSeen: &seen, Reason: "no progress after 3 consecutive nudges",
+	})
+	if err != nil {
+		t.Fatalf("Escalate: %v", err)
+	}
+	if where != "webhook" {
+		t.Errorf("where = %q, want webhook", where)
+	}
+	if got.Rig != "greenplace" || got.Polecat != "toast" || got.Nudges != 3 || got.IdleFor != "25m0s" {
+		t.Errorf("payload = %+v", got)
+	}
+	if got.LastSeen == nil || !got.LastSeen.Equal(seen) {
+		t.Errorf("last_seen = %v, want %v", got.LastSeen, seen)
+	}
+	if !strings.Contains(got.Text, "greenplace/toast") {
+		t.Errorf("text = %q, want the polecat named", got.Text)
+	}
+}
+
+func TestWebhookEscalator_ErrorStatusRedactsURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	_, err := NewWebhookEscalator(srv.URL + "/services/SECRET").Escalate(&Escalation{Rig: "r", Polecat: "p"})
+	if err == nil {
+		t.Fatal("Escalate succeeded, want an error for a 500")
+	}
+	if strings.Contains(err.Error(), "SECRET") || !strings.Contains(err.Error(), "500") {
+		t.Errorf("error = %q, want the status without the URL path", err)
+	}
+}
+
+func TestMultiEscalator_DeliversDespiteFailure(t *testing.T) {
+	ok := &fakeEscalator{}
+	multi := multiEscalator{&fakeEscalator{err: errors.New("down")}, ok}
+
+	where, err := multi.Escalate(&Escalation{Polecat: "toast"})
+	if err == nil || err.Error() != "down" {
+		t.Errorf("err = %v, want down", err)
+	}
+	if where != "fake" || len(ok.escalated) != 1 {
+		t.Errorf("where = %q, escalated = %v; want the second escalator to deliver", where, ok.escalated)
+	}
+}
+
+func TestWitnessConfig_Delivery(t *testing.T) {
+	tests := []struct {
+		cfg     WitnessConfig
+		want    string
+		wantErr bool
+	}{
+		{WitnessConfig{}, DeliveryBeads, false},
+		{WitnessConfig{WebhookURL: "https://hooks.example.com/x"}, DeliveryBoth, false},
+		{WitnessConfig{WebhookURL: "https://hooks.example.com/x", EscalationDelivery: DeliveryWebhook}, DeliveryWebhook, false},
+		{WitnessConfig{EscalationDelivery: DeliveryWebhook}, DeliveryWebhook, true},
+		{WitnessConfig{WebhookURL: "ftp://example.com"}, DeliveryBoth, true},
+		{WitnessConfig{EscalationDelivery: "pager"}, "pager", true},
+	}
+	for _, tt := range tests {
+		if got := tt.cfg.Delivery(); got != tt.want {
+			t.Errorf("%+v: Delivery() = %q, want %q", tt.cfg, got, tt.want)
+		}
+		if err := tt.cfg.validateDelivery(); (err != nil) != tt.wantErr {
+			t.Errorf("%+v: validateDelivery() = %v, wantErr %v", tt.cfg, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCheck_EscalatesThroughEscalator(t *testing.T) {
+	esc := &fakeEscalator{}
+	mgr := stuckPolecatManager(t, &fakeNudger{})
+	mgr.SetEscalator(esc)
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) { c.EscalationThreshold = 1 }); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	pc := result.Polecats[0]
+	if !pc.Escalated || !strings.Contains(pc.Reason, "escalated to fake") {
+		t.Errorf("check = %+v, want an escalation", pc)
+	}
+	if len(esc.escalated) != 1 || esc.escalated[0].Polecat != "toast" || esc.escalated[0].Nudges != 1 {
+		t.Errorf("escalated = %+v, want toast after one nudge", esc.escalated)
+	}
+}
+
+func TestCheck_EscalationCooldown(t *testing.T) {
+	esc := &fakeEscalator{}
+	mgr := stuckPolecatManager(t, &fakeNudger{})
+	mgr.SetEscalator(esc)
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) {
+		c.EscalationThreshold = 1
+		c.MinNudgeInterval = time.Nanosecond
+	}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := mgr.Check(); err != nil {
+			t.Fatalf("Check %d: %v", i+1, err)
+		}
+	}
+	if len(esc.escalated) != 1 {
+		t.Errorf("escalated %d times, want once within the cooldown", len(esc.escalated))
+	}
+	w, _ := mgr.Status()
+	ps := w.Stats.PerPolecat["toast"]
+	if ps.SuppressedEscalations != 1 || ps.LastEscalatedAt == nil {
+		t.Errorf("stats = %+v, want one escalation held by the cooldown", ps)
+	}
+	if la := ps.LastAction; la == nil || !strings.Contains(la.Reason, "cooldown") {
+		t.Errorf("last action = %+v, want the cooldown noted", la)
+	}
+
+	// Past the cooldown the escalation is delivered again
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) { c.EscalationCooldown = time.Nanosecond }); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+	if _, err := mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(esc.escalated) != 2 {
+		t.Errorf("escalated %d times, want a second escalation after the cooldown", len(esc.escalated))
+	}
+}
+
+func TestCheck_EscalationFailureRecorded(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	mgr := stuckPolecatManager(t, &fakeNudger{})
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) {
+		c.EscalationThreshold = 1
+		c.WebhookURL = srv.URL
+		c.EscalationDelivery = DeliveryWebhook
+	}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	pc := result.Polecats[0]
+	if pc.Action != ActionNudged || !strings.Contains(pc.Error, "502") {
+		t.Errorf("check = %+v, want a nudge with the webhook error", pc)
+	}
+	w, _ := mgr.Status()
+	if len(w.DeliveryErrors) != 1 || w.DeliveryErrors[0].Polecat != "toast" {
+		t.Errorf("DeliveryErrors = %+v, want one for toast", w.DeliveryErrors)
+	}
+	if w.Stats.TotalEscalations != 1 {
+		t.Errorf("TotalEscalations = %d, want 1", w.Stats.TotalEscalations)
+	}
+}
@@ -0,0 +1,261 @@
+package witness
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// EventSchemaVersion is the version of the Event format. It goes up when a
+// field changes meaning or is removed; new fields and event types don't
+// change it, so readers should ignore what they don't know.
+const EventSchemaVersion = 1
+
+// EventType names what happened in an Event.
+type EventType string
+
+const (
+	// EventCheckStarted is emitted as a monitoring pass begins.
+	EventCheckStarted EventType = "check-started"
+
+	// EventPolecatStateChanged is emitted when a check classifies a
+	// polecat differently than the last one (From is empty the first
+	// time a polecat is seen).
+	EventPolecatStateChanged EventType = "polecat-state-changed"
+
+	// EventNudged is emitted when a stuck polecat was nudged.
+	EventNudged EventType = "nudged"
+
+	// EventEscalated is emitted when a polecat reached the escalation
+	// threshold and was escalated.
+	EventEscalated EventType = "escalated"
+
+	// EventPaused and EventResumed are emitted when the loop notices the
+	// witness was paused or resumed (gt witness pause/resume).
+	EventPaused  EventType = "paused"
+	EventResumed EventType = "resumed"
+
+	// EventDropped reports events lost because the reader fell behind;
+	// Dropped says how many.
+	EventDropped EventType = "events-dropped"
+)
+
+// Event is one line of the witness event stream, written as JSON.
+type Event struct {
+	// Version is EventSchemaVersion.
+	Version int       `json:"v"`
+	Type    EventType `json:"type"`
+	Time    time.Time `json:"ts"`
+	Rig     string    `json:"rig,omitempty"`
+
+	// Polecat is the polecat the event is about, if any.
+	Polecat string `json:"polecat,omitempty"`
+
+	// From and State are the previous and new state of a
+	// polecat-state-changed event, and the polecat's state otherwise.
+	From  PolecatState `json:"from,omitempty"`
+	State PolecatState `json:"state,omitempty"`
+
+	// Reason says why the loop acted, as in the check log.
+	Reason string `json:"reason,omitempty"`
+
+	// Nudges counts the nudges since the polecat last made progress, for
+	// nudged and escalated events.
+	Nudges int `json:"nudges,omitempty"`
+
+	// Dropped is the number of events lost, for events-dropped.
+	Dropped int64 `json:"dropped,omitempty"`
+}
+
+// eventQueueSize bounds the events waiting for a slow reader; further
+// events are dropped rather than holding up the monitoring loop.
+const eventQueueSize = 256
+
+// eventCloseTimeout is how long Close waits for queued events to be
+// written, e.g. to a FIFO nobody is reading.
+const eventCloseTimeout = 2 * time.Second
+
+// EventSink appends witness events as JSON lines to a file or FIFO for
+// external tools to tail. Emit never blocks: events go through a bounded
+// queue to a writer goroutine, and are dropped (and counted in a later
+// events-dropped event) when the queue is full. A FIFO is opened, and
+// reopened after its reader goes away, by the writer goroutine, so the
+// loop keeps running while nobody is reading.
+type EventSink struct {
+	path    string
+	queue   chan []byte
+	done    chan struct{}
+	dropped atomic.Int64
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewEventSink starts writing events to path. A regular file is created
+// if missing and appended to; an existing FIFO is written to as is.
+func NewEventSink(path string) (*EventSink, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return nil, fmt.Errorf("event stream %s is a directory", path)
+	}
+	s := &EventSink{
+		path:  path,
+		queue: make(chan []byte, eventQueueSize),
+		done:  make(chan struct{}),
+	}
+	if !s.isFIFO() {
+		// Surface a bad path now rather than losing every event later
+		f, err := s.open()
+		if err != nil {
+			return nil, fmt.Errorf("opening event stream: %w", err)
+		}
+		_ = f.Close()
+	}
+	go s.write()
+	return s, nil
+}
+
+// Path returns the path events are written to.
+func (s *EventSink) Path() string {
+	return s.path
+}
+
+// Emit queues e for writing, filling in the version and time. It drops
+// the event if the queue is full or the sink is closed. A nil sink
+// ignores every event.
+func (s *EventSink) Emit(e Event) {
+	if s == nil {
+		return
+	}
+	e.Version = EventSchemaVersion
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.queue <- line:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Dropped returns how many events have been dropped so far.
+func (s *EventSink) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Close stops accepting events and waits briefly for the queued ones to
+// be written.
+func (s *EventSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+	case <-time.After(eventCloseTimeout):
+	}
+	return nil
+}
+
+func (s *EventSink) isFIFO() bool {
+	info, err := os.Stat(s.path)
+	return err == nil && info.Mode()&os.ModeNamedPipe != 0
+}
+
+func (s *EventSink) open() (*os.File, error) {
+	return os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) //nolint:gosec // G304: path is from the command line
+}
+
+// write is the writer goroutine. A failed write, e.g. a FIFO whose reader
+// went away, closes the file so the next event reopens it.
+func (s *EventSink) write() {
+	defer close(s.done)
+	var f *os.File
+	defer func() {
+		if f != nil {
+			_ = f.Close()
+		}
+	}()
+
+	var reported int64
+	for line := range s.queue {
+		if f == nil {
+			var err error
+			if f, err = s.open(); err != nil {
+				f = nil
+				s.dropped.Add(1)
+				continue
+			}
+		}
+		if dropped := s.dropped.Load(); dropped > reported {
+			note, _ := json.Marshal(Event{Version: EventSchemaVersion, Type: EventDropped, Time: time.Now(), Dropped: dropped - reported})
+			line = append(append(note, '\n'), line...)
+			reported = dropped
+		}
+		if _, err := f.Write(line); err != nil {
+			_ = f.Close()
+			f = nil
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// SetEventSink makes the monitoring loop emit events to s (nil: none).
+// One sink may be shared by the managers of a witness group.
+func (m *Manager) SetEventSink(s *EventSink) {
+	m.events = s
+}
+
+// emit sends e to the event sink, if any, tagged with the rig.
+func (m *Manager) emit(e Event) {
+	if m.events == nil {
+		return
+	}
+	e.Rig = m.rig.Name
+	m.events.Emit(e)
+}
+
+// notePauseState emits paused or resumed when the witness state moved
+// into or out of StatePaused since the loop last looked. The first call
+// only records the state.
+func (m *Manager) notePauseState(state State, now time.Time) {
+	if m.events == nil {
+		return
+	}
+	prev := m.lastState
+	m.lastState = state
+	switch {
+	case prev == "" || prev == state:
+	case state == StatePaused:
+		m.emit(Event{Type: EventPaused, Time: now})
+	case prev == StatePaused && state == StateRunning:
+		m.emit(Event{Type: EventResumed, Time: now})
+	}
+}
+
+// pollPauseState reloads the witness state between checks, so pauses
+// show up in the event stream without waiting for the next pass.
+func (m *Manager) pollPauseState() {
+	if m.events == nil {
+		return
+	}
+	if w, err := m.loadState(); err == nil {
+		m.notePauseState(w.State, time.Now())
+	}
+}
@@ -0,0 +1,111 @@
+package witness
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// readEvents parses the JSON lines of an event stream file.
+func readEvents(t *testing.T, path string) []Event {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("parsing event %q: %v", scanner.Text(), err)
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestEventSink_WritesJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	s, err := NewEventSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	s.Emit(Event{Type: EventCheckStarted, Time: at, Rig: "greenplace"})
+	s.Emit(Event{Type: EventNudged, Rig: "greenplace", Polecat: "Toast", Nudges: 2})
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s.Emit(Event{Type: EventNudged}) // after Close: dropped, no panic
+
+	events := readEvents(t, path)
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(events), events)
+	}
+	if e := events[0]; e.Version != EventSchemaVersion || e.Type != EventCheckStarted || !e.Time.Equal(at) {
+		t.Errorf("first event = %+v", e)
+	}
+	if e := events[1]; e.Polecat != "Toast" || e.Nudges != 2 || e.Time.IsZero() {
+		t.Errorf("second event = %+v", e)
+	}
+}
+
+func TestEventSink_EmitNeverBlocks(t *testing.T) {
+	// No writer goroutine: the queue fills and stays full
+	s := &EventSink{queue: make(chan []byte, 1), done: make(chan struct{})}
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 3; i++ {
+			s.Emit(Event{Type: EventCheckStarted})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Emit blocked on a full queue")
+	}
+	if got := s.Dropped(); got != 2 {
+		t.Errorf("Dropped() = %d, want 2", got)
+	}
+
+	var nilSink *EventSink
+	nilSink.Emit(Event{Type: EventCheckStarted})
+}
+
+func TestEventSink_RejectsDirectory(t *testing.T) {
+	if _, err := NewEventSink(t.TempDir()); err == nil {
+		t.Error("expected an error for a directory")
+	}
+}
+
+func TestNotePauseState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	s, err := NewEventSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mgr := NewManager(&rig.Rig{Name: "greenplace", Path: t.TempDir()})
+	mgr.SetEventSink(s)
+
+	now := time.Now()
+	for _, state := range []State{StateRunning, StateRunning, StatePaused, StatePaused, StateRunning} {
+		mgr.notePauseState(state, now)
+	}
+	_ = s.Close()
+
+	events := readEvents(t, path)
+	if len(events) != 2 || events[0].Type != EventPaused || events[1].Type != EventResumed {
+		t.Fatalf("events = %+v, want paused then resumed", events)
+	}
+	if events[0].Rig != "greenplace" {
+		t.Errorf("rig = %q, want greenplace", events[0].Rig)
+	}
+}
@@ -0,0 +1,301 @@
+package witness
+
+import (
+	"fmt"
+	"time"
+)
+
+// Signal is one input to a polecat's classification and whether it fired.
+type Signal struct {
+	Name   string `json:"name"`
+	Fired  bool   `json:"fired"`
+	Detail string `json:"detail"`
+}
+
+// Explanation is the reasoning behind a polecat's current classification:
+// the raw activity signals, the thresholds they were compared against,
+// and what the next monitoring pass would do about it.
+type Explanation struct {
+	Polecat         string        `json:"polecat"`
+	Session         string        `json:"session"`
+	State           PolecatState  `json:"state"`
+	LastActivity    time.Time     `json:"last_activity,omitempty"`
+	IdleFor         time.Duration `json:"idle_for,omitempty"`
+	IdleThreshold   time.Duration `json:"idle_threshold"`
+	StuckThreshold  time.Duration `json:"stuck_threshold"`
+	NudgeInterval   time.Duration `json:"min_nudge_interval"`
+	PaneHash        string        `json:"pane_hash,omitempty"`
+	PaneStableSince time.Time     `json:"pane_stable_since,omitempty"`
+	Quiet           string        `json:"quiet,omitempty"`
+	Signals         []Signal      `json:"signals"`
+	Action          string        `json:"action"`
+	Escalates       bool          `json:"escalates,omitempty"`
+}
+
+// Explain classifies a single polecat the same way a monitoring pass would,
+// without nudging or updating state, and reports which signals drove it.
+func (m *Manager) Explain(polecat string) (*Explanation, error) {
+	if !m.hasPolecat(polecat) {
+		return nil, fmt.Errorf("polecat %q not found in rig %s", polecat, m.rig.Name)
+	}
+
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	idle, stuck := w.Config.Thresholds()
+	prev := w.PaneSamples[polecat]
+	t := m.tmux
+	sessions, err := t.ListSessionInfo()
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+	pc, sample := m.classify(t, sessions, polecat, now, prev, idle, stuck, m.panePatterns(&w.Config))
+
+	ps := w.Stats.PerPolecat[polecat]
+	ps.noteSessionStart(t, pc, w.Config.StartupGrace, now)
+	e := explain(pc, prev, sample, w.Config.QuietReason(now), idle, stuck)
+	explainPaused(e, w.State == StatePaused)
+	explainNudgeInterval(e, ps, w.Config.NudgeInterval(), now)
+	explainDryRun(e, w.DryRun)
+	explainNudgeBudget(e, w, ps, now)
+	explainWarmup(e, ps, w.Config.StartupGrace, now)
+	explainNudgeLists(e, &w.Config)
+	explainEscalation(e, ps, w.Config.EscalationLimit())
+	return e, nil
+}
+
+// hasPolecat reports whether name is one of the rig's polecats, either
+// configured or discovered from its tmux session.
+func (m *Manager) hasPolecat(name string) bool {
+	polecats := m.rig.Polecats
+	if discovered, err := m.discoverPolecats(); err == nil {
+		polecats = append(append([]string(nil), polecats...), discovered...)
+	}
+	for _, p := range polecats {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// explain builds the explanation for a classified polecat. prev is the pane
+// sample stored by the last pass and sample the one taken just now.
+func explain(pc PolecatCheck, prev, sample PaneSample, quiet string, idle, stuck time.Duration) *Explanation {
+	e := &Explanation{
+		Polecat:        pc.Name,
+		Session:        pc.Session,
+		State:          pc.State,
+		LastActivity:   pc.LastActivity,
+		IdleFor:        pc.IdleFor,
+		IdleThreshold:  idle,
+		StuckThreshold: stuck,
+		PaneHash:       sample.Hash,
+		Quiet:          quiet,
+		Action:         ActionNone,
+	}
+
+	gone := pc.State == PolecatGone
+	e.Signals = append(e.Signals, Signal{
+		Name:   "session missing",
+		Fired:  gone,
+		Detail: fmt.Sprintf("tmux session %s", pc.Session),
+	})
+	if gone {
+		return e
+	}
+
+	if pc.LastActivity.IsZero() {
+		e.Signals = append(e.Signals, Signal{
+			Name:   "no activity data",
+			Fired:  true,
+			Detail: "tmux reported no activity time and the pane has no history; assuming active",
+		})
+	} else {
+		idleFor := pc.IdleFor.Round(time.Second)
+		e.Signals = append(e.Signals,
+			Signal{
+				Name:   "idle threshold",
+				Fired:  pc.IdleFor >= idle,
+				Detail: fmt.Sprintf("idle %s, threshold %s", idleFor, idle),
+			},
+			Signal{
+				Name:   "stuck threshold",
+				Fired:  pc.IdleFor >= stuck,
+				Detail: fmt.Sprintf("idle %s, threshold %s", idleFor, stuck),
+			},
+		)
+	}
+
+	switch {
+	case sample.Hash == "":
+		e.Signals = append(e.Signals, Signal{
+			Name:   "pane unchanged",
+			Detail: "pane could not be captured",
+		})
+	case prev.Hash == "":
+		e.Signals = append(e.Signals, Signal{
+			Name:   "pane unchanged",
+			Detail: "no earlier sample to compare; the next pass will establish a baseline",
+		})
+	case sample.Hash == prev.Hash:
+		detail := "pane identical to the last sample"
+		if !sample.ChangedAt.IsZero() {
+			e.PaneStableSince = sample.ChangedAt
+			detail = fmt.Sprintf("pane unchanged since %s", sample.ChangedAt.Format("15:04:05"))
+		}
+		e.Signals = append(e.Signals, Signal{Name: "pane unchanged", Fired: true, Detail: detail})
+	default:
+		e.Signals = append(e.Signals, Signal{
+			Name:   "pane unchanged",
+			Detail: "pane content changed since the last sample",
+		})
+	}
+
+	if pc.LastActivity.IsZero() || pc.IdleFor >= idle {
+		detail := "pane shows no prompt, tool call or error"
+		if pc.PaneMatch != "" {
+			detail = fmt.Sprintf("pane shows %q: %s, not nudged", pc.PaneMatch, pc.State)
+		}
+		e.Signals = append(e.Signals, Signal{Name: "pane pattern", Fired: pc.PaneMatch != "", Detail: detail})
+	}
+
+	e.Signals = append(e.Signals, Signal{
+		Name:   "quiet period",
+		Fired:  quiet != "",
+		Detail: quietDetail(quiet),
+	})
+
+	if pc.State == PolecatStuck {
+		if quiet != "" {
+			e.Action = ActionSuppressed
+		} else {
+			e.Action = ActionNudged
+		}
+	}
+	return e
+}
+
+// explainPaused adds the paused signal: a paused witness suppresses nudges
+// the way a quiet period does.
+func explainPaused(e *Explanation, paused bool) {
+	if e.State == PolecatGone {
+		return
+	}
+	detail := "witness is running"
+	if paused {
+		detail = "witness is paused; nudges and escalations suppressed"
+	}
+	e.Signals = append(e.Signals, Signal{Name: "paused", Fired: paused, Detail: detail})
+	if paused {
+		e.Quiet = "paused"
+		if e.Action == ActionNudged {
+			e.Action = ActionSuppressed
+		}
+	}
+}
+
+// explainNudgeInterval adds the minimum-nudge-interval signal, holding back
+// a nudge the interval would block.
+func explainNudgeInterval(e *Explanation, ps PolecatStats, interval time.Duration, now time.Time) {
+	e.NudgeInterval = interval
+	if e.State == PolecatGone {
+		return
+	}
+
+	wait := ps.nudgeWait(now, interval)
+	detail := fmt.Sprintf("not nudged yet; minimum interval %s", interval)
+	if ps.LastNudgeAt != nil {
+		detail = fmt.Sprintf("last nudged %s ago; minimum interval %s", now.Sub(*ps.LastNudgeAt).Round(time.Second), interval)
+	}
+	e.Signals = append(e.Signals, Signal{Name: "nudge interval", Fired: wait > 0, Detail: detail})
+	if wait > 0 && e.Action == ActionNudged {
+		e.Action = ActionHeld
+	}
+}
+
+// explainDryRun adds the dry-run signal when the witness runs with
+// --dry-run, turning a nudge into one that is only reported.
+func explainDryRun(e *Explanation, dryRun bool) {
+	if !dryRun || e.State == PolecatGone {
+		return
+	}
+	e.Signals = append(e.Signals, Signal{Name: "dry run", Fired: true, Detail: "nudges are logged, not sent"})
+	if e.Action == ActionNudged {
+		e.Action = ActionWouldNudge
+	}
+}
+
+// explainNudgeBudget adds the rig nudge budget signal when a budget is set,
+// holding back a nudge the exhausted budget would block.
+func explainNudgeBudget(e *Explanation, w *Witness, ps PolecatStats, now time.Time) {
+	if w.Config.RigNudgeBudget <= 0 || e.State == PolecatGone {
+		return
+	}
+	over, wait := w.NudgeBudgetExhausted(now)
+	detail := fmt.Sprintf("%d of %d nudges used in the last hour", len(w.recentRigNudges(now)), w.Config.RigNudgeBudget)
+	if over {
+		detail = fmt.Sprintf("rig nudge budget (%d per hour) exhausted, resets in %s", w.Config.RigNudgeBudget, wait.Round(time.Second))
+	}
+	e.Signals = append(e.Signals, Signal{Name: "nudge budget", Fired: over, Detail: detail})
+	if !over || e.Action != ActionNudged {
+		return
+	}
+	e.Action = ActionOverBudget
+}
+
+// explainWarmup adds the startup grace signal when a grace is set. A
+// polecat still warming up is neither nudged nor escalated, quiet period
+// or not.
+func explainWarmup(e *Explanation, ps PolecatStats, grace time.Duration, now time.Time) {
+	if grace <= 0 || e.State == PolecatGone {
+		return
+	}
+	left := ps.WarmupLeft(now, grace)
+	detail := fmt.Sprintf("session older than the %s startup grace", grace)
+	if left > 0 {
+		detail = fmt.Sprintf("warming up (%s of the %s startup grace left)", left.Round(time.Second), grace)
+	}
+	e.Signals = append(e.Signals, Signal{Name: "startup grace", Fired: left > 0, Detail: detail})
+	if left > 0 && e.Action != ActionNone {
+		e.Action = ActionWarmingUp
+	}
+}
+
+// explainEscalation notes whether the nudge the next pass sends, or holds
+// back for the budget, brings the polecat to the escalation threshold.
+func explainEscalation(e *Explanation, ps PolecatStats, limit int) {
+	if e.Action != ActionNudged && e.Action != ActionOverBudget {
+		return
+	}
+	e.Escalates = ps.ConsecutiveNudges+1 >= limit
+}
+
+// explainNudgeLists adds the nudge allowlist/denylist signal when either
+// list is set. An observe-only polecat is never nudged, whatever else
+// applies.
+func explainNudgeLists(e *Explanation, c *WitnessConfig) {
+	if len(c.NudgeAllowlist) == 0 && len(c.NudgeDenylist) == 0 {
+		return
+	}
+	observe := c.ObserveOnly(e.Polecat)
+	detail := "allowed by the nudge allowlist and denylist"
+	if observe {
+		detail = c.observeOnlyReason(e.Polecat)
+	}
+	e.Signals = append(e.Signals, Signal{Name: "nudge lists", Fired: observe, Detail: detail})
+	if observe && e.Action != ActionNone {
+		e.Action = ActionObserved
+	}
+}
+
+// quietDetail describes the quiet-period signal.
+func quietDetail(reason string) string {
+	if reason == "" {
+		return "no quiet period in effect"
+	}
+	return fmt.Sprintf("nudges suppressed (%s)", reason)
+}
@@ -0,0 +1,220 @@
+package witness
+
+import (
+	"testing"
+	"time"
+)
+
+func firedSignals(e *Explanation) map[string]bool {
+	fired := make(map[string]bool)
+	for _, s := range e.Signals {
+		if s.Fired {
+			fired[s.Name] = true
+		}
+	}
+	return fired
+}
+
+func TestExplain_Stuck(t *testing.T) {
+	changed := time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)
+	sample := PaneSample{Hash: "abc", ChangedAt: changed}
+	pc := PolecatCheck{
+		Name:         "toast",
+		Session:      "gt-rig-toast",
+		State:        PolecatStuck,
+		LastActivity: changed,
+		IdleFor:      45 * time.Minute,
+	}
+
+	e := explain(pc, sample, sample, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	fired := firedSignals(e)
+	for _, name := range []string{"idle threshold", "stuck threshold", "pane unchanged"} {
+		if !fired[name] {
+			t.Errorf("signal %q did not fire", name)
+		}
+	}
+	if fired["session missing"] || fired["quiet period"] {
+		t.Errorf("unexpected signals fired: %v", fired)
+	}
+	if !e.PaneStableSince.Equal(changed) {
+		t.Errorf("PaneStableSince = %v, want %v", e.PaneStableSince, changed)
+	}
+	if e.Action != ActionNudged {
+		t.Errorf("Action = %q, want %q", e.Action, ActionNudged)
+	}
+}
+
+func TestExplain_StuckDuringQuiet(t *testing.T) {
+	pc := PolecatCheck{
+		Name:         "toast",
+		State:        PolecatStuck,
+		LastActivity: time.Now().Add(-time.Hour),
+		IdleFor:      time.Hour,
+	}
+
+	e := explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "calendar", DefaultIdleThreshold, DefaultStuckThreshold)
+	if !firedSignals(e)["quiet period"] {
+		t.Error("quiet period signal did not fire")
+	}
+	if e.Action != ActionSuppressed {
+		t.Errorf("Action = %q, want %q", e.Action, ActionSuppressed)
+	}
+}
+
+func TestExplain_ObserveOnly(t *testing.T) {
+	pc := PolecatCheck{
+		Name:         "exp-rust",
+		State:        PolecatStuck,
+		LastActivity: time.Now().Add(-time.Hour),
+		IdleFor:      time.Hour,
+	}
+
+	e := explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainNudgeLists(e, &WitnessConfig{NudgeDenylist: []string{"exp-*"}})
+	if !firedSignals(e)["nudge lists"] {
+		t.Error("nudge lists signal did not fire")
+	}
+	if e.Action != ActionObserved {
+		t.Errorf("Action = %q, want %q", e.Action, ActionObserved)
+	}
+
+	// No lists, no signal
+	e = explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainNudgeLists(e, &WitnessConfig{})
+	if len(e.Signals) > 0 && e.Signals[len(e.Signals)-1].Name == "nudge lists" {
+		t.Error("nudge lists signal added without any lists")
+	}
+}
+
+func TestExplain_DryRun(t *testing.T) {
+	pc := PolecatCheck{
+		Name:         "toast",
+		State:        PolecatStuck,
+		LastActivity: time.Now().Add(-time.Hour),
+		IdleFor:      time.Hour,
+	}
+
+	e := explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainDryRun(e, true)
+	if !firedSignals(e)["dry run"] {
+		t.Error("dry run signal did not fire")
+	}
+	if e.Action != ActionWouldNudge {
+		t.Errorf("Action = %q, want %q", e.Action, ActionWouldNudge)
+	}
+
+	// A nudge held back by the interval stays held in a dry run too
+	e = explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	last := time.Now()
+	explainNudgeInterval(e, PolecatStats{LastNudgeAt: &last}, DefaultMinNudgeInterval, time.Now())
+	explainDryRun(e, true)
+	if e.Action != ActionHeld {
+		t.Errorf("Action = %q, want %q", e.Action, ActionHeld)
+	}
+}
+
+func TestExplain_OverBudget(t *testing.T) {
+	now := time.Now()
+	pc := PolecatCheck{
+		Name:         "toast",
+		State:        PolecatStuck,
+		LastActivity: now.Add(-time.Hour),
+		IdleFor:      time.Hour,
+	}
+	w := &Witness{
+		Config:    WitnessConfig{RigNudgeBudget: 1, EscalationThreshold: 2},
+		RigNudges: []time.Time{now.Add(-10 * time.Minute)},
+	}
+	ps := PolecatStats{ConsecutiveNudges: 1}
+
+	e := explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainNudgeBudget(e, w, ps, now)
+	explainEscalation(e, ps, w.Config.EscalationLimit())
+	if !firedSignals(e)["nudge budget"] {
+		t.Error("nudge budget signal did not fire")
+	}
+	if e.Action != ActionOverBudget {
+		t.Errorf("Action = %q, want %q", e.Action, ActionOverBudget)
+	}
+	if !e.Escalates {
+		t.Error("the held nudge reaches the threshold, but Escalates is false")
+	}
+
+	// Budget to spare: the nudge goes out
+	w.RigNudges = nil
+	e = explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainNudgeBudget(e, w, ps, now)
+	if firedSignals(e)["nudge budget"] || e.Action != ActionNudged {
+		t.Errorf("Action = %q with signals %v, want an unblocked nudge", e.Action, firedSignals(e))
+	}
+}
+
+func TestExplain_Paused(t *testing.T) {
+	pc := PolecatCheck{
+		Name:         "toast",
+		State:        PolecatStuck,
+		LastActivity: time.Now().Add(-time.Hour),
+		IdleFor:      time.Hour,
+	}
+
+	e := explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainPaused(e, true)
+	if !firedSignals(e)["paused"] {
+		t.Error("paused signal did not fire")
+	}
+	if e.Action != ActionSuppressed || e.Quiet != "paused" {
+		t.Errorf("Action = %q, Quiet = %q, want %q while paused", e.Action, e.Quiet, ActionSuppressed)
+	}
+}
+
+func TestExplain_WarmingUp(t *testing.T) {
+	now := time.Now()
+	pc := PolecatCheck{
+		Name:         "toast",
+		State:        PolecatStuck,
+		LastActivity: now.Add(-time.Hour),
+		IdleFor:      time.Hour,
+	}
+	started := now.Add(-time.Minute)
+	ps := PolecatStats{SessionStartedAt: &started}
+
+	// Warm-up wins over a quiet period, as it does in Check
+	e := explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "calendar", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainWarmup(e, ps, 5*time.Minute, now)
+	if !firedSignals(e)["startup grace"] {
+		t.Error("startup grace signal did not fire")
+	}
+	if e.Action != ActionWarmingUp {
+		t.Errorf("Action = %q, want %q", e.Action, ActionWarmingUp)
+	}
+
+	e = explain(pc, PaneSample{}, PaneSample{Hash: "abc"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	explainWarmup(e, ps, 30*time.Second, now)
+	if firedSignals(e)["startup grace"] || e.Action != ActionNudged {
+		t.Errorf("Action = %q after the grace, want %q", e.Action, ActionNudged)
+	}
+}
+
+func TestExplain_Active(t *testing.T) {
+	pc := PolecatCheck{
+		Name:         "toast",
+		State:        PolecatActive,
+		LastActivity: time.Now(),
+		IdleFor:      time.Minute,
+	}
+
+	e := explain(pc, PaneSample{Hash: "old"}, PaneSample{Hash: "new"}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	if fired := firedSignals(e); len(fired) != 0 {
+		t.Errorf("signals fired for active polecat: %v", fired)
+	}
+	if e.Action != ActionNone {
+		t.Errorf("Action = %q, want %q", e.Action, ActionNone)
+	}
+}
+
+func TestExplain_Gone(t *testing.T) {
+	e := explain(PolecatCheck{Name: "toast", State: PolecatGone}, PaneSample{}, PaneSample{}, "", DefaultIdleThreshold, DefaultStuckThreshold)
+	if len(e.Signals) != 1 || !e.Signals[0].Fired {
+		t.Errorf("Signals = %+v, want only a fired session-missing signal", e.Signals)
+	}
+}
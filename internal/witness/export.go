@@ -0,0 +1,184 @@
+package witness
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// StatsRow is one row of a stats export: a polecat's counters for a day,
+// or its cumulative totals in a snapshot.
+type StatsRow struct {
+	Date        string `json:"date"`
+	Rig         string `json:"rig"`
+	Polecat     string `json:"polecat"`
+	Checks      int    `json:"checks"`
+	Nudges      int    `json:"nudges"`
+	Escalations int    `json:"escalations"`
+}
+
+// StatsCSVHeader is the column header of a stats export.
+var StatsCSVHeader = []string{"date", "rig", "polecat", "checks", "nudges", "escalations"}
+
+// DailyStats returns per-day, per-polecat counters for dates from since to
+// until inclusive. Dates are YYYY-MM-DD in local time; an empty bound is
+// open. Days in the stats history (and today) come from there; older days
+// are rebuilt from the check log, including its last rotation, leaving out
+// entries from before a stats reset. Returns no
+// rows when there is neither, e.g. for a witness that has only run as an
+// agent session.
+func (m *Manager) DailyStats(since, until string) ([]StatsRow, error) {
+	for _, d := range []string{since, until} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return nil, fmt.Errorf("invalid date %q: want YYYY-MM-DD", d)
+		}
+	}
+	inRange := func(date string) bool {
+		return (since == "" || date >= since) && (until == "" || date <= until)
+	}
+
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+	var rows []StatsRow
+	fromHistory := make(map[string]bool)
+	for _, day := range w.Stats.RecentDays(len(w.Stats.History) + 1) {
+		fromHistory[day.Date] = true
+		if !inRange(day.Date) {
+			continue
+		}
+		for name, pd := range day.PerPolecat {
+			rows = append(rows, StatsRow{
+				Date: day.Date, Rig: m.rig.Name, Polecat: name,
+				Checks: pd.Checks, Nudges: pd.Nudges, Escalations: pd.Escalations,
+			})
+		}
+	}
+
+	path := m.LogPath()
+	counts := make(map[[2]string]*StatsRow)
+	for _, p := range []string{path + ".1", path} {
+		if err := readCheckLog(p, func(e CheckLogEntry) {
+			date := e.Timestamp.Local().Format(dateLayout)
+			if fromHistory[date] || !inRange(date) || w.Stats.beforeReset(e.Timestamp) {
+				return
+			}
+			key := [2]string{date, e.Polecat}
+			row := counts[key]
+			if row == nil {
+				row = &StatsRow{Date: date, Rig: m.rig.Name, Polecat: e.Polecat}
+				counts[key] = row
+			}
+			row.Checks++
+			if e.Action == ActionNudged {
+				row.Nudges++
+			}
+			if e.Escalated {
+				row.Escalations++
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	for _, row := range counts {
+		rows = append(rows, *row)
+	}
+	SortStatsRows(rows)
+	return rows, nil
+}
+
+// SnapshotStats returns each polecat's cumulative counters, dated with
+// the day the snapshot is taken.
+func (m *Manager) SnapshotStats(now time.Time) ([]StatsRow, error) {
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+	date := now.Format(dateLayout)
+	rows := make([]StatsRow, 0, len(w.Stats.PerPolecat))
+	for name, ps := range w.Stats.PerPolecat {
+		rows = append(rows, StatsRow{
+			Date:        date,
+			Rig:         m.rig.Name,
+			Polecat:     name,
+			Checks:      ps.Checks,
+			Nudges:      ps.Nudges,
+			Escalations: ps.Escalations,
+		})
+	}
+	SortStatsRows(rows)
+	return rows, nil
+}
+
+// SortStatsRows orders rows by date, rig and polecat, so exports are
+// stable from one run to the next.
+func SortStatsRows(rows []StatsRow) {
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if a.Rig != b.Rig {
+			return a.Rig < b.Rig
+		}
+		return a.Polecat < b.Polecat
+	})
+}
+
+// WriteStatsCSV writes rows as CSV under the column header, preceded by
+// the comment lines, each prefixed with "# ".
+func WriteStatsCSV(out io.Writer, comments []string, rows []StatsRow) error {
+	for _, c := range comments {
+		if _, err := fmt.Fprintf(out, "# %s\n", c); err != nil {
+			return err
+		}
+	}
+	cw := csv.NewWriter(out)
+	if err := cw.Write(StatsCSVHeader); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err := cw.Write([]string{
+			r.Date, r.Rig, r.Polecat,
+			strconv.Itoa(r.Checks), strconv.Itoa(r.Nudges), strconv.Itoa(r.Escalations),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// readCheckLog calls fn for every entry in the check log at path. A
+// missing log has no entries; lines that don't parse are skipped.
+func readCheckLog(path string, fn func(CheckLogEntry)) error {
+	f, err := os.Open(path) //nolint:gosec // G304: path is from witness config
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("reading check log: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e CheckLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		fn(e)
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,107 @@
+package witness
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestManager_DailyStats(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	day := func(d, h int) time.Time { return time.Date(2026, 1, d, h, 0, 0, 0, time.Local) }
+
+	// An older rotation and the current log both count
+	checks := []struct {
+		path string
+		r    *CheckResult
+	}{
+		{mgr.LogPath() + ".1", &CheckResult{CheckedAt: day(5, 10), Polecats: []PolecatCheck{
+			{Name: "Toast", Action: ActionNudged},
+			{Name: "Ace", Action: ActionNone},
+		}}},
+		{mgr.LogPath(), &CheckResult{CheckedAt: day(5, 11), Polecats: []PolecatCheck{
+			{Name: "Toast", Action: ActionNudged, Escalated: true},
+		}}},
+		{mgr.LogPath(), &CheckResult{CheckedAt: day(6, 9), Polecats: []PolecatCheck{
+			{Name: "Toast", Action: ActionHeld},
+		}}},
+	}
+	for _, c := range checks {
+		if err := mgr.writeCheckLog(c.path, 1<<20, c.r); err != nil {
+			t.Fatalf("writeCheckLog: %v", err)
+		}
+	}
+
+	rows, err := mgr.DailyStats("", "")
+	if err != nil {
+		t.Fatalf("DailyStats: %v", err)
+	}
+	want := []StatsRow{
+		{Date: "2026-01-05", Rig: "testrig", Polecat: "Ace", Checks: 1},
+		{Date: "2026-01-05", Rig: "testrig", Polecat: "Toast", Checks: 2, Nudges: 2, Escalations: 1},
+		{Date: "2026-01-06", Rig: "testrig", Polecat: "Toast", Checks: 1},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("DailyStats = %+v, want %+v", rows, want)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+
+	rows, _ = mgr.DailyStats("2026-01-06", "2026-01-06")
+	if len(rows) != 1 || rows[0].Date != "2026-01-06" {
+		t.Errorf("DailyStats(2026-01-06) = %+v", rows)
+	}
+	if _, err := mgr.DailyStats("last week", ""); err == nil {
+		t.Error("DailyStats(bad date) = nil error")
+	}
+}
+
+func TestWriteStatsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	rows := []StatsRow{{Date: "2026-01-05", Rig: "testrig", Polecat: "Toast", Checks: 2, Nudges: 1}}
+	if err := WriteStatsCSV(&buf, []string{"source: test"}, rows); err != nil {
+		t.Fatalf("WriteStatsCSV: %v", err)
+	}
+	want := "# source: test\ndate,rig,polecat,checks,nudges,escalations\n2026-01-05,testrig,Toast,2,1,0\n"
+	if buf.String() != want {
+		t.Errorf("WriteStatsCSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestManager_DailyStats_PrefersHistory(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	w, _ := mgr.loadState()
+	w.Stats.History = []DailySnapshot{{
+		Date:       "2026-01-05",
+		Checks:     10,
+		PerPolecat: map[string]PolecatDay{"Toast": {Checks: 10, Nudges: 3}},
+	}}
+	if err := mgr.saveState(w); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+	// The log's entry for the same day is ignored; other days still count
+	for _, d := range []int{5, 4} {
+		r := &CheckResult{CheckedAt: time.Date(2026, 1, d, 10, 0, 0, 0, time.Local),
+			Polecats: []PolecatCheck{{Name: "Toast", Action: ActionNudged}}}
+		if err := mgr.writeCheckLog(mgr.LogPath(), 1<<20, r); err != nil {
+			t.Fatalf("writeCheckLog: %v", err)
+		}
+	}
+
+	rows, err := mgr.DailyStats("", "")
+	if err != nil {
+		t.Fatalf("DailyStats: %v", err)
+	}
+	want := []StatsRow{
+		{Date: "2026-01-04", Rig: "testrig", Polecat: "Toast", Checks: 1, Nudges: 1},
+		{Date: "2026-01-05", Rig: "testrig", Polecat: "Toast", Checks: 10, Nudges: 3},
+	}
+	if len(rows) != len(want) || rows[0] != want[0] || rows[1] != want[1] {
+		t.Errorf("DailyStats = %+v, want %+v", rows, want)
+	}
+}
@@ -0,0 +1,197 @@
+package witness
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// Group runs one monitoring loop across several rigs, so a town with many
+// small rigs needs a single witness process instead of one per rig. Each
+// rig keeps its own Manager, so stats and state stay in the rig's own
+// state file.
+type Group struct {
+	name     string
+	managers []*Manager
+	output   io.Writer
+}
+
+// NewGroup creates a monitoring group for rigs. An empty name derives a
+// stable one from the rig names.
+func NewGroup(name string, rigs []*rig.Rig) *Group {
+	if name == "" {
+		names := make([]string, 0, len(rigs))
+		for _, r := range rigs {
+			names = append(names, r.Name)
+		}
+		name = GroupName(names)
+	}
+
+	g := &Group{name: name, output: os.Stdout}
+	for _, r := range rigs {
+		m := NewManager(r)
+		m.group = name
+		g.managers = append(g.managers, m)
+	}
+	return g
+}
+
+// GroupName derives a stable group name from a set of rig names, independent
+// of the order they were given in.
+func GroupName(rigNames []string) string {
+	sorted := append([]string(nil), rigNames...)
+	sort.Strings(sorted)
+	sum := sha256.Sum256([]byte(strings.Join(sorted, "\n")))
+	return hex.EncodeToString(sum[:4])
+}
+
+// GroupSessionName returns the tmux session name for a witness group.
+func GroupSessionName(name string) string {
+	return fmt.Sprintf("%switness-%s", session.RigPrefix(), name)
+}
+
+// Name returns the group name.
+func (g *Group) Name() string {
+	return g.name
+}
+
+// SessionName returns the tmux session name for this group.
+func (g *Group) SessionName() string {
+	return GroupSessionName(g.name)
+}
+
+// Managers returns the per-rig managers in the group.
+func (g *Group) Managers() []*Manager {
+	return g.managers
+}
+
+// SetOutput sets the output writer for every rig's loop reports.
+func (g *Group) SetOutput(w io.Writer) {
+	g.output = w
+	for _, m := range g.managers {
+		m.SetOutput(w)
+	}
+}
+
+// Run checks every rig in the group each interval, the shortest of the
+// rigs' intervals, until ctx is cancelled or all rigs have been stopped
+// (gt witness stop <rig> stops one rig).
+// A rig whose stop was requested gracefully is stopped between checks.
+func (g *Group) Run(ctx context.Context) error {
+	poll := time.NewTicker(stopPollInterval)
+	defer poll.Stop()
+
+	for {
+		// The group waits for the shortest interval any rig asks for
+		var interval time.Duration
+		active := 0
+		for _, m := range g.managers {
+			w, err := m.loadState()
+			if err != nil {
+				_, _ = fmt.Fprintf(g.output, "%s [%s] loading state: %v\n", time.Now().Format("15:04:05"), m.rig.Name, err)
+				active++
+				continue
+			}
+			if w.State == StateStopped {
+				continue
+			}
+			active++
+
+			result, err := m.Check()
+			if err != nil {
+				_, _ = fmt.Fprintf(g.output, "%s [%s] check failed: %v\n", time.Now().Format("15:04:05"), m.rig.Name, err)
+				continue
+			}
+			m.report(result)
+			if interval == 0 || result.Interval < interval {
+				interval = result.Interval
+			}
+		}
+		if active == 0 {
+			_, _ = fmt.Fprintln(g.output, "All rigs in the group are stopped")
+			return nil
+		}
+		if interval == 0 {
+			interval = DefaultCheckInterval
+		}
+
+		next := time.NewTimer(interval)
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				next.Stop()
+				return nil
+			case <-poll.C:
+				for _, m := range g.managers {
+					m.pollPauseState()
+				}
+				if g.stopRequested() && g.allStopped() {
+					_, _ = fmt.Fprintln(g.output, "All rigs in the group are stopped")
+					next.Stop()
+					return nil
+				}
+				if g.checkRequested() {
+					break wait
+				}
+			case <-next.C:
+				break wait
+			}
+		}
+		next.Stop()
+	}
+}
+
+// stopRequested stops any rig whose graceful stop was requested, so it
+// drops out of the next pass. Reports whether any rig was stopped.
+func (g *Group) stopRequested() bool {
+	stopped := false
+	for _, m := range g.managers {
+		if !m.stopRequested() {
+			continue
+		}
+		if err := m.Stop(); err != nil && !errors.Is(err, ErrNotRunning) {
+			_, _ = fmt.Fprintf(g.output, "%s [%s] stopping: %v\n", time.Now().Format("15:04:05"), m.rig.Name, err)
+			continue
+		}
+		// Already stopped outright: the request still needs answering
+		if err := m.ackStop(); err != nil {
+			_, _ = fmt.Fprintf(g.output, "%s [%s] stopping: %v\n", time.Now().Format("15:04:05"), m.rig.Name, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(g.output, "%s [%s] stopped\n", time.Now().Format("15:04:05"), m.rig.Name)
+		stopped = true
+	}
+	return stopped
+}
+
+// checkRequested reports whether gt witness check asked for an immediate
+// check of any rig in the group.
+func (g *Group) checkRequested() bool {
+	for _, m := range g.managers {
+		if m.checkRequested() {
+			return true
+		}
+	}
+	return false
+}
+
+// allStopped reports whether every rig in the group is stopped.
+func (g *Group) allStopped() bool {
+	for _, m := range g.managers {
+		if w, err := m.loadState(); err != nil || w.State != StateStopped {
+			return false
+		}
+	}
+	return true
+}
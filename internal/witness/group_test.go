@@ -0,0 +1,69 @@
+package witness
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestGroupName(t *testing.T) {
+	a := GroupName([]string{"rig1", "rig2", "rig3"})
+	b := GroupName([]string{"rig3", "rig1", "rig2"})
+	if a != b {
+		t.Errorf("GroupName depends on order: %q vs %q", a, b)
+	}
+	if c := GroupName([]string{"rig1", "rig2"}); c == a {
+		t.Errorf("different rig sets share group name %q", a)
+	}
+	if len(a) != 8 {
+		t.Errorf("GroupName = %q, want 8 hex chars", a)
+	}
+}
+
+func TestNewGroup(t *testing.T) {
+	rigs := []*rig.Rig{
+		{Name: "rig1", Path: t.TempDir()},
+		{Name: "rig2", Path: t.TempDir()},
+	}
+
+	g := NewGroup("", rigs)
+	if want := GroupName([]string{"rig1", "rig2"}); g.Name() != want {
+		t.Errorf("Name() = %q, want %q", g.Name(), want)
+	}
+	if g.SessionName() != "gt-witness-"+g.Name() {
+		t.Errorf("SessionName() = %q", g.SessionName())
+	}
+	if len(g.Managers()) != 2 {
+		t.Fatalf("got %d managers, want 2", len(g.Managers()))
+	}
+	for _, m := range g.Managers() {
+		if m.group != g.Name() {
+			t.Errorf("manager for %s has group %q, want %q", m.rig.Name, m.group, g.Name())
+		}
+	}
+
+	if named := NewGroup("small-rigs", rigs); named.Name() != "small-rigs" {
+		t.Errorf("explicit name ignored: %q", named.Name())
+	}
+}
+
+func TestManager_StartInGroup(t *testing.T) {
+	g := NewGroup("small-rigs", []*rig.Rig{{Name: "rig1", Path: t.TempDir()}})
+	m := g.Managers()[0]
+
+	if err := m.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	w, _ := m.loadState()
+	if w.Group != "small-rigs" || !w.Foreground || w.State != StateRunning {
+		t.Errorf("after Start: group=%q foreground=%v state=%s", w.Group, w.Foreground, w.State)
+	}
+
+	if err := m.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	w, _ = m.loadState()
+	if w.Group != "" || w.State != StateStopped {
+		t.Errorf("after Stop: group=%q state=%s", w.Group, w.State)
+	}
+}
@@ -0,0 +1,73 @@
+package witness
+
+// DefaultHistoryDays is how many past days of stats the daily history
+// keeps, unless the rig configures its own.
+const DefaultHistoryDays = 30
+
+// HistoryLimit returns how many days of history to keep. Zero or negative
+// values fall back to the default.
+func (c *WitnessConfig) HistoryLimit() int {
+	if c.HistoryDays <= 0 {
+		return DefaultHistoryDays
+	}
+	return c.HistoryDays
+}
+
+// Today returns a snapshot of the counters for StatsDate.
+func (s *WitnessStats) Today() DailySnapshot {
+	day := DailySnapshot{
+		Date:        s.StatsDate,
+		Checks:      s.TodayChecks,
+		Nudges:      s.TodayNudges,
+		Escalations: s.TodayEscalations,
+		HeldNudges:  s.TodayHeldNudges,
+		WouldNudges: s.TodayWouldNudges,
+	}
+	for name, ps := range s.PerPolecat {
+		if ps.Today == (PolecatDay{}) {
+			continue
+		}
+		if day.PerPolecat == nil {
+			day.PerPolecat = make(map[string]PolecatDay)
+		}
+		day.PerPolecat[name] = ps.Today
+	}
+	return day
+}
+
+// archiveDay appends the StatsDate counters to the history. A day that is
+// already there (a stats file written by an older rollover) is replaced
+// rather than repeated, and a day with no checks isn't recorded.
+func (s *WitnessStats) archiveDay() {
+	day := s.Today()
+	if day.Checks == 0 && day.Nudges == 0 && day.WouldNudges == 0 {
+		return
+	}
+	for i := range s.History {
+		if s.History[i].Date == day.Date {
+			s.History[i] = day
+			return
+		}
+	}
+	s.History = append(s.History, day)
+}
+
+// trimHistory drops the oldest days beyond keep.
+func (s *WitnessStats) trimHistory(keep int) {
+	if n := len(s.History); n > keep {
+		s.History = append([]DailySnapshot(nil), s.History[n-keep:]...)
+	}
+}
+
+// RecentDays returns up to n days ending with today (StatsDate), oldest
+// first: the history followed by today's counters.
+func (s *WitnessStats) RecentDays(n int) []DailySnapshot {
+	days := append([]DailySnapshot(nil), s.History...)
+	if s.StatsDate != "" {
+		days = append(days, s.Today())
+	}
+	if len(days) > n {
+		days = days[len(days)-n:]
+	}
+	return days
+}
@@ -0,0 +1,98 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestWitnessStats_RolloverArchivesDay(t *testing.T) {
+	day1 := time.Date(2026, 3, 1, 9, 0, 0, 0, time.Local)
+	s := WitnessStats{}
+	s.rollover(day1)
+	if len(s.History) != 0 {
+		t.Fatalf("first rollover archived an undated day: %+v", s.History)
+	}
+
+	s.TodayChecks, s.TodayNudges, s.TodayEscalations = 5, 2, 1
+	s.PerPolecat = map[string]PolecatStats{
+		"Toast": {Checks: 9, Today: PolecatDay{Checks: 5, Nudges: 2, Escalations: 1}},
+		"Ace":   {Checks: 4},
+	}
+
+	// A restart later the same day must not archive anything
+	s.rollover(day1.Add(3 * time.Hour))
+	if len(s.History) != 0 {
+		t.Fatalf("same-day rollover archived: %+v", s.History)
+	}
+
+	s.rollover(day1.AddDate(0, 0, 2))
+	if len(s.History) != 1 {
+		t.Fatalf("History = %+v, want one day", s.History)
+	}
+	got := s.History[0]
+	if got.Date != "2026-03-01" || got.Checks != 5 || got.Nudges != 2 || got.Escalations != 1 {
+		t.Errorf("archived day = %+v", got)
+	}
+	if pd := got.PerPolecat["Toast"]; pd != (PolecatDay{Checks: 5, Nudges: 2, Escalations: 1}) {
+		t.Errorf("archived Toast = %+v", pd)
+	}
+	if _, ok := got.PerPolecat["Ace"]; ok {
+		t.Error("polecat with no activity that day was archived")
+	}
+	if s.TodayChecks != 0 || s.PerPolecat["Toast"].Today != (PolecatDay{}) || s.PerPolecat["Toast"].Checks != 9 {
+		t.Errorf("rollover didn't reset today's counters only: %+v", s)
+	}
+
+	// A quiet day (no checks) isn't recorded
+	s.rollover(day1.AddDate(0, 0, 3))
+	if len(s.History) != 1 {
+		t.Errorf("empty day archived: %+v", s.History)
+	}
+}
+
+func TestWitnessStats_ArchiveDayReplacesDuplicate(t *testing.T) {
+	s := WitnessStats{
+		StatsDate:   "2026-03-01",
+		TodayChecks: 7,
+		History:     []DailySnapshot{{Date: "2026-03-01", Checks: 3}},
+	}
+	s.archiveDay()
+	if len(s.History) != 1 || s.History[0].Checks != 7 {
+		t.Errorf("History = %+v, want the day replaced", s.History)
+	}
+}
+
+func TestManager_SaveStateTrimsHistory(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	w, err := mgr.loadState()
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	w.Config.HistoryDays = 2
+	for _, d := range []string{"2026-03-01", "2026-03-02", "2026-03-03"} {
+		w.Stats.History = append(w.Stats.History, DailySnapshot{Date: d, Checks: 1})
+	}
+	if err := mgr.saveState(w); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+	w, _ = mgr.loadState()
+	if len(w.Stats.History) != 2 || w.Stats.History[0].Date != "2026-03-02" {
+		t.Errorf("History = %+v, want the last 2 days", w.Stats.History)
+	}
+}
+
+func TestWitnessStats_RecentDays(t *testing.T) {
+	s := WitnessStats{
+		StatsDate:   "2026-03-04",
+		TodayChecks: 2,
+		History: []DailySnapshot{
+			{Date: "2026-03-01"}, {Date: "2026-03-02"}, {Date: "2026-03-03"},
+		},
+	}
+	days := s.RecentDays(2)
+	if len(days) != 2 || days[0].Date != "2026-03-03" || days[1].Date != "2026-03-04" || days[1].Checks != 2 {
+		t.Errorf("RecentDays(2) = %+v", days)
+	}
+}
@@ -0,0 +1,120 @@
+package witness
+
+import (
+	"fmt"
+	"time"
+)
+
+// Bounds for the adaptive check interval, unless the rig configures its own.
+const (
+	// DefaultMinCheckInterval is the shortest interval adaptive mode speeds
+	// up to.
+	DefaultMinCheckInterval = 15 * time.Second
+
+	// DefaultMaxCheckInterval is the longest interval adaptive mode backs
+	// off to.
+	DefaultMaxCheckInterval = 10 * time.Minute
+
+	// minCheckIntervalFloor keeps a misconfigured interval from spinning
+	// the loop.
+	minCheckIntervalFloor = time.Second
+)
+
+// Interval returns the configured time between monitoring passes, the
+// starting point in adaptive mode.
+func (c *WitnessConfig) Interval() time.Duration {
+	if c.CheckInterval <= 0 {
+		return DefaultCheckInterval
+	}
+	return c.CheckInterval
+}
+
+// IntervalBounds returns the range adaptive mode keeps the interval in.
+func (c *WitnessConfig) IntervalBounds() (min, max time.Duration) {
+	min, max = c.MinCheckInterval, c.MaxCheckInterval
+	if min <= 0 {
+		min = DefaultMinCheckInterval
+	}
+	if max <= 0 {
+		max = DefaultMaxCheckInterval
+	}
+	return min, max
+}
+
+// validateInterval checks that the intervals can neither spin the loop nor
+// put it to sleep for good.
+func (c *WitnessConfig) validateInterval() error {
+	for _, d := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"check interval", c.CheckInterval},
+		{"min check interval", c.MinCheckInterval},
+		{"max check interval", c.MaxCheckInterval},
+	} {
+		if d.value != 0 && d.value < minCheckIntervalFloor {
+			return fmt.Errorf("%s %s is too short: the least is %s", d.name, d.value, minCheckIntervalFloor)
+		}
+	}
+	if min, max := c.IntervalBounds(); min > max {
+		return fmt.Errorf("min check interval (%s) must not be longer than max check interval (%s)", min, max)
+	}
+	return nil
+}
+
+// EffectiveInterval returns the interval the monitoring loop is waiting
+// between passes: the one adaptive mode last settled on, or the
+// configured interval.
+func (w *Witness) EffectiveInterval() time.Duration {
+	if w.Config.AdaptiveInterval && w.CurrentInterval > 0 {
+		return w.CurrentInterval
+	}
+	return w.Config.Interval()
+}
+
+// loopStaleAfter is how long a running foreground or group loop may go
+// without completing a check before it looks hung: three missed checks.
+func (w *Witness) loopStaleAfter() time.Duration {
+	return 3 * w.EffectiveInterval()
+}
+
+// nextInterval returns the interval to wait after a check. Outside
+// adaptive mode that is the configured interval. In adaptive mode the
+// interval is halved when the check saw an active polecat or nudged,
+// held or escalated one, and doubled when nothing was going on, within
+// the configured bounds.
+func nextInterval(cfg *WitnessConfig, current time.Duration, r *CheckResult) time.Duration {
+	if !cfg.AdaptiveInterval {
+		return cfg.Interval()
+	}
+	if current <= 0 {
+		current = cfg.Interval()
+	}
+	next := current * 2
+	if checkSawActivity(r) {
+		next = current / 2
+	}
+	min, max := cfg.IntervalBounds()
+	if next < min {
+		next = min
+	}
+	if next > max {
+		next = max
+	}
+	return next
+}
+
+// checkSawActivity reports whether a check found a polecat working or had
+// to act on one.
+func checkSawActivity(r *CheckResult) bool {
+	for _, pc := range r.Polecats {
+		if pc.State == PolecatActive || pc.Escalated {
+			return true
+		}
+		switch pc.Action {
+		case ActionNudged, ActionHeld, ActionWouldNudge:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux/tmuxtest"
+)
+
+func TestNextInterval(t *testing.T) {
+	active := &CheckResult{Polecats: []PolecatCheck{{Name: "toast", State: PolecatActive, Action: ActionNone}}}
+	nudged := &CheckResult{Polecats: []PolecatCheck{{Name: "toast", State: PolecatStuck, Action: ActionNudged}}}
+	quiet := &CheckResult{Polecats: []PolecatCheck{{Name: "toast", State: PolecatIdle, Action: ActionNone}}}
+
+	fixed := &WitnessConfig{CheckInterval: 30 * time.Second}
+	if got := nextInterval(fixed, 0, active); got != 30*time.Second {
+		t.Errorf("fixed interval = %s, want 30s", got)
+	}
+	if got := nextInterval(&WitnessConfig{}, 0, quiet); got != DefaultCheckInterval {
+		t.Errorf("default interval = %s, want %s", got, DefaultCheckInterval)
+	}
+
+	adaptive := &WitnessConfig{AdaptiveInterval: true, MinCheckInterval: 20 * time.Second, MaxCheckInterval: 5 * time.Minute}
+	tests := []struct {
+		name    string
+		current time.Duration
+		result  *CheckResult
+		want    time.Duration
+	}{
+		{"first quiet check backs off from the base", 0, quiet, 2 * time.Minute},
+		{"quiet backs off", 2 * time.Minute, quiet, 4 * time.Minute},
+		{"back-off stops at max", 4 * time.Minute, quiet, 5 * time.Minute},
+		{"activity speeds up", 4 * time.Minute, active, 2 * time.Minute},
+		{"nudge speeds up", time.Minute, nudged, 30 * time.Second},
+		{"speed-up stops at min", 30 * time.Second, active, 20 * time.Second},
+		{"no polecats backs off", time.Minute, &CheckResult{}, 2 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := nextInterval(adaptive, tt.current, tt.result); got != tt.want {
+			t.Errorf("%s: nextInterval(%s) = %s, want %s", tt.name, tt.current, got, tt.want)
+		}
+	}
+}
+
+func TestValidateInterval(t *testing.T) {
+	valid := []WitnessConfig{
+		{},
+		{CheckInterval: 30 * time.Second, AdaptiveInterval: true},
+		{MinCheckInterval: time.Second, MaxCheckInterval: time.Second},
+	}
+	for _, cfg := range valid {
+		if err := validateConfig(&cfg); err != nil {
+			t.Errorf("validateConfig(%+v) = %v", cfg, err)
+		}
+	}
+	invalid := []WitnessConfig{
+		{CheckInterval: 100 * time.Millisecond},
+		{MinCheckInterval: 10 * time.Minute, MaxCheckInterval: time.Minute},
+		{MinCheckInterval: 20 * time.Minute},
+	}
+	for _, cfg := range invalid {
+		if err := validateConfig(&cfg); err == nil {
+			t.Errorf("validateConfig(%+v) should fail", cfg)
+		}
+	}
+}
+
+func TestEffectiveInterval(t *testing.T) {
+	w := &Witness{Config: WitnessConfig{CheckInterval: 2 * time.Minute}, CurrentInterval: 5 * time.Minute}
+	if got := w.EffectiveInterval(); got != 2*time.Minute {
+		t.Errorf("EffectiveInterval() without adaptive = %s, want 2m", got)
+	}
+	w.Config.AdaptiveInterval = true
+	if got := w.EffectiveInterval(); got != 5*time.Minute {
+		t.Errorf("EffectiveInterval() adaptive = %s, want 5m", got)
+	}
+
+	// A loop backed off to 5m isn't dead after 3 default intervals
+	last := time.Now().Add(-4 * time.Minute)
+	w.Foreground, w.LastCheckAt = true, &last
+	if !w.LoopAlive(tmuxtest.NewFakeTmux(), time.Now()) {
+		t.Error("LoopAlive() = false for a backed-off loop that checked 4m ago")
+	}
+}
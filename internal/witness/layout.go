@@ -0,0 +1,46 @@
+package witness
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Witness session layouts.
+const (
+	// LayoutSingle runs the agent alone in the session (the default).
+	LayoutSingle = "single"
+
+	// LayoutSplit adds a pane running gt witness watch beside the agent.
+	LayoutSplit = "split"
+)
+
+// watchPanePercent is the share of the window the watch pane takes.
+const watchPanePercent = 35
+
+// ValidateLayout checks a --layout value.
+func ValidateLayout(layout string) error {
+	switch layout {
+	case "", LayoutSingle, LayoutSplit:
+		return nil
+	}
+	return fmt.Errorf("invalid layout %q: want %s or %s", layout, LayoutSingle, LayoutSplit)
+}
+
+// SetLayout sets the session layout for the next background Start. Empty
+// keeps the layout the witness last ran with.
+func (m *Manager) SetLayout(layout string) {
+	m.layout = layout
+}
+
+// sessionLayout returns the pane layout for an agent session running
+// command. The watch pane reaches the rig name through the environment,
+// like the agent command, rather than spliced into the command string.
+func (m *Manager) sessionLayout(layout, command string) tmux.Layout {
+	if layout != LayoutSplit {
+		return tmux.Layout{Panes: []tmux.Pane{{Command: command}}}
+	}
+	watch := config.ExportPrefix(map[string]string{"GT_RIG": m.rig.Name}) + `gt witness watch "$GT_RIG"`
+	return tmux.SplitLayout(command, watch, watchPanePercent)
+}
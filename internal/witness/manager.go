@@ -3,9 +3,10 @@ package witness
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
-	"strings"
+	"text/template"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/agent"
@@ -16,6 +17,7 @@ import (
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -23,27 +25,140 @@ import (
 var (
 	ErrNotRunning     = errors.New("witness not running")
 	ErrAlreadyRunning = errors.New("witness already running")
+	ErrAlreadyPaused  = errors.New("witness already paused")
+	ErrNotPaused      = errors.New("witness not paused")
+	ErrStopTimeout    = errors.New("witness loop did not stop in time; stopped forcefully")
+	ErrSessionLingers = errors.New("witness session still running after stop")
+	ErrCheckTimeout   = errors.New("witness loop did not run the requested check in time")
+	ErrCheckInSession = errors.New("witness runs as an agent session, which has no monitoring loop to ask for a check")
 )
 
 // Manager handles witness lifecycle and monitoring operations.
 type Manager struct {
-	rig          *rig.Rig
-	workDir      string
-	stateManager *agent.StateManager[Witness]
+	rig            *rig.Rig
+	workDir        string
+	stateManager   *agent.StateManager[Witness]
+	output         io.Writer // Output destination for monitoring loop reports
+	logTee         io.Writer // Optional copy of check log entries
+	group          string    // Witness group this rig is monitored in, if any
+	dryRun         bool      // Start in dry-run mode
+	staticPolecats bool      // Start with a fixed monitored set (no discovery)
+	agentCommand   string    // One-off agent command override for Start
+	layout         string    // Session layout for Start; "" keeps the last one
+	nudger         Nudger    // Delivers nudges to stuck polecats
+	escalator      Escalator // Delivers escalations; nil uses the config's
+
+	// tmux is how the manager talks to the tmux server; see SetTmux.
+	tmux tmux.Client
+
+	// events is the loop's event stream for external tools, if any;
+	// lastState is the witness state the loop last saw, for pause events.
+	events    *EventSink
+	lastState State
+
+	// onAction is the OnAction handler, if any.
+	onAction func(Action)
+
+	// fileConfig holds the settings from the town and rig witness config
+	// files (see loadFileConfig), or fileConfigErr why they couldn't be read.
+	fileConfig    map[string]config.WitnessSetting
+	fileConfigErr error
+
+	// nudgeTmpl caches the parsed nudge template for nudgeTmplText.
+	nudgeTmpl     *template.Template
+	nudgeTmplText string
 }
 
 // NewManager creates a new witness manager for a rig.
 func NewManager(r *rig.Rig) *Manager {
-	return &Manager{
+	m := &Manager{
 		rig:     r,
 		workDir: r.Path,
 		stateManager: agent.NewStateManager[Witness](r.Path, "witness.json", func() *Witness {
 			return &Witness{
-				RigName: r.Name,
-				State:   StateStopped,
+				SchemaVersion: StateSchemaVersion,
+				RigName:       r.Name,
+				State:         StateStopped,
 			}
 		}),
+		output: os.Stdout,
+		tmux:   tmux.NewTmux(),
+		nudger: NewTmuxNudger(r.Name),
 	}
+	m.fileConfig, m.fileConfigErr = loadFileConfig(r.Path, townRootOf(r.Path))
+	return m
+}
+
+// SetOutput sets the output writer for monitoring loop reports.
+// This is useful for testing or redirecting output.
+func (m *Manager) SetOutput(w io.Writer) {
+	m.output = w
+}
+
+// SetTmux makes the manager talk to tmux through t, e.g. a command's
+// configured client or a fake in tests. The default nudger follows it.
+// Starting an agent session still drives tmux directly; see Start.
+func (m *Manager) SetTmux(t tmux.Client) {
+	m.tmux = t
+	if n, ok := m.nudger.(*TmuxNudger); ok {
+		n.tmux = t
+	}
+}
+
+// SetAgentCommand overrides the configured agent command for the next
+// background Start without persisting it. Empty uses the config.
+func (m *Manager) SetAgentCommand(command string) {
+	m.agentCommand = command
+}
+
+// UpdateConfig applies fn to the persisted witness config and saves it.
+// Changes take effect on the next monitoring pass.
+// fn sees and sets only the overrides; see updateOverrides.
+func (m *Manager) UpdateConfig(fn func(*WitnessConfig)) error {
+	return m.updateState(func(w *Witness) error {
+		_ = m.updateOverrides(&w.Config, func(c *WitnessConfig) error {
+			fn(c)
+			return nil
+		})
+		return validateConfig(&w.Config)
+	})
+}
+
+// validateConfig checks config values that would otherwise fail mid-loop.
+func validateConfig(cfg *WitnessConfig) error {
+	if _, err := NewQuietCalendar(cfg.QuietDates, cfg.QuietDatesFile); err != nil {
+		return fmt.Errorf("invalid quiet dates: %w", err)
+	}
+	if cfg.QuietHours != nil {
+		if err := cfg.QuietHours.validate(); err != nil {
+			return err
+		}
+	}
+	if _, err := parseNudgeTemplate(cfg.NudgeTemplate); err != nil {
+		return fmt.Errorf("invalid nudge template: %w", err)
+	}
+	if _, err := cfg.panePatterns(); err != nil {
+		return err
+	}
+	if idle, stuck := cfg.Thresholds(); stuck < idle {
+		return fmt.Errorf("stuck threshold (%s) must not be shorter than idle threshold (%s)", stuck, idle)
+	}
+	if err := cfg.validateInterval(); err != nil {
+		return err
+	}
+	if err := cfg.validateDelivery(); err != nil {
+		return err
+	}
+	if err := cfg.validateNudgeLists(); err != nil {
+		return err
+	}
+	if _, err := config.ParseEnvAssignments(cfg.Env); err != nil {
+		return err
+	}
+	if cfg.CrashLoopThreshold == 1 {
+		return fmt.Errorf("crash loop threshold must be at least 2, so a single restart is not a crash loop")
+	}
+	return nil
 }
 
 // stateFile returns the path to the witness state file.
@@ -51,19 +166,60 @@ func (m *Manager) stateFile() string {
 	return m.stateManager.StateFile()
 }
 
-// loadState loads witness state from disk.
+// loadState loads witness state from disk, migrating older formats.
 func (m *Manager) loadState() (*Witness, error) {
-	return m.stateManager.Load()
+	return m.migrated(m.stateManager.Load())
+}
+
+// migrated brings a freshly loaded state up to the current format.
+func (m *Manager) migrated(w *Witness, err error) (*Witness, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := migrateState(w); err != nil {
+		return nil, fmt.Errorf("%s: %w", m.stateFile(), err)
+	}
+	m.applyFileConfig(&w.Config)
+	return w, nil
 }
 
-// saveState persists witness state to disk using atomic write.
+// saveState persists witness state to disk using atomic write. It
+// replaces whatever is there; use updateState to change state that other
+// gt invocations may be writing too.
 func (m *Manager) saveState(w *Witness) error {
-	return m.stateManager.Save(w)
+	saved := m.stored(w)
+	return m.stateManager.Save(&saved)
+}
+
+// updateState applies fn to the current witness state and saves it, under
+// the state file's lock throughout, so a concurrent save by a running loop
+// or another command can't be lost. If fn fails nothing is saved.
+func (m *Manager) updateState(fn func(*Witness) error) error {
+	return m.stateManager.Update(func(w *Witness) error {
+		if _, err := m.migrated(w, nil); err != nil {
+			return err
+		}
+		if err := fn(w); err != nil {
+			return err
+		}
+		*w = m.stored(w)
+		return nil
+	})
+}
+
+// stored returns w as it is written to the state file: in the current
+// format, with history trimmed and without the config files' settings.
+func (m *Manager) stored(w *Witness) Witness {
+	w.SchemaVersion = StateSchemaVersion
+	w.Stats.trimHistory(w.Config.HistoryLimit())
+	saved := *w
+	saved.Config = m.withoutFileConfig(w.Config)
+	return saved
 }
 
 // SessionName returns the tmux session name for this witness.
 func (m *Manager) SessionName() string {
-	return fmt.Sprintf("gt-%s-witness", m.rig.Name)
+	return session.WitnessSessionName(m.rig.Name)
 }
 
 // Status returns the current witness status.
@@ -74,11 +230,34 @@ func (m *Manager) Status() (*Witness, error) {
 	if err != nil {
 		return nil, err
 	}
+	m.refreshStatus(w)
+	return w, nil
+}
 
+// Snapshot returns the witness status like Status, read under the state
+// file's lock so it doesn't land in the middle of a save by a running
+// loop. The state is decoded afresh for every call, so the copy shares
+// nothing with the loop or other callers: command code may reconcile and
+// render it freely, and changes never reach the state file.
+func (m *Manager) Snapshot() (*Witness, error) {
+	w, err := m.migrated(m.stateManager.LoadShared())
+	if err != nil {
+		return nil, err
+	}
+	m.refreshStatus(w)
+	return w, nil
+}
+
+// refreshStatus fills in the parts of a loaded status that are derived
+// rather than stored.
+func (m *Manager) refreshStatus(w *Witness) {
 	// Update monitored polecats list (still useful for display)
-	w.MonitoredPolecats = m.rig.Polecats
+	if polecats, err := m.monitoredPolecats(w); err == nil {
+		w.MonitoredPolecats = polecats
+	}
 
-	return w, nil
+	// Don't report yesterday's counters as today's
+	w.Stats.rollover(time.Now())
 }
 
 // witnessDir returns the working directory for the witness.
@@ -98,53 +277,115 @@ func (m *Manager) witnessDir() string {
 }
 
 // Start starts the witness.
-// If foreground is true, only updates state (no tmux session - deprecated).
+// If foreground is true, only updates state (no tmux session); the caller
+// then drives the Go monitoring loop with Run.
 // Otherwise, spawns a Claude agent in a tmux session.
 // agentOverride optionally specifies a different agent alias to use.
-// envOverrides are KEY=VALUE pairs that override all other env var sources.
+// envOverrides are KEY=VALUE pairs that override all other env var sources,
+// including the config's env list; a malformed pair fails the start.
+// A state file that says running while neither the session nor a loop is
+// alive is stale, and starting replaces it rather than failing.
 func (m *Manager) Start(foreground bool, agentOverride string, envOverrides []string) error {
+	if m.fileConfigErr != nil {
+		return fmt.Errorf("witness config: %w", m.fileConfigErr)
+	}
+	if err := m.checkAgentOverride(agentOverride); err != nil {
+		return err
+	}
 	w, err := m.loadState()
 	if err != nil {
 		return err
 	}
+	if err := validateConfig(&w.Config); err != nil {
+		return err
+	}
+	extraEnv, err := extraAgentEnv(&w.Config, envOverrides)
+	if err != nil {
+		return err
+	}
+	m.nudgeTemplate(&w.Config)
 
+	// Launching the agent takes more of tmux than tmux.Client covers
+	// (layouts, theming, prompt detection), so Start uses a full driver.
 	t := tmux.NewTmux()
 	sessionID := m.SessionName()
 
 	if foreground {
-		// Foreground mode is deprecated - patrol logic moved to mol-witness-patrol
+		// Foreground mode runs the Go monitoring loop in this process.
+		// Patrol decisions still belong to mol-witness-patrol in the agent session.
 		// Just check tmux session (no PID inference per ZFC)
 		if running, _ := t.HasSession(sessionID); running && t.IsClaudeRunning(sessionID) {
-			return ErrAlreadyRunning
+			return m.stateError("start", liveState(w), StateRunning, ErrAlreadyRunning)
+		}
+		if w.State != StateStopped && w.LoopAlive(t, time.Now()) {
+			return m.stateError("start", w.State, StateRunning, ErrAlreadyRunning)
 		}
 
+		// A paused witness stays paused across a loop restart.
+		// Stats carry over; only yesterday's daily counters reset.
 		now := time.Now()
-		w.State = StateRunning
+		w.Stats.rollover(now)
+		w.StartMark = w.Stats.mark(now)
+		if w.State != StatePaused {
+			w.State = StateRunning
+		}
 		w.StartedAt = &now
 		w.PID = 0 // No longer track PID (ZFC)
-		w.MonitoredPolecats = m.rig.Polecats
+		w.Foreground = true
+		w.LoopSession = t.CurrentSession()
+		w.DryRun = m.dryRun
+		w.Group = m.group
+		w.StopRequestedAt = nil
+		w.StaticPolecats = m.staticPolecats
+		w.MonitoredPolecats = m.startPolecats(w)
 
 		return m.saveState(w)
 	}
 
 	// Background mode: check if session already exists
 	running, _ := t.HasSession(sessionID)
-	if running {
-		// Session exists - check if Claude is actually running (healthy vs zombie)
-		if t.IsClaudeRunning(sessionID) {
-			// Healthy - Claude is running
-			return ErrAlreadyRunning
+	if running && t.IsClaudeRunning(sessionID) {
+		// Healthy - Claude is running
+		return m.stateError("start", liveState(w), StateRunning, ErrAlreadyRunning)
+	}
+	if w.CrashLoopAt != nil {
+		// Held stopped after a crash loop; only an explicit start
+		// (ClearCrashLoop first) brings it back.
+		return ErrCrashLoop
+	}
+	if running || (w.State == StateRunning && !w.Foreground && w.Group == "") {
+		// Zombie (tmux alive but Claude dead) or a session that vanished
+		// while we thought it was running: the agent crashed.
+		var reason string
+		if running {
+			if pane, err := t.CapturePane(sessionID, paneSampleLines); err == nil {
+				reason = crashReason(pane)
+			}
+		}
+		if w.recordCrash(time.Now(), reason) {
+			if running {
+				_ = t.KillSession(sessionID)
+			}
+			if err := m.saveState(w); err != nil {
+				return fmt.Errorf("saving state: %w", err)
+			}
+			return ErrCrashLoop
 		}
-		// Zombie - tmux alive but Claude dead. Kill and recreate.
-		if err := t.KillSession(sessionID); err != nil {
-			return fmt.Errorf("killing zombie session: %w", err)
+		if running {
+			// Kill and recreate.
+			if err := t.KillSession(sessionID); err != nil {
+				return &SessionError{Session: sessionID, Op: "killing zombie", Err: err}
+			}
 		}
 	}
 
 	// Note: No PID check per ZFC - tmux session is the source of truth
 
-	// Working directory
-	witnessDir := m.witnessDir()
+	// Work out the session before writing anything for it
+	p, err := m.plan(w, agentOverride, extraEnv)
+	if err != nil {
+		return err
+	}
 
 	// Ensure Claude settings exist in witness/ (not witness/rig/) so we don't
 	// write into the source repo. Claude walks up the tree to find settings.
@@ -153,59 +394,40 @@ func (m *Manager) Start(foreground bool, agentOverride string, envOverrides []st
 		return fmt.Errorf("ensuring Claude settings: %w", err)
 	}
 
-	roleConfig, err := m.roleConfig()
-	if err != nil {
-		return err
-	}
-
-	townRoot := m.townRoot()
-
-	// Build startup command first
-	// NOTE: No gt prime injection needed - SessionStart hook handles it automatically
-	// Export GT_ROLE and BD_ACTOR in the command since tmux SetEnvironment only affects new panes
-	// Pass m.rig.Path so rig agent settings are honored (not town-level defaults)
-	command, err := buildWitnessStartCommand(m.rig.Path, m.rig.Name, townRoot, agentOverride, roleConfig)
-	if err != nil {
-		return err
-	}
-
 	// Create session with command directly to avoid send-keys race condition.
 	// See: https://github.com/anthropics/gastown/issues/280
-	if err := t.NewSessionWithCommand(sessionID, witnessDir, command); err != nil {
-		return fmt.Errorf("creating tmux session: %w", err)
+	created, err := t.EnsureSessionWithLayout(sessionID, p.WorkDir, m.sessionLayout(p.Layout, p.Command))
+	if err != nil {
+		return &SessionError{Session: sessionID, Op: "creating", Err: err}
+	}
+	if !created {
+		// Another start (an attach, the daemon) got there first; it
+		// sets up the session and records the state.
+		return m.stateError("start", StateRunning, StateRunning, ErrAlreadyRunning)
 	}
 
 	// Set environment variables (non-fatal: session works without these)
-	// Use centralized AgentEnv for consistency across all role startup paths
-	envVars := config.AgentEnv(config.AgentEnvConfig{
-		Role:     "witness",
-		Rig:      m.rig.Name,
-		TownRoot: townRoot,
-	})
-	for k, v := range envVars {
-		_ = t.SetEnvironment(sessionID, k, v)
-	}
-	// Apply role config env vars if present (non-fatal).
-	for key, value := range roleConfigEnvVars(roleConfig, townRoot, m.rig.Name) {
-		_ = t.SetEnvironment(sessionID, key, value)
-	}
-	// Apply CLI env overrides (highest priority, non-fatal).
-	for _, override := range envOverrides {
-		if key, value, ok := strings.Cut(override, "="); ok {
-			_ = t.SetEnvironment(sessionID, key, value)
-		}
+	if err := t.SetEnvironmentBatch(sessionID, p.Env); err != nil {
+		_, _ = fmt.Fprintf(m.output, "warning: %s environment: %v\n", sessionID, err)
 	}
 
 	// Apply Gas Town theming (non-fatal: theming failure doesn't affect operation)
-	theme := tmux.AssignTheme(m.rig.Name)
-	_ = t.ConfigureGasTownSession(sessionID, theme, m.rig.Name, "witness", "witness")
+	_ = t.ConfigureGasTownSession(sessionID, p.Theme, m.rig.Name, "witness", "witness")
 
-	// Update state to running
+	// Update state to running. Stats carry over from the previous run.
 	now := time.Now()
+	w.Stats.rollover(now)
+	w.StartMark = w.Stats.mark(now)
 	w.State = StateRunning
 	w.StartedAt = &now
 	w.PID = 0 // Claude agent doesn't have a PID we track
-	w.MonitoredPolecats = m.rig.Polecats
+	w.Foreground = false
+	w.LoopSession = ""
+	w.DryRun = m.dryRun
+	w.Layout = p.Layout
+	w.Group = ""
+	w.StaticPolecats = m.staticPolecats
+	w.MonitoredPolecats = m.startPolecats(w)
 	if err := m.saveState(w); err != nil {
 		_ = t.KillSession(sessionID) // best-effort cleanup on state save failure
 		return fmt.Errorf("saving state: %w", err)
@@ -219,9 +441,19 @@ func (m *Manager) Start(foreground bool, agentOverride string, envOverrides []st
 	// Accept bypass permissions warning dialog if it appears.
 	_ = t.AcceptBypassPermissionsWarning(sessionID)
 
-	time.Sleep(constants.ShutdownNotifyDelay)
+	// Nudges typed before the agent is ready land in a shell, or are
+	// lost; without a marker to watch for, fall back to a short pause
+	if marker := p.ReadyMarker; marker == "" {
+		time.Sleep(constants.ShutdownNotifyDelay)
+	} else if !waitForReady(t, sessionID, marker, readyTimeout) {
+		_, _ = fmt.Fprintf(m.output, "warning: %s showed no %q within %s; skipping the startup nudges\n",
+			sessionID, marker, readyTimeout)
+		return nil
+	}
 
-	// Inject startup nudge for predecessor discovery via /resume
+	// Inject startup nudge for predecessor discovery via /resume. The pane
+	// is captured first so the prompt can be seen to come back after it.
+	beforeBeacon, _ := t.CapturePaneLines(sessionID, 10)
 	address := fmt.Sprintf("%s/witness", m.rig.Name)
 	_ = session.StartupNudge(t, sessionID, session.StartupNudgeConfig{
 		Recipient: address,
@@ -230,14 +462,37 @@ func (m *Manager) Start(foreground bool, agentOverride string, envOverrides []st
 	}) // Non-fatal
 
 	// GUPP: Gas Town Universal Propulsion Principle
-	// Send the propulsion nudge to trigger autonomous patrol execution.
-	// Wait for beacon to be fully processed (needs to be separate prompt)
-	time.Sleep(2 * time.Second)
-	_ = t.NudgeSession(sessionID, session.PropulsionNudgeForRole("witness", witnessDir)) // Non-fatal
+	// Send the propulsion nudge to trigger autonomous patrol execution once
+	// the agent's prompt is back after the beacon, so it lands as a
+	// separate prompt.
+	nudge := session.PropulsionNudgeForRole("witness", p.WorkDir)
+	prompt := witnessReadyPrompt(m.rig.Path)
+	if prompt == "" {
+		// Nothing to watch for; wait for the beacon to be processed.
+		time.Sleep(beaconDelay)
+		_ = t.NudgeSession(sessionID, nudge) // Non-fatal
+	} else if err := t.SendKeysConfirmAfter(sessionID, nudge, prompt, beforeBeacon, constants.PromptWaitTimeout); errors.Is(err, tmux.ErrPromptNotFound) {
+		// Prompt detection can miss custom runtimes; send anyway.
+		_ = t.NudgeSession(sessionID, nudge) // Non-fatal
+	}
 
 	return nil
 }
 
+// beaconDelay is how long Start waits after the startup beacon before the
+// propulsion nudge when the runtime declares no prompt to watch for.
+const beaconDelay = 2 * time.Second
+
+// witnessReadyPrompt returns the prompt the rig's runtime shows when it is
+// ready for input, or "" if the runtime doesn't declare one.
+func witnessReadyPrompt(rigPath string) string {
+	rc := config.LoadRuntimeConfig(rigPath)
+	if rc == nil || rc.Tmux == nil {
+		return ""
+	}
+	return rc.Tmux.ReadyPromptPrefix
+}
+
 func (m *Manager) roleConfig() (*beads.RoleConfig, error) {
 	// Role beads use hq- prefix and live in town-level beads, not rig beads
 	townRoot := m.townRoot()
@@ -268,12 +523,56 @@ func roleConfigEnvVars(roleConfig *beads.RoleConfig, townRoot, rigName string) m
 	return expanded
 }
 
-func buildWitnessStartCommand(rigPath, rigName, townRoot, agentOverride string, roleConfig *beads.RoleConfig) (string, error) {
+// extraAgentEnv returns the variables the config's env list and the
+// KEY=VALUE overrides add to the agent environment; overrides win.
+func extraAgentEnv(cfg *WitnessConfig, overrides []string) (map[string]string, error) {
+	env, err := config.ParseEnvAssignments(cfg.Env)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := config.ParseEnvAssignments(overrides)
+	if err != nil {
+		return nil, err
+	}
+	return config.MergeEnv(env, cli), nil
+}
+
+// errAgentConflict is returned when both an agent alias and an agent
+// command are given for one start.
+var errAgentConflict = errors.New("--agent and --agent-command can't be used together")
+
+// checkAgentOverride rejects an agent alias given alongside a one-off
+// agent command.
+func (m *Manager) checkAgentOverride(agentOverride string) error {
+	if agentOverride != "" && m.agentCommand != "" {
+		return errAgentConflict
+	}
+	return nil
+}
+
+// buildWitnessStartCommand returns the command the witness session runs.
+// Values substituted into a role start_command are shell-quoted, so a
+// pattern such as "run --rig {rig}" gets the rig name as one word.
+func buildWitnessStartCommand(rigPath, rigName, townRoot, agentOverride, agentCommand string, roleConfig *beads.RoleConfig) (string, error) {
+	if agentCommand != "" && agentOverride != "" {
+		return "", errAgentConflict
+	}
+	if agentCommand != "" {
+		// The rig name reaches the command only through the exported
+		// environment, never by splicing it into the command string.
+		env := config.AgentEnv(config.AgentEnvConfig{
+			Role:     "witness",
+			Rig:      rigName,
+			TownRoot: townRoot,
+		})
+		return config.ExportPrefix(env) + agentCommand, nil
+	}
 	if agentOverride != "" {
 		roleConfig = nil
 	}
 	if roleConfig != nil && roleConfig.StartCommand != "" {
-		return beads.ExpandRolePattern(roleConfig.StartCommand, townRoot, rigName, "", "witness"), nil
+		return beads.ExpandRolePattern(roleConfig.StartCommand,
+			util.ShellQuote(townRoot), util.ShellQuote(rigName), "", "witness"), nil
 	}
 	command, err := config.BuildAgentStartupCommandWithAgentOverride("witness", rigName, townRoot, rigPath, "", agentOverride)
 	if err != nil {
@@ -284,30 +583,196 @@ func buildWitnessStartCommand(rigPath, rigName, townRoot, agentOverride string,
 
 // Stop stops the witness.
 func (m *Manager) Stop() error {
-	w, err := m.loadState()
-	if err != nil {
-		return err
-	}
+	return m.stop(0, false)
+}
 
+// stop marks the witness stopped and kills its session. With a grace
+// period the agent is interrupted first and given that long to exit
+// cleanly; without one the session is killed outright. keepStopRequest
+// leaves a graceful stop request in place for a loop that hasn't seen it
+// yet; otherwise stopping answers it.
+func (m *Manager) stop(grace time.Duration, keepStopRequest bool) error {
 	// Check if tmux session exists
-	t := tmux.NewTmux()
+	t := m.tmux
 	sessionID := m.SessionName()
 	sessionRunning, _ := t.HasSession(sessionID)
 
-	// If neither state nor session indicates running, it's not running
-	if w.State != StateRunning && !sessionRunning {
-		return ErrNotRunning
+	// Write state before tearing the session down so status never sees a
+	// dead session with a half-updated state file
+	err := m.updateState(func(w *Witness) error {
+		// If neither state nor session indicates running, it's not running
+		if w.State == StateStopped && !sessionRunning {
+			return m.stateError("stop", StateStopped, StateStopped, ErrNotRunning)
+		}
+
+		// Note: No PID-based stop per ZFC - tmux session kill is sufficient
+
+		w.State = StateStopped
+		w.PID = 0
+		w.Foreground = false
+		w.LoopSession = ""
+		w.DryRun = false
+		w.Group = ""
+		w.PausedAt = nil
+		if !keepStopRequest {
+			w.StopRequestedAt = nil
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Kill tmux session if it exists (best-effort: may already be dead)
 	if sessionRunning {
-		_ = t.KillSession(sessionID)
+		if grace > 0 {
+			_ = t.KillSessionGraceful(sessionID, grace)
+		} else {
+			_ = t.KillSession(sessionID)
+		}
+	}
+	return nil
+}
+
+// StopGraceful stops the witness without interrupting a check in flight.
+// A foreground monitoring loop is asked to stop through the state file; it
+// finishes its current check, saves stats and marks itself stopped. If it
+// hasn't done so within timeout, the witness is marked stopped anyway,
+// which a loop that is still running takes as its cue to exit. An agent
+// session, with no loop to ask, is interrupted and given timeout to exit
+// before its session is killed.
+func (m *Manager) StopGraceful(timeout time.Duration) error {
+	w, err := m.loadState()
+	if err != nil {
+		return err
+	}
+
+	if w.State == StateStopped || !w.LoopAlive(m.tmux, time.Now()) {
+		return m.stop(timeout, false)
+	}
+
+	if err := m.updateState(func(w *Witness) error {
+		now := time.Now()
+		w.StopRequestedAt = &now
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(stopPollInterval)
+		if w, err := m.loadState(); err == nil && w.State == StateStopped {
+			return nil
+		}
+	}
+
+	// Loop didn't acknowledge in time - force it, leaving the request
+	// for the loop to see when it next looks
+	if err := m.stop(0, true); err != nil && !errors.Is(err, ErrNotRunning) {
+		return err
+	}
+	return ErrStopTimeout
+}
+
+// WaitSessionGone polls until the witness tmux session has disappeared, so
+// a restart never starts a new agent beside one that is still shutting down.
+func (m *Manager) WaitSessionGone(timeout time.Duration) error {
+	t := m.tmux
+	sessionID := m.SessionName()
+	deadline := time.Now().Add(timeout)
+	for {
+		if running, _ := t.HasSession(sessionID); !running {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return &SessionError{Session: sessionID, Op: "waiting for", Err: ErrSessionLingers}
+		}
+		time.Sleep(constants.PollInterval)
+	}
+}
+
+// stopRequested reports whether StopGraceful has asked the loop to stop.
+func (m *Manager) stopRequested() bool {
+	w, err := m.loadState()
+	return err == nil && w.StopRequestedAt != nil
+}
+
+// loopStopReason says why a running monitoring loop should exit: a
+// graceful stop was requested, or the witness was stopped outright while
+// the loop ran. Empty if it should carry on.
+func (m *Manager) loopStopReason() string {
+	w, err := m.loadState()
+	switch {
+	case err != nil:
+		return ""
+	case w.StopRequestedAt != nil:
+		return "stop requested"
+	case w.State == StateStopped:
+		return "witness stopped"
 	}
+	return ""
+}
 
-	// Note: No PID-based stop per ZFC - tmux session kill is sufficient
+// ackStop clears a graceful stop request once the loop has acted on it.
+func (m *Manager) ackStop() error {
+	return m.updateState(func(w *Witness) error {
+		w.StopRequestedAt = nil
+		return nil
+	})
+}
 
-	w.State = StateStopped
-	w.PID = 0
+// Pause stops the witness from nudging or escalating while leaving it
+// running: the tmux session stays up and the monitoring loop keeps checking
+// so stats stay current. A background agent session is told about the pause.
+func (m *Manager) Pause() error {
+	err := m.updateState(func(w *Witness) error {
+		switch w.State {
+		case StatePaused:
+			return m.stateError("pause", w.State, StatePaused, ErrAlreadyPaused)
+		case StateStopped:
+			return m.stateError("pause", w.State, StatePaused, ErrNotRunning)
+		}
 
-	return m.saveState(w)
+		now := time.Now()
+		w.State = StatePaused
+		w.PausedAt = &now
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.notifySession("Witness PAUSED by operator: do not nudge or escalate polecats until resumed " +
+		"(`gt witness resume`). Keep observing.")
+	return nil
+}
+
+// Resume restores normal nudging and escalation after Pause.
+func (m *Manager) Resume() error {
+	err := m.updateState(func(w *Witness) error {
+		if w.State != StatePaused {
+			return m.stateError("resume", w.State, StateRunning, ErrNotPaused)
+		}
+
+		w.State = StateRunning
+		w.PausedAt = nil
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.notifySession("Witness RESUMED: normal patrol, nudges and escalations may continue.")
+	return nil
+}
+
+// notifySession nudges the witness agent session, if one is running, so
+// the agent learns about operator state changes (non-fatal).
+func (m *Manager) notifySession(msg string) {
+	t := m.tmux
+	sessionID := m.SessionName()
+	if running, _ := t.HasSession(sessionID); running {
+		_ = t.NudgeSession(sessionID, msg)
+	}
 }
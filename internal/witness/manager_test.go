@@ -1,10 +1,22 @@
 package witness
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 func TestBuildWitnessStartCommand_UsesRoleConfig(t *testing.T) {
@@ -12,19 +24,19 @@ func TestBuildWitnessStartCommand_UsesRoleConfig(t *testing.T) {
 		StartCommand: "exec run --town {town} --rig {rig} --role {role}",
 	}
 
-	got, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "", roleConfig)
+	got, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "", "", roleConfig)
 	if err != nil {
 		t.Fatalf("buildWitnessStartCommand: %v", err)
 	}
 
-	want := "exec run --town /town --rig gastown --role witness"
+	want := "exec run --town '/town' --rig 'gastown' --role witness"
 	if got != want {
 		t.Errorf("buildWitnessStartCommand = %q, want %q", got, want)
 	}
 }
 
 func TestBuildWitnessStartCommand_DefaultsToRuntime(t *testing.T) {
-	got, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "", nil)
+	got, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "", "", nil)
 	if err != nil {
 		t.Fatalf("buildWitnessStartCommand: %v", err)
 	}
@@ -42,7 +54,7 @@ func TestBuildWitnessStartCommand_AgentOverrideWins(t *testing.T) {
 		StartCommand: "exec run --role {role}",
 	}
 
-	got, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "codex", roleConfig)
+	got, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "codex", "", roleConfig)
 	if err != nil {
 		t.Fatalf("buildWitnessStartCommand: %v", err)
 	}
@@ -53,3 +65,535 @@ func TestBuildWitnessStartCommand_AgentOverrideWins(t *testing.T) {
 		t.Errorf("expected GT_ROLE=witness in command, got %q", got)
 	}
 }
+
+func TestBuildWitnessStartCommand_AgentCommand(t *testing.T) {
+	roleConfig := &beads.RoleConfig{
+		StartCommand: "exec run --role {role}",
+	}
+
+	got, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "", `my-agent --rig "$GT_RIG"`, roleConfig)
+	if err != nil {
+		t.Fatalf("buildWitnessStartCommand: %v", err)
+	}
+	want := `export BD_ACTOR=gastown/witness GIT_AUTHOR_NAME=gastown/witness GT_RIG=gastown GT_ROLE=witness GT_ROOT=/town && my-agent --rig "$GT_RIG"`
+	if got != want {
+		t.Errorf("buildWitnessStartCommand = %q, want %q", got, want)
+	}
+
+	if _, err := buildWitnessStartCommand("/town/rig", "gastown", "/town", "codex", "my-agent", roleConfig); !errors.Is(err, errAgentConflict) {
+		t.Errorf("buildWitnessStartCommand with --agent and --agent-command = %v, want errAgentConflict", err)
+	}
+}
+
+func TestBuildWitnessStartCommand_QuotesRigName(t *testing.T) {
+	rigName := "evil;touch /tmp/pwned"
+	for _, agentCommand := range []string{"", "my-agent"} {
+		got, err := buildWitnessStartCommand("/town/rig", rigName, "/town", "", agentCommand, nil)
+		if err != nil {
+			t.Fatalf("buildWitnessStartCommand: %v", err)
+		}
+		if !strings.Contains(got, "GT_RIG='evil;touch /tmp/pwned'") {
+			t.Errorf("rig name not quoted in %q", got)
+		}
+	}
+}
+
+func TestBuildWitnessStartCommand_QuotesRolePattern(t *testing.T) {
+	roleConfig := &beads.RoleConfig{StartCommand: "exec run --town {town} --rig {rig}"}
+	got, err := buildWitnessStartCommand("/town/rig", "evil;touch /tmp/pwned", "/my town", "", "", roleConfig)
+	if err != nil {
+		t.Fatalf("buildWitnessStartCommand: %v", err)
+	}
+	want := "exec run --town '/my town' --rig 'evil;touch /tmp/pwned'"
+	if got != want {
+		t.Errorf("buildWitnessStartCommand = %q, want %q", got, want)
+	}
+}
+
+func TestValidateConfig_Thresholds(t *testing.T) {
+	if err := validateConfig(&WitnessConfig{IdleThreshold: 5 * time.Minute, StuckThreshold: 15 * time.Minute}); err != nil {
+		t.Errorf("validateConfig(valid thresholds) = %v", err)
+	}
+	if err := validateConfig(&WitnessConfig{IdleThreshold: 20 * time.Minute, StuckThreshold: 5 * time.Minute}); err == nil {
+		t.Error("validateConfig(stuck < idle) = nil, want error")
+	}
+	// Invalid values fall back to defaults rather than failing
+	if err := validateConfig(&WitnessConfig{IdleThreshold: -time.Minute}); err != nil {
+		t.Errorf("validateConfig(negative idle) = %v", err)
+	}
+}
+
+func TestManager_PauseResume(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	if err := mgr.Pause(); !errors.Is(err, ErrNotRunning) {
+		t.Fatalf("Pause() on stopped witness = %v, want ErrNotRunning", err)
+	}
+
+	w, err := mgr.loadState()
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	w.State = StateRunning
+	if err := mgr.saveState(w); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	if err := mgr.Pause(); err != nil {
+		t.Fatalf("Pause() = %v", err)
+	}
+	w, _ = mgr.loadState()
+	if w.State != StatePaused || w.PausedAt == nil {
+		t.Errorf("after Pause: state = %s, PausedAt = %v", w.State, w.PausedAt)
+	}
+	if got := w.QuietReason(time.Now()); got != "paused" {
+		t.Errorf("QuietReason() while paused = %q, want %q", got, "paused")
+	}
+	if err := mgr.Pause(); !errors.Is(err, ErrAlreadyPaused) {
+		t.Errorf("second Pause() = %v, want ErrAlreadyPaused", err)
+	}
+
+	if err := mgr.Resume(); err != nil {
+		t.Fatalf("Resume() = %v", err)
+	}
+	w, _ = mgr.loadState()
+	if w.State != StateRunning || w.PausedAt != nil {
+		t.Errorf("after Resume: state = %s, PausedAt = %v", w.State, w.PausedAt)
+	}
+	if err := mgr.Resume(); !errors.Is(err, ErrNotPaused) {
+		t.Errorf("second Resume() = %v, want ErrNotPaused", err)
+	}
+}
+
+// pausingNudger pauses the witness as it delivers a nudge, like a
+// gt witness pause landing while a check is in progress.
+type pausingNudger struct {
+	fakeNudger
+	mgr *Manager
+	err error
+}
+
+func (p *pausingNudger) Nudge(polecat, msg string) error {
+	p.err = p.mgr.Pause()
+	return p.fakeNudger.Nudge(polecat, msg)
+}
+
+func TestCheck_KeepsPauseFromMidCheck(t *testing.T) {
+	n := &pausingNudger{}
+	mgr := stuckPolecatManager(t, n)
+	n.mgr = mgr
+	if err := mgr.updateState(func(w *Witness) error {
+		w.State = StateRunning
+		return nil
+	}); err != nil {
+		t.Fatalf("updateState: %v", err)
+	}
+
+	if _, err := mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if n.err != nil {
+		t.Fatalf("Pause() mid-check = %v", n.err)
+	}
+	w, _ := mgr.loadState()
+	if w.State != StatePaused || w.PausedAt == nil {
+		t.Errorf("after check: state = %s, PausedAt = %v; the check overwrote the pause", w.State, w.PausedAt)
+	}
+	if w.LastCheckAt == nil || w.Stats.TotalNudges != 1 {
+		t.Errorf("after check: LastCheckAt = %v, TotalNudges = %d; the check's results were lost", w.LastCheckAt, w.Stats.TotalNudges)
+	}
+
+	// The next check sees the pause
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if pc := result.Polecats[0]; pc.Action != ActionSuppressed {
+		t.Errorf("check after pause: action = %q, want %q", pc.Action, ActionSuppressed)
+	}
+}
+
+func TestManager_Snapshot(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	w := &Witness{RigName: "testrig", State: StatePaused, Watched: []string{"toast"}}
+	w.Stats.PerPolecat = map[string]PolecatStats{"toast": {Checks: 3}}
+	if err := mgr.saveState(w); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	snap, err := mgr.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() = %v", err)
+	}
+	if snap.State != StatePaused || snap.Stats.PerPolecat["toast"].Checks != 3 {
+		t.Fatalf("Snapshot() = state %s, stats %+v", snap.State, snap.Stats.PerPolecat["toast"])
+	}
+
+	// Reconciling and scribbling on one snapshot touches nothing shared
+	snap.State = StateStopped
+	snap.Watched[0] = "nux"
+	snap.Stats.PerPolecat["toast"] = PolecatStats{Checks: 99}
+
+	again, err := mgr.Snapshot()
+	if err != nil {
+		t.Fatalf("second Snapshot() = %v", err)
+	}
+	if again.State != StatePaused || again.Watched[0] != "toast" || again.Stats.PerPolecat["toast"].Checks != 3 {
+		t.Errorf("second Snapshot() saw changes to the first: state %s, watched %v, checks %d",
+			again.State, again.Watched, again.Stats.PerPolecat["toast"].Checks)
+	}
+	if saved, _ := mgr.loadState(); saved.State != StatePaused {
+		t.Errorf("state file changed to %s through a snapshot", saved.State)
+	}
+}
+
+func TestManager_StartWhilePaused(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := mgr.Pause(); err != nil {
+		t.Fatalf("Pause: %v", err)
+	}
+	w, _ := mgr.loadState()
+	now := time.Now()
+	w.LastCheckAt = &now // loop is alive
+	if err := mgr.saveState(w); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	err := mgr.Start(true, "", nil)
+	if !errors.Is(err, ErrAlreadyRunning) {
+		t.Fatalf("Start() while paused = %v, want ErrAlreadyRunning", err)
+	}
+	var stateErr *StateError
+	if !errors.As(err, &stateErr) {
+		t.Fatalf("Start() while paused = %T, want *StateError", err)
+	}
+	if stateErr.Current != StatePaused || stateErr.Requested != StateRunning || stateErr.Rig != "testrig" {
+		t.Errorf("StateError = %+v, want paused -> running for testrig", stateErr)
+	}
+}
+
+func TestManager_StartHealsStaleRunningState(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	// bd stub: no role bead, so the agent command below is used as is
+	binDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte("#!/bin/sh\necho '[]'\n"), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	rigName := "staletest" + strconv.Itoa(os.Getpid())
+	mgr := NewManager(&rig.Rig{Name: rigName, Path: t.TempDir()})
+	mgr.SetAgentCommand("printf '> '; sleep 300") // shows the ready prompt
+	tm := tmux.NewTmux()
+	t.Cleanup(func() { _ = tm.KillSession(mgr.SessionName()) })
+
+	// The state file says running, but the session is gone
+	w, _ := mgr.loadState()
+	w.State = StateRunning
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+	if running, _ := tm.HasSession(mgr.SessionName()); running {
+		t.Fatalf("session %s exists before the test", mgr.SessionName())
+	}
+
+	if err := mgr.Start(false, "", nil); err != nil {
+		t.Fatalf("Start() with a stale running state = %v, want it to heal", err)
+	}
+	if running, _ := tm.HasSession(mgr.SessionName()); !running {
+		t.Error("Start() did not recreate the session")
+	}
+	if w, _ := mgr.loadState(); w.State != StateRunning {
+		t.Errorf("state = %s, want running", w.State)
+	}
+}
+
+func TestManager_StartForegroundAfterLoopSessionGone(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	// A loop that checked in just now from a session that no longer exists
+	w, _ := mgr.loadState()
+	now := time.Now()
+	w.State = StateRunning
+	w.Foreground = true
+	w.LastCheckAt = &now
+	w.LoopSession = "gt-witness-gone" + strconv.Itoa(os.Getpid())
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+	if w.LoopAlive(tmux.NewTmux(), now) {
+		t.Fatal("LoopAlive() = true for a loop whose session is gone")
+	}
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start() = %v, want the dead loop's state replaced", err)
+	}
+
+	// Without the session record the recent check-in keeps it running
+	w, _ = mgr.loadState()
+	w.LastCheckAt = &now
+	w.LoopSession = ""
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.Start(true, "", nil); !errors.Is(err, ErrAlreadyRunning) {
+		t.Errorf("Start() with a live loop = %v, want ErrAlreadyRunning", err)
+	}
+}
+
+func TestSessionError(t *testing.T) {
+	cause := errors.New("server exited")
+	err := error(&SessionError{Session: "gt-testrig-witness", Op: "creating", Err: cause})
+	if got, want := err.Error(), "creating session gt-testrig-witness: server exited"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("SessionError does not unwrap to its cause")
+	}
+}
+
+func TestManager_StopGraceful(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	w, _ := mgr.loadState()
+	now := time.Now()
+	w.LastCheckAt = &now
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+
+	// Stand-in for the loop: acknowledge the request the way Run's caller does
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for !mgr.stopRequested() {
+			time.Sleep(10 * time.Millisecond)
+		}
+		_ = mgr.Stop()
+	}()
+
+	if err := mgr.StopGraceful(5 * time.Second); err != nil {
+		t.Fatalf("StopGraceful() = %v", err)
+	}
+	<-done
+	w, _ = mgr.loadState()
+	if w.State != StateStopped || w.StopRequestedAt != nil {
+		t.Errorf("after StopGraceful: state = %s, StopRequestedAt = %v", w.State, w.StopRequestedAt)
+	}
+}
+
+func TestManager_StopGraceful_Timeout(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	w, _ := mgr.loadState()
+	now := time.Now()
+	w.LastCheckAt = &now
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nobody acknowledges: falls back to a forced stop
+	if err := mgr.StopGraceful(time.Millisecond); !errors.Is(err, ErrStopTimeout) {
+		t.Fatalf("StopGraceful() = %v, want ErrStopTimeout", err)
+	}
+	w, _ = mgr.loadState()
+	if w.State != StateStopped {
+		t.Errorf("state = %s, want stopped", w.State)
+	}
+	if w.StopRequestedAt == nil {
+		t.Error("forced stop cleared the stop request the loop hasn't seen")
+	}
+}
+
+func TestManager_RunExitsWhenStopped(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	mgr.SetOutput(io.Discard)
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := mgr.updateState(func(w *Witness) error {
+		now := time.Now()
+		w.StopRequestedAt = &now
+		return nil
+	}); err != nil {
+		t.Fatalf("updateState: %v", err)
+	}
+	// Stopped outright, as a timed-out graceful stop or stop --force does
+	if err := mgr.stop(0, true); err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- mgr.Run(context.Background()) }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run() = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() still looping after the witness was stopped")
+	}
+	if w, _ := mgr.loadState(); w.State != StateStopped || w.StopRequestedAt != nil {
+		t.Errorf("after Run: state = %s, StopRequestedAt = %v; want stopped, request answered", w.State, w.StopRequestedAt)
+	}
+}
+
+func TestManager_StopGraceful_NoLoop(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if err := mgr.StopGraceful(time.Second); !errors.Is(err, ErrNotRunning) {
+		t.Errorf("StopGraceful() on stopped witness = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestWaitSessionGone(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+
+	mgr := NewManager(&rig.Rig{Name: "waitgone" + strconv.Itoa(os.Getpid()), Path: t.TempDir()})
+	if err := mgr.WaitSessionGone(time.Second); err != nil {
+		t.Fatalf("WaitSessionGone with no session = %v, want nil", err)
+	}
+
+	tm := tmux.NewTmux()
+	if err := tm.NewSession(mgr.SessionName(), ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer func() { _ = tm.KillSession(mgr.SessionName()) }()
+
+	if err := mgr.WaitSessionGone(300 * time.Millisecond); !errors.Is(err, ErrSessionLingers) {
+		t.Errorf("WaitSessionGone with live session = %v, want ErrSessionLingers", err)
+	}
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		_ = tm.KillSession(mgr.SessionName())
+	}()
+	if err := mgr.WaitSessionGone(5 * time.Second); err != nil {
+		t.Errorf("WaitSessionGone after kill = %v, want nil", err)
+	}
+}
+
+func TestSessionLayout(t *testing.T) {
+	for _, layout := range []string{"", LayoutSingle, LayoutSplit} {
+		if err := ValidateLayout(layout); err != nil {
+			t.Errorf("ValidateLayout(%q): %v", layout, err)
+		}
+	}
+	if err := ValidateLayout("grid"); err == nil {
+		t.Error("ValidateLayout(grid) should fail")
+	}
+
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if got := mgr.sessionLayout(LayoutSingle, "agent"); len(got.Panes) != 1 {
+		t.Errorf("single layout has %d panes, want 1", len(got.Panes))
+	}
+	split := mgr.sessionLayout(LayoutSplit, "agent")
+	if len(split.Panes) != 2 || split.Panes[0].Command != "agent" {
+		t.Fatalf("split layout = %+v", split)
+	}
+	if watch := split.Panes[1].Command; !strings.Contains(watch, "GT_RIG=testrig") || !strings.HasSuffix(watch, `gt witness watch "$GT_RIG"`) {
+		t.Errorf("watch pane command = %q", watch)
+	}
+}
+
+func TestManager_ConcurrentStateWrites(t *testing.T) {
+	dir := t.TempDir()
+	// Separate managers stand in for separate gt invocations.
+	writers := []*Manager{
+		NewManager(&rig.Rig{Name: "testrig", Path: dir}),
+		NewManager(&rig.Rig{Name: "testrig", Path: dir}),
+		NewManager(&rig.Rig{Name: "testrig", Path: dir}),
+	}
+	if err := writers[0].saveState(&Witness{RigName: "testrig"}); err != nil {
+		t.Fatalf("saveState: %v", err)
+	}
+
+	const saves = 200
+	var wg sync.WaitGroup
+	errs := make(chan error, len(writers))
+	for i, mgr := range writers {
+		wg.Add(1)
+		go func(i int, mgr *Manager) {
+			defer wg.Done()
+			for n := 0; n < saves; n++ {
+				w := &Witness{RigName: "testrig", State: StateRunning}
+				// Vary the size so a torn write would show up as bad JSON
+				w.LastError = strings.Repeat("x", (n*(i+1))%500)
+				if err := mgr.saveState(w); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i, mgr)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	path := writers[0].stateFile()
+	reads := 0
+	for finished := false; !finished; reads++ {
+		select {
+		case <-done:
+			finished = true
+		default:
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read %d: %v", reads, err)
+		}
+		if !json.Valid(data) {
+			t.Fatalf("read %d saw malformed JSON: %q", reads, data)
+		}
+		if _, err := writers[0].loadState(); err != nil {
+			t.Fatalf("loadState during writes: %v", err)
+		}
+	}
+	close(errs)
+	for err := range errs {
+		t.Errorf("concurrent saveState: %v", err)
+	}
+
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temp file left behind: %v", err)
+	}
+}
+
+func TestManager_StartRejectsMalformedEnv(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if err := mgr.Start(true, "", []string{"API_KEY"}); err == nil || !strings.Contains(err.Error(), "KEY=VALUE") {
+		t.Fatalf("Start with --env API_KEY = %v, want KEY=VALUE error", err)
+	}
+	if w, _ := mgr.loadState(); w.State != StateStopped {
+		t.Errorf("state = %s after a rejected start, want stopped", w.State)
+	}
+}
+
+func TestExtraAgentEnv(t *testing.T) {
+	cfg := &WitnessConfig{Env: []string{"MODEL=haiku", "API_KEY=from-config"}}
+	env, err := extraAgentEnv(cfg, []string{"MODEL=sonnet"})
+	if err != nil {
+		t.Fatalf("extraAgentEnv: %v", err)
+	}
+	if env["MODEL"] != "sonnet" || env["API_KEY"] != "from-config" {
+		t.Errorf("env = %v, want the --env MODEL over the config's, config API_KEY kept", env)
+	}
+
+	if err := validateConfig(&WitnessConfig{Env: []string{"not valid=x"}}); err == nil {
+		t.Error("validateConfig accepted an env entry with an invalid name")
+	}
+}
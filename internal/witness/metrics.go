@@ -0,0 +1,164 @@
+package witness
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricsServer serves witness stats in the Prometheus text exposition
+// format on /metrics, for one rig or every rig in a witness group.
+type MetricsServer struct {
+	server   *http.Server
+	listener net.Listener
+}
+
+// NewMetricsServer starts serving metrics for managers on addr. The
+// address must be free; ":0" picks a random port (see Addr).
+func NewMetricsServer(addr string, managers ...*Manager) (*MetricsServer, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("metrics listener: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+		writeMetrics(&buf, managers)
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = w.Write(buf.Bytes())
+	})
+
+	s := &MetricsServer{
+		server: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			WriteTimeout:      30 * time.Second,
+		},
+		listener: ln,
+	}
+	go func() { _ = s.server.Serve(ln) }()
+	return s, nil
+}
+
+// Addr returns the address the server is listening on.
+func (s *MetricsServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close shuts the server down, letting in-flight scrapes finish.
+func (s *MetricsServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// metric is one metric family: its samples share a name, type and help.
+type metric struct {
+	name, typ, help string
+	samples         []sample
+}
+
+type sample struct {
+	labels string
+	value  float64
+}
+
+// writeMetrics renders the state of every manager's witness. A rig whose
+// state can't be read is reported down rather than failing the scrape.
+func writeMetrics(out io.Writer, managers []*Manager) {
+	up := &metric{name: "gastown_witness_up", typ: "gauge", help: "Whether the witness is running (1) or not (0)."}
+	checks := &metric{name: "gastown_witness_checks_total", typ: "counter", help: "Monitoring passes completed."}
+	nudges := &metric{name: "gastown_witness_nudges_total", typ: "counter", help: "Nudges sent to stuck polecats."}
+	escalations := &metric{name: "gastown_witness_escalations_total", typ: "counter", help: "Polecats escalated to the mayor."}
+	heldNudges := &metric{name: "gastown_witness_held_nudges_total", typ: "counter", help: "Nudges held back by the minimum nudge interval."}
+	wouldNudges := &metric{name: "gastown_witness_would_nudges_total", typ: "counter", help: "Nudges held back in dry-run mode."}
+	monitored := &metric{name: "gastown_witness_monitored_polecats", typ: "gauge", help: "Polecats monitored by the witness."}
+	lastCheck := &metric{name: "gastown_witness_last_check_timestamp_seconds", typ: "gauge", help: "Unix time of the last completed check."}
+	pcChecks := &metric{name: "gastown_witness_polecat_checks_total", typ: "counter", help: "Monitoring passes that checked the polecat."}
+	pcNudges := &metric{name: "gastown_witness_polecat_nudges_total", typ: "counter", help: "Nudges sent to the polecat."}
+	pcEscalations := &metric{name: "gastown_witness_polecat_escalations_total", typ: "counter", help: "Times the polecat was escalated to the mayor."}
+	pcConsecutive := &metric{name: "gastown_witness_polecat_consecutive_nudges", typ: "gauge", help: "Nudges since the polecat last made progress."}
+	pcLastActive := &metric{name: "gastown_witness_polecat_last_active_timestamp_seconds", typ: "gauge", help: "Unix time the polecat was last seen active."}
+
+	for _, m := range managers {
+		rigLabel := labels("rig", m.rig.Name)
+		w, err := m.loadState()
+		if err != nil {
+			up.add(rigLabel, 0)
+			continue
+		}
+
+		running := 0.0
+		if w.State == StateRunning {
+			running = 1
+		}
+		up.add(rigLabel, running)
+		checks.add(rigLabel, float64(w.Stats.TotalChecks))
+		nudges.add(rigLabel, float64(w.Stats.TotalNudges))
+		escalations.add(rigLabel, float64(w.Stats.TotalEscalations))
+		heldNudges.add(rigLabel, float64(w.Stats.TotalHeldNudges))
+		wouldNudges.add(rigLabel, float64(w.Stats.TotalWouldNudges))
+		monitored.add(rigLabel, float64(len(w.MonitoredPolecats)))
+		if w.LastCheckAt != nil {
+			lastCheck.add(rigLabel, float64(w.LastCheckAt.Unix()))
+		}
+
+		names := make([]string, 0, len(w.Stats.PerPolecat))
+		for name, ps := range w.Stats.PerPolecat {
+			if !ps.Stale {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			ps := w.Stats.PerPolecat[name]
+			pcLabel := labels("rig", m.rig.Name, "polecat", name)
+			pcChecks.add(pcLabel, float64(ps.Checks))
+			pcNudges.add(pcLabel, float64(ps.Nudges))
+			pcEscalations.add(pcLabel, float64(ps.Escalations))
+			pcConsecutive.add(pcLabel, float64(ps.ConsecutiveNudges))
+			if ps.LastActiveAt != nil {
+				pcLastActive.add(pcLabel, float64(ps.LastActiveAt.Unix()))
+			}
+		}
+	}
+
+	families := []*metric{
+		up, checks, nudges, escalations, heldNudges, wouldNudges, monitored, lastCheck,
+		pcChecks, pcNudges, pcEscalations, pcConsecutive, pcLastActive,
+	}
+	for _, f := range families {
+		if len(f.samples) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.typ)
+		for _, s := range f.samples {
+			_, _ = fmt.Fprintf(out, "%s%s %s\n", f.name, s.labels, strconv.FormatFloat(s.value, 'f', -1, 64))
+		}
+	}
+}
+
+func (f *metric) add(labels string, value float64) {
+	f.samples = append(f.samples, sample{labels: labels, value: value})
+}
+
+// labelEscaper escapes label values as the text exposition format requires.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labels renders name/value pairs as a Prometheus label set.
+func labels(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, pairs[i], labelEscaper.Replace(pairs[i+1])))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
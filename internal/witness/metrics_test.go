@@ -0,0 +1,88 @@
+package witness
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	w, _ := mgr.loadState()
+	checked := time.Unix(1767225600, 0)
+	w.State = StateRunning
+	w.MonitoredPolecats = []string{"Toast", "Nux"}
+	w.LastCheckAt = &checked
+	w.Stats.TotalChecks = 42
+	w.Stats.TotalNudges = 3
+	w.Stats.PerPolecat = map[string]PolecatStats{
+		"Toast": {Checks: 42, Nudges: 3, ConsecutiveNudges: 1},
+		"Gone":  {Checks: 5, Stale: true},
+	}
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	writeMetrics(&b, []*Manager{mgr})
+	out := b.String()
+
+	for _, want := range []string{
+		"# TYPE gastown_witness_up gauge\n",
+		`gastown_witness_up{rig="testrig"} 1` + "\n",
+		`gastown_witness_checks_total{rig="testrig"} 42` + "\n",
+		`gastown_witness_monitored_polecats{rig="testrig"} 2` + "\n",
+		`gastown_witness_last_check_timestamp_seconds{rig="testrig"} 1767225600` + "\n",
+		`gastown_witness_polecat_nudges_total{rig="testrig",polecat="Toast"} 3` + "\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("metrics missing %q\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, `polecat="Gone"`) {
+		t.Errorf("stale polecat exported:\n%s", out)
+	}
+}
+
+func TestLabels_Escapes(t *testing.T) {
+	got := labels("rig", `a"b\c`+"\nd")
+	want := `{rig="a\"b\\c\nd"}`
+	if got != want {
+		t.Errorf("labels() = %s, want %s", got, want)
+	}
+}
+
+func TestMetricsServer(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+
+	s, err := NewMetricsServer("127.0.0.1:0", mgr)
+	if err != nil {
+		t.Fatalf("NewMetricsServer: %v", err)
+	}
+
+	// The requested address is not replaced with a random one when taken
+	if _, err := NewMetricsServer(s.Addr(), mgr); err == nil {
+		t.Error("NewMetricsServer on a busy address succeeded")
+	}
+
+	resp, err := http.Get("http://" + s.Addr() + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), `gastown_witness_up{rig="testrig"} 0`) {
+		t.Errorf("stopped witness not reported down:\n%s", body)
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if _, err := http.Get("http://" + s.Addr() + "/metrics"); err == nil {
+		t.Error("server still serving after Close")
+	}
+}
@@ -0,0 +1,570 @@
+package witness
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Monitoring parameters for the foreground loop.
+const (
+	// DefaultCheckInterval is how often the loop checks polecats, unless
+	// the rig configures its own.
+	DefaultCheckInterval = time.Minute
+
+	// DefaultIdleThreshold is how long without pane activity before a
+	// polecat is idle, unless the rig configures its own.
+	DefaultIdleThreshold = 10 * time.Minute
+
+	// DefaultStuckThreshold is how long without pane activity before a
+	// polecat is stuck and gets nudged, unless the rig configures its own.
+	DefaultStuckThreshold = 30 * time.Minute
+
+	// paneSampleLines is how much of the pane is hashed to detect changes.
+	paneSampleLines = 50
+
+	// stopPollInterval is how often a waiting loop checks for a graceful
+	// stop request.
+	stopPollInterval = time.Second
+
+	// nudgeEchoGrace covers the pane activity caused by the nudge itself, so
+	// the nudge text appearing in the pane isn't mistaken for progress.
+	nudgeEchoGrace = time.Minute
+)
+
+// PolecatState is the monitor's classification of a polecat session.
+type PolecatState string
+
+const (
+	// PolecatActive means the pane showed activity recently.
+	PolecatActive PolecatState = "active"
+
+	// PolecatIdle means no activity for longer than the idle threshold.
+	PolecatIdle PolecatState = "idle"
+
+	// PolecatStuck means no activity for longer than the stuck threshold.
+	PolecatStuck PolecatState = "stuck"
+
+	// PolecatGone means the polecat has no tmux session.
+	PolecatGone PolecatState = "gone"
+
+	// PolecatAwaitingInput means a quiet polecat's pane shows a prompt
+	// waiting on a human, such as a permission question.
+	PolecatAwaitingInput PolecatState = "awaiting-input"
+
+	// PolecatInTool means a quiet polecat's pane shows a tool call still
+	// running.
+	PolecatInTool PolecatState = "in-tool"
+
+	// PolecatErrored means a quiet polecat's pane shows an agent error.
+	PolecatErrored PolecatState = "errored"
+)
+
+// PolecatStates lists every polecat state, in display order.
+var PolecatStates = []PolecatState{
+	PolecatActive, PolecatIdle, PolecatStuck, PolecatAwaitingInput, PolecatInTool, PolecatErrored, PolecatGone,
+}
+
+// Actions recorded in a PolecatCheck.
+const (
+	ActionNone       = "none"
+	ActionNudged     = "nudged"
+	ActionSuppressed = "suppressed"
+	ActionWouldNudge = "would-nudge"
+	ActionHeld       = "held"
+	ActionObserved   = "observe-only"
+	ActionOverBudget = "over-budget"
+	ActionWarmingUp  = "warming-up"
+)
+
+// PolecatCheck is the outcome of checking one polecat.
+type PolecatCheck struct {
+	Name         string        `json:"name"`
+	Session      string        `json:"session"`
+	State        PolecatState  `json:"state"`
+	LastActivity time.Time     `json:"last_activity,omitempty"`
+	IdleFor      time.Duration `json:"idle_for,omitempty"`
+	Action       string        `json:"action"`
+	Escalated    bool          `json:"escalated,omitempty"`
+	PaneMatch    string        `json:"pane_match,omitempty"`
+	Reason       string        `json:"reason,omitempty"`
+	Error        string        `json:"error,omitempty"`
+}
+
+// PaneSample remembers a polecat's pane content hash between checks so a
+// changing pane counts as activity even when tmux activity times lag.
+type PaneSample struct {
+	Hash      string    `json:"hash"`
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+}
+
+// CheckResult records what a single monitoring pass observed and did.
+type CheckResult struct {
+	CheckedAt time.Time      `json:"checked_at"`
+	Quiet     string         `json:"quiet,omitempty"`
+	Polecats  []PolecatCheck `json:"polecats"`
+
+	// Interval is how long the loop waits before its next check.
+	Interval time.Duration `json:"interval,omitempty"`
+}
+
+// Run executes the monitoring loop until ctx is cancelled, a graceful stop
+// is requested or the witness is stopped outright, waiting the interval
+// each check returns (see nextInterval) between passes. A check in
+// progress always runs to completion. Each pass is printed to the
+// manager's output as a one-line summary.
+func (m *Manager) Run(ctx context.Context) error {
+	poll := time.NewTicker(stopPollInterval)
+	defer poll.Stop()
+
+	interval := DefaultCheckInterval
+	for {
+		result, err := m.Check()
+		if err != nil {
+			_, _ = fmt.Fprintf(m.output, "%s check failed: %v\n", time.Now().Format("15:04:05"), err)
+		} else {
+			m.report(result)
+			interval = result.Interval
+		}
+
+		next := time.NewTimer(interval)
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				next.Stop()
+				return nil
+			case <-poll.C:
+				m.pollPauseState()
+				if reason := m.loopStopReason(); reason != "" {
+					_, _ = fmt.Fprintf(m.output, "%s %s\n", time.Now().Format("15:04:05"), reason)
+					next.Stop()
+					return m.ackStop()
+				}
+				if m.checkRequested() {
+					break wait
+				}
+			case <-next.C:
+				break wait
+			}
+		}
+		next.Stop()
+	}
+}
+
+// Check performs a single monitoring pass over the rig's polecats:
+// classify each by pane activity, nudge stuck ones, and update stats.
+func (m *Manager) Check() (*CheckResult, error) {
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	w.Stats.rollover(now)
+	m.notePauseState(w.State, now)
+	m.emit(Event{Type: EventCheckStarted, Time: now})
+
+	result := &CheckResult{
+		CheckedAt: now,
+		Quiet:     w.QuietReason(now),
+	}
+
+	if w.PaneSamples == nil {
+		w.PaneSamples = make(map[string]PaneSample)
+	}
+	if w.Stats.PerPolecat == nil {
+		w.Stats.PerPolecat = make(map[string]PolecatStats)
+	}
+
+	idle, stuck := w.Config.Thresholds()
+	nudgeTmpl := m.nudgeTemplate(&w.Config)
+	patterns := m.panePatterns(&w.Config)
+	t := m.tmux
+	polecats, err := m.monitoredPolecats(w)
+	if err != nil {
+		_, _ = fmt.Fprintf(m.output, "warning: %v\n", err)
+	}
+	// One listing for the whole pass, not a query per polecat: against a
+	// remote tmux server every call is an ssh round trip
+	sessions, err := t.ListSessionInfo()
+	if err != nil {
+		_, _ = fmt.Fprintf(m.output, "warning: listing sessions: %v\n", err)
+	}
+	for _, name := range polecats {
+		pc, sample := m.classify(t, sessions, name, now, w.PaneSamples[name], idle, stuck, patterns)
+		if sample.Hash != "" {
+			w.PaneSamples[name] = sample
+		}
+
+		ps := w.Stats.PerPolecat[name]
+		ps.noteSessionStart(t, pc, w.Config.StartupGrace, now)
+		ps.Checks++
+		ps.Today.Checks++
+		ps.Stale = false
+		if ps.LastState != pc.State {
+			m.emit(Event{Type: EventPolecatStateChanged, Time: now, Polecat: name, From: ps.LastState, State: pc.State})
+		}
+		ps.LastState = pc.State
+		if pc.State == PolecatActive {
+			seen := now
+			if !pc.LastActivity.IsZero() {
+				seen = pc.LastActivity
+			}
+			ps.LastActiveAt = &seen
+		}
+
+		if pc.State == PolecatStuck {
+			wait := ps.nudgeWait(now, w.Config.NudgeInterval())
+			overBudget, budgetWait := w.NudgeBudgetExhausted(now)
+			switch {
+			case w.Config.ObserveOnly(name):
+				pc.Action = ActionObserved
+				pc.Reason = w.Config.observeOnlyReason(name)
+			case ps.WarmupLeft(now, w.Config.StartupGrace) > 0:
+				pc.Action = ActionWarmingUp
+				pc.Reason = fmt.Sprintf("no activity for %s; warming up (%s left)",
+					pc.IdleFor.Round(time.Second), ps.WarmupLeft(now, w.Config.StartupGrace).Round(time.Second))
+			case result.Quiet != "":
+				pc.Action = ActionSuppressed
+				pc.Reason = fmt.Sprintf("quiet (%s)", result.Quiet)
+			case wait > 0:
+				// Nudged too recently; give the last nudge time to land
+				pc.Action = ActionHeld
+				pc.Reason = fmt.Sprintf("no activity for %s; last nudged %s ago, next nudge in %s",
+					pc.IdleFor.Round(time.Second), now.Sub(*ps.LastNudgeAt).Round(time.Second), wait.Round(time.Second))
+				w.Stats.recordHeldNudge(&ps)
+			case w.DryRun:
+				msg := renderNudge(nudgeTmpl, NudgeData{Polecat: name, Rig: m.rig.Name, IdleFor: pc.IdleFor})
+				pc.Action = ActionWouldNudge
+				pc.Reason = fmt.Sprintf("dry run: no activity for %s; would send %q", pc.IdleFor.Round(time.Second), msg)
+				w.Stats.recordWouldNudge(&ps)
+				if limit := w.Config.EscalationLimit(); ps.WouldStreak == limit {
+					ps.WouldEscalations++
+					w.Stats.TotalWouldEscalations++
+					pc.Reason += fmt.Sprintf("; would escalate to mayor after %d nudges", limit)
+				}
+			case overBudget:
+				// The rig's budget caps nudges only: held nudges still count
+				// toward the escalation threshold
+				pc.Action = ActionOverBudget
+				pc.Reason = fmt.Sprintf("no activity for %s; rig nudge budget (%d per hour) exhausted, resets in %s",
+					pc.IdleFor.Round(time.Second), w.Config.RigNudgeBudget, budgetWait.Round(time.Second))
+				w.Stats.recordBudgetHeldNudge(&ps, now)
+				m.escalateIfDue(w, &ps, &pc, now)
+				if pc.Escalated {
+					m.emit(Event{Type: EventEscalated, Time: now, Polecat: name, State: pc.State, Reason: pc.Reason, Nudges: ps.ConsecutiveNudges})
+				}
+			default:
+				msg := renderNudge(nudgeTmpl, NudgeData{Polecat: name, Rig: m.rig.Name, IdleFor: pc.IdleFor})
+				if err := m.nudger.Nudge(name, msg); err != nil {
+					pc.Error = err.Error()
+					break
+				}
+				pc.Action = ActionNudged
+				pc.Reason = fmt.Sprintf("no activity for %s", pc.IdleFor.Round(time.Second))
+				w.Stats.TotalNudges++
+				w.Stats.TodayNudges++
+				w.recordRigNudge(now)
+
+				ps.recordNudge(now)
+				m.escalateIfDue(w, &ps, &pc, now)
+				m.emit(Event{Type: EventNudged, Time: now, Polecat: name, State: pc.State, Reason: pc.Reason, Nudges: ps.ConsecutiveNudges})
+				if pc.Escalated {
+					m.emit(Event{Type: EventEscalated, Time: now, Polecat: name, State: pc.State, Reason: pc.Reason, Nudges: ps.ConsecutiveNudges})
+				}
+			}
+		} else if ps.LastNudgeAt != nil && pc.LastActivity.After(ps.LastNudgeAt.Add(nudgeEchoGrace)) {
+			// Real progress since the last nudge - start counting afresh
+			ps.resetNudges()
+		}
+		if pc.State == PolecatActive || pc.State == PolecatIdle {
+			// No nudge was sent in a dry run, so any activity is progress
+			ps.WouldStreak = 0
+		}
+		ps.recordAction(pc, now)
+		w.Stats.PerPolecat[name] = ps
+		m.notifyAction(pc, now)
+
+		result.Polecats = append(result.Polecats, pc)
+	}
+
+	// The log is a debugging aid; a write failure shouldn't stop monitoring
+	if err := m.writeCheckLog(m.logPath(&w.Config), logMaxSize(&w.Config), result); err != nil {
+		_, _ = fmt.Fprintf(m.output, "warning: witness log: %v\n", err)
+	}
+
+	w.CurrentInterval = nextInterval(&w.Config, w.CurrentInterval, result)
+	result.Interval = w.CurrentInterval
+
+	w.Stats.TotalChecks++
+	w.Stats.TodayChecks++
+	w.Stats.markStale(polecats)
+	w.LastCheckAt = &now
+	w.MonitoredPolecats = polecats
+
+	// The check took a while; operator commands may have saved since
+	if err := m.updateState(func(cur *Witness) error {
+		cur.mergeCheck(w)
+		return nil
+	}); err != nil {
+		return result, fmt.Errorf("saving state: %w", err)
+	}
+	return result, nil
+}
+
+// mergeCheck copies what a check records from checked, the state the
+// check started from, into w, the state on disk now. Everything else is
+// left as it is on disk: a pause, resume or stop that landed while the
+// check ran stands. The check answers check requests made before it
+// started; one made while it ran gets a check of its own.
+func (w *Witness) mergeCheck(checked *Witness) {
+	if w.CheckRequestedAt != nil && !w.CheckRequestedAt.After(*checked.LastCheckAt) {
+		w.CheckRequestedAt = nil
+	}
+	// A reset-stats made meanwhile wins over the check's counts
+	if !w.Stats.resetSince(&checked.Stats) {
+		w.Stats = checked.Stats
+	}
+	w.PaneSamples = checked.PaneSamples
+	w.RigNudges = checked.RigNudges
+	w.DeliveryErrors = checked.DeliveryErrors
+	w.CurrentInterval = checked.CurrentInterval
+	w.LastCheckAt = checked.LastCheckAt
+	w.DiscoveredPolecats = checked.DiscoveredPolecats
+	// A watch-add or watch-remove made meanwhile still applies
+	w.MonitoredPolecats = applyWatchList(checked.MonitoredPolecats, w)
+	w.Stats.markStale(w.MonitoredPolecats)
+}
+
+// recordAction keeps the action a check took about the polecat as its
+// last action. Checks that did nothing leave the previous one in place.
+func (ps *PolecatStats) recordAction(pc PolecatCheck, now time.Time) {
+	if pc.Action == "" || pc.Action == ActionNone {
+		return
+	}
+	ps.LastAction = &PolecatAction{Action: pc.Action, At: now, Reason: pc.Reason}
+}
+
+// classify determines a polecat's state from its tmux session activity and
+// whether its pane content changed since the previous sample, against the
+// given idle and stuck thresholds. A polecat that has gone quiet is then
+// checked against the pane patterns: one waiting on a human, running a
+// tool or showing an error is reported as such and never nudged.
+// sessions is the pass's session listing; a polecat missing from it is gone.
+// Returns the check and the updated pane sample to persist.
+func (m *Manager) classify(t tmux.Client, sessions map[string]*tmux.SessionInfo, name string, now time.Time, prev PaneSample, idle, stuck time.Duration, patterns []panePattern) (PolecatCheck, PaneSample) {
+	pc := PolecatCheck{
+		Name:    name,
+		Session: session.PolecatSessionName(m.rig.Name, name),
+		Action:  ActionNone,
+	}
+
+	info, ok := sessions[pc.Session]
+	if !ok {
+		pc.State = PolecatGone
+		return pc, PaneSample{}
+	}
+
+	sample := prev
+	content, err := t.CapturePane(pc.Session, paneSampleLines)
+	if err == nil {
+		sample = nextPaneSample(prev, content, now)
+	}
+
+	last, ok := parseTmuxTime(info.Activity)
+	if sample.ChangedAt.After(last) {
+		last, ok = sample.ChangedAt, true
+	}
+	if !ok {
+		// No activity timestamp (older tmux) and no pane history - don't guess
+		pc.State = PolecatActive
+		return pc, sample
+	}
+	pc.LastActivity = last
+	pc.IdleFor = now.Sub(last)
+	pc.State = classifyIdle(pc.IdleFor, idle, stuck)
+	if pc.State != PolecatActive && content != "" {
+		if state, match := matchPaneState(content, patterns); state != "" {
+			pc.State, pc.PaneMatch = state, match
+		}
+	}
+	return pc, sample
+}
+
+// nextPaneSample hashes pane content and records when it last changed.
+// The first sample has no ChangedAt: a pane we've never seen before is not
+// evidence of activity.
+func nextPaneSample(prev PaneSample, content string, now time.Time) PaneSample {
+	sum := sha256.Sum256([]byte(content))
+	hash := hex.EncodeToString(sum[:8])
+	if hash == prev.Hash {
+		return prev
+	}
+	next := PaneSample{Hash: hash}
+	if prev.Hash != "" {
+		next.ChangedAt = now
+	}
+	return next
+}
+
+// classifyIdle maps time-since-activity onto a polecat state.
+func classifyIdle(idleFor, idle, stuck time.Duration) PolecatState {
+	switch {
+	case idleFor >= stuck:
+		return PolecatStuck
+	case idleFor >= idle:
+		return PolecatIdle
+	default:
+		return PolecatActive
+	}
+}
+
+// parseTmuxTime parses a tmux time format variable (seconds since epoch).
+func parseTmuxTime(s string) (time.Time, bool) {
+	secs, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || secs <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, 0), true
+}
+
+// Thresholds returns the idle and stuck thresholds in effect. Unset, zero
+// or negative values fall back to the defaults so a bad config can't make
+// the witness nudge on every pass.
+func (c *WitnessConfig) Thresholds() (idle, stuck time.Duration) {
+	idle, stuck = c.IdleThreshold, c.StuckThreshold
+	if idle <= 0 {
+		idle = DefaultIdleThreshold
+	}
+	if stuck <= 0 {
+		stuck = DefaultStuckThreshold
+	}
+	return idle, stuck
+}
+
+// rollover resets the Today* counters when the local date changes, after
+// saving the finished day in the history.
+func (s *WitnessStats) rollover(now time.Time) {
+	today := now.Format(dateLayout)
+	if s.StatsDate == today {
+		return
+	}
+	if s.StatsDate != "" && s.StatsDate < today {
+		s.archiveDay()
+	}
+	s.TodayChecks = 0
+	s.TodayNudges = 0
+	s.TodayWouldNudges = 0
+	s.TodayHeldNudges = 0
+	s.TodayEscalations = 0
+	for name, ps := range s.PerPolecat {
+		ps.Today = PolecatDay{}
+		s.PerPolecat[name] = ps
+	}
+	s.StatsDate = today
+}
+
+// markStale flags per-polecat stats for polecats no longer monitored.
+// Their history is kept rather than deleted.
+func (s *WitnessStats) markStale(monitored []string) {
+	current := make(map[string]bool, len(monitored))
+	for _, name := range monitored {
+		current[name] = true
+	}
+	for name, ps := range s.PerPolecat {
+		if !current[name] && !ps.Stale {
+			ps.Stale = true
+			s.PerPolecat[name] = ps
+		}
+	}
+}
+
+// report prints a one-line summary of a check.
+func (m *Manager) report(r *CheckResult) {
+	counts := make(map[PolecatState]int)
+	nudged, held, overBudget, warming, wouldNudge := 0, 0, 0, 0, 0
+	for _, pc := range r.Polecats {
+		counts[pc.State]++
+		switch pc.Action {
+		case ActionNudged:
+			nudged++
+		case ActionHeld:
+			held++
+		case ActionOverBudget:
+			overBudget++
+		case ActionWarmingUp:
+			warming++
+		case ActionWouldNudge:
+			wouldNudge++
+			_, _ = fmt.Fprintf(m.output, "  %s: %s\n", pc.Name, pc.Reason)
+		}
+		if pc.Error != "" {
+			_, _ = fmt.Fprintf(m.output, "  %s: %s\n", pc.Name, pc.Error)
+		}
+	}
+	prefix := r.CheckedAt.Format("15:04:05")
+	if m.group != "" {
+		prefix += fmt.Sprintf(" [%s]", m.rig.Name)
+	}
+	line := fmt.Sprintf("%s checked %d polecats: %d active, %d idle, %d stuck, %d gone",
+		prefix, len(r.Polecats),
+		counts[PolecatActive], counts[PolecatIdle], counts[PolecatStuck], counts[PolecatGone])
+	for _, state := range []PolecatState{PolecatAwaitingInput, PolecatInTool, PolecatErrored} {
+		if counts[state] > 0 {
+			line += fmt.Sprintf(", %d %s", counts[state], state)
+		}
+	}
+	line += fmt.Sprintf("; %d nudged", nudged)
+	if held > 0 {
+		line += fmt.Sprintf(", %d held", held)
+	}
+	if overBudget > 0 {
+		line += fmt.Sprintf(", %d over budget", overBudget)
+	}
+	if warming > 0 {
+		line += fmt.Sprintf(", %d warming up", warming)
+	}
+	if wouldNudge > 0 {
+		line += fmt.Sprintf(", %d would nudge", wouldNudge)
+	}
+	if r.Quiet != "" {
+		line += fmt.Sprintf(" [quiet (%s)]", r.Quiet)
+	}
+	_, _ = fmt.Fprintln(m.output, line)
+}
+
+// QuietReason returns why nudges are suppressed at t, or "" when the
+// witness may act: an operator pause takes precedence over the config.
+func (w *Witness) QuietReason(t time.Time) string {
+	if w.State == StatePaused {
+		return "paused"
+	}
+	return w.Config.QuietReason(t)
+}
+
+// LoopAlive reports whether a foreground monitoring loop has checked in
+// recently enough to be considered running. A loop that ran in a tmux
+// session is dead once t says the session is gone, however recent its
+// check-in: that is how a killed witness group's state is told apart from
+// a live one.
+func (w *Witness) LoopAlive(t tmux.Client, now time.Time) bool {
+	if !w.Foreground || w.LastCheckAt == nil {
+		return false
+	}
+	if now.Sub(*w.LastCheckAt) >= w.loopStaleAfter() {
+		return false
+	}
+	if w.LoopSession != "" {
+		if running, err := t.HasSession(w.LoopSession); err == nil && !running {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,215 @@
+package witness
+
+import (
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux/tmuxtest"
+)
+
+func TestClassifyIdle(t *testing.T) {
+	tests := []struct {
+		idleFor time.Duration
+		want    PolecatState
+	}{
+		{0, PolecatActive},
+		{9 * time.Minute, PolecatActive},
+		{10 * time.Minute, PolecatIdle},
+		{29 * time.Minute, PolecatIdle},
+		{30 * time.Minute, PolecatStuck},
+		{2 * time.Hour, PolecatStuck},
+	}
+	for _, tt := range tests {
+		if got := classifyIdle(tt.idleFor, DefaultIdleThreshold, DefaultStuckThreshold); got != tt.want {
+			t.Errorf("classifyIdle(%v) = %q, want %q", tt.idleFor, got, tt.want)
+		}
+	}
+}
+
+func TestParseTmuxTime(t *testing.T) {
+	got, ok := parseTmuxTime("1767225600")
+	if !ok || !got.Equal(time.Unix(1767225600, 0)) {
+		t.Errorf("parseTmuxTime() = %v, %v", got, ok)
+	}
+	for _, bad := range []string{"", "0", "yesterday"} {
+		if _, ok := parseTmuxTime(bad); ok {
+			t.Errorf("parseTmuxTime(%q) should fail", bad)
+		}
+	}
+}
+
+func TestWitnessStats_Rollover(t *testing.T) {
+	day1 := time.Date(2026, 3, 1, 23, 59, 0, 0, time.Local)
+	day2 := day1.Add(2 * time.Minute)
+
+	s := WitnessStats{TotalChecks: 10, TodayChecks: 4, TodayNudges: 2}
+	s.rollover(day1)
+	if s.TodayChecks != 0 || s.StatsDate != "2026-03-01" {
+		t.Fatalf("first rollover should reset undated counters, got %+v", s)
+	}
+
+	s.TodayChecks, s.TodayNudges = 3, 1
+	s.rollover(day1)
+	if s.TodayChecks != 3 {
+		t.Errorf("same-day rollover reset counters: %+v", s)
+	}
+
+	s.rollover(day2)
+	if s.TodayChecks != 0 || s.TodayNudges != 0 || s.StatsDate != "2026-03-02" {
+		t.Errorf("midnight rollover = %+v", s)
+	}
+	if s.TotalChecks != 10 {
+		t.Errorf("rollover touched totals: TotalChecks = %d", s.TotalChecks)
+	}
+}
+
+func TestWitness_LoopAlive(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-DefaultCheckInterval)
+	stale := now.Add(-10 * DefaultCheckInterval)
+
+	tests := []struct {
+		name string
+		w    Witness
+		want bool
+	}{
+		{"background", Witness{LastCheckAt: &recent}, false},
+		{"foreground never checked", Witness{Foreground: true}, false},
+		{"foreground recent", Witness{Foreground: true, LastCheckAt: &recent}, true},
+		{"foreground stale", Witness{Foreground: true, LastCheckAt: &stale}, false},
+		{"loop session up", Witness{Foreground: true, LastCheckAt: &recent, LoopSession: "gt-witness-all"}, true},
+		{"loop session gone", Witness{Foreground: true, LastCheckAt: &recent, LoopSession: "gt-witness-gone"}, false},
+	}
+	sessions := tmuxtest.NewFakeTmux("gt-witness-all")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.w.LoopAlive(sessions, now); got != tt.want {
+				t.Errorf("LoopAlive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextPaneSample(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+
+	first := nextPaneSample(PaneSample{}, "hello", t0)
+	if first.Hash == "" {
+		t.Fatal("first sample has no hash")
+	}
+	if !first.ChangedAt.IsZero() {
+		t.Errorf("first sample ChangedAt = %v, want zero", first.ChangedAt)
+	}
+
+	same := nextPaneSample(first, "hello", t1)
+	if same != first {
+		t.Errorf("unchanged pane sample = %+v, want %+v", same, first)
+	}
+
+	changed := nextPaneSample(first, "hello world", t1)
+	if changed.Hash == first.Hash {
+		t.Error("changed pane kept the same hash")
+	}
+	if !changed.ChangedAt.Equal(t1) {
+		t.Errorf("changed pane ChangedAt = %v, want %v", changed.ChangedAt, t1)
+	}
+}
+
+func TestWitnessConfig_Thresholds(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       WitnessConfig
+		wantIdle  time.Duration
+		wantStuck time.Duration
+	}{
+		{"unset", WitnessConfig{}, DefaultIdleThreshold, DefaultStuckThreshold},
+		{"configured", WitnessConfig{IdleThreshold: 5 * time.Minute, StuckThreshold: 15 * time.Minute}, 5 * time.Minute, 15 * time.Minute},
+		{"negative falls back", WitnessConfig{IdleThreshold: -time.Minute, StuckThreshold: -time.Second}, DefaultIdleThreshold, DefaultStuckThreshold},
+		{"partial", WitnessConfig{StuckThreshold: time.Hour}, DefaultIdleThreshold, time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idle, stuck := tt.cfg.Thresholds()
+			if idle != tt.wantIdle || stuck != tt.wantStuck {
+				t.Errorf("Thresholds() = (%s, %s), want (%s, %s)", idle, stuck, tt.wantIdle, tt.wantStuck)
+			}
+		})
+	}
+}
+
+func TestWitnessStats_MarkStale(t *testing.T) {
+	s := WitnessStats{PerPolecat: map[string]PolecatStats{
+		"toast": {Checks: 5},
+		"nux":   {Checks: 3, Nudges: 2},
+	}}
+
+	s.markStale([]string{"toast"})
+
+	if s.PerPolecat["toast"].Stale {
+		t.Error("monitored polecat marked stale")
+	}
+	nux, ok := s.PerPolecat["nux"]
+	if !ok {
+		t.Fatal("stats for unmonitored polecat were deleted")
+	}
+	if !nux.Stale {
+		t.Error("unmonitored polecat not marked stale")
+	}
+	if nux.Checks != 3 || nux.Nudges != 2 {
+		t.Errorf("stale stats changed: %+v", nux)
+	}
+}
+
+func TestPolecatStats_RecordAction(t *testing.T) {
+	t1 := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+	var ps PolecatStats
+
+	ps.recordAction(PolecatCheck{Action: ActionNudged, Reason: "no activity for 31m0s"}, t1)
+	if a := ps.LastAction; a == nil || a.Action != ActionNudged || !a.At.Equal(t1) || a.Reason != "no activity for 31m0s" {
+		t.Fatalf("after nudge LastAction = %+v", a)
+	}
+
+	// A pass that does nothing keeps the last action
+	ps.recordAction(PolecatCheck{Action: ActionNone}, t2)
+	if a := ps.LastAction; a.Action != ActionNudged || !a.At.Equal(t1) {
+		t.Errorf("after no-op LastAction = %+v, want the nudge kept", a)
+	}
+
+	ps.recordAction(PolecatCheck{Action: ActionHeld, Reason: "next nudge in 4m"}, t2)
+	if a := ps.LastAction; a.Action != ActionHeld || !a.At.Equal(t2) {
+		t.Errorf("after hold LastAction = %+v", a)
+	}
+}
+
+func TestCheck_ThroughInjectedTmux(t *testing.T) {
+	f := tmuxtest.NewFakeTmux()
+	sess := session.PolecatSessionName("testrig", "toast")
+	if err := f.NewSession(sess, "/"); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	f.Session(sess).Activity = strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	mgr.SetTmux(f)
+	mgr.SetOutput(io.Discard)
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(result.Polecats) != 1 || result.Polecats[0].State != PolecatStuck || result.Polecats[0].Action != ActionNudged {
+		t.Fatalf("polecats = %+v, want toast stuck and nudged", result.Polecats)
+	}
+	// The default nudger follows the injected client
+	if pane := f.Session(sess).Pane; pane == "" {
+		t.Error("nudge never reached the fake session")
+	}
+}
@@ -0,0 +1,74 @@
+package witness
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DefaultNudgeTemplate is the nudge sent to stuck polecats unless the rig
+// configures its own.
+const DefaultNudgeTemplate = "Witness check-in: no activity for {{.IdleFor}}. Continue your hooked work (`gt hook`), " +
+	"or if you're blocked, mail {{.Rig}}/witness with HELP in the subject."
+
+// defaultNudgeTmpl is the parsed DefaultNudgeTemplate.
+var defaultNudgeTmpl = template.Must(template.New("nudge").Parse(DefaultNudgeTemplate))
+
+// NudgeData is the data available to a nudge template.
+type NudgeData struct {
+	// Polecat is the name of the polecat being nudged.
+	Polecat string
+
+	// Rig is the rig name.
+	Rig string
+
+	// IdleFor is how long the polecat has been inactive, rounded to the minute.
+	IdleFor time.Duration
+}
+
+// parseNudgeTemplate parses a nudge template, using the default for "".
+func parseNudgeTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return defaultNudgeTmpl, nil
+	}
+	tmpl, err := template.New("nudge").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	// Execute against sample data so references to unknown fields fail
+	// here rather than on the first nudge.
+	if err := tmpl.Execute(&strings.Builder{}, NudgeData{}); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// nudgeTemplate returns the parsed nudge template for cfg, cached on the
+// manager until the configured text changes. A template that fails to
+// parse falls back to the default; Start and UpdateConfig reject such
+// templates up front, so this only covers a hand-edited state file.
+func (m *Manager) nudgeTemplate(cfg *WitnessConfig) *template.Template {
+	if m.nudgeTmpl != nil && m.nudgeTmplText == cfg.NudgeTemplate {
+		return m.nudgeTmpl
+	}
+	tmpl, err := parseNudgeTemplate(cfg.NudgeTemplate)
+	if err != nil {
+		_, _ = fmt.Fprintf(m.output, "warning: invalid nudge template, using default: %v\n", err)
+		tmpl = defaultNudgeTmpl
+	}
+	m.nudgeTmpl, m.nudgeTmplText = tmpl, cfg.NudgeTemplate
+	return tmpl
+}
+
+// renderNudge builds the text sent to a stuck polecat, falling back to the
+// default template if the configured one fails to execute.
+func renderNudge(tmpl *template.Template, data NudgeData) string {
+	data.IdleFor = data.IdleFor.Round(time.Minute)
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		b.Reset()
+		_ = defaultNudgeTmpl.Execute(&b, data)
+	}
+	return b.String()
+}
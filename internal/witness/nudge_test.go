@@ -0,0 +1,63 @@
+package witness
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestRenderNudge_Default(t *testing.T) {
+	tmpl, err := parseNudgeTemplate("")
+	if err != nil {
+		t.Fatalf("parseNudgeTemplate(\"\"): %v", err)
+	}
+	got := renderNudge(tmpl, NudgeData{Polecat: "toast", Rig: "greenplace", IdleFor: 44*time.Minute + 40*time.Second})
+	for _, want := range []string{"no activity for 45m0s", "greenplace/witness", "gt hook"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("default nudge %q missing %q", got, want)
+		}
+	}
+}
+
+func TestRenderNudge_Custom(t *testing.T) {
+	tmpl, err := parseNudgeTemplate("{{.Polecat}}@{{.Rig}}: idle {{.IdleFor}}, check bd ready")
+	if err != nil {
+		t.Fatalf("parseNudgeTemplate: %v", err)
+	}
+	got := renderNudge(tmpl, NudgeData{Polecat: "toast", Rig: "greenplace", IdleFor: 30 * time.Minute})
+	if want := "toast@greenplace: idle 30m0s, check bd ready"; got != want {
+		t.Errorf("renderNudge() = %q, want %q", got, want)
+	}
+}
+
+func TestParseNudgeTemplate_Invalid(t *testing.T) {
+	for _, text := range []string{"{{.Polecat", "{{.Unknown}}"} {
+		if _, err := parseNudgeTemplate(text); err == nil {
+			t.Errorf("parseNudgeTemplate(%q) = nil error, want error", text)
+		}
+	}
+	if err := validateConfig(&WitnessConfig{NudgeTemplate: "{{.Nope}}"}); err == nil {
+		t.Error("validateConfig accepted an invalid nudge template")
+	}
+}
+
+func TestManager_NudgeTemplateFallback(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	mgr.SetOutput(io.Discard)
+
+	if got := mgr.nudgeTemplate(&WitnessConfig{NudgeTemplate: "{{.Broken"}); got != defaultNudgeTmpl {
+		t.Error("invalid template did not fall back to the default")
+	}
+
+	cfg := &WitnessConfig{NudgeTemplate: "hi {{.Polecat}}"}
+	first := mgr.nudgeTemplate(cfg)
+	if first == defaultNudgeTmpl {
+		t.Fatal("valid template replaced by default")
+	}
+	if mgr.nudgeTemplate(cfg) != first {
+		t.Error("template not cached between calls")
+	}
+}
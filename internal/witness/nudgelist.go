@@ -0,0 +1,53 @@
+package witness
+
+import (
+	"fmt"
+	"path"
+)
+
+// ObserveOnly reports whether the witness must leave the polecat alone:
+// it is still checked and counted, but never nudged or escalated. A
+// polecat matching the nudge denylist is observe-only, as is one missing
+// from a non-empty nudge allowlist; the denylist wins when both match.
+// Patterns are shell globs on the polecat name, as in path.Match.
+func (c *WitnessConfig) ObserveOnly(polecat string) bool {
+	if matchesAny(c.NudgeDenylist, polecat) {
+		return true
+	}
+	return len(c.NudgeAllowlist) > 0 && !matchesAny(c.NudgeAllowlist, polecat)
+}
+
+// observeOnlyReason says which list makes a polecat observe-only.
+func (c *WitnessConfig) observeOnlyReason(polecat string) string {
+	if matchesAny(c.NudgeDenylist, polecat) {
+		return "on the nudge denylist"
+	}
+	return "not on the nudge allowlist"
+}
+
+// validateNudgeLists checks that every allowlist and denylist pattern is
+// a well-formed glob.
+func (c *WitnessConfig) validateNudgeLists() error {
+	for _, list := range []struct {
+		name     string
+		patterns []string
+	}{{"nudge allowlist", c.NudgeAllowlist}, {"nudge denylist", c.NudgeDenylist}} {
+		for _, p := range list.patterns {
+			if _, err := path.Match(p, ""); err != nil {
+				return fmt.Errorf("invalid %s pattern %q: %w", list.name, p, err)
+			}
+		}
+	}
+	return nil
+}
+
+// matchesAny reports whether name matches one of the glob patterns.
+// Malformed patterns match nothing; validateConfig rejects them.
+func matchesAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package witness
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestObserveOnly(t *testing.T) {
+	tests := []struct {
+		allow, deny []string
+		polecat     string
+		want        bool
+	}{
+		{nil, nil, "toast", false},
+		{[]string{"toast", "nux"}, nil, "toast", false},
+		{[]string{"toast", "nux"}, nil, "capable", true},
+		{nil, []string{"exp-*"}, "exp-rust", true},
+		{nil, []string{"exp-*"}, "toast", false},
+		{[]string{"*"}, []string{"exp-*"}, "exp-rust", true},
+		{[]string{"exp-*"}, []string{"exp-rust"}, "exp-go", false},
+		{[]string{"exp-*"}, []string{"exp-rust"}, "exp-rust", true},
+	}
+	for _, tt := range tests {
+		c := WitnessConfig{NudgeAllowlist: tt.allow, NudgeDenylist: tt.deny}
+		if got := c.ObserveOnly(tt.polecat); got != tt.want {
+			t.Errorf("allow %v, deny %v: ObserveOnly(%q) = %v, want %v", tt.allow, tt.deny, tt.polecat, got, tt.want)
+		}
+	}
+
+	c := WitnessConfig{NudgeAllowlist: []string{"toast"}, NudgeDenylist: []string{"nux"}}
+	if got := c.observeOnlyReason("nux"); !strings.Contains(got, "denylist") {
+		t.Errorf("observeOnlyReason(nux) = %q", got)
+	}
+	if got := c.observeOnlyReason("capable"); !strings.Contains(got, "allowlist") {
+		t.Errorf("observeOnlyReason(capable) = %q", got)
+	}
+}
+
+func TestValidateConfig_NudgeLists(t *testing.T) {
+	if err := validateConfig(&WitnessConfig{NudgeDenylist: []string{"exp-[a-"}}); err == nil ||
+		!strings.Contains(err.Error(), "nudge denylist") {
+		t.Errorf("validateConfig(bad denylist) = %v", err)
+	}
+	if err := validateConfig(&WitnessConfig{NudgeAllowlist: []string{"toast", "exp-?"}}); err != nil {
+		t.Errorf("validateConfig(good allowlist) = %v", err)
+	}
+}
+
+func TestCheck_ObserveOnlyPolecatNotNudged(t *testing.T) {
+	n := &fakeNudger{}
+	mgr := stuckPolecatManager(t, n)
+	esc := &fakeEscalator{}
+	mgr.SetEscalator(esc)
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) {
+		c.NudgeDenylist = []string{"to*"}
+		c.EscalationThreshold = 1
+	}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	pc := result.Polecats[0]
+	if pc.State != PolecatStuck || pc.Action != ActionObserved || pc.Escalated {
+		t.Errorf("check = %+v, want a stuck polecat left observe-only", pc)
+	}
+	if len(n.nudged) != 0 || len(esc.escalated) != 0 {
+		t.Errorf("nudged %v, escalated %v; want neither", n.nudged, esc.escalated)
+	}
+
+	w, _ := mgr.Status()
+	ps := w.Stats.PerPolecat["toast"]
+	if ps.Checks != 1 || ps.Nudges != 0 {
+		t.Errorf("stats = %+v, want one check and no nudges", ps)
+	}
+	if ps.LastAction == nil || ps.LastAction.Action != ActionObserved || ps.LastAction.Reason != "on the nudge denylist" {
+		t.Errorf("LastAction = %+v, want observe-only on the denylist", ps.LastAction)
+	}
+}
@@ -0,0 +1,41 @@
+package witness
+
+import (
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Nudger delivers a nudge message to a polecat. The monitoring loop goes
+// through it for every nudge, so a runtime that isn't driven by tmux
+// keystrokes (or a test) can supply its own delivery.
+type Nudger interface {
+	Nudge(polecat, msg string) error
+}
+
+// TmuxNudger nudges polecats by typing the message into their tmux session.
+// It is the default Nudger.
+type TmuxNudger struct {
+	rigName string
+	tmux    tmux.Client
+}
+
+// NewTmuxNudger returns a Nudger for the polecats of rigName.
+func NewTmuxNudger(rigName string) *TmuxNudger {
+	return &TmuxNudger{rigName: rigName, tmux: tmux.NewTmux()}
+}
+
+// Nudge sends msg to the polecat's session.
+func (n *TmuxNudger) Nudge(polecat, msg string) error {
+	return n.tmux.NudgeSession(session.PolecatSessionName(n.rigName, polecat), msg)
+}
+
+// NewManagerWithNudger creates a witness manager that delivers nudges
+// through n instead of tmux. A nil n uses the tmux nudger.
+func NewManagerWithNudger(r *rig.Rig, n Nudger) *Manager {
+	m := NewManager(r)
+	if n != nil {
+		m.nudger = n
+	}
+	return m
+}
@@ -0,0 +1,106 @@
+package witness
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// fakeNudger records nudges instead of delivering them.
+type fakeNudger struct {
+	nudged map[string][]string
+	err    error
+}
+
+func (f *fakeNudger) Nudge(polecat, msg string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.nudged == nil {
+		f.nudged = make(map[string][]string)
+	}
+	f.nudged[polecat] = append(f.nudged[polecat], msg)
+	return nil
+}
+
+func TestNewManagerWithNudger_NilUsesTmux(t *testing.T) {
+	mgr := NewManagerWithNudger(&rig.Rig{Name: "testrig", Path: t.TempDir()}, nil)
+	if _, ok := mgr.nudger.(*TmuxNudger); !ok {
+		t.Errorf("nudger = %T, want *TmuxNudger", mgr.nudger)
+	}
+}
+
+// stuckPolecatManager returns a manager watching one polecat with a live
+// tmux session and thresholds low enough that the polecat counts as stuck.
+func stuckPolecatManager(t *testing.T, n Nudger) *Manager {
+	t.Helper()
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+
+	rigName := "nudgetest" + strconv.Itoa(os.Getpid())
+	r := &rig.Rig{Name: rigName, Path: t.TempDir(), Polecats: []string{"toast"}}
+	tm := tmux.NewTmux()
+	sessionName := session.PolecatSessionName(rigName, "toast")
+	if err := tm.NewSession(sessionName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	t.Cleanup(func() { _ = tm.KillSession(sessionName) })
+
+	mgr := NewManagerWithNudger(r, n)
+	mgr.SetOutput(io.Discard)
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) {
+		c.IdleThreshold = time.Nanosecond
+		c.StuckThreshold = time.Nanosecond
+	}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+	time.Sleep(1100 * time.Millisecond) // tmux activity has one-second resolution
+	return mgr
+}
+
+func TestCheck_NudgesThroughNudger(t *testing.T) {
+	n := &fakeNudger{}
+	mgr := stuckPolecatManager(t, n)
+
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if got := result.Polecats[0].Action; got != ActionNudged {
+		t.Fatalf("action = %q, want %q (%+v)", got, ActionNudged, result.Polecats[0])
+	}
+	if len(n.nudged["toast"]) != 1 {
+		t.Errorf("nudges delivered = %v, want one to toast", n.nudged)
+	}
+
+	w, _ := mgr.Status()
+	if w.Stats.TotalNudges != 1 || w.Stats.PerPolecat["toast"].Nudges != 1 {
+		t.Errorf("stats = %+v, want one nudge counted", w.Stats)
+	}
+}
+
+func TestCheck_NudgerErrorNotCounted(t *testing.T) {
+	mgr := stuckPolecatManager(t, &fakeNudger{err: errors.New("delivery failed")})
+
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	pc := result.Polecats[0]
+	if pc.Action == ActionNudged || pc.Error != "delivery failed" {
+		t.Errorf("check = %+v, want an unnudged polecat with the delivery error", pc)
+	}
+	w, _ := mgr.Status()
+	if w.Stats.TotalNudges != 0 {
+		t.Errorf("TotalNudges = %d, want 0", w.Stats.TotalNudges)
+	}
+}
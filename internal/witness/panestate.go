@@ -0,0 +1,88 @@
+package witness
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Default pane patterns, matched against the bottom of a quiet polecat's
+// pane. They are deliberately narrow: a false match only costs a nudge the
+// polecat didn't get, while a missed one nudges a polecat that is fine.
+const (
+	// DefaultAwaitingInputPattern matches agent permission prompts and
+	// yes/no questions waiting for a human.
+	DefaultAwaitingInputPattern = `Do you want to (proceed|make this edit|create)|\((y/n|yes/no)\)|\[(y/N|Y/n)\]|Press Enter to continue`
+
+	// DefaultInToolPattern matches an agent that is running a tool call.
+	DefaultInToolPattern = `esc to interrupt|Running…`
+
+	// DefaultErroredPattern matches agent API and usage errors.
+	DefaultErroredPattern = `API Error|Request timed out|usage limit reached|overloaded_error|rate_limit_error`
+
+	// paneStateLines is how many lines from the bottom of the pane the
+	// patterns are matched against, so old scrollback doesn't count.
+	paneStateLines = 15
+)
+
+// panePattern recognizes one pane-derived polecat state.
+type panePattern struct {
+	state PolecatState
+	re    *regexp.Regexp
+}
+
+// panePatterns compiles the configured pane patterns, in the order they
+// are tried: a polecat waiting on a human is never nudged, whatever else
+// its pane shows.
+func (c *WitnessConfig) panePatterns() ([]panePattern, error) {
+	sources := []struct {
+		state PolecatState
+		expr  string
+		def   string
+	}{
+		{PolecatAwaitingInput, c.AwaitingInputPattern, DefaultAwaitingInputPattern},
+		{PolecatErrored, c.ErroredPattern, DefaultErroredPattern},
+		{PolecatInTool, c.InToolPattern, DefaultInToolPattern},
+	}
+	patterns := make([]panePattern, 0, len(sources))
+	for _, s := range sources {
+		expr := s.expr
+		if expr == "" {
+			expr = s.def
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern: %w", s.state, err)
+		}
+		patterns = append(patterns, panePattern{state: s.state, re: re})
+	}
+	return patterns, nil
+}
+
+// panePatterns returns the compiled pane patterns for cfg. Patterns that
+// fail to compile fall back to the defaults; UpdateConfig rejects them up
+// front, so this only covers a hand-edited state file.
+func (m *Manager) panePatterns(cfg *WitnessConfig) []panePattern {
+	patterns, err := cfg.panePatterns()
+	if err != nil {
+		_, _ = fmt.Fprintf(m.output, "warning: %v; using default pane patterns\n", err)
+		patterns, _ = (&WitnessConfig{}).panePatterns()
+	}
+	return patterns
+}
+
+// matchPaneState returns the state whose pattern matches the bottom of the
+// pane content, and the matching text, or "" if none does.
+func matchPaneState(content string, patterns []panePattern) (PolecatState, string) {
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	if len(lines) > paneStateLines {
+		lines = lines[len(lines)-paneStateLines:]
+	}
+	tail := strings.Join(lines, "\n")
+	for _, p := range patterns {
+		if match := p.re.FindString(tail); match != "" {
+			return p.state, match
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,60 @@
+package witness
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatchPaneState(t *testing.T) {
+	patterns, err := (&WitnessConfig{}).panePatterns()
+	if err != nil {
+		t.Fatalf("default patterns: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    PolecatState
+	}{
+		{"shell prompt", "$ go test ./...\nok  \tpkg\t0.1s\n$ ", ""},
+		{"permission prompt", "Bash(rm -rf build)\n Do you want to proceed?\n ❯ 1. Yes\n   2. No", PolecatAwaitingInput},
+		{"yes/no", "Overwrite config? [y/N] ", PolecatAwaitingInput},
+		{"tool call", "⏺ Bash(go test ./...)\n  ⎿  Running…\n✻ Working… (esc to interrupt)", PolecatInTool},
+		{"api error", "⎿  API Error: 529 {\"type\":\"overloaded_error\"}", PolecatErrored},
+		{"error while prompting", "API Error: 500\nDo you want to proceed?", PolecatAwaitingInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, match := matchPaneState(tt.content, patterns)
+			if got != tt.want {
+				t.Errorf("matchPaneState = %q (%q), want %q", got, match, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPaneStateOnlyTail(t *testing.T) {
+	patterns, _ := (&WitnessConfig{}).panePatterns()
+	content := "Do you want to proceed?\n" + strings.Repeat("output\n", paneStateLines)
+	if got, _ := matchPaneState(content, patterns); got != "" {
+		t.Errorf("a prompt scrolled out of the tail matched as %q", got)
+	}
+}
+
+func TestPanePatternsConfig(t *testing.T) {
+	cfg := &WitnessConfig{AwaitingInputPattern: `waiting for reviewer`}
+	patterns, err := cfg.panePatterns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := matchPaneState("blocked: waiting for reviewer", patterns); got != PolecatAwaitingInput {
+		t.Errorf("configured pattern: got %q, want %q", got, PolecatAwaitingInput)
+	}
+	if got, _ := matchPaneState("Do you want to proceed?", patterns); got != "" {
+		t.Errorf("configured pattern should replace the default, got %q", got)
+	}
+
+	if err := validateConfig(&WitnessConfig{ErroredPattern: `(unclosed`}); err == nil {
+		t.Error("an invalid pattern should fail validation")
+	}
+}
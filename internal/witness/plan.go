@@ -0,0 +1,124 @@
+package witness
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// SessionPlan is the tmux session a background Start sets up for the
+// witness agent. Start follows it, and gt witness plan prints it without
+// creating anything.
+type SessionPlan struct {
+	Rig     string `json:"rig"`
+	Session string `json:"session"`
+	WorkDir string `json:"work_dir"`
+
+	// Command launches the agent, with the agent environment exported.
+	Command string `json:"command"`
+
+	// Layout is LayoutSingle or LayoutSplit; Panes lists the initial
+	// command of each pane, the agent's first.
+	Layout string   `json:"layout"`
+	Panes  []string `json:"panes"`
+
+	// Env is set on the session, for processes started in it later.
+	Env map[string]string `json:"env"`
+
+	// Theme is left to callers to render as they like.
+	Theme tmux.Theme `json:"-"`
+
+	// ReadyMarker is what the startup nudges wait for in the pane ("":
+	// a short pause instead).
+	ReadyMarker string `json:"ready_marker,omitempty"`
+}
+
+// Plan works out the session a background Start would create now, with
+// the same agent override and env overrides, from the rig's config and
+// the witness state. It never touches tmux, nor writes anything.
+func (m *Manager) Plan(agentOverride string, envOverrides []string) (*SessionPlan, error) {
+	if m.fileConfigErr != nil {
+		return nil, fmt.Errorf("witness config: %w", m.fileConfigErr)
+	}
+	if err := m.checkAgentOverride(agentOverride); err != nil {
+		return nil, err
+	}
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateConfig(&w.Config); err != nil {
+		return nil, err
+	}
+	extraEnv, err := extraAgentEnv(&w.Config, envOverrides)
+	if err != nil {
+		return nil, err
+	}
+	return m.plan(w, agentOverride, extraEnv)
+}
+
+// plan builds the session plan for w; see Plan.
+func (m *Manager) plan(w *Witness, agentOverride string, extraEnv map[string]string) (*SessionPlan, error) {
+	roleConfig, err := m.roleConfig()
+	if err != nil {
+		return nil, err
+	}
+	townRoot := m.townRoot()
+
+	// NOTE: No gt prime injection needed - SessionStart hook handles it automatically
+	// Export GT_ROLE and BD_ACTOR in the command since tmux SetEnvironment only affects new panes
+	// Pass m.rig.Path so rig agent settings are honored (not town-level defaults)
+	// A one-off --agent replaces the configured agent command too.
+	agentCommand := m.agentCommand
+	if agentCommand == "" && agentOverride == "" {
+		agentCommand = w.Config.AgentCommand
+	}
+	command, err := buildWitnessStartCommand(m.rig.Path, m.rig.Name, townRoot, agentOverride, agentCommand, roleConfig)
+	if err != nil {
+		return nil, err
+	}
+	// Export the extra variables in the command too: the session
+	// environment only reaches processes started after it is set, such
+	// as a respawned agent, not the first one.
+	command = config.PrependEnv(command, extraEnv)
+
+	layout := m.layout
+	if layout == "" {
+		layout = w.Layout
+	}
+	if layout == "" {
+		layout = LayoutSingle
+	}
+	var panes []string
+	for _, pane := range m.sessionLayout(layout, command).Panes {
+		panes = append(panes, pane.Command)
+	}
+
+	// Use centralized AgentEnv for consistency across all role startup
+	// paths; role config env vars come next, then configured and CLI env
+	// (highest priority).
+	env := config.AgentEnv(config.AgentEnvConfig{
+		Role:     "witness",
+		Rig:      m.rig.Name,
+		TownRoot: townRoot,
+	})
+	for key, value := range roleConfigEnvVars(roleConfig, townRoot, m.rig.Name) {
+		env[key] = value
+	}
+	for key, value := range extraEnv {
+		env[key] = value
+	}
+
+	return &SessionPlan{
+		Rig:         m.rig.Name,
+		Session:     m.SessionName(),
+		WorkDir:     m.witnessDir(),
+		Command:     command,
+		Layout:      layout,
+		Panes:       panes,
+		Env:         env,
+		Theme:       tmux.AssignThemeForTown(townRoot, m.rig.Name),
+		ReadyMarker: m.readyMarker(&w.Config),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package witness
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestManager_Plan(t *testing.T) {
+	// bd stub: no role bead, so the agent command below is used as is
+	binDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte("#!/bin/sh\necho '[]'\n"), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	rigPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(rigPath, "witness", "rig"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	mgr := NewManager(&rig.Rig{Name: "greenplace", Path: rigPath})
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) {
+		c.AgentCommand = "my-agent --witness"
+		c.Env = []string{"MODEL=sonnet", "REGION=eu"}
+		c.ReadyMarker = "ready>"
+	}); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+	mgr.SetLayout(LayoutSplit)
+
+	p, err := mgr.Plan("", []string{"MODEL=opus"})
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if p.Session != mgr.SessionName() {
+		t.Errorf("session = %q, want %q", p.Session, mgr.SessionName())
+	}
+	if want := filepath.Join(rigPath, "witness", "rig"); p.WorkDir != want {
+		t.Errorf("work dir = %q, want %q", p.WorkDir, want)
+	}
+	if !strings.HasSuffix(p.Command, "my-agent --witness") || !strings.Contains(p.Command, "MODEL=opus") {
+		t.Errorf("command = %q, want the agent command with the env override exported", p.Command)
+	}
+	if p.Layout != LayoutSplit || len(p.Panes) != 2 || p.Panes[0] != p.Command {
+		t.Errorf("layout = %s with panes %q, want split with the agent first", p.Layout, p.Panes)
+	}
+	if p.Env["GT_ROLE"] == "" || p.Env["MODEL"] != "opus" || p.Env["REGION"] != "eu" {
+		t.Errorf("env = %v, want the agent env with the overrides winning", p.Env)
+	}
+	if p.Theme.Name == "" {
+		t.Error("no theme in the plan")
+	}
+	if p.ReadyMarker != "ready>" {
+		t.Errorf("ready marker = %q, want the configured one", p.ReadyMarker)
+	}
+
+	// Planning writes nothing: no Claude settings for the agent yet
+	if _, err := os.Stat(filepath.Join(rigPath, "witness", ".claude")); !os.IsNotExist(err) {
+		t.Errorf("plan wrote agent settings (stat err %v)", err)
+	}
+
+	if _, err := mgr.Plan("", []string{"not-an-assignment"}); err == nil {
+		t.Error("Plan accepted a malformed env override")
+	}
+
+	// A one-off agent alias replaces the configured agent command...
+	if p, err := mgr.Plan("claude", nil); err != nil {
+		t.Errorf("Plan with --agent: %v", err)
+	} else if strings.Contains(p.Command, "my-agent") {
+		t.Errorf("command = %q, want the --agent alias over the configured agent command", p.Command)
+	}
+	// ...but can't be combined with a one-off agent command.
+	mgr.SetAgentCommand("other-agent")
+	if _, err := mgr.Plan("claude", nil); !errors.Is(err, errAgentConflict) {
+		t.Errorf("Plan with --agent and --agent-command = %v, want errAgentConflict", err)
+	}
+}
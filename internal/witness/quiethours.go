@@ -0,0 +1,152 @@
+package witness
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// clockLayout is the layout of quiet hours start and end times.
+const clockLayout = "15:04"
+
+// QuietHours is a daily window during which the witness keeps checking
+// polecats and updating stats but suppresses nudges and escalations, e.g.
+// overnight when nobody is around to act on an escalation.
+//
+// A window whose end is before its start wraps past midnight: 22:00-07:00
+// runs from 22:00 to 07:00 the next morning. Days limits the window to the
+// days it starts on, so "22:00-07:00 on fri" covers Friday night into
+// Saturday morning.
+type QuietHours struct {
+	// Start is when the window opens, as HH:MM.
+	Start string `json:"start"`
+
+	// End is when the window closes, as HH:MM.
+	End string `json:"end"`
+
+	// Days lists the weekday rules ("mon", "weekdays", "weekends") the
+	// window starts on (default: every day).
+	Days []string `json:"days,omitempty"`
+
+	// Timezone is the IANA zone the times are in (default: local time).
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// ParseQuietWindow parses a "HH:MM-HH:MM" window into its start and end.
+func ParseQuietWindow(window string) (start, end string, err error) {
+	start, end, ok := strings.Cut(strings.TrimSpace(window), "-")
+	if !ok {
+		return "", "", fmt.Errorf("invalid quiet hours %q: want HH:MM-HH:MM (e.g. 22:00-07:00)", window)
+	}
+	return strings.TrimSpace(start), strings.TrimSpace(end), nil
+}
+
+// Window returns the window as "HH:MM-HH:MM".
+func (q *QuietHours) Window() string {
+	if q == nil || (q.Start == "" && q.End == "") {
+		return ""
+	}
+	return q.Start + "-" + q.End
+}
+
+// String describes the quiet hours, e.g. "22:00-07:00 mon,fri (Europe/Berlin)".
+func (q *QuietHours) String() string {
+	s := q.Window()
+	if len(q.Days) > 0 {
+		s += " " + strings.Join(q.Days, ",")
+	}
+	zone := q.Timezone
+	if zone == "" {
+		zone = "local"
+	}
+	return s + " (" + zone + ")"
+}
+
+// validate checks that the window, days and timezone all parse.
+func (q *QuietHours) validate() error {
+	if q.Start == "" || q.End == "" {
+		return fmt.Errorf("quiet hours need a start and end (e.g. 22:00-07:00)")
+	}
+	start, err := time.Parse(clockLayout, q.Start)
+	if err != nil {
+		return fmt.Errorf("invalid quiet hours start %q: want HH:MM", q.Start)
+	}
+	end, err := time.Parse(clockLayout, q.End)
+	if err != nil {
+		return fmt.Errorf("invalid quiet hours end %q: want HH:MM", q.End)
+	}
+	if start.Equal(end) {
+		return fmt.Errorf("quiet hours %s are empty: start and end are the same", q.Window())
+	}
+	if _, err := q.weekdays(); err != nil {
+		return err
+	}
+	if _, err := q.location(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// weekdays returns the days the window may start on, or nil for every day.
+func (q *QuietHours) weekdays() (map[time.Weekday]bool, error) {
+	if len(q.Days) == 0 {
+		return nil, nil
+	}
+	days := make(map[time.Weekday]bool)
+	for _, d := range q.Days {
+		names, ok := weekdayNames[strings.ToLower(strings.TrimSpace(d))]
+		if !ok {
+			return nil, fmt.Errorf("invalid quiet hours day %q: want a weekday (e.g. mon, weekdays, weekends)", d)
+		}
+		for _, wd := range names {
+			days[wd] = true
+		}
+	}
+	return days, nil
+}
+
+// location returns the timezone the window is in.
+func (q *QuietHours) location() (*time.Location, error) {
+	if q.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quiet hours timezone %q: %w", q.Timezone, err)
+	}
+	return loc, nil
+}
+
+// Contains reports whether t falls inside the quiet hours. Quiet hours
+// that don't validate never contain anything.
+func (q *QuietHours) Contains(t time.Time) bool {
+	if q == nil || q.validate() != nil {
+		return false
+	}
+	loc, _ := q.location()
+	days, _ := q.weekdays()
+	start, _ := time.Parse(clockLayout, q.Start)
+	end, _ := time.Parse(clockLayout, q.End)
+
+	t = t.In(loc)
+	now := t.Hour()*60 + t.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	// The day the window containing t opened on
+	opened := t.Weekday()
+	switch {
+	case from < to:
+		if now < from || now >= to {
+			return false
+		}
+	case now >= from:
+		// Wrapping window, before midnight
+	case now < to:
+		// Wrapping window, after midnight: it opened yesterday
+		opened = (opened + 6) % 7
+	default:
+		return false
+	}
+	return days == nil || days[opened]
+}
@@ -0,0 +1,63 @@
+package witness
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuietHours_Contains(t *testing.T) {
+	utc := func(day, hour, min int) time.Time {
+		// January 2026: the 2nd is a Friday, the 3rd a Saturday
+		return time.Date(2026, 1, day, hour, min, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name string
+		q    QuietHours
+		t    time.Time
+		want bool
+	}{
+		{"inside same-day window", QuietHours{Start: "12:00", End: "13:00", Timezone: "UTC"}, utc(2, 12, 30), true},
+		{"end is exclusive", QuietHours{Start: "12:00", End: "13:00", Timezone: "UTC"}, utc(2, 13, 0), false},
+		{"wrapping, before midnight", QuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"}, utc(2, 23, 0), true},
+		{"wrapping, after midnight", QuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"}, utc(3, 6, 59), true},
+		{"wrapping, daytime", QuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"}, utc(3, 12, 0), false},
+		{"day is the day the window opened", QuietHours{Start: "22:00", End: "07:00", Days: []string{"fri"}, Timezone: "UTC"}, utc(3, 6, 0), true},
+		{"window not opened on a listed day", QuietHours{Start: "22:00", End: "07:00", Days: []string{"sat"}, Timezone: "UTC"}, utc(3, 6, 0), false},
+		{"weekdays rule", QuietHours{Start: "00:00", End: "08:00", Days: []string{"weekdays"}, Timezone: "UTC"}, utc(3, 1, 0), false},
+		{"timezone applied", QuietHours{Start: "22:00", End: "07:00", Timezone: "Asia/Tokyo"}, utc(2, 14, 0), true},
+		{"invalid never quiet", QuietHours{Start: "25:00", End: "07:00"}, utc(2, 23, 0), false},
+	}
+	for _, tt := range tests {
+		if got := tt.q.Contains(tt.t); got != tt.want {
+			t.Errorf("%s: Contains(%s) = %v, want %v", tt.name, tt.t.Format(time.RFC3339), got, tt.want)
+		}
+	}
+}
+
+func TestQuietHours_Validate(t *testing.T) {
+	for _, q := range []QuietHours{
+		{Start: "22:00"},
+		{Start: "22:00", End: "22:00"},
+		{Start: "10pm", End: "07:00"},
+		{Start: "22:00", End: "07:00", Days: []string{"someday"}},
+		{Start: "22:00", End: "07:00", Timezone: "Mars/Olympus"},
+	} {
+		if err := q.validate(); err == nil {
+			t.Errorf("validate(%+v) = nil, want error", q)
+		}
+	}
+	q := QuietHours{Start: "22:00", End: "07:00", Days: []string{"weekdays"}, Timezone: "Europe/Berlin"}
+	if err := q.validate(); err != nil {
+		t.Errorf("validate(%+v) = %v", q, err)
+	}
+}
+
+func TestWitnessConfig_QuietReason_Hours(t *testing.T) {
+	cfg := WitnessConfig{QuietHours: &QuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"}}
+	if got := cfg.QuietReason(time.Date(2026, 1, 2, 23, 0, 0, 0, time.UTC)); got != "hours 22:00-07:00" {
+		t.Errorf("QuietReason(23:00) = %q, want %q", got, "hours 22:00-07:00")
+	}
+	if got := cfg.QuietReason(time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)); got != "" {
+		t.Errorf("QuietReason(12:00) = %q, want \"\"", got)
+	}
+}
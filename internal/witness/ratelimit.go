@@ -0,0 +1,38 @@
+package witness
+
+import "time"
+
+// DefaultMinNudgeInterval is the least time between two nudges to the same
+// polecat, unless the rig configures its own. Without it a slow polecat
+// would be nudged on every check.
+const DefaultMinNudgeInterval = 5 * time.Minute
+
+// NudgeInterval returns the minimum time between nudges to one polecat.
+// Zero or negative values fall back to the default.
+func (c *WitnessConfig) NudgeInterval() time.Duration {
+	if c.MinNudgeInterval <= 0 {
+		return DefaultMinNudgeInterval
+	}
+	return c.MinNudgeInterval
+}
+
+// nudgeWait returns how much longer a nudge to the polecat must be held
+// back at now, or 0 if it may be sent.
+func (ps *PolecatStats) nudgeWait(now time.Time, interval time.Duration) time.Duration {
+	if ps.LastNudgeAt == nil {
+		return 0
+	}
+	if wait := ps.LastNudgeAt.Add(interval).Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// recordHeldNudge counts a nudge held back by the minimum interval. The
+// unanswered-nudge streak is left alone, so held nudges never count toward
+// escalation.
+func (s *WitnessStats) recordHeldNudge(ps *PolecatStats) {
+	s.TotalHeldNudges++
+	s.TodayHeldNudges++
+	ps.HeldNudges++
+}
@@ -0,0 +1,80 @@
+package witness
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWitnessConfig_NudgeInterval(t *testing.T) {
+	if got := (&WitnessConfig{}).NudgeInterval(); got != DefaultMinNudgeInterval {
+		t.Errorf("unset NudgeInterval = %s, want %s", got, DefaultMinNudgeInterval)
+	}
+	if got := (&WitnessConfig{MinNudgeInterval: -time.Minute}).NudgeInterval(); got != DefaultMinNudgeInterval {
+		t.Errorf("negative NudgeInterval = %s, want %s", got, DefaultMinNudgeInterval)
+	}
+	if got := (&WitnessConfig{MinNudgeInterval: 2 * time.Minute}).NudgeInterval(); got != 2*time.Minute {
+		t.Errorf("NudgeInterval = %s, want 2m", got)
+	}
+}
+
+func TestPolecatStats_NudgeWait(t *testing.T) {
+	now := time.Now()
+	var ps PolecatStats
+	if got := ps.nudgeWait(now, 5*time.Minute); got != 0 {
+		t.Errorf("never nudged: wait = %s, want 0", got)
+	}
+
+	last := now.Add(-2 * time.Minute)
+	ps.LastNudgeAt = &last
+	if got := ps.nudgeWait(now, 5*time.Minute); got != 3*time.Minute {
+		t.Errorf("nudged 2m ago: wait = %s, want 3m", got)
+	}
+	if got := ps.nudgeWait(now, 2*time.Minute); got != 0 {
+		t.Errorf("interval elapsed: wait = %s, want 0", got)
+	}
+}
+
+func TestExplainNudgeInterval(t *testing.T) {
+	now := time.Now()
+	last := now.Add(-time.Minute)
+	ps := PolecatStats{LastNudgeAt: &last}
+
+	e := &Explanation{State: PolecatStuck, Action: ActionNudged}
+	explainNudgeInterval(e, ps, 5*time.Minute, now)
+	if e.Action != ActionHeld {
+		t.Errorf("action = %q, want %q", e.Action, ActionHeld)
+	}
+	if n := len(e.Signals); n != 1 || !e.Signals[0].Fired {
+		t.Errorf("signals = %+v, want one fired nudge interval signal", e.Signals)
+	}
+
+	// A quiet period already suppresses the nudge; that reason stands.
+	e = &Explanation{State: PolecatStuck, Action: ActionSuppressed}
+	explainNudgeInterval(e, ps, 5*time.Minute, now)
+	if e.Action != ActionSuppressed {
+		t.Errorf("action = %q, want %q", e.Action, ActionSuppressed)
+	}
+}
+
+func TestCheck_HoldsNudgeWithinInterval(t *testing.T) {
+	n := &fakeNudger{}
+	mgr := stuckPolecatManager(t, n)
+
+	for i := 0; i < 3; i++ {
+		if _, err := mgr.Check(); err != nil {
+			t.Fatalf("Check %d: %v", i, err)
+		}
+	}
+
+	if got := len(n.nudged["toast"]); got != 1 {
+		t.Errorf("nudges delivered = %d, want 1", got)
+	}
+	w, _ := mgr.Status()
+	ps := w.Stats.PerPolecat["toast"]
+	if ps.Nudges != 1 || ps.HeldNudges != 2 || w.Stats.TotalHeldNudges != 2 {
+		t.Errorf("stats = %+v / %+v, want 1 nudge and 2 held", w.Stats, ps)
+	}
+	if ps.ConsecutiveNudges != 1 {
+		t.Errorf("ConsecutiveNudges = %d, want 1: held nudges must not count toward escalation", ps.ConsecutiveNudges)
+	}
+}
@@ -0,0 +1,52 @@
+package witness
+
+import (
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// readyTimeout bounds the wait for a new witness agent to show its ready
+// marker, after the agent process itself has started.
+const readyTimeout = constants.PromptWaitTimeout
+
+// readySampleLines is how much of the bottom of the pane is searched for
+// the ready marker.
+const readySampleLines = 10
+
+// readyPollInterval is the pause between looks at the pane; a var so
+// tests needn't wait.
+var readyPollInterval = 200 * time.Millisecond
+
+// paneCapturer is the part of tmux the readiness poll needs.
+type paneCapturer interface {
+	CapturePane(session string, lines int) (string, error)
+}
+
+// readyMarker returns the pane text that shows the witness agent is ready:
+// the configured marker, else the rig runtime's ready prompt.
+func (m *Manager) readyMarker(cfg *WitnessConfig) string {
+	if cfg.ReadyMarker != "" {
+		return cfg.ReadyMarker
+	}
+	return witnessReadyPrompt(m.rig.Path)
+}
+
+// waitForReady polls the bottom of the session's pane until a line starts
+// with marker, matched as tmux matches a ready prompt, for at most timeout,
+// and reports whether it did. A pane that can't be captured counts as not
+// ready yet.
+func waitForReady(t paneCapturer, session, marker string, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if content, err := t.CapturePane(session, readySampleLines); err == nil && tmux.PaneHasPrompt(strings.Split(content, "\n"), marker) {
+			return true
+		}
+		if !time.Now().Before(deadline) {
+			return false
+		}
+		time.Sleep(readyPollInterval)
+	}
+}
@@ -0,0 +1,63 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux/tmuxtest"
+)
+
+// warmingPane is a fake tmux whose pane shows the agent's prompt only
+// from the readyAfter'th capture on.
+type warmingPane struct {
+	*tmuxtest.FakeTmux
+	session    string
+	captures   int
+	readyAfter int
+}
+
+func (p *warmingPane) CapturePane(session string, lines int) (string, error) {
+	p.captures++
+	if p.captures == p.readyAfter {
+		p.Session(p.session).Pane = "Welcome to Claude Code\n> \n"
+	}
+	return p.FakeTmux.CapturePane(session, lines)
+}
+
+func TestWaitForReady(t *testing.T) {
+	defer func(d time.Duration) { readyPollInterval = d }(readyPollInterval)
+	readyPollInterval = time.Millisecond
+
+	const session = "gt-greenplace-witness"
+	newPane := func(readyAfter int) *warmingPane {
+		f := tmuxtest.NewFakeTmux(session)
+		f.Session(session).Pane = "$ claude --dangerously-skip-permissions\n"
+		return &warmingPane{FakeTmux: f, session: session, readyAfter: readyAfter}
+	}
+
+	p := newPane(3)
+	if !waitForReady(p, session, "> ", time.Second) {
+		t.Fatal("waitForReady = false, want the prompt found")
+	}
+	if p.captures != 3 {
+		t.Errorf("captured the pane %d times, want 3 (ready on the third)", p.captures)
+	}
+
+	// A pane that never gets there gives up after the timeout
+	p = newPane(-1)
+	start := time.Now()
+	if waitForReady(p, session, "> ", 20*time.Millisecond) {
+		t.Fatal("waitForReady = true for a pane that never showed the prompt")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waitForReady took %s, want it bounded by the timeout", elapsed)
+	}
+	if p.captures < 2 {
+		t.Errorf("captured the pane %d times, want it polled", p.captures)
+	}
+
+	// A missing session is never ready
+	if waitForReady(newPane(1), "gt-other-witness", "> ", 5*time.Millisecond) {
+		t.Error("waitForReady = true for a missing session")
+	}
+}
@@ -0,0 +1,47 @@
+package witness
+
+import "time"
+
+// ResetStats zeroes the witness's counters, daily history and per-polecat
+// stats, keeping its config and run state. Check log entries from before the reset no longer count in
+// exports or deltas. The since bookmark goes with the old counters; the
+// start bookmark moves to the reset.
+func (m *Manager) ResetStats(now time.Time) error {
+	return m.updateState(func(w *Witness) error {
+		w.Stats = WitnessStats{
+			StatsDate: now.Format(dateLayout),
+			ResetAt:   &now,
+		}
+		w.SinceMark = nil
+		if w.StartMark != nil {
+			w.StartMark = w.Stats.mark(now)
+		}
+		return nil
+	})
+}
+
+// SinceStart returns what changed since the witness last started (or its
+// stats were last reset, if that was later). Returns nil for a witness
+// started before start bookmarks were kept.
+func (w *Witness) SinceStart(now time.Time) *StatsDelta {
+	if w.StartMark == nil {
+		return nil
+	}
+	d := deltaFromMark(w.StartMark, &w.Stats, now)
+	d.Source = DeltaFromStart
+	return d
+}
+
+// resetSince reports whether s was reset after earlier was read from the
+// same state: their reset times differ.
+func (s *WitnessStats) resetSince(earlier *WitnessStats) bool {
+	if s.ResetAt == nil || earlier.ResetAt == nil {
+		return s.ResetAt != earlier.ResetAt
+	}
+	return !s.ResetAt.Equal(*earlier.ResetAt)
+}
+
+// beforeReset reports whether t predates the last stats reset.
+func (s *WitnessStats) beforeReset(t time.Time) bool {
+	return s.ResetAt != nil && t.Before(*s.ResetAt)
+}
@@ -0,0 +1,107 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestManager_ResetStats(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	base := time.Date(2026, 1, 5, 12, 0, 0, 0, time.Local)
+
+	w, _ := mgr.loadState()
+	w.State = StateRunning
+	w.Config.EscalationThreshold = 5
+	w.Stats.StatsDate = "2026-01-05"
+	w.Stats.TotalChecks, w.Stats.TotalNudges, w.Stats.TodayChecks = 50, 7, 3
+	w.Stats.History = []DailySnapshot{{Date: "2026-01-04", Checks: 47, Nudges: 7}}
+	w.Stats.PerPolecat = map[string]PolecatStats{"toast": {Checks: 50, Nudges: 7}}
+	w.StartMark = w.Stats.mark(base)
+	w.SinceMark = w.Stats.mark(base)
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &WitnessConfig{}
+	if err := mgr.writeCheckLog(mgr.logPath(cfg), logMaxSize(cfg), &CheckResult{
+		CheckedAt: base.Add(-time.Hour), Polecats: []PolecatCheck{{Name: "toast", Action: ActionNudged}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	reset := base.Add(time.Minute)
+	if err := mgr.ResetStats(reset); err != nil {
+		t.Fatalf("ResetStats: %v", err)
+	}
+
+	w, _ = mgr.loadState()
+	if w.Stats.TotalChecks != 0 || w.Stats.TotalNudges != 0 || len(w.Stats.History) != 0 || len(w.Stats.PerPolecat) != 0 {
+		t.Errorf("stats after reset = %+v, want zeroed", w.Stats)
+	}
+	if w.State != StateRunning || w.Config.EscalationThreshold != 5 {
+		t.Errorf("state %s, config %+v; want both kept", w.State, w.Config)
+	}
+	if w.SinceMark != nil {
+		t.Errorf("SinceMark = %+v, want dropped", w.SinceMark)
+	}
+	if d := w.SinceStart(reset); d == nil || !d.Since.Equal(reset) || d.Checks != 0 {
+		t.Errorf("SinceStart = %+v, want an empty delta from the reset", d)
+	}
+
+	// The pre-reset nudge in the check log no longer counts
+	rows, err := mgr.DailyStats("", "")
+	if err != nil {
+		t.Fatalf("DailyStats: %v", err)
+	}
+	if len(rows) != 0 {
+		t.Errorf("DailyStats after reset = %+v, want none", rows)
+	}
+	d, err := mgr.Since(base.Add(-2*time.Hour), reset.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if d.Nudges != 0 || !d.Since.Equal(reset) {
+		t.Errorf("Since after reset = %+v, want no nudges from the reset on", d)
+	}
+}
+
+func TestWitness_SinceStart(t *testing.T) {
+	now := time.Date(2026, 1, 5, 12, 0, 0, 0, time.Local)
+	w := &Witness{}
+	if d := w.SinceStart(now); d != nil {
+		t.Errorf("SinceStart without a start mark = %+v, want nil", d)
+	}
+
+	w.Stats = WitnessStats{TotalChecks: 10, TotalNudges: 2,
+		PerPolecat: map[string]PolecatStats{"toast": {Checks: 10, Nudges: 2}}}
+	w.StartMark = w.Stats.mark(now)
+	w.Stats.TotalChecks, w.Stats.TotalNudges = 14, 3
+	w.Stats.PerPolecat["toast"] = PolecatStats{Checks: 14, Nudges: 3}
+
+	d := w.SinceStart(now.Add(time.Hour))
+	if d.Source != DeltaFromStart || d.Checks != 4 || d.Nudges != 1 {
+		t.Errorf("SinceStart = %+v", d)
+	}
+}
+
+func TestMergeCheck_KeepsResetStats(t *testing.T) {
+	now := time.Now()
+	checked := &Witness{LastCheckAt: &now}
+	checked.Stats.TotalChecks = 51
+
+	// reset-stats saved while the check ran
+	resetAt := now.Add(time.Second)
+	w := &Witness{Stats: WitnessStats{ResetAt: &resetAt}}
+	w.mergeCheck(checked)
+	if w.Stats.TotalChecks != 0 || w.Stats.ResetAt == nil {
+		t.Errorf("stats = %+v, want the reset kept over the check's counts", w.Stats)
+	}
+
+	// No reset meanwhile: the check's counts are saved
+	w = &Witness{}
+	w.mergeCheck(checked)
+	if w.Stats.TotalChecks != 51 {
+		t.Errorf("TotalChecks = %d, want the check's 51", w.Stats.TotalChecks)
+	}
+}
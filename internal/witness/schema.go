@@ -0,0 +1,36 @@
+package witness
+
+import "fmt"
+
+// StateSchemaVersion is the witness state file format written by this
+// build. Bump it and append to stateMigrations when the format changes,
+// so state files from older releases keep their stats.
+const StateSchemaVersion = 1
+
+// stateMigrations upgrade loaded state one version at a time:
+// stateMigrations[v] turns version v into version v+1.
+var stateMigrations = []func(w *Witness){
+	// 0 → 1: files written before versioning may carry daily counters
+	// without the date they belong to. Date them by the last check so
+	// they roll over at the right midnight rather than immediately.
+	func(w *Witness) {
+		if w.Stats.StatsDate == "" && w.LastCheckAt != nil {
+			w.Stats.StatsDate = w.LastCheckAt.Format(dateLayout)
+		}
+	},
+}
+
+// migrateState upgrades w in place to StateSchemaVersion. State written by
+// a newer release is rejected rather than silently rewritten in the old
+// format.
+func migrateState(w *Witness) error {
+	if w.SchemaVersion > StateSchemaVersion {
+		return fmt.Errorf("witness state schema version %d is newer than supported (%d); upgrade gt",
+			w.SchemaVersion, StateSchemaVersion)
+	}
+	for v := w.SchemaVersion; v < StateSchemaVersion; v++ {
+		stateMigrations[v](w)
+	}
+	w.SchemaVersion = StateSchemaVersion
+	return nil
+}
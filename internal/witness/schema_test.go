@@ -0,0 +1,83 @@
+package witness
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func writeStateFile(t *testing.T, mgr *Manager, data string) {
+	t.Helper()
+	path := mgr.stateFile()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestManager_LoadState_MigratesUnversioned(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	writeStateFile(t, mgr, `{
+  "rig_name": "testrig",
+  "state": "stopped",
+  "last_check_at": "2026-01-05T23:59:00Z",
+  "stats": {"total_checks": 120, "total_nudges": 7, "today_checks": 40, "today_nudges": 2}
+}`)
+
+	w, err := mgr.loadState()
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	if w.SchemaVersion != StateSchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", w.SchemaVersion, StateSchemaVersion)
+	}
+	if w.Stats.TotalChecks != 120 || w.Stats.TotalNudges != 7 {
+		t.Errorf("totals lost in migration: %+v", w.Stats)
+	}
+	if w.Stats.StatsDate != w.LastCheckAt.Format(dateLayout) {
+		t.Errorf("StatsDate = %q, want date of last check", w.Stats.StatsDate)
+	}
+}
+
+func TestManager_LoadState_RejectsNewerSchema(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	writeStateFile(t, mgr, `{"schema_version": 99, "rig_name": "testrig", "state": "stopped"}`)
+
+	_, err := mgr.loadState()
+	if err == nil || !strings.Contains(err.Error(), "newer") {
+		t.Errorf("loadState() = %v, want newer-schema error", err)
+	}
+}
+
+func TestManager_StatsSurviveRestart(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	mgr.SetOutput(&strings.Builder{})
+
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	w, _ := mgr.loadState()
+	w.Stats.TotalChecks = 50
+	w.Stats.TotalNudges = 3
+	w.Stats.TotalEscalations = 1
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mgr.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("restart: %v", err)
+	}
+
+	w, _ = mgr.loadState()
+	if w.Stats.TotalChecks != 50 || w.Stats.TotalNudges != 3 || w.Stats.TotalEscalations != 1 {
+		t.Errorf("stats after restart = %+v", w.Stats)
+	}
+}
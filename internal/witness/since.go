@@ -0,0 +1,192 @@
+package witness
+
+import (
+	"fmt"
+	"time"
+)
+
+// Sources of a StatsDelta.
+const (
+	DeltaFromBookmark = "bookmark"
+	DeltaFromCheckLog = "check log"
+	DeltaFromHistory  = "daily history"
+	DeltaFromStart    = "start"
+)
+
+// StatsMark is a bookmark of the witness's running totals, saved by
+// gt witness since --mark so a later call can show what changed.
+type StatsMark struct {
+	At          time.Time             `json:"at"`
+	Checks      int                   `json:"checks"`
+	Nudges      int                   `json:"nudges"`
+	Escalations int                   `json:"escalations"`
+	PerPolecat  map[string]PolecatDay `json:"per_polecat,omitempty"`
+}
+
+// StatsDelta is how much monitoring happened in a window ending now.
+type StatsDelta struct {
+	Since       time.Time             `json:"since"`
+	Until       time.Time             `json:"until"`
+	Source      string                `json:"source"`
+	Checks      int                   `json:"checks"`
+	Nudges      int                   `json:"nudges"`
+	Escalations int                   `json:"escalations"`
+	PerPolecat  map[string]PolecatDay `json:"per_polecat,omitempty"`
+}
+
+// add counts one polecat's numbers into the delta.
+func (d *StatsDelta) add(name string, pd PolecatDay) {
+	if pd == (PolecatDay{}) {
+		return
+	}
+	if d.PerPolecat == nil {
+		d.PerPolecat = make(map[string]PolecatDay)
+	}
+	sum := d.PerPolecat[name]
+	sum.Checks += pd.Checks
+	sum.Nudges += pd.Nudges
+	sum.Escalations += pd.Escalations
+	d.PerPolecat[name] = sum
+}
+
+// Mark bookmarks the current running totals and returns the bookmark it
+// replaced, if any.
+func (m *Manager) Mark(now time.Time) (*StatsMark, error) {
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+	prev := w.SinceMark
+	mark := w.Stats.mark(now)
+	w.SinceMark = mark
+	if err := m.saveState(w); err != nil {
+		return nil, err
+	}
+	return prev, nil
+}
+
+// mark bookmarks the running totals at now.
+func (s *WitnessStats) mark(now time.Time) *StatsMark {
+	mark := &StatsMark{
+		At:          now,
+		Checks:      s.TotalChecks,
+		Nudges:      s.TotalNudges,
+		Escalations: s.TotalEscalations,
+	}
+	for name, ps := range s.PerPolecat {
+		if mark.PerPolecat == nil {
+			mark.PerPolecat = make(map[string]PolecatDay)
+		}
+		mark.PerPolecat[name] = PolecatDay{Checks: ps.Checks, Nudges: ps.Nudges, Escalations: ps.Escalations}
+	}
+	return mark
+}
+
+// SinceMark returns what changed since the bookmark, from the running
+// totals. Returns nil if there is no bookmark.
+func (m *Manager) SinceMark(now time.Time) (*StatsDelta, error) {
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+	if w.SinceMark == nil {
+		return nil, nil
+	}
+	return deltaFromMark(w.SinceMark, &w.Stats, now), nil
+}
+
+// deltaFromMark diffs the running totals against a bookmark.
+func deltaFromMark(mark *StatsMark, s *WitnessStats, now time.Time) *StatsDelta {
+	d := &StatsDelta{
+		Since:       mark.At,
+		Until:       now,
+		Source:      DeltaFromBookmark,
+		Checks:      s.TotalChecks - mark.Checks,
+		Nudges:      s.TotalNudges - mark.Nudges,
+		Escalations: s.TotalEscalations - mark.Escalations,
+	}
+	for name, ps := range s.PerPolecat {
+		was := mark.PerPolecat[name]
+		d.add(name, PolecatDay{
+			Checks:      ps.Checks - was.Checks,
+			Nudges:      ps.Nudges - was.Nudges,
+			Escalations: ps.Escalations - was.Escalations,
+		})
+	}
+	return d
+}
+
+// Since returns what changed between since and now. The check log gives
+// exact numbers when it reaches back that far; otherwise whole days of
+// the daily history are summed, starting with the day since falls on.
+// Fails when neither goes back far enough. A window reaching back past a
+// stats reset starts at the reset.
+func (m *Manager) Since(since, now time.Time) (*StatsDelta, error) {
+	w, err := m.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing before a stats reset counts; the check log covers the rest
+	covered := false
+	if w.Stats.beforeReset(since) {
+		since = *w.Stats.ResetAt
+		covered = true
+	}
+
+	d := &StatsDelta{Since: since, Until: now, Source: DeltaFromCheckLog}
+	var oldest time.Time
+	passes := make(map[time.Time]bool)
+	path := m.logPath(&w.Config)
+	for _, p := range []string{path + ".1", path} {
+		if err := readCheckLog(p, func(e CheckLogEntry) {
+			if oldest.IsZero() || e.Timestamp.Before(oldest) {
+				oldest = e.Timestamp
+			}
+			if e.Timestamp.Before(since) || e.Timestamp.After(now) {
+				return
+			}
+			passes[e.Timestamp] = true
+			pd := PolecatDay{Checks: 1}
+			if e.Action == ActionNudged {
+				pd.Nudges = 1
+			}
+			if e.Escalated {
+				pd.Escalations = 1
+			}
+			d.Nudges += pd.Nudges
+			d.Escalations += pd.Escalations
+			d.add(e.Polecat, pd)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if covered || (!oldest.IsZero() && !oldest.After(since)) {
+		d.Checks = len(passes)
+		return d, nil
+	}
+
+	// The log doesn't go back far enough: fall back to whole days
+	var days []DailySnapshot
+	if w.Stats.StatsDate != "" {
+		w.Stats.rollover(now)
+		days = w.Stats.RecentDays(len(w.Stats.History) + 1)
+	}
+	from := since.Local().Format(dateLayout)
+	if len(days) == 0 || days[0].Date > from {
+		return nil, fmt.Errorf("no witness history for %s reaches back to %s", m.rig.Name, since.Format("2006-01-02 15:04"))
+	}
+	d = &StatsDelta{Since: since, Until: now, Source: DeltaFromHistory}
+	for _, day := range days {
+		if day.Date < from {
+			continue
+		}
+		d.Checks += day.Checks
+		d.Nudges += day.Nudges
+		d.Escalations += day.Escalations
+		for name, pd := range day.PerPolecat {
+			d.add(name, pd)
+		}
+	}
+	return d, nil
+}
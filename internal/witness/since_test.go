@@ -0,0 +1,103 @@
+package witness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestManager_MarkAndSinceMark(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	now := time.Date(2026, 1, 5, 12, 0, 0, 0, time.Local)
+
+	if d, err := mgr.SinceMark(now); err != nil || d != nil {
+		t.Fatalf("SinceMark() without bookmark = %v, %v; want nil, nil", d, err)
+	}
+
+	w, _ := mgr.loadState()
+	w.Stats.TotalChecks, w.Stats.TotalNudges = 10, 2
+	w.Stats.PerPolecat = map[string]PolecatStats{"toast": {Checks: 10, Nudges: 2}}
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+	if prev, err := mgr.Mark(now); err != nil || prev != nil {
+		t.Fatalf("first Mark() = %v, %v", prev, err)
+	}
+
+	w, _ = mgr.loadState()
+	w.Stats.TotalChecks, w.Stats.TotalNudges, w.Stats.TotalEscalations = 16, 5, 1
+	w.Stats.PerPolecat = map[string]PolecatStats{
+		"toast": {Checks: 13, Nudges: 5, Escalations: 1},
+		"nux":   {Checks: 3},
+	}
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := mgr.SinceMark(now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("SinceMark: %v", err)
+	}
+	if d.Source != DeltaFromBookmark || d.Checks != 6 || d.Nudges != 3 || d.Escalations != 1 || !d.Since.Equal(now) {
+		t.Errorf("delta = %+v", d)
+	}
+	if got := d.PerPolecat["toast"]; got != (PolecatDay{Checks: 3, Nudges: 3, Escalations: 1}) {
+		t.Errorf("toast delta = %+v", got)
+	}
+	if got := d.PerPolecat["nux"]; got != (PolecatDay{Checks: 3}) {
+		t.Errorf("nux delta = %+v", got)
+	}
+}
+
+func TestManager_Since(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	base := time.Date(2026, 1, 5, 12, 0, 0, 0, time.Local)
+	now := base.Add(3 * time.Hour)
+
+	if _, err := mgr.Since(base, now); err == nil {
+		t.Fatal("Since() with no history should fail")
+	}
+
+	w, _ := mgr.loadState()
+	w.Stats.StatsDate = "2026-01-05"
+	w.Stats.TodayChecks, w.Stats.TodayNudges = 40, 4
+	w.Stats.History = []DailySnapshot{{Date: "2026-01-04", Checks: 100, Nudges: 9, Escalations: 2}}
+	if err := mgr.saveState(w); err != nil {
+		t.Fatal(err)
+	}
+
+	// The log doesn't exist yet: whole days from the history
+	d, err := mgr.Since(base.Add(-24*time.Hour), now)
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if d.Source != DeltaFromHistory || d.Checks != 140 || d.Nudges != 13 || d.Escalations != 2 {
+		t.Errorf("history delta = %+v", d)
+	}
+
+	cfg := &WitnessConfig{}
+	for i, r := range []*CheckResult{
+		{CheckedAt: base, Polecats: []PolecatCheck{{Name: "toast", Action: ActionNone}}},
+		{CheckedAt: base.Add(time.Hour), Polecats: []PolecatCheck{
+			{Name: "toast", Action: ActionNudged},
+			{Name: "nux", Action: ActionNudged, Escalated: true},
+		}},
+		{CheckedAt: base.Add(2 * time.Hour), Polecats: []PolecatCheck{{Name: "toast", Action: ActionHeld}}},
+	} {
+		if err := mgr.writeCheckLog(mgr.logPath(cfg), logMaxSize(cfg), r); err != nil {
+			t.Fatalf("writeCheckLog %d: %v", i, err)
+		}
+	}
+
+	d, err = mgr.Since(base.Add(30*time.Minute), now)
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if d.Source != DeltaFromCheckLog || d.Checks != 2 || d.Nudges != 2 || d.Escalations != 1 {
+		t.Errorf("check log delta = %+v", d)
+	}
+	if got := d.PerPolecat["nux"]; got != (PolecatDay{Checks: 1, Nudges: 1, Escalations: 1}) {
+		t.Errorf("nux delta = %+v", got)
+	}
+}
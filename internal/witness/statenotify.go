@@ -0,0 +1,54 @@
+package witness
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrStateNotifyUnsupported is returned by NotifyStateChanges where the
+// platform has no file change notifications; callers fall back to polling.
+var ErrStateNotifyUnsupported = errors.New("state change notifications not supported on this platform")
+
+// NotifyStateChanges returns a channel that receives a value shortly after
+// the witness state file is written, until ctx is done. Writes closer
+// together than debounce are coalesced into one notification, so a burst
+// of saves during a check redraws a watcher once rather than flickering.
+//
+// The state file is replaced by rename on every save, so the notification
+// watches its directory, which must exist.
+func (m *Manager) NotifyStateChanges(ctx context.Context, debounce time.Duration) (<-chan struct{}, error) {
+	writes, err := notifyWrites(ctx, m.stateFile())
+	if err != nil {
+		return nil, err
+	}
+	return debounceNotify(ctx, writes, debounce), nil
+}
+
+// debounceNotify forwards a notification once in has been quiet for
+// debounce. The output holds at most one pending notification.
+func debounceNotify(ctx context.Context, in <-chan struct{}, debounce time.Duration) <-chan struct{} {
+	out := make(chan struct{}, 1)
+	go func() {
+		timer := time.NewTimer(debounce)
+		timer.Stop()
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-in:
+				if !ok {
+					return
+				}
+				timer.Reset(debounce)
+			case <-timer.C:
+				select {
+				case out <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,60 @@
+package witness
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+// notifyWrites reports writes to path using inotify on its directory:
+// a rename onto path (an atomic save) or closing it after writing.
+func notifyWrites(ctx context.Context, path string) (<-chan struct{}, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("inotify: %w", err)
+	}
+	// Non-blocking, so reads go through the runtime poller and Close
+	// wakes a pending Read.
+	f := os.NewFile(uintptr(fd), "inotify")
+	dir, name := filepath.Split(path)
+	if _, err := syscall.InotifyAddWatch(fd, dir, syscall.IN_CLOSE_WRITE|syscall.IN_MOVED_TO); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("watching %s: %w", dir, err)
+	}
+
+	out := make(chan struct{}, 1)
+	go func() {
+		<-ctx.Done()
+		_ = f.Close()
+	}()
+	go func() {
+		defer close(out)
+		buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				return
+			}
+			for off := 0; off+syscall.SizeofInotifyEvent <= n; {
+				ev := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[off]))
+				start := off + syscall.SizeofInotifyEvent
+				off = start + int(ev.Len)
+				if off > n {
+					break
+				}
+				if string(bytes.TrimRight(buf[start:off], "\x00")) != name {
+					continue
+				}
+				select {
+				case out <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package witness
+
+import "context"
+
+func notifyWrites(ctx context.Context, path string) (<-chan struct{}, error) {
+	return nil, ErrStateNotifyUnsupported
+}
@@ -0,0 +1,57 @@
+package witness
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestDebounceNotify(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in := make(chan struct{})
+	out := debounceNotify(ctx, in, 50*time.Millisecond)
+	for i := 0; i < 5; i++ {
+		in <- struct{}{}
+	}
+	select {
+	case <-out:
+	case <-time.After(time.Second):
+		t.Fatal("no notification after a burst")
+	}
+	select {
+	case <-out:
+		t.Error("a burst should give one notification")
+	case <-time.After(150 * time.Millisecond):
+	}
+}
+
+func TestNotifyStateChanges(t *testing.T) {
+	mgr := NewManager(&rig.Rig{Name: "testrig", Path: t.TempDir()})
+	if err := mgr.saveState(&Witness{RigName: "testrig"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes, err := mgr.NotifyStateChanges(ctx, 20*time.Millisecond)
+	if errors.Is(err, ErrStateNotifyUnsupported) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatalf("NotifyStateChanges: %v", err)
+	}
+
+	if err := mgr.saveState(&Witness{RigName: "testrig", State: StateRunning}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-changes:
+	case <-time.After(2 * time.Second):
+		t.Fatal("no notification for a state write")
+	}
+}
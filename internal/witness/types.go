@@ -19,6 +19,10 @@ const (
 
 // Witness represents a rig's polecat monitoring agent.
 type Witness struct {
+	// SchemaVersion is the state file format version; older files are
+	// migrated on load (see StateSchemaVersion).
+	SchemaVersion int `json:"schema_version"`
+
 	// RigName is the rig this witness monitors.
 	RigName string `json:"rig_name"`
 
@@ -34,11 +38,268 @@ type Witness struct {
 	// MonitoredPolecats tracks polecats being monitored.
 	MonitoredPolecats []string `json:"monitored_polecats,omitempty"`
 
+	// StaticPolecats is true when polecat discovery is off: the loop keeps
+	// monitoring the MonitoredPolecats recorded at start
+	// (gt witness start --discover=false).
+	StaticPolecats bool `json:"static_polecats,omitempty"`
+
+	// Watched lists polecats added by hand (gt witness watch-add). They stay
+	// monitored even when discovery doesn't find them.
+	Watched []string `json:"watched,omitempty"`
+
+	// Unwatched lists polecats removed by hand (gt witness watch-remove).
+	// Discovery never adds them back.
+	Unwatched []string `json:"unwatched,omitempty"`
+
+	// ExplicitPolecats lists the polecats asked for explicitly: with
+	// discovery off the rig's polecats at start, plus any added by
+	// watch-add, less those removed by watch-remove.
+	ExplicitPolecats []string `json:"explicit_polecats,omitempty"`
+
+	// DiscoveredPolecats lists the polecats discovery last found, before
+	// watch-add and watch-remove are applied. Empty with discovery off.
+	DiscoveredPolecats []string `json:"discovered_polecats,omitempty"`
+
 	// Config contains auto-spawn configuration.
 	Config WitnessConfig `json:"config"`
 
 	// SpawnedIssues tracks which issues have been spawned (to avoid duplicates).
 	SpawnedIssues []string `json:"spawned_issues,omitempty"`
+
+	// Foreground is true when the Go monitoring loop runs in a terminal
+	// (gt witness start --foreground) rather than as a tmux agent session.
+	Foreground bool `json:"foreground,omitempty"`
+
+	// LoopSession is the tmux session the foreground loop runs in, if any
+	// (e.g. a witness group's session). The loop is gone with it.
+	LoopSession string `json:"loop_session,omitempty"`
+
+	// DryRun is true when the witness logs the nudges and escalations it
+	// would make instead of making them (gt witness start --dry-run).
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// Layout is the agent session's pane layout (gt witness start
+	// --layout), kept so daemon restarts bring the session back the same.
+	Layout string `json:"layout,omitempty"`
+
+	// Group names the multi-rig witness group whose loop monitors this rig,
+	// if any (gt witness start rig1 rig2 ...).
+	Group string `json:"group,omitempty"`
+
+	// LastCheckAt is when the monitoring loop last completed a check.
+	LastCheckAt *time.Time `json:"last_check_at,omitempty"`
+
+	// CurrentInterval is how long the monitoring loop waits after its last
+	// check; it only differs from the configured interval in adaptive mode.
+	CurrentInterval time.Duration `json:"current_interval,omitempty"`
+
+	// Stats contains cumulative monitoring statistics.
+	Stats WitnessStats `json:"stats"`
+
+	// StopRequestedAt is set by a graceful stop; the monitoring loop exits
+	// after its current check once it sees it.
+	StopRequestedAt *time.Time `json:"stop_requested_at,omitempty"`
+
+	// SinceMark is the bookmark gt witness since --mark last saved.
+	SinceMark *StatsMark `json:"since_mark,omitempty"`
+
+	// StartMark bookmarks the running totals when the witness last
+	// started, for gt witness status --since-start.
+	StartMark *StatsMark `json:"start_mark,omitempty"`
+
+	// CheckRequestedAt is set by gt witness check; the monitoring loop runs
+	// a check right away instead of waiting for the next interval, and
+	// clears it.
+	CheckRequestedAt *time.Time `json:"check_requested_at,omitempty"`
+
+	// PausedAt is when the witness was paused, if it is paused.
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+
+	// PaneSamples holds the last pane content hash seen for each polecat.
+	PaneSamples map[string]PaneSample `json:"pane_samples,omitempty"`
+
+	// RigNudges holds when the rig's polecats were nudged within the last
+	// hour, oldest first, for RigNudgeBudget.
+	RigNudges []time.Time `json:"rig_nudges,omitempty"`
+
+	// Crashes holds when the agent session was recently found dead and
+	// restarted, for crash-loop detection.
+	Crashes []time.Time `json:"crashes,omitempty"`
+
+	// LastCrashAt is when the agent was last found dead.
+	LastCrashAt *time.Time `json:"last_crash_at,omitempty"`
+
+	// LastCrashReason is the tail of the dead agent's pane, if captured.
+	LastCrashReason string `json:"last_crash_reason,omitempty"`
+
+	// CrashLoopAt is set when repeated crashes tripped crash-loop detection.
+	// The witness stays stopped until started explicitly.
+	CrashLoopAt *time.Time `json:"crash_loop_at,omitempty"`
+
+	// LastError describes why the witness was last stopped by an error.
+	LastError string `json:"last_error,omitempty"`
+
+	// DeliveryErrors holds the escalation delivery failures since the last
+	// successful delivery, oldest first.
+	DeliveryErrors []DeliveryError `json:"delivery_errors,omitempty"`
+}
+
+// WitnessStats contains cumulative witness statistics.
+type WitnessStats struct {
+	// TotalChecks is the number of monitoring passes ever completed.
+	TotalChecks int `json:"total_checks"`
+
+	// TotalNudges is the number of nudges ever sent.
+	TotalNudges int `json:"total_nudges"`
+
+	// TotalEscalations is the number of times a polecat hit the escalation threshold.
+	TotalEscalations int `json:"total_escalations"`
+
+	// TodayChecks is the number of checks completed today.
+	TodayChecks int `json:"today_checks"`
+
+	// TodayNudges is the number of nudges sent today.
+	TodayNudges int `json:"today_nudges"`
+
+	// TotalHeldNudges is the number of nudges held back because the polecat
+	// was nudged less than the minimum nudge interval ago.
+	TotalHeldNudges int `json:"total_held_nudges,omitempty"`
+
+	// TodayHeldNudges is the number of nudges held back today.
+	TodayHeldNudges int `json:"today_held_nudges,omitempty"`
+
+	// TotalBudgetHeldNudges is the number of nudges held back because the
+	// rig's nudge budget was used up.
+	TotalBudgetHeldNudges int `json:"total_budget_held_nudges,omitempty"`
+
+	// TotalWouldNudges is the number of nudges a dry run held back.
+	TotalWouldNudges int `json:"total_would_nudges,omitempty"`
+
+	// TodayWouldNudges is the number of nudges a dry run held back today.
+	TodayWouldNudges int `json:"today_would_nudges,omitempty"`
+
+	// TotalWouldEscalations is the number of escalations a dry run held back.
+	TotalWouldEscalations int `json:"total_would_escalations,omitempty"`
+
+	// TodayEscalations is the number of polecats escalated today.
+	TodayEscalations int `json:"today_escalations,omitempty"`
+
+	// StatsDate is the local date (YYYY-MM-DD) the Today* counters belong to.
+	StatsDate string `json:"stats_date,omitempty"`
+
+	// History holds a snapshot of each past day's counters, oldest first,
+	// capped at WitnessConfig.HistoryDays days.
+	History []DailySnapshot `json:"history,omitempty"`
+
+	// PerPolecat breaks the counters down by polecat name.
+	PerPolecat map[string]PolecatStats `json:"per_polecat,omitempty"`
+
+	// ResetAt is when the stats were last reset (gt witness reset-stats).
+	// Check log entries from before it are left out of exports and deltas.
+	ResetAt *time.Time `json:"reset_at,omitempty"`
+}
+
+// PolecatStats contains monitoring statistics for a single polecat.
+type PolecatStats struct {
+	// Checks is the number of monitoring passes that checked this polecat.
+	Checks int `json:"checks"`
+
+	// Nudges is the number of nudges sent to this polecat.
+	Nudges int `json:"nudges"`
+
+	// Escalations is the number of times this polecat hit the escalation threshold.
+	Escalations int `json:"escalations"`
+
+	// LastActiveAt is the last time the polecat was seen active.
+	LastActiveAt *time.Time `json:"last_active_at,omitempty"`
+
+	// SessionStartedAt is when the polecat's tmux session was created, as
+	// of the last check, for the startup grace.
+	SessionStartedAt *time.Time `json:"session_started_at,omitempty"`
+
+	// LastState is the state the last monitoring pass classified the
+	// polecat as.
+	LastState PolecatState `json:"last_state,omitempty"`
+
+	// ConsecutiveNudges counts nudges since the polecat last made progress.
+	ConsecutiveNudges int `json:"consecutive_nudges,omitempty"`
+
+	// HeldNudges is the number of nudges held back from this polecat by the
+	// minimum nudge interval.
+	HeldNudges int `json:"held_nudges,omitempty"`
+
+	// BudgetHeldNudges is the number of nudges held back from this polecat
+	// by the rig's nudge budget.
+	BudgetHeldNudges int `json:"budget_held_nudges,omitempty"`
+
+	// LastNudgeAt is when the polecat was last nudged.
+	LastNudgeAt *time.Time `json:"last_nudge_at,omitempty"`
+
+	// RecentNudges holds the times of the current run of unanswered nudges.
+	RecentNudges []time.Time `json:"recent_nudges,omitempty"`
+
+	// LastAction is the last thing the loop did about the polecat, or held
+	// back from doing, and why.
+	LastAction *PolecatAction `json:"last_action,omitempty"`
+
+	// EscalationBead is the town-level bead the polecat was last escalated in.
+	EscalationBead string `json:"escalation_bead,omitempty"`
+
+	// LastEscalatedAt is when an escalation of the polecat was last
+	// delivered in its current stuck episode; further ones wait out the
+	// escalation cooldown.
+	LastEscalatedAt *time.Time `json:"last_escalated_at,omitempty"`
+
+	// SuppressedEscalations is the number of escalations held back by the
+	// escalation cooldown.
+	SuppressedEscalations int `json:"suppressed_escalations,omitempty"`
+
+	// WouldNudges is the number of nudges a dry run held back from this polecat.
+	WouldNudges int `json:"would_nudges,omitempty"`
+
+	// WouldEscalations is the number of escalations a dry run held back.
+	WouldEscalations int `json:"would_escalations,omitempty"`
+
+	// WouldStreak counts dry-run nudges since the polecat last made progress.
+	WouldStreak int `json:"would_streak,omitempty"`
+
+	// Stale is true when the polecat is no longer monitored; its stats are
+	// kept for the record.
+	Stale bool `json:"stale,omitempty"`
+
+	// Today holds this polecat's counters for WitnessStats.StatsDate, for
+	// the daily history.
+	Today PolecatDay `json:"today"`
+}
+
+// PolecatAction is an action the monitoring loop took about a polecat: a
+// nudge, or a nudge held, suppressed or skipped (see the Action constants).
+type PolecatAction struct {
+	Action string    `json:"action"`
+	At     time.Time `json:"at"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// DailySnapshot records one day's witness counters.
+type DailySnapshot struct {
+	// Date is the local date (YYYY-MM-DD) the counters belong to.
+	Date string `json:"date"`
+
+	Checks      int `json:"checks"`
+	Nudges      int `json:"nudges"`
+	Escalations int `json:"escalations"`
+	HeldNudges  int `json:"held_nudges,omitempty"`
+	WouldNudges int `json:"would_nudges,omitempty"`
+
+	// PerPolecat breaks the day's counters down by polecat name.
+	PerPolecat map[string]PolecatDay `json:"per_polecat,omitempty"`
+}
+
+// PolecatDay counts one polecat's checks, nudges and escalations in a day.
+type PolecatDay struct {
+	Checks      int `json:"checks,omitempty"`
+	Nudges      int `json:"nudges,omitempty"`
+	Escalations int `json:"escalations,omitempty"`
 }
 
 // WitnessConfig contains configuration for the witness.
@@ -57,6 +318,122 @@ type WitnessConfig struct {
 
 	// IssuePrefix limits spawning to issues with this prefix (optional).
 	IssuePrefix string `json:"issue_prefix,omitempty"`
-}
 
+	// QuietDates lists dates on which the witness observes but does not act.
+	// Entries are YYYY-MM-DD dates or weekday rules ("sat", "sunday", "weekends").
+	QuietDates []string `json:"quiet_dates,omitempty"`
 
+	// QuietDatesFile is a calendar of quiet dates: an ICS file, whose
+	// all-day events make whole days quiet and timed events just their
+	// hours, or a plain list with one YYYY-MM-DD date per line.
+	QuietDatesFile string `json:"quiet_dates_file,omitempty"`
+
+	// QuietHours is a daily window (e.g. overnight) in which the witness
+	// observes but does not act.
+	QuietHours *QuietHours `json:"quiet_hours,omitempty"`
+
+	// IdleThreshold is how long a polecat can go without activity before it
+	// is considered idle (default: 10m).
+	IdleThreshold time.Duration `json:"idle_threshold,omitempty"`
+
+	// StuckThreshold is how long a polecat can go without activity before it
+	// is considered stuck and nudged (default: 30m).
+	StuckThreshold time.Duration `json:"stuck_threshold,omitempty"`
+
+	// StartupGrace is how long after its session starts a polecat is left
+	// to spin up: it is neither nudged nor escalated, however quiet
+	// (default: 0, no grace).
+	StartupGrace time.Duration `json:"startup_grace,omitempty"`
+
+	// MinNudgeInterval is the least time between two nudges to the same
+	// polecat; a stuck polecat isn't nudged again sooner (default: 5m).
+	MinNudgeInterval time.Duration `json:"min_nudge_interval,omitempty"`
+
+	// EscalationThreshold is how many consecutive unanswered nudges escalate
+	// a polecat to the mayor via a town-level bead (default: 3).
+	EscalationThreshold int `json:"escalation_threshold,omitempty"`
+
+	// RigNudgeBudget caps the nudges sent to all the rig's polecats
+	// together in any rolling hour; further nudges are held back, though
+	// escalations still go out (default: 0, no cap).
+	RigNudgeBudget int `json:"rig_nudge_budget,omitempty"`
+
+	// EscalationCooldown is how long a polecat that stays stuck after being
+	// escalated goes before it is escalated again (default: 30m).
+	EscalationCooldown time.Duration `json:"escalation_cooldown,omitempty"`
+
+	// NudgeTemplate is a text/template for the message sent to stuck
+	// polecats, with fields .Polecat, .Rig and .IdleFor (default: a check-in
+	// pointing at gt hook and witness mail).
+	NudgeTemplate string `json:"nudge_template,omitempty"`
+
+	// LogFile overrides where the JSON check log is written
+	// (default: witness.log beside the state file).
+	LogFile string `json:"log_file,omitempty"`
+
+	// LogMaxSizeMB is the size at which the check log is rotated (default: 10).
+	LogMaxSizeMB int `json:"log_max_size_mb,omitempty"`
+
+	// CheckInterval is the time between monitoring passes of the Go loop
+	// (default: 1m). In adaptive mode it is where the interval starts.
+	CheckInterval time.Duration `json:"check_interval,omitempty"`
+
+	// AdaptiveInterval lets the loop back off the interval while nothing is
+	// happening and speed it up on activity or nudges, between
+	// MinCheckInterval (default: 15s) and MaxCheckInterval (default: 10m).
+	AdaptiveInterval bool          `json:"adaptive_interval,omitempty"`
+	MinCheckInterval time.Duration `json:"min_check_interval,omitempty"`
+	MaxCheckInterval time.Duration `json:"max_check_interval,omitempty"`
+
+	// HistoryDays is how many past days of stats are kept in the daily
+	// history (default: 30).
+	HistoryDays int `json:"history_days,omitempty"`
+
+	// WebhookURL is an http(s) URL escalations are POSTed to as JSON,
+	// e.g. a Slack incoming webhook.
+	WebhookURL string `json:"webhook_url,omitempty"`
+
+	// EscalationDelivery is where escalations go: "beads", "webhook" or
+	// "both" (default: beads, or both when WebhookURL is set).
+	EscalationDelivery string `json:"escalation_delivery,omitempty"`
+
+	// CrashLoopThreshold is how many agent crashes within 30 minutes stop
+	// the witness instead of restarting it again (default: 3, minimum: 2).
+	CrashLoopThreshold int `json:"crash_loop_threshold,omitempty"`
+
+	// NudgeAllowlist and NudgeDenylist are glob patterns on polecat names
+	// limiting which polecats the witness may nudge or escalate; the rest
+	// are observe-only (see ObserveOnly). An empty allowlist allows all.
+	NudgeAllowlist []string `json:"nudge_allowlist,omitempty"`
+	NudgeDenylist  []string `json:"nudge_denylist,omitempty"`
+
+	// AwaitingInputPattern, InToolPattern and ErroredPattern are regular
+	// expressions matched against the bottom of a quiet polecat's pane to
+	// tell a polecat waiting on a human, running a tool call or showing an
+	// error from a stuck one; such polecats aren't nudged (default: the
+	// Default*Pattern constants).
+	AwaitingInputPattern string `json:"awaiting_input_pattern,omitempty"`
+	InToolPattern        string `json:"in_tool_pattern,omitempty"`
+	ErroredPattern       string `json:"errored_pattern,omitempty"`
+
+	// AgentCommand replaces the command that launches the witness agent
+	// (default: the rig's configured runtime). It runs as-is after the
+	// agent environment is exported, so refer to the rig as "$GT_RIG"
+	// rather than writing its name into the command.
+	AgentCommand string `json:"agent_command,omitempty"`
+
+	// Env lists extra KEY=VALUE variables for the witness agent session,
+	// such as API keys or model overrides, on top of GT_ROLE, GT_RIG and
+	// the rest of the agent environment. gt witness start --env wins over
+	// an entry for the same key.
+	Env []string `json:"env,omitempty"`
+
+	// ReadyMarker is text a line of the witness agent's pane starts with
+	// once it is ready for input; the startup nudges wait for it (default:
+	// the runtime's ready prompt, "> " for Claude).
+	ReadyMarker string `json:"ready_marker,omitempty"`
+
+	// filled maps the keys the config files filled in on load to the
+	// values they were given; see Manager.applyFileConfig. Not saved.
+	filled map[string]string
+}
@@ -0,0 +1,37 @@
+package witness
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// noteSessionStart records when a polecat's session was created, from its
+// uptime, so a freshly started polecat gets the startup grace. tmux is only
+// asked while a grace is configured; a polecat without a session has no
+// start to remember.
+func (ps *PolecatStats) noteSessionStart(t tmux.Client, pc PolecatCheck, grace time.Duration, now time.Time) {
+	if pc.State == PolecatGone {
+		ps.SessionStartedAt = nil
+		return
+	}
+	if grace <= 0 {
+		return
+	}
+	if uptime, err := t.SessionUptime(pc.Session); err == nil {
+		started := now.Add(-uptime).Truncate(time.Second)
+		ps.SessionStartedAt = &started
+	}
+}
+
+// WarmupLeft returns how much of the startup grace the polecat has left at
+// now, or 0 once its session is older than grace.
+func (ps PolecatStats) WarmupLeft(now time.Time, grace time.Duration) time.Duration {
+	if ps.SessionStartedAt == nil || grace <= 0 {
+		return 0
+	}
+	if left := ps.SessionStartedAt.Add(grace).Sub(now); left > 0 {
+		return left
+	}
+	return 0
+}
@@ -0,0 +1,60 @@
+package witness
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolecatStats_WarmupLeft(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	started := now.Add(-2 * time.Minute)
+	ps := PolecatStats{SessionStartedAt: &started}
+
+	if got := ps.WarmupLeft(now, 5*time.Minute); got != 3*time.Minute {
+		t.Errorf("WarmupLeft = %s, want 3m", got)
+	}
+	if got := ps.WarmupLeft(now, time.Minute); got != 0 {
+		t.Errorf("WarmupLeft past the grace = %s, want 0", got)
+	}
+	if got := ps.WarmupLeft(now, 0); got != 0 {
+		t.Errorf("WarmupLeft without a grace = %s, want 0", got)
+	}
+	if got := (PolecatStats{}).WarmupLeft(now, 5*time.Minute); got != 0 {
+		t.Errorf("WarmupLeft with no session start = %s, want 0", got)
+	}
+}
+
+func TestCheck_StartupGrace(t *testing.T) {
+	nudger := &fakeNudger{}
+	mgr := stuckPolecatManager(t, nudger)
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) { c.StartupGrace = time.Hour }); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	pc := result.Polecats[0]
+	if pc.State != PolecatStuck || pc.Action != ActionWarmingUp {
+		t.Errorf("check = %s/%s, want a stuck polecat left warming up", pc.State, pc.Action)
+	}
+	if len(nudger.nudged) != 0 {
+		t.Errorf("nudged %v during the startup grace", nudger.nudged)
+	}
+	w, _ := mgr.Status()
+	if left := w.Stats.PerPolecat["toast"].WarmupLeft(time.Now(), time.Hour); left < 58*time.Minute {
+		t.Errorf("WarmupLeft = %s, want most of the hour", left)
+	}
+
+	// Once the session is older than the grace, the polecat is nudged
+	if err := mgr.UpdateConfig(func(c *WitnessConfig) { c.StartupGrace = time.Nanosecond }); err != nil {
+		t.Fatalf("UpdateConfig: %v", err)
+	}
+	if result, err = mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if pc := result.Polecats[0]; pc.Action != ActionNudged {
+		t.Errorf("action after the grace = %s, want %s", pc.Action, ActionNudged)
+	}
+}
@@ -0,0 +1,147 @@
+package witness
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// Errors from changing the monitored set by hand.
+var (
+	ErrAlreadyWatched = errors.New("polecat already watched")
+	ErrNotWatched     = errors.New("polecat not watched")
+)
+
+// WatchAdd adds a polecat to the monitored set. The polecat must have a
+// tmux session. The monitoring loop reloads state every check, so a
+// running witness picks the change up on its next pass.
+func (m *Manager) WatchAdd(polecat string) error {
+	sessionName := session.PolecatSessionName(m.rig.Name, polecat)
+	if running, err := m.tmux.HasSession(sessionName); err != nil {
+		return fmt.Errorf("checking session %s: %w", sessionName, err)
+	} else if !running {
+		return fmt.Errorf("polecat %q has no tmux session (%s)", polecat, sessionName)
+	}
+
+	return m.updateState(func(w *Witness) error {
+		monitored, _ := m.monitoredPolecats(w)
+		if contains(monitored, polecat) {
+			return ErrAlreadyWatched
+		}
+
+		w.Unwatched = without(w.Unwatched, polecat)
+		if !contains(w.Watched, polecat) {
+			w.Watched = append(w.Watched, polecat)
+		}
+		if !contains(w.ExplicitPolecats, polecat) {
+			w.ExplicitPolecats = append(w.ExplicitPolecats, polecat)
+		}
+		w.MonitoredPolecats = applyWatchList(monitored, w)
+		return nil
+	})
+}
+
+// WatchRemove drops a polecat from the monitored set, even if discovery
+// would find it again. Its stats are kept and marked stale.
+func (m *Manager) WatchRemove(polecat string) error {
+	return m.updateState(func(w *Witness) error {
+		monitored, _ := m.monitoredPolecats(w)
+		if !contains(monitored, polecat) {
+			return ErrNotWatched
+		}
+
+		w.Watched = without(w.Watched, polecat)
+		w.ExplicitPolecats = without(w.ExplicitPolecats, polecat)
+		if !contains(w.Unwatched, polecat) {
+			w.Unwatched = append(w.Unwatched, polecat)
+		}
+		w.MonitoredPolecats = applyWatchList(monitored, w)
+		w.Stats.markStale(w.MonitoredPolecats)
+		return nil
+	})
+}
+
+// PolecatSource says why a polecat shows up in a witness's polecat list.
+type PolecatSource string
+
+const (
+	// SourceExplicit marks a polecat asked for explicitly: one of the
+	// rig's polecats with discovery off, or added by watch-add.
+	SourceExplicit PolecatSource = "explicit"
+
+	// SourceDiscovered marks a polecat monitored because discovery found
+	// its tmux session.
+	SourceDiscovered PolecatSource = "discovered"
+
+	// SourceStale marks a polecat no longer monitored whose stats are
+	// kept.
+	SourceStale PolecatSource = "stale"
+)
+
+// SourcedPolecat is a polecat with the reason it is listed.
+type SourcedPolecat struct {
+	Name   string        `json:"name"`
+	Source PolecatSource `json:"source"`
+}
+
+// PolecatSources lists the monitored polecats, each marked explicit or
+// discovered, followed by the stale ones, sorted.
+func (w *Witness) PolecatSources() []SourcedPolecat {
+	out := make([]SourcedPolecat, 0, len(w.MonitoredPolecats))
+	for _, p := range w.MonitoredPolecats {
+		source := SourceDiscovered
+		if w.StaticPolecats || contains(w.ExplicitPolecats, p) || contains(w.Watched, p) {
+			source = SourceExplicit
+		}
+		out = append(out, SourcedPolecat{Name: p, Source: source})
+	}
+	var stale []string
+	for name, ps := range w.Stats.PerPolecat {
+		if ps.Stale && !contains(w.MonitoredPolecats, name) {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+	for _, p := range stale {
+		out = append(out, SourcedPolecat{Name: p, Source: SourceStale})
+	}
+	return out
+}
+
+// applyWatchList reconciles a discovered or fixed set of polecats with the
+// operator's watch-add and watch-remove choices, which always win.
+func applyWatchList(polecats []string, w *Witness) []string {
+	out := make([]string, 0, len(polecats)+len(w.Watched))
+	for _, p := range polecats {
+		if !contains(w.Unwatched, p) && !contains(out, p) {
+			out = append(out, p)
+		}
+	}
+	for _, p := range w.Watched {
+		if !contains(w.Unwatched, p) && !contains(out, p) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func without(list []string, s string) []string {
+	var out []string
+	for _, v := range list {
+		if v != s {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+package witness
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestApplyWatchList(t *testing.T) {
+	w := &Witness{Watched: []string{"max", "toast"}, Unwatched: []string{"nux"}}
+	got := applyWatchList([]string{"nux", "toast", "slit"}, w)
+	if want := []string{"toast", "slit", "max"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("applyWatchList = %v, want %v", got, want)
+	}
+}
+
+func TestMergeCheck_KeepsWatchChanges(t *testing.T) {
+	now := time.Now()
+	checked := &Witness{LastCheckAt: &now, MonitoredPolecats: []string{"toast", "nux"}}
+	checked.Stats.PerPolecat = map[string]PolecatStats{"toast": {Checks: 2}, "nux": {Checks: 2}}
+
+	// watch-remove toast and watch-add max saved while the check ran
+	w := &Witness{Unwatched: []string{"toast"}, Watched: []string{"max"}, ExplicitPolecats: []string{"max"}}
+	w.mergeCheck(checked)
+	if want := []string{"nux", "max"}; !reflect.DeepEqual(w.MonitoredPolecats, want) {
+		t.Errorf("MonitoredPolecats = %v, want %v", w.MonitoredPolecats, want)
+	}
+	if !reflect.DeepEqual(w.Unwatched, []string{"toast"}) || !reflect.DeepEqual(w.Watched, []string{"max"}) {
+		t.Errorf("watch lists = %v / %v, want the changes kept", w.Watched, w.Unwatched)
+	}
+	if ps := w.Stats.PerPolecat["toast"]; ps.Checks != 2 || !ps.Stale {
+		t.Errorf("toast stats = %+v, want kept and marked stale", ps)
+	}
+}
+
+func TestManager_WatchAddRemove(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+	rigName := "watchtest" + strconv.Itoa(os.Getpid())
+	mgr := NewManagerWithNudger(&rig.Rig{Name: rigName, Path: t.TempDir()}, &fakeNudger{})
+	mgr.SetOutput(io.Discard)
+
+	newPolecatSession(t, rigName, "toast")
+	if err := mgr.Start(true, "", nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if _, err := mgr.Check(); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+
+	if w, _ := mgr.Status(); !reflect.DeepEqual(w.DiscoveredPolecats, []string{"toast"}) || len(w.ExplicitPolecats) != 0 {
+		t.Errorf("discovered = %v, explicit = %v; want toast discovered", w.DiscoveredPolecats, w.ExplicitPolecats)
+	}
+
+	if err := mgr.WatchAdd("toast"); !errors.Is(err, ErrAlreadyWatched) {
+		t.Errorf("WatchAdd(discovered) = %v, want ErrAlreadyWatched", err)
+	}
+	if err := mgr.WatchAdd("ghost"); err == nil {
+		t.Error("WatchAdd without a session succeeded")
+	}
+
+	// Removal wins over discovery, and the polecat's stats are kept
+	if err := mgr.WatchRemove("toast"); err != nil {
+		t.Fatalf("WatchRemove: %v", err)
+	}
+	result, err := mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(result.Polecats) != 0 {
+		t.Errorf("checked %+v after watch-remove, want none", result.Polecats)
+	}
+	w, _ := mgr.Status()
+	if ps := w.Stats.PerPolecat["toast"]; ps.Checks != 1 || !ps.Stale {
+		t.Errorf("toast stats = %+v, want the earlier check kept and marked stale", ps)
+	}
+	if err := mgr.WatchRemove("toast"); !errors.Is(err, ErrNotWatched) {
+		t.Errorf("second WatchRemove = %v, want ErrNotWatched", err)
+	}
+
+	if err := mgr.WatchAdd("toast"); err != nil {
+		t.Fatalf("WatchAdd: %v", err)
+	}
+	result, err = mgr.Check()
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(result.Polecats) != 1 || result.Polecats[0].Name != "toast" {
+		t.Errorf("checked %+v after watch-add, want toast", result.Polecats)
+	}
+	w, _ = mgr.Status()
+	if got := w.PolecatSources(); len(got) != 1 || got[0].Source != SourceExplicit {
+		t.Errorf("sources after watch-add = %v, want toast explicit", got)
+	}
+}